@@ -0,0 +1,44 @@
+package bic
+
+import "github.com/mavolin/standards/iso3166"
+
+// Code generated by tools/codegen/bic_bank_codes. DO NOT EDIT.
+
+// completeBankCodeCountries are the countries all of whose bank codes are
+// listed in bankCodeRanges.
+// For all other countries, bankCodeRanges contains at most an excerpt.
+var completeBankCodeCountries = map[iso3166.Alpha2Code]struct{}{}
+
+var bankCodeRanges = map[iso3166.Alpha2Code][]bankCodeRange{
+	iso3166.AT: {
+		{First: "12000", Last: "12000", BIC: BIC{"BKAU", "AT", "WW", "XXX"}},
+		{First: "14000", Last: "14000", BIC: BIC{"BAWA", "AT", "WW", "XXX"}},
+		{First: "14200", Last: "14200", BIC: BIC{"EASY", "AT", "W1", "XXX"}},
+		{First: "20111", Last: "20111", BIC: BIC{"GIBA", "AT", "WW", "XXX"}},
+		{First: "32000", Last: "32000", BIC: BIC{"RLNW", "AT", "WW", "XXX"}},
+		{First: "60000", Last: "60000", BIC: BIC{"OPSK", "AT", "WW", "XXX"}},
+	},
+	iso3166.BE: {
+		{First: "050", Last: "099", BIC: BIC{"GKCC", "BE", "BB", "XXX"}},
+		{First: "200", Last: "299", BIC: BIC{"GEBA", "BE", "BB", "XXX"}},
+		{First: "310", Last: "399", BIC: BIC{"BBRU", "BE", "BB", "XXX"}},
+		{First: "730", Last: "749", BIC: BIC{"KRED", "BE", "BB", "XXX"}},
+	},
+	iso3166.CH: {
+		{First: "00230", Last: "00230", BIC: BIC{"UBSW", "CH", "ZH", "80A"}},
+		{First: "00700", Last: "00700", BIC: BIC{"ZKBK", "CH", "ZZ", "80A"}},
+		{First: "04835", Last: "04835", BIC: BIC{"CRES", "CH", "ZZ", "80A"}},
+		{First: "09000", Last: "09000", BIC: BIC{"POFI", "CH", "BE", "XXX"}},
+	},
+	iso3166.NL: {
+		{First: "ABNA", Last: "ABNA", BIC: BIC{"ABNA", "NL", "2A", "XXX"}},
+		{First: "ASNB", Last: "ASNB", BIC: BIC{"ASNB", "NL", "21", "XXX"}},
+		{First: "BUNQ", Last: "BUNQ", BIC: BIC{"BUNQ", "NL", "2A", "XXX"}},
+		{First: "INGB", Last: "INGB", BIC: BIC{"INGB", "NL", "2A", "XXX"}},
+		{First: "KNAB", Last: "KNAB", BIC: BIC{"KNAB", "NL", "2H", "XXX"}},
+		{First: "RABO", Last: "RABO", BIC: BIC{"RABO", "NL", "2U", "XXX"}},
+		{First: "RBRB", Last: "RBRB", BIC: BIC{"RBRB", "NL", "21", "XXX"}},
+		{First: "SNSB", Last: "SNSB", BIC: BIC{"SNSB", "NL", "2A", "XXX"}},
+		{First: "TRIO", Last: "TRIO", BIC: BIC{"TRIO", "NL", "2U", "XXX"}},
+	},
+}
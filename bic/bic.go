@@ -1,7 +1,14 @@
 // Package bic provides parsing and validation of BIC (ISO 9362) codes.
 package bic
 
-import "encoding"
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"strings"
+
+	"github.com/mavolin/standards/internal/sqlutil"
+)
 
 // BIC is a ISO 9362 Business Identifier Code.
 type BIC struct {
@@ -34,6 +41,98 @@ func (bic *BIC) Compact() string {
 	return bic.String()
 }
 
+// Canonical returns the canonical form of the BIC, in which the parts of the
+// BIC are uppercase, and the primary office is always denoted by the branch
+// code "XXX".
+//
+// Two BICs identify the same office, if their canonical forms are equal.
+// Hence, canonical BICs can be used as map keys.
+func (bic BIC) Canonical() BIC {
+	bic.BusinessPartyPrefix = strings.ToUpper(bic.BusinessPartyPrefix)
+	bic.CountryCode = strings.ToUpper(bic.CountryCode)
+	bic.BusinessPartySuffix = strings.ToUpper(bic.BusinessPartySuffix)
+	bic.BranchCode = strings.ToUpper(bic.BranchCode)
+
+	if bic.BranchCode == "" {
+		bic.BranchCode = "XXX"
+	}
+
+	return bic
+}
+
+// Equal reports whether bic and other identify the same office, i.e. whether
+// their canonical forms are equal.
+//
+// Unlike ==, Equal treats the 8- and 11-character forms of the BIC of a
+// primary office, e.g. "BELADEBE" and "BELADEBEXXX", as equal.
+func (bic BIC) Equal(other BIC) bool {
+	return bic.Canonical() == other.Canonical()
+}
+
+// Institution returns the BIC of the institution the BIC belongs to, i.e.
+// the BIC without its branch code.
+//
+// Two BICs belong to the same institution, if their institutions are
+// [BIC.Equal].
+func (bic BIC) Institution() BIC {
+	bic.BranchCode = ""
+	return bic
+}
+
+// Compact11 returns the 11-character form of the BIC.
+//
+// If the BIC has no branch code, the branch code "XXX" is used.
+func (bic BIC) Compact11() string {
+	if bic.BranchCode == "" {
+		return bic.BusinessPartyPrefix + bic.CountryCode + bic.BusinessPartySuffix + "XXX"
+	}
+
+	return bic.String()
+}
+
+// Compact8 returns the 8-character form of the BIC, i.e. the BIC without its
+// branch code.
+//
+// Note that the 8-character form identifies the primary office of the
+// institution, so for BICs of other branches, see [BIC.IsPrimaryOffice],
+// the returned BIC identifies a different office.
+func (bic BIC) Compact8() string {
+	return bic.BusinessPartyPrefix + bic.CountryCode + bic.BusinessPartySuffix
+}
+
+// IsTest reports whether the BIC is a test BIC, i.e. whether the second
+// character of its business party suffix is '0'.
+//
+// Test BICs are used to test and train SWIFT connectivity and must not be
+// used for production payments.
+func (bic BIC) IsTest() bool {
+	return len(bic.BusinessPartySuffix) == 2 && bic.BusinessPartySuffix[1] == '0'
+}
+
+// IsPassive reports whether the BIC belongs to a passive participant, i.e.
+// whether the second character of its business party suffix is '1'.
+//
+// Passive participants are not connected to the SWIFT network, but can
+// still be reached through other institutions.
+func (bic BIC) IsPassive() bool {
+	return len(bic.BusinessPartySuffix) == 2 && bic.BusinessPartySuffix[1] == '1'
+}
+
+// IsReverseBilling reports whether the BIC uses reverse billing, i.e.
+// whether the second character of its business party suffix is '2'.
+//
+// For reverse billing BICs, the receiver, instead of the sender, pays for the
+// messages sent to it.
+func (bic BIC) IsReverseBilling() bool {
+	return len(bic.BusinessPartySuffix) == 2 && bic.BusinessPartySuffix[1] == '2'
+}
+
+// IsPrimaryOffice reports whether the BIC identifies the primary office of
+// the institution, i.e. whether its branch code is either empty or "XXX".
+func (bic BIC) IsPrimaryOffice() bool {
+	return bic.BranchCode == "" || bic.BranchCode == "XXX"
+}
+
 var _ encoding.TextMarshaler = BIC{}
 
 func (bic BIC) MarshalText() ([]byte, error) {
@@ -51,3 +150,43 @@ func (bic *BIC) UnmarshalText(text []byte) error {
 	*bic = b
 	return nil
 }
+
+var _ sql.Scanner = (*BIC)(nil)
+
+// Scan implements [sql.Scanner] for BICs stored as text, e.g. in a CHAR(11)
+// column.
+// Both the 8- and the 11-character form are accepted.
+//
+// NULL is scanned into the zero BIC.
+func (bic *BIC) Scan(src any) error {
+	s, ok, err := sqlutil.String(src, "bic", "BIC")
+	if err != nil {
+		return err
+	} else if !ok {
+		*bic = BIC{}
+		return nil
+	}
+
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+
+	*bic = parsed
+	return nil
+}
+
+var _ driver.Valuer = BIC{}
+
+// Value implements [driver.Valuer] by storing the BIC without spaces, and
+// with a branch code only if bic has one.
+// Store [BIC.Compact11] instead, if all BICs should have the same length.
+//
+// The zero BIC is stored as NULL.
+func (bic BIC) Value() (driver.Value, error) {
+	if bic == (BIC{}) {
+		return nil, nil
+	}
+
+	return bic.Compact(), nil
+}
@@ -0,0 +1,191 @@
+package bic
+
+import "testing"
+
+func TestBIC_Compact(t *testing.T) {
+	testCases := []struct {
+		In        string
+		Compact11 string
+		Compact8  string
+	}{
+		{In: "DEUTDEFF", Compact11: "DEUTDEFFXXX", Compact8: "DEUTDEFF"},
+		{In: "DEUTDEFFXXX", Compact11: "DEUTDEFFXXX", Compact8: "DEUTDEFF"},
+		{In: "DEUTDEFF500", Compact11: "DEUTDEFF500", Compact8: "DEUTDEFF"},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.In, func(t *testing.T) {
+			bic, err := Parse(c.In)
+			if err != nil {
+				t.Fatalf("Parse(%q): %s", c.In, err)
+			}
+
+			if actual := bic.Compact11(); actual != c.Compact11 {
+				t.Errorf("Compact11: expected %q, got %q", c.Compact11, actual)
+			}
+
+			if actual := bic.Compact8(); actual != c.Compact8 {
+				t.Errorf("Compact8: expected %q, got %q", c.Compact8, actual)
+			}
+		})
+	}
+}
+
+func TestBIC_Is(t *testing.T) {
+	testCases := []struct {
+		In             string
+		Test           bool
+		Passive        bool
+		ReverseBilling bool
+		PrimaryOffice  bool
+	}{
+		{In: "DEUTDEFF", PrimaryOffice: true},
+		{In: "DEUTDEFFXXX", PrimaryOffice: true},
+		{In: "DEUTDEFF500"},
+		{In: "DEUTDEF0", Test: true, PrimaryOffice: true},
+		{In: "MARKDEF1100", Passive: true},
+		{In: "ABNANL2A", PrimaryOffice: true},
+		{In: "ABCDDE22XXX", ReverseBilling: true, PrimaryOffice: true},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.In, func(t *testing.T) {
+			bic, err := Parse(c.In)
+			if err != nil {
+				t.Fatalf("Parse(%q): %s", c.In, err)
+			}
+
+			if actual := bic.IsTest(); actual != c.Test {
+				t.Errorf("IsTest: expected %t, got %t", c.Test, actual)
+			}
+
+			if actual := bic.IsPassive(); actual != c.Passive {
+				t.Errorf("IsPassive: expected %t, got %t", c.Passive, actual)
+			}
+
+			if actual := bic.IsReverseBilling(); actual != c.ReverseBilling {
+				t.Errorf("IsReverseBilling: expected %t, got %t", c.ReverseBilling, actual)
+			}
+
+			if actual := bic.IsPrimaryOffice(); actual != c.PrimaryOffice {
+				t.Errorf("IsPrimaryOffice: expected %t, got %t", c.PrimaryOffice, actual)
+			}
+		})
+	}
+}
+
+func TestBIC_Equal(t *testing.T) {
+	testCases := []struct {
+		A, B   BIC
+		Expect bool
+	}{
+		{A: BIC{"BELA", "DE", "BE", ""}, B: BIC{"BELA", "DE", "BE", "XXX"}, Expect: true},
+		{A: BIC{"BELA", "DE", "BE", "XXX"}, B: BIC{"bela", "de", "be", "xxx"}, Expect: true},
+		{A: BIC{"BELA", "DE", "BE", ""}, B: BIC{"BELA", "DE", "BE", ""}, Expect: true},
+		{A: BIC{"BELA", "DE", "BE", ""}, B: BIC{"BELA", "DE", "BE", "123"}, Expect: false},
+		{A: BIC{"BELA", "DE", "BE", ""}, B: BIC{"DEUT", "DE", "FF", ""}, Expect: false},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.A.String()+"="+c.B.String(), func(t *testing.T) {
+			if actual := c.A.Equal(c.B); actual != c.Expect {
+				t.Errorf("expected %t, got %t", c.Expect, actual)
+			}
+
+			if actual := c.A.Canonical() == c.B.Canonical(); actual != c.Expect {
+				t.Errorf("canonical forms: expected equality %t, got %t", c.Expect, actual)
+			}
+		})
+	}
+}
+
+func TestBIC_Institution(t *testing.T) {
+	a := BIC{"DEUT", "DE", "FF", "500"}
+	b := BIC{"DEUT", "DE", "FF", "XXX"}
+
+	if !a.Institution().Equal(b.Institution()) {
+		t.Errorf("expected %s and %s to belong to the same institution", a, b)
+	}
+
+	if expect := (BIC{"DEUT", "DE", "FF", ""}); a.Institution() != expect {
+		t.Errorf("expected %s, got %s", expect, a.Institution())
+	}
+}
+
+func TestBIC_Scan(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		In     any
+		Expect BIC
+	}{
+		{
+			Name:   "string",
+			In:     "COBADEFFXXX",
+			Expect: BIC{BusinessPartyPrefix: "COBA", CountryCode: "DE", BusinessPartySuffix: "FF", BranchCode: "XXX"},
+		},
+		{
+			Name:   "bytes",
+			In:     []byte("COBADEFF"),
+			Expect: BIC{BusinessPartyPrefix: "COBA", CountryCode: "DE", BusinessPartySuffix: "FF"},
+		},
+		{
+			Name:   "padded",
+			In:     "COBADEFF   ",
+			Expect: BIC{BusinessPartyPrefix: "COBA", CountryCode: "DE", BusinessPartySuffix: "FF"},
+		},
+		{Name: "null", In: nil, Expect: BIC{}},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.Name, func(t *testing.T) {
+			bic := BIC{BusinessPartyPrefix: "BELA", CountryCode: "DE", BusinessPartySuffix: "BE"}
+			if err := bic.Scan(c.In); err != nil {
+				t.Fatalf("Scan(%#v): %s", c.In, err)
+			}
+
+			if bic != c.Expect {
+				t.Errorf("expected %#v, got %#v", c.Expect, bic)
+			}
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		var bic BIC
+		if err := bic.Scan("COBA"); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestBIC_Value(t *testing.T) {
+	for _, s := range []string{"COBADEFF", "COBADEFFXXX", "DEUTDEDB110"} {
+		t.Run(s, func(t *testing.T) {
+			bic, err := Parse(s)
+			if err != nil {
+				t.Fatalf("Parse(%q): %s", s, err)
+			}
+
+			v, err := bic.Value()
+			if err != nil {
+				t.Fatalf("Value: %s", err)
+			}
+			if v != s {
+				t.Errorf("expected %q, got %#v", s, v)
+			}
+
+			var scanned BIC
+			if err := scanned.Scan(v); err != nil {
+				t.Fatalf("Scan(%#v): %s", v, err)
+			}
+			if scanned != bic {
+				t.Errorf("expected %#v after round trip, got %#v", bic, scanned)
+			}
+		})
+	}
+
+	t.Run("null", func(t *testing.T) {
+		if actual, err := (BIC{}).Value(); err != nil || actual != nil {
+			t.Errorf("expected nil, got %#v (err: %v)", actual, err)
+		}
+	})
+}
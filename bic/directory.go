@@ -0,0 +1,191 @@
+package bic
+
+// Code generated by tools/codegen/bic_directory. DO NOT EDIT.
+
+// completeCountries are the countries all of whose BICs are listed in
+// directory.
+// For all other countries, directory contains at most an excerpt.
+var completeCountries = map[string]struct{}{}
+
+var directory = map[BIC]Entry{
+	{"ABNA", "NL", "2A", "XXX"}: {
+		BIC:    BIC{"ABNA", "NL", "2A", "XXX"},
+		Name:   "ABN AMRO Bank N.V.",
+		City:   "Amsterdam",
+		Active: true,
+	},
+	{"ASNB", "NL", "21", "XXX"}: {
+		BIC:    BIC{"ASNB", "NL", "21", "XXX"},
+		Name:   "ASN Bank",
+		City:   "Utrecht",
+		Active: true,
+	},
+	{"BAWA", "AT", "WW", "XXX"}: {
+		BIC:    BIC{"BAWA", "AT", "WW", "XXX"},
+		Name:   "BAWAG P.S.K. Bank für Arbeit und Wirtschaft und Österreichische Postsparkasse AG",
+		City:   "Wien",
+		Active: true,
+	},
+	{"BBRU", "BE", "BB", "XXX"}: {
+		BIC:    BIC{"BBRU", "BE", "BB", "XXX"},
+		Name:   "ING Belgium",
+		City:   "Brussel",
+		Active: true,
+	},
+	{"BKAU", "AT", "WW", "XXX"}: {
+		BIC:    BIC{"BKAU", "AT", "WW", "XXX"},
+		Name:   "UniCredit Bank Austria AG",
+		City:   "Wien",
+		Active: true,
+	},
+	{"BUNQ", "NL", "2A", "XXX"}: {
+		BIC:    BIC{"BUNQ", "NL", "2A", "XXX"},
+		Name:   "bunq B.V.",
+		City:   "Amsterdam",
+		Active: true,
+	},
+	{"BYLA", "DE", "M1", "001"}: {
+		BIC:    BIC{"BYLA", "DE", "M1", "001"},
+		Name:   "Deutsche Kreditbank Berlin",
+		City:   "Berlin",
+		Active: true,
+	},
+	{"COBA", "DE", "FF", "XXX"}: {
+		BIC:    BIC{"COBA", "DE", "FF", "XXX"},
+		Name:   "Commerzbank",
+		City:   "Frankfurt am Main",
+		Active: true,
+	},
+	{"CRES", "CH", "ZZ", "80A"}: {
+		BIC:    BIC{"CRES", "CH", "ZZ", "80A"},
+		Name:   "Credit Suisse (Schweiz) AG",
+		City:   "Zürich",
+		Active: true,
+	},
+	{"DEUT", "DE", "FF", "XXX"}: {
+		BIC:    BIC{"DEUT", "DE", "FF", "XXX"},
+		Name:   "Deutsche Bank",
+		City:   "Frankfurt am Main",
+		Active: true,
+	},
+	{"DRES", "DE", "FF", "XXX"}: {
+		BIC:    BIC{"DRES", "DE", "FF", "XXX"},
+		Name:   "Dresdner Bank",
+		City:   "Frankfurt am Main",
+		Active: false,
+	},
+	{"EASY", "AT", "W1", "XXX"}: {
+		BIC:    BIC{"EASY", "AT", "W1", "XXX"},
+		Name:   "easybank AG",
+		City:   "Wien",
+		Active: true,
+	},
+	{"GEBA", "BE", "BB", "XXX"}: {
+		BIC:    BIC{"GEBA", "BE", "BB", "XXX"},
+		Name:   "BNP Paribas Fortis",
+		City:   "Brussel",
+		Active: true,
+	},
+	{"GIBA", "AT", "WW", "XXX"}: {
+		BIC:    BIC{"GIBA", "AT", "WW", "XXX"},
+		Name:   "Erste Bank der oesterreichischen Sparkassen AG",
+		City:   "Wien",
+		Active: true,
+	},
+	{"GKCC", "BE", "BB", "XXX"}: {
+		BIC:    BIC{"GKCC", "BE", "BB", "XXX"},
+		Name:   "Belfius Bank",
+		City:   "Brussel",
+		Active: true,
+	},
+	{"INGB", "NL", "2A", "XXX"}: {
+		BIC:    BIC{"INGB", "NL", "2A", "XXX"},
+		Name:   "ING Bank N.V.",
+		City:   "Amsterdam",
+		Active: true,
+	},
+	{"INGD", "DE", "FF", "XXX"}: {
+		BIC:    BIC{"INGD", "DE", "FF", "XXX"},
+		Name:   "ING-DiBa",
+		City:   "Frankfurt am Main",
+		Active: true,
+	},
+	{"KNAB", "NL", "2H", "XXX"}: {
+		BIC:    BIC{"KNAB", "NL", "2H", "XXX"},
+		Name:   "Knab",
+		City:   "Den Haag",
+		Active: true,
+	},
+	{"KRED", "BE", "BB", "XXX"}: {
+		BIC:    BIC{"KRED", "BE", "BB", "XXX"},
+		Name:   "KBC Bank",
+		City:   "Brussel",
+		Active: true,
+	},
+	{"MARK", "DE", "F1", "100"}: {
+		BIC:    BIC{"MARK", "DE", "F1", "100"},
+		Name:   "Deutsche Bundesbank Filiale Berlin",
+		City:   "Berlin",
+		Active: true,
+	},
+	{"MARK", "DE", "FF", "XXX"}: {
+		BIC:    BIC{"MARK", "DE", "FF", "XXX"},
+		Name:   "Deutsche Bundesbank",
+		City:   "Frankfurt am Main",
+		Active: true,
+	},
+	{"OPSK", "AT", "WW", "XXX"}: {
+		BIC:    BIC{"OPSK", "AT", "WW", "XXX"},
+		Name:   "BAWAG P.S.K. Bank für Arbeit und Wirtschaft und Österreichische Postsparkasse AG",
+		City:   "Wien",
+		Active: true,
+	},
+	{"POFI", "CH", "BE", "XXX"}: {
+		BIC:    BIC{"POFI", "CH", "BE", "XXX"},
+		Name:   "PostFinance AG",
+		City:   "Bern",
+		Active: true,
+	},
+	{"RABO", "NL", "2U", "XXX"}: {
+		BIC:    BIC{"RABO", "NL", "2U", "XXX"},
+		Name:   "Coöperatieve Rabobank U.A.",
+		City:   "Utrecht",
+		Active: true,
+	},
+	{"RBRB", "NL", "21", "XXX"}: {
+		BIC:    BIC{"RBRB", "NL", "21", "XXX"},
+		Name:   "RegioBank",
+		City:   "Utrecht",
+		Active: true,
+	},
+	{"RLNW", "AT", "WW", "XXX"}: {
+		BIC:    BIC{"RLNW", "AT", "WW", "XXX"},
+		Name:   "Raiffeisenlandesbank Niederösterreich-Wien AG",
+		City:   "Wien",
+		Active: true,
+	},
+	{"SNSB", "NL", "2A", "XXX"}: {
+		BIC:    BIC{"SNSB", "NL", "2A", "XXX"},
+		Name:   "SNS",
+		City:   "Utrecht",
+		Active: true,
+	},
+	{"TRIO", "NL", "2U", "XXX"}: {
+		BIC:    BIC{"TRIO", "NL", "2U", "XXX"},
+		Name:   "Triodos Bank N.V.",
+		City:   "Zeist",
+		Active: true,
+	},
+	{"UBSW", "CH", "ZH", "80A"}: {
+		BIC:    BIC{"UBSW", "CH", "ZH", "80A"},
+		Name:   "UBS Switzerland AG",
+		City:   "Zürich",
+		Active: true,
+	},
+	{"ZKBK", "CH", "ZZ", "80A"}: {
+		BIC:    BIC{"ZKBK", "CH", "ZZ", "80A"},
+		Name:   "Zürcher Kantonalbank",
+		City:   "Zürich",
+		Active: true,
+	},
+}
@@ -0,0 +1,79 @@
+package bic
+
+import "errors"
+
+//go:generate go run github.com/mavolin/standards/tools/codegen/bic_directory
+
+// ErrUnknownBIC is returned by [Lookup], if the BIC is not listed in the
+// directory of its country.
+var ErrUnknownBIC = errors.New("bic: unknown bic")
+
+// Directory looks up BICs in a BIC directory.
+//
+// Implement it to use a directory other than the one embedded in this
+// package, e.g. a commercial SWIFT directory.
+type Directory interface {
+	// LookupBIC returns the directory entry of the passed BIC.
+	//
+	// BICs that are [BIC.Equal] must be treated as the same BIC, e.g. a BIC
+	// without a branch code must be treated as if its branch code was "XXX".
+	//
+	// If the directory doesn't cover the BIC's country, LookupBIC should
+	// return an error wrapping [ErrNoDirectory], and if it doesn't list the
+	// BIC, it should return an error wrapping [ErrUnknownBIC].
+	LookupBIC(BIC) (Entry, error)
+}
+
+// Entry is an entry of a BIC directory.
+type Entry struct {
+	// BIC is the 11-character form of the BIC.
+	BIC BIC
+	// Name is the name of the institution the BIC is assigned to, e.g.
+	// "Commerzbank".
+	Name string
+	// City is the city the institution, or the branch identified by the
+	// BIC's branch code, is located in.
+	//
+	// It may be empty, if the directory of the BIC's country doesn't list
+	// cities.
+	City string
+	// Active indicates whether the BIC is connected, i.e. whether payments
+	// can be routed to it.
+	//
+	// Inactive BICs have been deleted or have expired, but may still appear
+	// in old data.
+	Active bool
+}
+
+// EmbeddedDirectory is a [Directory] that uses the BIC directory embedded in
+// this package.
+//
+// The directory is compiled from the directories published by the central
+// banks or clearing houses of AT, BE, CH, DE, and NL, and only covers BICs of
+// these countries.
+// If a country's directory was not available when this package was built,
+// only an excerpt of its BICs is embedded, and BICs of that country missing
+// from the excerpt are reported as [ErrNoDirectory] instead of
+// [ErrUnknownBIC].
+var EmbeddedDirectory Directory = embeddedDirectory{}
+
+// Lookup looks up the passed BIC in the [EmbeddedDirectory].
+func Lookup(bic BIC) (Entry, error) {
+	return EmbeddedDirectory.LookupBIC(bic)
+}
+
+type embeddedDirectory struct{}
+
+func (embeddedDirectory) LookupBIC(bic BIC) (Entry, error) {
+	bic = bic.Canonical()
+
+	if e, ok := directory[bic]; ok {
+		return e, nil
+	}
+
+	if _, ok := completeCountries[bic.CountryCode]; !ok {
+		return Entry{}, ErrNoDirectory
+	}
+
+	return Entry{}, ErrUnknownBIC
+}
@@ -0,0 +1,128 @@
+package bic
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	successCases := []struct {
+		In     string
+		Expect Entry
+	}{
+		{
+			In: "COBADEFFXXX",
+			Expect: Entry{
+				BIC:    BIC{"COBA", "DE", "FF", "XXX"},
+				Name:   "Commerzbank",
+				City:   "Frankfurt am Main",
+				Active: true,
+			},
+		},
+		{
+			In: "COBADEFF",
+			Expect: Entry{
+				BIC:    BIC{"COBA", "DE", "FF", "XXX"},
+				Name:   "Commerzbank",
+				City:   "Frankfurt am Main",
+				Active: true,
+			},
+		},
+		{
+			In: "ZKBKCHZZ80A",
+			Expect: Entry{
+				BIC:    BIC{"ZKBK", "CH", "ZZ", "80A"},
+				Name:   "Zürcher Kantonalbank",
+				City:   "Zürich",
+				Active: true,
+			},
+		},
+		{
+			In: "DRESDEFF",
+			Expect: Entry{
+				BIC:    BIC{"DRES", "DE", "FF", "XXX"},
+				Name:   "Dresdner Bank",
+				City:   "Frankfurt am Main",
+				Active: false,
+			},
+		},
+	}
+
+	failureCases := []struct {
+		In       string
+		Complete bool
+		Expect   error
+	}{
+		{In: "BNPAFRPPXXX", Expect: ErrNoDirectory},
+		{In: "COBADEFF123", Expect: ErrNoDirectory},
+		{In: "ABCDDEFF", Expect: ErrNoDirectory},
+		{In: "COBADEFF123", Complete: true, Expect: ErrUnknownBIC},
+		{In: "ABCDDEFF", Complete: true, Expect: ErrUnknownBIC},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		for _, c := range successCases {
+			t.Run(c.In, func(t *testing.T) {
+				bic, err := Parse(c.In)
+				if err != nil {
+					t.Fatalf("Parse(%q): %s", c.In, err)
+				}
+
+				actual, err := Lookup(bic)
+				if err != nil {
+					t.Fatalf("Lookup(%q): %s", c.In, err)
+				}
+
+				if actual != c.Expect {
+					t.Errorf("Lookup(%q): expected %+v, got %+v", c.In, c.Expect, actual)
+				}
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		for _, c := range failureCases {
+			name := c.In
+			if c.Complete {
+				name += "/complete"
+			}
+
+			t.Run(name, func(t *testing.T) {
+				bic, err := Parse(c.In)
+				if err != nil {
+					t.Fatalf("Parse(%q): %s", c.In, err)
+				}
+
+				if c.Complete {
+					setComplete(t, bic.CountryCode)
+				}
+
+				if _, err := Lookup(bic); !errors.Is(err, c.Expect) {
+					t.Errorf("Lookup(%q): expected %v, got %v", c.In, c.Expect, err)
+				}
+			})
+		}
+	})
+
+	t.Run("resolvable", func(t *testing.T) {
+		// every BIC the EmbeddedResolver resolves to should be listed
+		for _, ranges := range bankCodeRanges {
+			for _, r := range ranges {
+				if _, err := Lookup(r.BIC); err != nil {
+					t.Errorf("Lookup(%q): %s", r.BIC, err)
+				}
+			}
+		}
+	})
+}
+
+// setComplete marks the directory of the passed country as complete for the
+// duration of the test.
+func setComplete(t *testing.T, country string) {
+	if _, ok := completeCountries[country]; ok {
+		return
+	}
+
+	completeCountries[country] = struct{}{}
+	t.Cleanup(func() { delete(completeCountries, country) })
+}
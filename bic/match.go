@@ -0,0 +1,131 @@
+package bic
+
+import (
+	"github.com/mavolin/standards/iban"
+	"github.com/mavolin/standards/iso3166"
+)
+
+// Match is the result of [MatchesIBAN].
+type Match uint8
+
+const (
+	// CountryMismatch indicates that the BIC belongs to a different country
+	// than the IBAN.
+	CountryMismatch Match = iota + 1
+	// BankCodeMismatch indicates that the BIC and IBAN belong to the same
+	// country, but the BIC belongs to a different institution than the
+	// IBAN's bank code.
+	BankCodeMismatch
+	// CountryMatch indicates that the BIC and IBAN belong to the same
+	// country, but that it is unknown whether the BIC belongs to the
+	// institution identified by the IBAN's bank code, as there is no bank
+	// directory for the country, or because the bank code is not listed in
+	// it.
+	CountryMatch
+	// FullMatch indicates that the BIC belongs to the institution
+	// identified by the IBAN's bank code.
+	FullMatch
+)
+
+// IsMismatch reports whether m is either CountryMismatch or
+// BankCodeMismatch.
+func (m Match) IsMismatch() bool {
+	return m == CountryMismatch || m == BankCodeMismatch
+}
+
+func (m Match) String() string {
+	switch m {
+	case CountryMismatch:
+		return "country mismatch"
+	case BankCodeMismatch:
+		return "bank code mismatch"
+	case CountryMatch:
+		return "country match"
+	case FullMatch:
+		return "full match"
+	default:
+		return "unknown"
+	}
+}
+
+// territories maps territories that use the BICs or IBANs of another country
+// to that country.
+var territories = map[iso3166.Alpha2Code]iso3166.Alpha2Code{
+	// Åland Islands
+	iso3166.AX: iso3166.FI,
+
+	// French overseas departments and collectivities, whose banks are part
+	// of the French banking system, and may use BICs and IBANs of either
+	// France or the territory
+	iso3166.BL: iso3166.FR,
+	iso3166.GF: iso3166.FR,
+	iso3166.GP: iso3166.FR,
+	iso3166.MF: iso3166.FR,
+	iso3166.MQ: iso3166.FR,
+	iso3166.NC: iso3166.FR,
+	iso3166.PF: iso3166.FR,
+	iso3166.PM: iso3166.FR,
+	iso3166.RE: iso3166.FR,
+	iso3166.TF: iso3166.FR,
+	iso3166.WF: iso3166.FR,
+	iso3166.YT: iso3166.FR,
+
+	// Crown Dependencies, which use British IBANs
+	iso3166.GG: iso3166.GB,
+	iso3166.IM: iso3166.GB,
+	iso3166.JE: iso3166.GB,
+}
+
+// MatchesIBAN checks whether the passed BIC plausibly belongs to the bank of
+// the passed IBAN.
+// A mismatch is a common sign of a typo or of fraud.
+//
+// MatchesIBAN first compares the countries of the BIC and the IBAN.
+// Territories that are part of another country's banking system are
+// considered to belong to that country, e.g. a French BIC matches an IBAN
+// from Guadeloupe (GP), and a British BIC matches an IBAN from Jersey (JE).
+//
+// If the countries match, MatchesIBAN resolves the BIC of the IBAN's bank
+// code using the [EmbeddedResolver], and compares the institutions of both
+// BICs, ignoring the branch code.
+// If the BIC of the IBAN can't be resolved, MatchesIBAN returns CountryMatch.
+//
+// Note that some institutions have multiple BICs, so a BankCodeMismatch
+// should be treated as a signal for further inspection rather than as proof
+// that the BIC is wrong.
+func MatchesIBAN(bic BIC, i iban.IBAN) Match {
+	return MatchesIBANWith(EmbeddedResolver, bic, i)
+}
+
+// MatchesIBANWith is the same as [MatchesIBAN], but uses the passed
+// [Resolver] to resolve the BIC of the IBAN's bank code.
+func MatchesIBANWith(r Resolver, bic BIC, i iban.IBAN) Match {
+	country, err := iso3166.ParseAlpha2(bic.CountryCode)
+	if err != nil || !sameBankingSystem(country, i.CountryCode) {
+		return CountryMismatch
+	}
+
+	resolved, err := r.ResolveBIC(i)
+	if err != nil {
+		return CountryMatch
+	}
+
+	if !bic.Institution().Equal(resolved.Institution()) {
+		return BankCodeMismatch
+	}
+
+	return FullMatch
+}
+
+// sameBankingSystem reports whether a and b are the same country, or
+// territories using the same banking system.
+func sameBankingSystem(a, b iso3166.Alpha2Code) bool {
+	if parent, ok := territories[a]; ok {
+		a = parent
+	}
+	if parent, ok := territories[b]; ok {
+		b = parent
+	}
+
+	return a == b
+}
@@ -0,0 +1,94 @@
+package bic
+
+import (
+	"testing"
+
+	"github.com/mavolin/standards/iban"
+)
+
+func TestMatchesIBAN(t *testing.T) {
+	testCases := []struct {
+		BIC    string
+		IBAN   string
+		Expect Match
+	}{
+		{BIC: "COBADEFFXXX", IBAN: "DE89370400440532013000", Expect: FullMatch},
+		{BIC: "COBADEFF", IBAN: "DE89370400440532013000", Expect: FullMatch},
+		{BIC: "cobadeff370", IBAN: "DE89370400440532013000", Expect: FullMatch},
+		{BIC: "ABNANL2A", IBAN: "NL91ABNA0417164300", Expect: FullMatch},
+		{BIC: "DEUTDEFFXXX", IBAN: "DE89370400440532013000", Expect: BankCodeMismatch},
+		{BIC: "INGBNL2A", IBAN: "NL91ABNA0417164300", Expect: BankCodeMismatch},
+		{BIC: "BNPAFRPPXXX", IBAN: "FR1420041010050500013M02606", Expect: CountryMatch},
+		{BIC: "BNPAFRPPXXX", IBAN: "DE89370400440532013000", Expect: CountryMismatch},
+		{BIC: "COBADEFFXXX", IBAN: "AT026000000001349870", Expect: CountryMismatch},
+		// territories
+		{BIC: "BNPAFRPPXXX", IBAN: "GP7310107001011234567890129", Expect: CountryMatch},
+		{BIC: "BDAFGPGPXXX", IBAN: "FR1420041010050500013M02606", Expect: CountryMatch},
+		{BIC: "BARCGB22XXX", IBAN: "JE24BARC20000055779911", Expect: CountryMatch},
+		{BIC: "BARCGB22XXX", IBAN: "GG45BARC20000055779911", Expect: CountryMatch},
+		{BIC: "BDAFGPGPXXX", IBAN: "GG45BARC20000055779911", Expect: CountryMismatch},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.BIC+"/"+c.IBAN, func(t *testing.T) {
+			bic, err := Parse(c.BIC)
+			if err != nil {
+				t.Fatalf("Parse(%q): %s", c.BIC, err)
+			}
+
+			i, err := iban.Parse(c.IBAN)
+			if err != nil {
+				t.Fatalf("iban.Parse(%q): %s", c.IBAN, err)
+			}
+
+			if actual := MatchesIBAN(bic, i); actual != c.Expect {
+				t.Errorf("MatchesIBAN(%q, %q): expected %s, got %s", c.BIC, c.IBAN, c.Expect, actual)
+			}
+		})
+	}
+}
+
+// resolverFunc is a Resolver backed by a function.
+type resolverFunc func(iban.IBAN) (BIC, error)
+
+func (f resolverFunc) ResolveBIC(i iban.IBAN) (BIC, error) { return f(i) }
+
+func TestMatchesIBANWith(t *testing.T) {
+	r := resolverFunc(func(i iban.IBAN) (BIC, error) {
+		if i.BankCode != "20041" {
+			return BIC{}, ErrUnknownBankCode
+		}
+		return Parse("PSSTFRPPXXX")
+	})
+
+	testCases := []struct {
+		BIC    string
+		IBAN   string
+		Expect Match
+	}{
+		{BIC: "PSSTFRPPXXX", IBAN: "FR1420041010050500013M02606", Expect: FullMatch},
+		{BIC: "PSSTFRPPMAR", IBAN: "FR1420041010050500013M02606", Expect: FullMatch},
+		{BIC: "BNPAFRPPXXX", IBAN: "FR1420041010050500013M02606", Expect: BankCodeMismatch},
+		{BIC: "BNPAFRPPXXX", IBAN: "FR7630006000011234567890189", Expect: CountryMatch},
+		{BIC: "DEUTDEFFXXX", IBAN: "DE88100900001234567892", Expect: CountryMatch},
+		{BIC: "PSSTFRPPXXX", IBAN: "DE89370400440532013000", Expect: CountryMismatch},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.BIC+"/"+c.IBAN, func(t *testing.T) {
+			bic, err := Parse(c.BIC)
+			if err != nil {
+				t.Fatalf("Parse(%q): %s", c.BIC, err)
+			}
+
+			i, err := iban.Parse(c.IBAN)
+			if err != nil {
+				t.Fatalf("iban.Parse(%q): %s", c.IBAN, err)
+			}
+
+			if actual := MatchesIBANWith(r, bic, i); actual != c.Expect {
+				t.Errorf("MatchesIBANWith(%q, %q): expected %s, got %s", c.BIC, c.IBAN, c.Expect, actual)
+			}
+		})
+	}
+}
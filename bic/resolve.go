@@ -0,0 +1,106 @@
+package bic
+
+import (
+	"errors"
+
+	"github.com/mavolin/standards/de/bankcode"
+	"github.com/mavolin/standards/iban"
+	"github.com/mavolin/standards/iso3166"
+)
+
+//go:generate go run github.com/mavolin/standards/tools/codegen/bic_bank_codes
+
+var (
+	// ErrNoDirectory is returned by [FromIBAN] and [Lookup], if there is no
+	// bank directory for the IBAN's or BIC's country, or if only an excerpt
+	// of it is embedded, and the IBAN's bank code or the BIC is not part of
+	// it.
+	ErrNoDirectory = errors.New("bic: no bank directory available for country")
+	// ErrUnknownBankCode is returned by [FromIBAN], if the IBAN's bank code is
+	// not listed in the bank directory of its country, or if the bank does not
+	// have a BIC.
+	ErrUnknownBankCode = errors.New("bic: unknown bank code")
+)
+
+// Resolver resolves the BIC of the bank an IBAN belongs to.
+//
+// Implement it to use a directory other than the ones embedded in this
+// package, e.g. a commercial SWIFT directory.
+type Resolver interface {
+	// ResolveBIC returns the BIC of the bank the passed IBAN belongs to.
+	//
+	// If the resolver has no directory for the IBAN's country, it should
+	// return an error wrapping [ErrNoDirectory], and if it doesn't know the
+	// IBAN's bank code, it should return an error wrapping
+	// [ErrUnknownBankCode].
+	ResolveBIC(iban.IBAN) (BIC, error)
+}
+
+// EmbeddedResolver is a [Resolver] that uses the bank directories embedded in
+// this package.
+//
+// It supports the following countries:
+//
+//   - AT: bank codes (Bankleitzahlen) of the Oesterreichische Nationalbank
+//   - BE: bank identification codes of the National Bank of Belgium
+//   - CH: institution identifications of SIX Interbank Clearing
+//   - DE: bank codes (Bankleitzahlen) of the Deutsche Bundesbank, see
+//     [bankcode.Resolve]
+//   - NL: bank codes of Betaalvereniging Nederland
+//
+// If a country's directory was not available when this package was built,
+// only an excerpt of it is embedded, and bank codes of that country missing
+// from the excerpt are reported as [ErrNoDirectory] instead of
+// [ErrUnknownBankCode].
+var EmbeddedResolver Resolver = embeddedResolver{}
+
+// FromIBAN derives the BIC of the bank the passed IBAN belongs to, using the
+// [EmbeddedResolver].
+func FromIBAN(i iban.IBAN) (BIC, error) {
+	return EmbeddedResolver.ResolveBIC(i)
+}
+
+type embeddedResolver struct{}
+
+func (embeddedResolver) ResolveBIC(i iban.IBAN) (BIC, error) {
+	if i.CountryCode == iso3166.DE {
+		bank, err := bankcode.Resolve(bankcode.BankCode(i.BankCode))
+		if errors.Is(err, bankcode.ErrIncomplete) {
+			return BIC{}, ErrNoDirectory
+		} else if err != nil {
+			return BIC{}, ErrUnknownBankCode
+		}
+
+		if bank.BIC == "" {
+			return BIC{}, ErrUnknownBankCode
+		}
+
+		return Parse(bank.BIC)
+	}
+
+	ranges, ok := bankCodeRanges[i.CountryCode]
+	if !ok {
+		return BIC{}, ErrNoDirectory
+	}
+
+	for _, r := range ranges {
+		if len(i.BankCode) == len(r.First) && i.BankCode >= r.First && i.BankCode <= r.Last {
+			return r.BIC, nil
+		}
+	}
+
+	if _, ok := completeBankCodeCountries[i.CountryCode]; !ok {
+		return BIC{}, ErrNoDirectory
+	}
+
+	return BIC{}, ErrUnknownBankCode
+}
+
+// bankCodeRange is a range of national bank codes that all belong to the
+// same BIC.
+type bankCodeRange struct {
+	// First and Last are the first and last bank code of the range, both
+	// inclusive.
+	First, Last string
+	BIC         BIC
+}
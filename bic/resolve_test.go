@@ -0,0 +1,90 @@
+package bic
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mavolin/standards/iban"
+	"github.com/mavolin/standards/iso3166"
+)
+
+func TestFromIBAN(t *testing.T) {
+	successCases := []struct {
+		In     string
+		Expect string
+	}{
+		{In: "DE89370400440532013000", Expect: "COBADEFFXXX"},
+		{In: "DE02500105170137075030", Expect: "INGDDEFFXXX"},
+		{In: "AT026000000001349870", Expect: "OPSKATWWXXX"},
+		{In: "CH0209000000100013997", Expect: "POFICHBEXXX"},
+		{In: "CH0200700110000387896", Expect: "ZKBKCHZZ80A"},
+		{In: "BE71096123456769", Expect: "GKCCBEBBXXX"},
+		{In: "NL91ABNA0417164300", Expect: "ABNANL2AXXX"},
+	}
+
+	failureCases := []struct {
+		In       string
+		Complete bool
+		Expect   error
+	}{
+		{In: "FR7630006000011234567890189", Expect: ErrNoDirectory},
+		{In: "DE88100900001234567892", Expect: ErrNoDirectory},
+		{In: "AT309999900001349870", Expect: ErrNoDirectory},
+		{In: "AT309999900001349870", Complete: true, Expect: ErrUnknownBankCode},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		for _, c := range successCases {
+			t.Run(c.In, func(t *testing.T) {
+				i, err := iban.Parse(c.In)
+				if err != nil {
+					t.Fatalf("iban.Parse(%q): %s", c.In, err)
+				}
+
+				bic, err := FromIBAN(i)
+				if err != nil {
+					t.Fatalf("FromIBAN(%q): %s", c.In, err)
+				}
+
+				if bic.String() != c.Expect {
+					t.Errorf("FromIBAN(%q): expected %q, got %q", c.In, c.Expect, bic.String())
+				}
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		for _, c := range failureCases {
+			name := c.In
+			if c.Complete {
+				name += "/complete"
+			}
+
+			t.Run(name, func(t *testing.T) {
+				i, err := iban.Parse(c.In)
+				if err != nil {
+					t.Fatalf("iban.Parse(%q): %s", c.In, err)
+				}
+
+				if c.Complete {
+					setBankCodesComplete(t, i.CountryCode)
+				}
+
+				if _, err := FromIBAN(i); !errors.Is(err, c.Expect) {
+					t.Errorf("FromIBAN(%q): expected %q, got %v", c.In, c.Expect, err)
+				}
+			})
+		}
+	})
+}
+
+// setBankCodesComplete marks the bank codes of the passed country as complete
+// for the duration of the test.
+func setBankCodesComplete(t *testing.T, country iso3166.Alpha2Code) {
+	if _, ok := completeBankCodeCountries[country]; ok {
+		return
+	}
+
+	completeBankCodeCountries[country] = struct{}{}
+	t.Cleanup(func() { delete(completeBankCodeCountries, country) })
+}
@@ -0,0 +1,149 @@
+// Package bankcode provides parsing, validation, and lookup of German bank
+// codes (Bankleitzahlen).
+//
+// Lookups are performed using the Bankleitzahlendatei of the Deutsche
+// Bundesbank, which is embedded at build time.
+// If only an excerpt of it is embedded, bank codes missing from the excerpt
+// are reported as [ErrIncomplete] instead of [ErrUnknown].
+package bankcode
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+
+	"github.com/mavolin/standards/de/postalcode"
+	"github.com/mavolin/standards/internal/sqlutil"
+)
+
+//go:generate go run github.com/mavolin/standards/tools/codegen/bankcode
+
+// BankCode is a German bank code (Bankleitzahl).
+//
+// It is an 8-digit string.
+type BankCode string
+
+// String pretty-prints the bank code, by grouping it the way it is commonly
+// written.
+//
+// The returned string is in the format "XXX XXX XX".
+//
+// If the BankCode is invalid, it is returned as is.
+func (c BankCode) String() string {
+	if len(c) != 8 {
+		return string(c)
+	}
+
+	return string(c[:3] + " " + c[3:6] + " " + c[6:])
+}
+
+func (c BankCode) Compact() string {
+	return string(c)
+}
+
+var _ encoding.TextMarshaler = BankCode("")
+
+func (c BankCode) MarshalText() ([]byte, error) {
+	return []byte(c.Compact()), nil
+}
+
+var _ encoding.TextUnmarshaler = (*BankCode)(nil)
+
+func (c *BankCode) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+	return nil
+}
+
+var _ sql.Scanner = (*BankCode)(nil)
+
+// Scan implements [sql.Scanner] for bank codes stored as text.
+// Like [Parse], it ignores spaces, so "37040044" and "370 400 44" are both
+// accepted.
+//
+// Scan only checks the syntax of the bank code, use [Lookup] to check whether
+// it is assigned.
+//
+// NULL is scanned into the empty bank code.
+func (c *BankCode) Scan(src any) error {
+	s, ok, err := sqlutil.String(src, "de/bankcode", "BankCode")
+	if err != nil {
+		return err
+	} else if !ok {
+		*c = BankCode("")
+		return nil
+	}
+
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+	return nil
+}
+
+var _ driver.Valuer = BankCode("")
+
+// Value implements [driver.Valuer] by storing the eight digits of the bank
+// code, without the spaces added by [BankCode.String].
+//
+// The empty bank code is stored as NULL.
+func (c BankCode) Value() (driver.Value, error) {
+	if c == BankCode("") {
+		return nil, nil
+	}
+
+	return c.Compact(), nil
+}
+
+// Bank returns the bank identified by the bank code.
+//
+// It is short for Lookup(c).
+func (c BankCode) Bank() (Bank, error) {
+	return Lookup(c)
+}
+
+// Bank is a bank, as listed in the Bankleitzahlendatei.
+//
+// If a bank has multiple branches, Bank is the record of the bank itself,
+// i.e. the record that is marked as 'bankleitzahlführend'.
+type Bank struct {
+	// Code is the bank code of the bank.
+	Code BankCode
+	// Name is the name of the bank, e.g. "Commerzbank".
+	Name string
+	// PostalCode is the postal code of the bank's location.
+	PostalCode postalcode.PostalCode
+	// City is the city the bank is located in.
+	City string
+	// ShortName is the short name of the bank, typically consisting of the
+	// name and the city, e.g. "Commerzbank Köln".
+	ShortName string
+	// PAN is the institution number used for card payments
+	// (Institutsnummer für PAN), if any.
+	PAN string
+	// BIC is the BIC of the bank, if any.
+	BIC string
+	// CheckMethod is the identifier of the check digit method
+	// (Prüfzifferberechnungsmethode) used to validate the bank's account
+	// numbers, e.g. "00" or "A2".
+	CheckMethod string
+
+	// Deleted indicates that the bank code is marked for deletion
+	// (Bankleitzahllöschung).
+	//
+	// Bank codes marked for deletion will be removed in the next issue of the
+	// Bankleitzahlendatei.
+	// Until then, they remain valid.
+	Deleted bool
+	// Successor is the bank code that replaces this bank code
+	// (Nachfolge-Bankleitzahl), if any.
+	//
+	// It is usually only set if Deleted is true.
+	Successor BankCode
+}
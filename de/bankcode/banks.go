@@ -0,0 +1,70 @@
+package bankcode
+
+// Code generated by tools/codegen/bankcode. DO NOT EDIT.
+
+// complete indicates whether banks contains the full Bankleitzahlendatei, and
+// not just an excerpt of it.
+var complete = false
+
+var banks = map[BankCode]Bank{
+	"10000000": {
+		Code:        "10000000",
+		Name:        "Bundesbank",
+		PostalCode:  "10591",
+		City:        "Berlin",
+		ShortName:   "BBk Berlin",
+		PAN:         "",
+		BIC:         "MARKDEF1100",
+		CheckMethod: "09",
+		Deleted:     false,
+		Successor:   "",
+	},
+	"12030000": {
+		Code:        "12030000",
+		Name:        "Deutsche Kreditbank Berlin",
+		PostalCode:  "10919",
+		City:        "Berlin",
+		ShortName:   "DKB Berlin",
+		PAN:         "",
+		BIC:         "BYLADEM1001",
+		CheckMethod: "00",
+		Deleted:     false,
+		Successor:   "",
+	},
+	"37040044": {
+		Code:        "37040044",
+		Name:        "Commerzbank",
+		PostalCode:  "50447",
+		City:        "Köln",
+		ShortName:   "Commerzbank Köln",
+		PAN:         "",
+		BIC:         "COBADEFFXXX",
+		CheckMethod: "13",
+		Deleted:     false,
+		Successor:   "",
+	},
+	"50010517": {
+		Code:        "50010517",
+		Name:        "ING-DiBa",
+		PostalCode:  "60628",
+		City:        "Frankfurt am Main",
+		ShortName:   "ING-DiBa Frankfurt am Main",
+		PAN:         "",
+		BIC:         "INGDDEFFXXX",
+		CheckMethod: "17",
+		Deleted:     false,
+		Successor:   "",
+	},
+	"50070010": {
+		Code:        "50070010",
+		Name:        "Deutsche Bank",
+		PostalCode:  "60262",
+		City:        "Frankfurt am Main",
+		ShortName:   "Deutsche Bank Ffm",
+		PAN:         "",
+		BIC:         "DEUTDEFFXXX",
+		CheckMethod: "63",
+		Deleted:     false,
+		Successor:   "",
+	},
+}
@@ -0,0 +1,100 @@
+package bankcode
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/mavolin/standards/internal/validate"
+)
+
+var (
+	ErrSyntax  = errors.New("de/bankcode: bank codes must be 8-digit numbers not starting with 0 or 9")
+	ErrUnknown = errors.New("de/bankcode: unknown bank code")
+	// ErrIncomplete is returned by [Lookup] and [Resolve] instead of
+	// [ErrUnknown], if the embedded directory is only an excerpt of the
+	// Bankleitzahlendatei, and the bank code is not part of it.
+	ErrIncomplete = errors.New("de/bankcode: bank code is not part of the embedded excerpt of the Bankleitzahlendatei")
+	// ErrDeleted is returned by [Resolve], if the bank code is marked for
+	// deletion, and there is no successor bank code.
+	ErrDeleted = errors.New("de/bankcode: bank code is deleted and has no successor")
+)
+
+// Parse parses the passed bank code.
+//
+// Spaces are ignored.
+//
+// If Parse returns without an error, the bank code is considered
+// syntactically valid.
+// This means it is an 8-digit number, whose first digit, which identifies the
+// clearing area, is a 1-8.
+// Use [Lookup] to check if the bank code is actually assigned.
+func Parse(s string) (BankCode, error) {
+	s = strings.ReplaceAll(s, " ", "")
+
+	if len(s) != 8 || !validate.IsNumeric(s) || s[0] == '0' || s[0] == '9' {
+		return "", ErrSyntax
+	}
+
+	return BankCode(s), nil
+}
+
+// IsValid checks whether s is a syntactically valid bank code, as defined in
+// [Parse].
+func IsValid(s string) bool {
+	_, err := Parse(s)
+	return err == nil
+}
+
+// Lookup looks up the bank with the passed bank code.
+//
+// If there is no such bank, Lookup returns [ErrUnknown].
+// If the embedded directory is only an excerpt of the Bankleitzahlendatei,
+// Lookup cannot tell whether a bank code missing from it is assigned, and
+// returns [ErrIncomplete] instead.
+//
+// Bank codes marked for deletion are returned as is, use [Resolve] to follow
+// them to their successor.
+func Lookup(c BankCode) (Bank, error) {
+	b, ok := banks[c]
+	if !ok {
+		if !complete {
+			return Bank{}, ErrIncomplete
+		}
+
+		return Bank{}, ErrUnknown
+	}
+
+	return b, nil
+}
+
+// Resolve is the same as [Lookup], but if the bank code is marked for
+// deletion, it follows the successor bank codes, until it finds a bank code
+// that is not marked for deletion.
+//
+// If a bank code is marked for deletion, but has no successor, Resolve
+// returns [ErrDeleted].
+func Resolve(c BankCode) (Bank, error) {
+	b, err := Lookup(c)
+	if err != nil {
+		return Bank{}, err
+	}
+
+	// the Bundesbank should never create cycles, but let's make sure we don't
+	// loop forever, in case the data is broken
+	for i := 0; b.Deleted && i < len(banks); i++ {
+		if b.Successor == "" {
+			return Bank{}, ErrDeleted
+		}
+
+		b, err = Lookup(b.Successor)
+		if err != nil {
+			return Bank{}, err
+		}
+	}
+
+	if b.Deleted {
+		return Bank{}, ErrDeleted
+	}
+
+	return b, nil
+}
@@ -0,0 +1,159 @@
+package bankcode
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		In     string
+		Expect string
+	}{
+		{In: "37040044", Expect: "370 400 44"},
+		{In: "370 400 44", Expect: "370 400 44"},
+		{In: "10000000", Expect: "100 000 00"},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.In, func(t *testing.T) {
+			code, err := Parse(c.In)
+			if err != nil {
+				t.Errorf("Parse(%q): %s", c.In, err)
+			}
+
+			if code.String() != c.Expect {
+				t.Errorf("Parse(%q): expected %q, got %q", c.In, c.Expect, code.String())
+			}
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	b, err := Lookup("37040044")
+	if err != nil {
+		t.Fatalf("Lookup(%q): %s", "37040044", err)
+	}
+
+	if b.BIC != "COBADEFFXXX" {
+		t.Errorf("Lookup(%q): expected BIC %q, got %q", "37040044", "COBADEFFXXX", b.BIC)
+	}
+
+	t.Run("unknown", func(t *testing.T) {
+		setComplete(t, true)
+
+		if _, err := Lookup("80000005"); err != ErrUnknown {
+			t.Errorf("Lookup(%q): expected ErrUnknown, got %v", "80000005", err)
+		}
+	})
+
+	t.Run("excerpt", func(t *testing.T) {
+		setComplete(t, false)
+
+		if _, err := Lookup("80000005"); err != ErrIncomplete {
+			t.Errorf("Lookup(%q): expected ErrIncomplete, got %v", "80000005", err)
+		}
+	})
+}
+
+// setComplete sets complete for the duration of the test.
+func setComplete(t *testing.T, c bool) {
+	old := complete
+	complete = c
+	t.Cleanup(func() { complete = old })
+}
+
+func TestResolve(t *testing.T) {
+	banks["80000001"] = Bank{Code: "80000001", Deleted: true, Successor: "80000002"}
+	banks["80000002"] = Bank{Code: "80000002", Deleted: true, Successor: "80000003"}
+	banks["80000003"] = Bank{Code: "80000003"}
+	banks["80000004"] = Bank{Code: "80000004", Deleted: true}
+	t.Cleanup(func() {
+		delete(banks, "80000001")
+		delete(banks, "80000002")
+		delete(banks, "80000003")
+		delete(banks, "80000004")
+	})
+
+	t.Run("successor", func(t *testing.T) {
+		b, err := Resolve("80000001")
+		if err != nil {
+			t.Fatalf("Resolve(%q): %s", "80000001", err)
+		}
+
+		if b.Code != "80000003" {
+			t.Errorf("Resolve(%q): expected %q, got %q", "80000001", "80000003", b.Code)
+		}
+	})
+
+	t.Run("no successor", func(t *testing.T) {
+		if _, err := Resolve("80000004"); err != ErrDeleted {
+			t.Errorf("Resolve(%q): expected ErrDeleted, got %v", "80000004", err)
+		}
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		setComplete(t, true)
+
+		if _, err := Resolve("80000005"); err != ErrUnknown {
+			t.Errorf("Resolve(%q): expected ErrUnknown, got %v", "80000005", err)
+		}
+	})
+}
+
+func TestBankCode_Scan(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		In     any
+		Expect BankCode
+	}{
+		{Name: "string", In: "37040044", Expect: "37040044"},
+		{Name: "spaces", In: "370 400 44", Expect: "37040044"},
+		{Name: "bytes", In: []byte("10000000"), Expect: "10000000"},
+		{Name: "null", In: nil, Expect: ""},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.Name, func(t *testing.T) {
+			code := BankCode("50010517")
+			if err := code.Scan(c.In); err != nil {
+				t.Fatalf("Scan(%#v): %s", c.In, err)
+			}
+
+			if code != c.Expect {
+				t.Errorf("expected %q, got %q", c.Expect, code)
+			}
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		var code BankCode
+		if err := code.Scan("3704004"); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestBankCode_Value(t *testing.T) {
+	code, err := Parse("370 400 44")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v, err := code.Value()
+	if err != nil {
+		t.Fatalf("Value: %s", err)
+	}
+	if v != "37040044" {
+		t.Errorf("expected %q, got %#v", "37040044", v)
+	}
+
+	var scanned BankCode
+	if err := scanned.Scan(v); err != nil {
+		t.Fatalf("Scan(%#v): %s", v, err)
+	}
+	if scanned != code {
+		t.Errorf("expected %q after round trip, got %q", code, scanned)
+	}
+
+	if actual, err := BankCode("").Value(); err != nil || actual != nil {
+		t.Errorf("expected nil, got %#v (err: %v)", actual, err)
+	}
+}
@@ -3,9 +3,13 @@
 package healthinsurancenumber
 
 import (
+	"database/sql"
+	"database/sql/driver"
 	"encoding"
 	"errors"
 	"regexp"
+
+	"github.com/mavolin/standards/internal/sqlutil"
 )
 
 var (
@@ -83,3 +87,43 @@ func (id *HealthInsuranceNumber) UnmarshalText(text []byte) error {
 	*id = HealthInsuranceNumber(text)
 	return nil
 }
+
+var _ sql.Scanner = (*HealthInsuranceNumber)(nil)
+
+// Scan implements [sql.Scanner] for health insurance numbers stored as text,
+// e.g. "A123456780".
+// Unlike [HealthInsuranceNumber.UnmarshalText], Scan validates the check
+// digit, so that corrupted rows are reported instead of silently accepted.
+//
+// NULL is scanned into the empty health insurance number.
+func (id *HealthInsuranceNumber) Scan(src any) error {
+	s, ok, err := sqlutil.String(src, "de/healthinsurancenumber", "HealthInsuranceNumber")
+	if err != nil {
+		return err
+	} else if !ok {
+		*id = HealthInsuranceNumber("")
+		return nil
+	}
+
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+
+	*id = parsed
+	return nil
+}
+
+var _ driver.Valuer = HealthInsuranceNumber("")
+
+// Value implements [driver.Valuer] by storing the letter and the nine digits
+// of the health insurance number.
+//
+// The empty health insurance number is stored as NULL.
+func (id HealthInsuranceNumber) Value() (driver.Value, error) {
+	if id == HealthInsuranceNumber("") {
+		return nil, nil
+	}
+
+	return id.Compact(), nil
+}
@@ -0,0 +1,97 @@
+package healthinsurancenumber
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	successCases := []string{"A123456786", "Z987654326"}
+
+	for _, c := range successCases {
+		t.Run(c, func(t *testing.T) {
+			id, err := Parse(c)
+			if err != nil {
+				t.Fatalf("Parse(%q): %s", c, err)
+			}
+
+			if id.String() != c {
+				t.Errorf("Parse(%q): expected %q, got %q", c, c, id.String())
+			}
+		})
+	}
+
+	t.Run("syntax", func(t *testing.T) {
+		for _, c := range []string{"", "A12345678", "a123456786", "1123456786"} {
+			if _, err := Parse(c); !errors.Is(err, ErrSyntax) {
+				t.Errorf("Parse(%q): expected ErrSyntax, got %v", c, err)
+			}
+		}
+	})
+
+	t.Run("check digit", func(t *testing.T) {
+		if _, err := Parse("A123456787"); !errors.Is(err, ErrCheckDigit) {
+			t.Errorf("expected ErrCheckDigit, got %v", err)
+		}
+	})
+}
+
+func TestHealthInsuranceNumber_Scan(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		In     any
+		Expect HealthInsuranceNumber
+	}{
+		{Name: "string", In: "A123456786", Expect: "A123456786"},
+		{Name: "bytes", In: []byte("Z987654326"), Expect: "Z987654326"},
+		{Name: "padded", In: "A123456786  ", Expect: "A123456786"},
+		{Name: "null", In: nil, Expect: ""},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.Name, func(t *testing.T) {
+			id := HealthInsuranceNumber("Z987654326")
+			if err := id.Scan(c.In); err != nil {
+				t.Fatalf("Scan(%#v): %s", c.In, err)
+			}
+
+			if id != c.Expect {
+				t.Errorf("expected %q, got %q", c.Expect, id)
+			}
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		var id HealthInsuranceNumber
+		if err := id.Scan("A123456787"); !errors.Is(err, ErrCheckDigit) {
+			t.Errorf("expected ErrCheckDigit, got %v", err)
+		}
+	})
+}
+
+func TestHealthInsuranceNumber_Value(t *testing.T) {
+	id, err := Parse("A123456786")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v, err := id.Value()
+	if err != nil {
+		t.Fatalf("Value: %s", err)
+	}
+	if v != "A123456786" {
+		t.Errorf("expected %q, got %#v", "A123456786", v)
+	}
+
+	var scanned HealthInsuranceNumber
+	if err := scanned.Scan(v); err != nil {
+		t.Fatalf("Scan(%#v): %s", v, err)
+	}
+	if scanned != id {
+		t.Errorf("expected %q after round trip, got %q", id, scanned)
+	}
+
+	if actual, err := HealthInsuranceNumber("").Value(); err != nil || actual != nil {
+		t.Errorf("expected nil, got %#v (err: %v)", actual, err)
+	}
+}
@@ -28,3 +28,77 @@ func TestParse(t *testing.T) {
 		})
 	}
 }
+
+func TestPensionInsuranceNumber_Scan(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		In     any
+		Expect string
+	}{
+		{Name: "string", In: "15070649C103", Expect: "15070649C103"},
+		{Name: "pretty", In: "15 070649 C 103", Expect: "15070649C103"},
+		{Name: "bytes", In: []byte("15/070649/C/103"), Expect: "15070649C103"},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.Name, func(t *testing.T) {
+			var pin PensionInsuranceNumber
+			if err := pin.Scan(c.In); err != nil {
+				t.Fatalf("Scan(%#v): %s", c.In, err)
+			}
+
+			if actual := pin.Compact(); actual != c.Expect {
+				t.Errorf("expected %q, got %q", c.Expect, actual)
+			}
+		})
+	}
+
+	t.Run("null", func(t *testing.T) {
+		pin, err := Parse("15070649C103")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := pin.Scan(nil); err != nil {
+			t.Fatalf("Scan(nil): %s", err)
+		}
+
+		if pin != (PensionInsuranceNumber{}) {
+			t.Errorf("expected zero value, got %+v", pin)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var pin PensionInsuranceNumber
+		if err := pin.Scan("15070649C104"); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestPensionInsuranceNumber_Value(t *testing.T) {
+	pin, err := Parse("15 070649 C 103")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v, err := pin.Value()
+	if err != nil {
+		t.Fatalf("Value: %s", err)
+	}
+	if v != "15070649C103" {
+		t.Errorf("expected %q, got %#v", "15070649C103", v)
+	}
+
+	var scanned PensionInsuranceNumber
+	if err := scanned.Scan(v); err != nil {
+		t.Fatalf("Scan(%#v): %s", v, err)
+	}
+	if scanned != pin {
+		t.Errorf("expected %+v after round trip, got %+v", pin, scanned)
+	}
+
+	if actual, err := (PensionInsuranceNumber{}).Value(); err != nil || actual != nil {
+		t.Errorf("expected nil, got %#v (err: %v)", actual, err)
+	}
+}
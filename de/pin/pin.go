@@ -2,9 +2,13 @@
 // Numbers (Rentenversicherungsnummer).
 package pin // probably ambiguous, but pensioninsurancenumber seems too long
 import (
+	"database/sql"
+	"database/sql/driver"
 	"encoding"
 	"fmt"
 	"time"
+
+	"github.com/mavolin/standards/internal/sqlutil"
 )
 
 type PensionInsuranceNumber struct {
@@ -154,3 +158,44 @@ func (pin *PensionInsuranceNumber) UnmarshalText(text []byte) error {
 	*pin = parsed
 	return nil
 }
+
+var _ sql.Scanner = (*PensionInsuranceNumber)(nil)
+
+// Scan implements [sql.Scanner] for pension insurance numbers stored as text.
+// Like [Parse], it ignores spaces and '/', and is case-insensitive, so that
+// the compact form as well as the form returned by
+// [PensionInsuranceNumber.String] are accepted.
+//
+// NULL is scanned into the zero PensionInsuranceNumber.
+func (pin *PensionInsuranceNumber) Scan(src any) error {
+	s, ok, err := sqlutil.String(src, "de/pin", "PensionInsuranceNumber")
+	if err != nil {
+		return err
+	} else if !ok {
+		*pin = PensionInsuranceNumber{}
+		return nil
+	}
+
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+
+	*pin = parsed
+	return nil
+}
+
+var _ driver.Valuer = PensionInsuranceNumber{}
+
+// Value implements [driver.Valuer] by storing the twelve characters of the
+// pension insurance number, without spaces, as returned by
+// [PensionInsuranceNumber.Compact].
+//
+// The zero PensionInsuranceNumber is stored as NULL.
+func (pin PensionInsuranceNumber) Value() (driver.Value, error) {
+	if pin == (PensionInsuranceNumber{}) {
+		return nil, nil
+	}
+
+	return pin.Compact(), nil
+}
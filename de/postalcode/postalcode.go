@@ -3,9 +3,13 @@
 package postalcode
 
 import (
+	"database/sql"
+	"database/sql/driver"
 	"encoding"
 	"errors"
 	"regexp"
+
+	"github.com/mavolin/standards/internal/sqlutil"
 )
 
 var ErrSyntax = errors.New("de/postalcode: postal codes must be 5-digit numbers")
@@ -41,6 +45,45 @@ func (c *PostalCode) UnmarshalText(text []byte) error {
 	return nil
 }
 
+var _ sql.Scanner = (*PostalCode)(nil)
+
+// Scan implements [sql.Scanner] for postal codes stored as text.
+// Postal codes must be stored with their leading zeros, e.g. "01067", which
+// is why they can't be scanned from integer columns.
+//
+// NULL is scanned into the empty postal code.
+func (c *PostalCode) Scan(src any) error {
+	s, ok, err := sqlutil.String(src, "de/postalcode", "PostalCode")
+	if err != nil {
+		return err
+	} else if !ok {
+		*c = PostalCode("")
+		return nil
+	}
+
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+	return nil
+}
+
+var _ driver.Valuer = PostalCode("")
+
+// Value implements [driver.Valuer] by storing the postal code as text, so that
+// leading zeros are preserved.
+//
+// The empty postal code is stored as NULL.
+func (c PostalCode) Value() (driver.Value, error) {
+	if c == PostalCode("") {
+		return nil, nil
+	}
+
+	return c.Compact(), nil
+}
+
 var postalCodeRegexp = regexp.MustCompile(`\d{5}`)
 
 // Parse parses the passed postal code.
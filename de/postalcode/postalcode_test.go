@@ -24,3 +24,70 @@ func TestParse(t *testing.T) {
 		})
 	}
 }
+
+func TestPostalCode_Scan(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		In     any
+		Expect PostalCode
+	}{
+		{Name: "string", In: "26123", Expect: "26123"},
+		{Name: "leading zero", In: "01067", Expect: "01067"},
+		{Name: "bytes", In: []byte("21149"), Expect: "21149"},
+		{Name: "null", In: nil, Expect: ""},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.Name, func(t *testing.T) {
+			code := PostalCode("10115")
+			if err := code.Scan(c.In); err != nil {
+				t.Fatalf("Scan(%#v): %s", c.In, err)
+			}
+
+			if code != c.Expect {
+				t.Errorf("expected %q, got %q", c.Expect, code)
+			}
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		var code PostalCode
+		if err := code.Scan("1067"); err == nil {
+			t.Error("expected an error")
+		}
+	})
+
+	t.Run("integer", func(t *testing.T) {
+		var code PostalCode
+		if err := code.Scan(int64(1067)); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestPostalCode_Value(t *testing.T) {
+	code, err := Parse("01067")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v, err := code.Value()
+	if err != nil {
+		t.Fatalf("Value: %s", err)
+	}
+	if v != "01067" {
+		t.Errorf("expected %q, got %#v", "01067", v)
+	}
+
+	var scanned PostalCode
+	if err := scanned.Scan(v); err != nil {
+		t.Fatalf("Scan(%#v): %s", v, err)
+	}
+	if scanned != code {
+		t.Errorf("expected %q after round trip, got %q", code, scanned)
+	}
+
+	if actual, err := PostalCode("").Value(); err != nil || actual != nil {
+		t.Errorf("expected nil, got %#v (err: %v)", actual, err)
+	}
+}
@@ -34,3 +34,36 @@ func TestParse(t *testing.T) {
 		})
 	}
 }
+
+func TestTIN_Scan(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		In     any
+		Expect TIN
+	}{
+		{Name: "int64", In: int64(86095742719), Expect: 86095742719},
+		{Name: "string", In: "86 095 742 719", Expect: 86095742719},
+		{Name: "bytes", In: []byte("86095742719"), Expect: 86095742719},
+		{Name: "null", In: nil, Expect: 0},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.Name, func(t *testing.T) {
+			id := TIN(47036892816)
+			if err := id.Scan(c.In); err != nil {
+				t.Fatalf("Scan(%#v): %s", c.In, err)
+			}
+
+			if id != c.Expect {
+				t.Errorf("expected %d, got %d", c.Expect, id)
+			}
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		var id TIN
+		if err := id.Scan(int64(-86095742719)); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
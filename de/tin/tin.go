@@ -3,7 +3,10 @@
 package tin
 
 import (
+	"database/sql"
+	"database/sql/driver"
 	"encoding"
+	"fmt"
 	"strconv"
 )
 
@@ -52,3 +55,57 @@ func (id *TIN) UnmarshalText(text []byte) error {
 	*id = parsedID
 	return nil
 }
+
+var _ sql.Scanner = (*TIN)(nil)
+
+// Scan implements [sql.Scanner].
+//
+// src may either be an int64, which is parsed using [ParseNum], or a string
+// or []byte, which is parsed using [Parse].
+//
+// If src is NULL, id is set to 0.
+func (id *TIN) Scan(src any) error {
+	var (
+		parsed TIN
+		err    error
+	)
+
+	switch src := src.(type) {
+	case nil:
+		*id = 0
+		return nil
+	case int64:
+		if src < 0 {
+			return ErrLength
+		}
+
+		parsed, err = ParseNum(uint64(src))
+	case string:
+		parsed, err = Parse(src)
+	case []byte:
+		parsed, err = Parse(string(src))
+	default:
+		return fmt.Errorf("de/tin: cannot scan %T into TIN", src)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	*id = parsed
+	return nil
+}
+
+var _ driver.Valuer = TIN(0)
+
+// Value implements [driver.Valuer] by returning the TIN as int64, so that it
+// can be stored in an integer column.
+//
+// If id is 0, Value returns NULL.
+func (id TIN) Value() (driver.Value, error) {
+	if id == 0 {
+		return nil, nil
+	}
+
+	return int64(id), nil
+}
@@ -0,0 +1,346 @@
+// Package epcqr provides generation and parsing of the payload of EPC QR
+// codes (EPC069-12), also known as GiroCodes, which are used to initiate SEPA
+// credit transfers.
+//
+// The payload is described in the EPC's "Quick Response Code: Guidelines to
+// Enable Data Capture for the Initiation of a SEPA Credit Transfer", see
+// https://www.europeanpaymentscouncil.eu/document-library/guidance-documents/quick-response-code-guidelines-enable-data-capture-initiation
+package epcqr
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/charmap"
+
+	"github.com/mavolin/standards/bic"
+	"github.com/mavolin/standards/iban"
+	"github.com/mavolin/standards/internal/sqlutil"
+	"github.com/mavolin/standards/internal/validate"
+)
+
+const (
+	// MaxLen is the maximum length of a payload in bytes.
+	MaxLen = 331
+
+	// MaxNameLen is the maximum length of [Payload.Name] in characters.
+	MaxNameLen = 70
+	// MaxReferenceLen is the maximum length of [Payload.Reference] in
+	// characters.
+	MaxReferenceLen = 35
+	// MaxRemittanceInfoLen is the maximum length of [Payload.RemittanceInfo]
+	// in characters.
+	MaxRemittanceInfoLen = 140
+	// MaxInformationLen is the maximum length of [Payload.Information] in
+	// characters.
+	MaxInformationLen = 70
+
+	// MinAmount is the minimum amount of a credit transfer in euro cents.
+	MinAmount = 1
+	// MaxAmount is the maximum amount of a credit transfer in euro cents.
+	MaxAmount = 999_999_999_99
+)
+
+const (
+	serviceTag     = "BCD"
+	identification = "SCT"
+	currencyEUR    = "EUR"
+)
+
+// Version is the version of the payload format.
+type Version string
+
+const (
+	// Version1 is version 001 of the payload format, which requires a BIC.
+	Version1 Version = "001"
+	// Version2 is version 002 of the payload format, in which the BIC is
+	// optional.
+	Version2 Version = "002"
+)
+
+// CharacterSet is the character set the payload is encoded in.
+type CharacterSet uint8
+
+const (
+	UTF8 CharacterSet = iota + 1
+	ISO8859_1
+	ISO8859_2
+	ISO8859_4
+	ISO8859_5
+	ISO8859_7
+	ISO8859_10
+	ISO8859_15
+)
+
+func (set CharacterSet) charmap() *charmap.Charmap {
+	switch set {
+	case ISO8859_1:
+		return charmap.ISO8859_1
+	case ISO8859_2:
+		return charmap.ISO8859_2
+	case ISO8859_4:
+		return charmap.ISO8859_4
+	case ISO8859_5:
+		return charmap.ISO8859_5
+	case ISO8859_7:
+		return charmap.ISO8859_7
+	case ISO8859_10:
+		return charmap.ISO8859_10
+	case ISO8859_15:
+		return charmap.ISO8859_15
+	default:
+		return nil
+	}
+}
+
+// Payload is the payload of an EPC QR code.
+type Payload struct {
+	// Version is the version of the payload format.
+	//
+	// If it is empty, Version2 is used.
+	Version Version
+	// CharacterSet is the character set the payload is encoded in.
+	//
+	// If it is 0, UTF8 is used.
+	CharacterSet CharacterSet
+	// BIC is the BIC of the beneficiary's bank.
+	//
+	// It is mandatory for Version1, and optional for Version2.
+	// The zero value denotes the absence of a BIC.
+	BIC bic.BIC
+	// Name is the name of the beneficiary.
+	//
+	// It is mandatory, and may be at most MaxNameLen characters long.
+	Name string
+	// IBAN is the IBAN of the beneficiary's account.
+	//
+	// It is mandatory.
+	IBAN iban.IBAN
+	// Currency is the ISO 4217 currency code of the amount.
+	//
+	// As SEPA credit transfers are always made in euros, it must be "EUR".
+	// If it is empty, "EUR" is used.
+	Currency string
+	// Amount is the amount to transfer in euro cents.
+	//
+	// It must be between MinAmount and MaxAmount, or 0, if the amount shall
+	// be entered by the originator.
+	Amount int64
+	// Purpose is the optional ISO 20022 purpose code of the credit
+	// transfer, e.g. "CHAR" for charity payments.
+	Purpose string
+	// Reference is the optional structured remittance information, i.e. an
+	// ISO 11649 creditor reference.
+	//
+	// It may be at most MaxReferenceLen characters long, and cannot be used
+	// together with RemittanceInfo.
+	Reference string
+	// RemittanceInfo is the optional unstructured remittance information.
+	//
+	// It may be at most MaxRemittanceInfoLen characters long, and cannot be
+	// used together with Reference.
+	RemittanceInfo string
+	// Information is an optional message from the beneficiary to the
+	// originator, which is not part of the credit transfer.
+	//
+	// It may be at most MaxInformationLen characters long.
+	Information string
+}
+
+// Encode validates the payload and encodes it in its character set.
+//
+// The returned payload omits trailing empty lines, as required by
+// EPC069-12.
+func (p Payload) Encode() ([]byte, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	if p.Version == "" {
+		p.Version = Version2
+	}
+	if p.CharacterSet == 0 {
+		p.CharacterSet = UTF8
+	}
+
+	var amount string
+	if p.Amount > 0 {
+		amount = currencyEUR + strconv.FormatInt(p.Amount/100, 10) + "." + twoDigits(p.Amount%100)
+	}
+
+	var bicStr string
+	if p.BIC != (bic.BIC{}) {
+		bicStr = p.BIC.String()
+	}
+
+	lines := []string{
+		serviceTag,
+		string(p.Version),
+		strconv.Itoa(int(p.CharacterSet)),
+		identification,
+		bicStr,
+		p.Name,
+		p.IBAN.Compact(),
+		amount,
+		p.Purpose,
+		p.Reference,
+		p.RemittanceInfo,
+		p.Information,
+	}
+
+	for lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	s := strings.Join(lines, "\n")
+
+	if cm := p.CharacterSet.charmap(); cm != nil {
+		var err error
+		s, err = cm.NewEncoder().String(s)
+		if err != nil {
+			return nil, ErrEncoding
+		}
+	}
+
+	if len(s) > MaxLen {
+		return nil, ErrLength
+	}
+
+	return []byte(s), nil
+}
+
+// Validate checks whether the payload is valid, and returns a descriptive
+// error, if not.
+// Errors returned by [bic.Parse] and [iban.Parse] are wrapped.
+//
+// It does not check whether the payload can be encoded in its character set,
+// or whether it exceeds MaxLen, use [Payload.Encode] for that.
+func (p Payload) Validate() error {
+	switch p.Version {
+	case "", Version2:
+	case Version1:
+		if p.BIC == (bic.BIC{}) {
+			return ErrBICRequired
+		}
+	default:
+		return ErrVersion
+	}
+
+	if p.CharacterSet > ISO8859_15 {
+		return ErrCharacterSet
+	}
+
+	if p.BIC != (bic.BIC{}) {
+		if _, err := bic.Parse(p.BIC.String()); err != nil {
+			return fmt.Errorf("epcqr: invalid bic: %w", err)
+		}
+	}
+
+	if p.Name == "" || !isLine(p.Name, MaxNameLen) {
+		return ErrName
+	}
+
+	if _, err := iban.Parse(p.IBAN.Compact()); err != nil {
+		return fmt.Errorf("epcqr: invalid iban: %w", err)
+	}
+
+	if p.Currency != "" && p.Currency != currencyEUR {
+		return ErrCurrency
+	}
+
+	if p.Amount != 0 && (p.Amount < MinAmount || p.Amount > MaxAmount) {
+		return ErrAmount
+	}
+
+	if p.Purpose != "" && (len(p.Purpose) != 4 || !validate.IsUpperAlphanumeric(p.Purpose)) {
+		return ErrPurpose
+	}
+
+	if p.Reference != "" && p.RemittanceInfo != "" {
+		return ErrRemittance
+	}
+	if !isLine(p.Reference, MaxReferenceLen) || !isLine(p.RemittanceInfo, MaxRemittanceInfoLen) {
+		return ErrRemittance
+	}
+
+	if !isLine(p.Information, MaxInformationLen) {
+		return ErrInformation
+	}
+
+	return nil
+}
+
+var _ encoding.TextMarshaler = Payload{}
+
+func (p Payload) MarshalText() ([]byte, error) {
+	return p.Encode()
+}
+
+var _ encoding.TextUnmarshaler = (*Payload)(nil)
+
+func (p *Payload) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+
+	*p = parsed
+	return nil
+}
+
+var _ sql.Scanner = (*Payload)(nil)
+
+// Scan implements [sql.Scanner] for payloads stored as text or binary data,
+// as returned by [Payload.Value].
+// The payload is decoded from the character set it declares.
+//
+// NULL is scanned into the zero Payload.
+func (p *Payload) Scan(src any) error {
+	s, ok, err := sqlutil.String(src, "epcqr", "Payload")
+	if err != nil {
+		return err
+	} else if !ok {
+		*p = Payload{}
+		return nil
+	}
+
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+
+	*p = parsed
+	return nil
+}
+
+var _ driver.Valuer = Payload{}
+
+// Value implements [driver.Valuer] by returning the payload encoded by
+// [Payload.Encode].
+//
+// As the payload is encoded in its character set, which need not be UTF-8,
+// Value returns a []byte.
+//
+// The zero Payload is stored as NULL.
+func (p Payload) Value() (driver.Value, error) {
+	if p == (Payload{}) {
+		return nil, nil
+	}
+
+	return p.Encode()
+}
+
+// isLine reports whether s contains no line breaks, and is at most maxLen
+// characters long.
+func isLine(s string, maxLen int) bool {
+	return !strings.ContainsAny(s, "\r\n") && utf8.RuneCountInString(s) <= maxLen
+}
+
+func twoDigits(n int64) string {
+	return string(rune('0'+n/10)) + string(rune('0'+n%10))
+}
@@ -0,0 +1,220 @@
+package epcqr
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mavolin/standards/bic"
+	"github.com/mavolin/standards/iban"
+)
+
+func TestPayload_Encode(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		successCases := []struct {
+			Name   string
+			In     Payload
+			IBAN   string
+			Expect string
+		}{
+			{
+				Name:   "minimal",
+				In:     Payload{Name: "Franz Mustermann"},
+				IBAN:   "DE89370400440532013000",
+				Expect: "BCD\n002\n1\nSCT\n\nFranz Mustermann\nDE89370400440532013000",
+			},
+			{
+				Name: "full",
+				In: Payload{
+					Version:      Version1,
+					CharacterSet: ISO8859_1,
+					BIC:          bic.BIC{BusinessPartyPrefix: "COBA", CountryCode: "DE", BusinessPartySuffix: "FF"},
+					Name:         "Franz Mustermänn",
+					Currency:     "EUR",
+					Amount:       1230,
+					Purpose:      "GDDS",
+					Reference:    "RF18539007547034",
+					Information:  "Danke",
+				},
+				IBAN: "DE89370400440532013000",
+				Expect: "BCD\n001\n2\nSCT\nCOBADEFF\nFranz Musterm\xe4nn\nDE89370400440532013000\nEUR12.30\nGDDS\n" +
+					"RF18539007547034\n\nDanke",
+			},
+		}
+
+		for _, c := range successCases {
+			t.Run(c.Name, func(t *testing.T) {
+				c.In.IBAN = mustIBAN(t, c.IBAN)
+
+				actual, err := c.In.Encode()
+				if err != nil {
+					t.Fatalf("Encode(): %s", err)
+				}
+
+				if string(actual) != c.Expect {
+					t.Errorf("Encode():\nexpected: %q\ngot:      %q", c.Expect, actual)
+				}
+
+				parsed, err := Parse(string(actual))
+				if err != nil {
+					t.Fatalf("Parse(%q): %s", actual, err)
+				}
+
+				if parsed.Name != c.In.Name || parsed.Amount != c.In.Amount || parsed.IBAN != c.In.IBAN {
+					t.Errorf("Parse(%q): round trip resulted in %+v", actual, parsed)
+				}
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		valid := Payload{Name: "Franz Mustermann"}
+
+		failureCases := []struct {
+			Name   string
+			Modify func(p *Payload)
+			Expect error
+		}{
+			{Name: "bic required", Modify: func(p *Payload) { p.Version = Version1 }, Expect: ErrBICRequired},
+			{Name: "version", Modify: func(p *Payload) { p.Version = "003" }, Expect: ErrVersion},
+			{Name: "character set", Modify: func(p *Payload) { p.CharacterSet = 9 }, Expect: ErrCharacterSet},
+			{Name: "iban", Modify: func(p *Payload) { p.IBAN = iban.IBAN{} }, Expect: iban.ErrLength},
+			{Name: "empty name", Modify: func(p *Payload) { p.Name = "" }, Expect: ErrName},
+			{Name: "long name", Modify: func(p *Payload) { p.Name = strings.Repeat("a", 71) }, Expect: ErrName},
+			{Name: "multiline name", Modify: func(p *Payload) { p.Name = "Franz\nMustermann" }, Expect: ErrName},
+			{Name: "currency", Modify: func(p *Payload) { p.Currency = "USD" }, Expect: ErrCurrency},
+			{Name: "negative amount", Modify: func(p *Payload) { p.Amount = -1 }, Expect: ErrAmount},
+			{Name: "large amount", Modify: func(p *Payload) { p.Amount = MaxAmount + 1 }, Expect: ErrAmount},
+			{Name: "purpose", Modify: func(p *Payload) { p.Purpose = "gdds" }, Expect: ErrPurpose},
+			{
+				Name: "both remittance",
+				Modify: func(p *Payload) {
+					p.Reference = "RF18539007547034"
+					p.RemittanceInfo = "Rechnung 123"
+				},
+				Expect: ErrRemittance,
+			},
+			{Name: "information", Modify: func(p *Payload) { p.Information = strings.Repeat("a", 71) }, Expect: ErrInformation},
+			{
+				Name: "encoding",
+				Modify: func(p *Payload) {
+					p.CharacterSet = ISO8859_1
+					p.Name = "Franz Mustermann ☺"
+				},
+				Expect: ErrEncoding,
+			},
+			{
+				Name: "length",
+				Modify: func(p *Payload) {
+					p.Name = strings.Repeat("ä", 70)
+					p.RemittanceInfo = strings.Repeat("ä", 140)
+					p.Information = strings.Repeat("ä", 70)
+				},
+				Expect: ErrLength,
+			},
+		}
+
+		for _, c := range failureCases {
+			t.Run(c.Name, func(t *testing.T) {
+				p := valid
+				p.IBAN = mustIBAN(t, "DE89370400440532013000")
+				c.Modify(&p)
+
+				_, err := p.Encode()
+				if !errors.Is(err, c.Expect) {
+					t.Errorf("Encode(): expected error %q, got %v", c.Expect, err)
+				}
+			})
+		}
+	})
+}
+
+func TestPayload_Scan(t *testing.T) {
+	const s = "BCD\n002\n1\nSCT\nBHBLDEHHXXX\nFranz Mustermänn\nDE71110220330123456789\nEUR12.3\nGDDS\n" +
+		"RF18539007547034"
+
+	expect, err := Parse(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name   string
+		In     any
+		Expect Payload
+	}{
+		{Name: "string", In: s, Expect: expect},
+		{Name: "bytes", In: []byte(s), Expect: expect},
+		{Name: "null", In: nil, Expect: Payload{}},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.Name, func(t *testing.T) {
+			p := Payload{Name: "Erika Mustermann", IBAN: mustIBAN(t, "DE89370400440532013000")}
+			if err := p.Scan(c.In); err != nil {
+				t.Fatalf("Scan(%#v): %s", c.In, err)
+			}
+
+			if p != c.Expect {
+				t.Errorf("expected %+v, got %+v", c.Expect, p)
+			}
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		var p Payload
+		if err := p.Scan("BCD\n002\n1\nSCT\n\nFranz Mustermann"); err == nil {
+			t.Error("expected an error")
+		}
+	})
+
+	t.Run("unsupported type", func(t *testing.T) {
+		var p Payload
+		if err := p.Scan(int64(1)); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestPayload_Value(t *testing.T) {
+	p := Payload{
+		Version:      Version1,
+		CharacterSet: ISO8859_1,
+		BIC:          bic.BIC{BusinessPartyPrefix: "COBA", CountryCode: "DE", BusinessPartySuffix: "FF"},
+		Name:         "Franz Mustermänn",
+		IBAN:         mustIBAN(t, "DE89370400440532013000"),
+		Currency:     "EUR",
+		Amount:       1230,
+		Purpose:      "GDDS",
+		Reference:    "RF18539007547034",
+		Information:  "Danke",
+	}
+
+	v, err := p.Value()
+	if err != nil {
+		t.Fatalf("Value: %s", err)
+	}
+
+	b, ok := v.([]byte)
+	if !ok {
+		t.Fatalf("expected a []byte, got %T", v)
+	}
+
+	expect := "BCD\n001\n2\nSCT\nCOBADEFF\nFranz Musterm\xe4nn\nDE89370400440532013000\nEUR12.30\nGDDS\n" +
+		"RF18539007547034\n\nDanke"
+	if string(b) != expect {
+		t.Errorf("expected %q, got %q", expect, b)
+	}
+
+	var scanned Payload
+	if err := scanned.Scan(v); err != nil {
+		t.Fatalf("Scan(%#v): %s", v, err)
+	}
+	if scanned != p {
+		t.Errorf("expected %+v after round trip, got %+v", p, scanned)
+	}
+
+	if actual, err := (Payload{}).Value(); err != nil || actual != nil {
+		t.Errorf("expected nil, got %#v (err: %v)", actual, err)
+	}
+}
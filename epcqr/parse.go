@@ -0,0 +1,169 @@
+package epcqr
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mavolin/standards/bic"
+	"github.com/mavolin/standards/iban"
+)
+
+var (
+	ErrLength         = errors.New("epcqr: payload must be at most 331 bytes long")
+	ErrLines          = errors.New("epcqr: payload must consist of 7 to 12 lines")
+	ErrServiceTag     = errors.New("epcqr: service tag must be BCD")
+	ErrVersion        = errors.New("epcqr: version must be 001 or 002")
+	ErrCharacterSet   = errors.New("epcqr: character set must be a digit between 1 and 8")
+	ErrEncoding       = errors.New("epcqr: payload is not encoded in, or cannot be encoded in its character set")
+	ErrIdentification = errors.New("epcqr: identification must be SCT")
+	ErrBICRequired    = errors.New("epcqr: version 001 requires a bic")
+	ErrName           = errors.New("epcqr: name must be a single line of 1 to 70 characters")
+	ErrCurrency       = errors.New("epcqr: currency must be EUR")
+	ErrAmount         = errors.New("epcqr: amount must be between EUR0.01 and EUR999999999.99")
+	ErrPurpose        = errors.New("epcqr: purpose must be a 4 character alphanumeric code")
+	ErrRemittance     = errors.New("epcqr: remittance information must be either a single line reference of at most 35 " +
+		"characters, or a single line text of at most 140 characters")
+	ErrInformation = errors.New("epcqr: information must be a single line of at most 70 characters")
+)
+
+// Parse parses the passed EPC QR code payload.
+//
+// Lines may be separated by either LF or CRLF, and a single trailing line
+// separator is tolerated.
+// All lines after the character set are decoded from the payload's character
+// set.
+//
+// If Parse returns without an error, the payload is valid, as defined by
+// [Payload.Validate].
+// Errors returned by [bic.Parse] and [iban.Parse] are wrapped.
+func Parse(s string) (Payload, error) {
+	if len(s) > MaxLen {
+		return Payload{}, ErrLength
+	}
+
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	s = strings.TrimSuffix(s, "\n")
+
+	lines := strings.Split(s, "\n")
+	if len(lines) < 7 || len(lines) > 12 {
+		return Payload{}, ErrLines
+	}
+
+	// pad, so that we don't need to check for the presence of optional lines
+	lines = append(lines, make([]string, 12-len(lines))...)
+
+	if lines[0] != serviceTag {
+		return Payload{}, ErrServiceTag
+	}
+
+	var p Payload
+
+	p.Version = Version(lines[1])
+	if p.Version != Version1 && p.Version != Version2 {
+		return Payload{}, ErrVersion
+	}
+
+	if len(lines[2]) != 1 || lines[2][0] < '1' || lines[2][0] > '8' {
+		return Payload{}, ErrCharacterSet
+	}
+	p.CharacterSet = CharacterSet(lines[2][0] - '0')
+
+	if lines[3] != identification {
+		return Payload{}, ErrIdentification
+	}
+
+	if cm := p.CharacterSet.charmap(); cm != nil {
+		for i := 4; i < len(lines); i++ {
+			decoded, err := cm.NewDecoder().String(lines[i])
+			if err != nil {
+				return Payload{}, ErrEncoding
+			}
+
+			lines[i] = decoded
+		}
+	} else if !utf8.ValidString(s) {
+		return Payload{}, ErrEncoding
+	}
+
+	var err error
+	if lines[4] != "" {
+		p.BIC, err = bic.Parse(lines[4])
+		if err != nil {
+			return Payload{}, fmt.Errorf("epcqr: invalid bic: %w", err)
+		}
+	}
+
+	p.Name = lines[5]
+
+	p.IBAN, err = iban.Parse(lines[6])
+	if err != nil {
+		return Payload{}, fmt.Errorf("epcqr: invalid iban: %w", err)
+	}
+
+	if lines[7] != "" {
+		p.Currency = currencyEUR
+		p.Amount, err = parseAmount(lines[7])
+		if err != nil {
+			return Payload{}, err
+		}
+	}
+
+	p.Purpose = lines[8]
+	p.Reference = lines[9]
+	p.RemittanceInfo = lines[10]
+	p.Information = lines[11]
+
+	if err := p.Validate(); err != nil {
+		return Payload{}, err
+	}
+
+	return p, nil
+}
+
+// parseAmount parses an amount in the format "EUR12.34" and returns it in
+// euro cents.
+func parseAmount(s string) (int64, error) {
+	if !strings.HasPrefix(s, currencyEUR) {
+		return 0, ErrCurrency
+	}
+	s = s[len(currencyEUR):]
+
+	// at most 9 digits, a '.', and 2 decimals
+	if len(s) == 0 || len(s) > 12 {
+		return 0, ErrAmount
+	}
+
+	euros, cents, hasCents := strings.Cut(s, ".")
+	if hasCents && (len(cents) == 0 || len(cents) > 2) {
+		return 0, ErrAmount
+	}
+
+	if len(cents) == 1 {
+		cents += "0"
+	}
+
+	amount, err := strconv.ParseUint(euros+cents, 10, 64)
+	if err != nil || euros == "" {
+		return 0, ErrAmount
+	}
+
+	if !hasCents {
+		amount *= 100
+	}
+
+	if amount < MinAmount || amount > MaxAmount {
+		return 0, ErrAmount
+	}
+
+	return int64(amount), nil
+}
+
+// IsValid checks whether s is a valid EPC QR code payload, as defined by
+// [Parse].
+func IsValid(s string) bool {
+	_, err := Parse(s)
+	return err == nil
+}
@@ -0,0 +1,132 @@
+package epcqr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mavolin/standards/bic"
+	"github.com/mavolin/standards/iban"
+)
+
+func mustIBAN(t *testing.T, s string) iban.IBAN {
+	t.Helper()
+
+	i, err := iban.Parse(s)
+	if err != nil {
+		t.Fatalf("iban.Parse(%q): %s", s, err)
+	}
+
+	return i
+}
+
+func TestParse(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		successCases := []struct {
+			Name       string
+			In         string
+			Expect     Payload
+			ExpectIBAN string
+		}{
+			{
+				// example from EPC069-12
+				Name: "full",
+				In: "BCD\n002\n1\nSCT\nBHBLDEHHXXX\nFranz Mustermänn\nDE71110220330123456789\nEUR12.3\nGDDS\n" +
+					"RF18539007547034",
+				Expect: Payload{
+					Version:      Version2,
+					CharacterSet: UTF8,
+					BIC:          bic.BIC{BusinessPartyPrefix: "BHBL", CountryCode: "DE", BusinessPartySuffix: "HH", BranchCode: "XXX"},
+					Name:         "Franz Mustermänn",
+					Currency:     "EUR",
+					Amount:       1230,
+					Purpose:      "GDDS",
+					Reference:    "RF18539007547034",
+				},
+				ExpectIBAN: "DE71110220330123456789",
+			},
+			{
+				Name: "minimal",
+				In:   "BCD\r\n002\r\n1\r\nSCT\r\n\r\nFranz Mustermann\r\nDE89 3704 0044 0532 0130 00\r\n",
+				Expect: Payload{
+					Version:      Version2,
+					CharacterSet: UTF8,
+					Name:         "Franz Mustermann",
+				},
+				ExpectIBAN: "DE89370400440532013000",
+			},
+			{
+				Name: "latin-1",
+				In: "BCD\n001\n2\nSCT\nCOBADEFF\nFranz Musterm\xe4nn\nDE89370400440532013000\nEUR1\n\n\n" +
+					"Rechnung 123\nDanke",
+				Expect: Payload{
+					Version:        Version1,
+					CharacterSet:   ISO8859_1,
+					BIC:            bic.BIC{BusinessPartyPrefix: "COBA", CountryCode: "DE", BusinessPartySuffix: "FF"},
+					Name:           "Franz Mustermänn",
+					Currency:       "EUR",
+					Amount:         100,
+					RemittanceInfo: "Rechnung 123",
+					Information:    "Danke",
+				},
+				ExpectIBAN: "DE89370400440532013000",
+			},
+		}
+
+		for _, c := range successCases {
+			t.Run(c.Name, func(t *testing.T) {
+				actual, err := Parse(c.In)
+				if err != nil {
+					t.Fatalf("Parse(%q): %s", c.In, err)
+				}
+
+				c.Expect.IBAN = mustIBAN(t, c.ExpectIBAN)
+				if actual != c.Expect {
+					t.Errorf("Parse(%q):\nexpected: %+v\ngot:      %+v", c.In, c.Expect, actual)
+				}
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		failureCases := []struct {
+			Name   string
+			In     string
+			Expect error
+		}{
+			{Name: "too few lines", In: "BCD\n002\n1\nSCT\n\nFranz Mustermann", Expect: ErrLines},
+			{Name: "service tag", In: "BCE\n002\n1\nSCT\n\nFranz Mustermann\nDE89370400440532013000", Expect: ErrServiceTag},
+			{Name: "version", In: "BCD\n003\n1\nSCT\n\nFranz Mustermann\nDE89370400440532013000", Expect: ErrVersion},
+			{Name: "character set", In: "BCD\n002\n9\nSCT\n\nFranz Mustermann\nDE89370400440532013000", Expect: ErrCharacterSet},
+			{Name: "identification", In: "BCD\n002\n1\nSCX\n\nFranz Mustermann\nDE89370400440532013000", Expect: ErrIdentification},
+			{Name: "utf-8", In: "BCD\n002\n1\nSCT\n\nFranz Musterm\xe4nn\nDE89370400440532013000", Expect: ErrEncoding},
+			{Name: "bic required", In: "BCD\n001\n1\nSCT\n\nFranz Mustermann\nDE89370400440532013000", Expect: ErrBICRequired},
+			{Name: "bic", In: "BCD\n002\n1\nSCT\nCOBADE\nFranz Mustermann\nDE89370400440532013000", Expect: bic.ErrLength},
+			{Name: "name", In: "BCD\n002\n1\nSCT\n\n\nDE89370400440532013000", Expect: ErrName},
+			{Name: "iban", In: "BCD\n002\n1\nSCT\n\nFranz Mustermann\nDE88370400440532013000", Expect: iban.ErrChecksum},
+			{Name: "currency", In: "BCD\n002\n1\nSCT\n\nFranz Mustermann\nDE89370400440532013000\nUSD1", Expect: ErrCurrency},
+			{Name: "amount zero", In: "BCD\n002\n1\nSCT\n\nFranz Mustermann\nDE89370400440532013000\nEUR0.00", Expect: ErrAmount},
+			{Name: "amount decimals", In: "BCD\n002\n1\nSCT\n\nFranz Mustermann\nDE89370400440532013000\nEUR1.234", Expect: ErrAmount},
+			{Name: "amount sign", In: "BCD\n002\n1\nSCT\n\nFranz Mustermann\nDE89370400440532013000\nEUR-1", Expect: ErrAmount},
+			{Name: "purpose", In: "BCD\n002\n1\nSCT\n\nFranz Mustermann\nDE89370400440532013000\n\nGDD", Expect: ErrPurpose},
+			{
+				Name:   "both remittance",
+				In:     "BCD\n002\n1\nSCT\n\nFranz Mustermann\nDE89370400440532013000\n\n\nRF18539007547034\nRechnung",
+				Expect: ErrRemittance,
+			},
+			{
+				Name:   "too many lines",
+				In:     "BCD\n002\n1\nSCT\n\nFranz Mustermann\nDE89370400440532013000\n\n\n\n\n\nfoo",
+				Expect: ErrLines,
+			},
+		}
+
+		for _, c := range failureCases {
+			t.Run(c.Name, func(t *testing.T) {
+				_, err := Parse(c.In)
+				if !errors.Is(err, c.Expect) {
+					t.Errorf("Parse(%q): expected error %q, got %v", c.In, c.Expect, err)
+				}
+			})
+		}
+	})
+}
@@ -3,3 +3,5 @@ module github.com/mavolin/standards
 go 1.19
 
 require golang.org/x/net v0.5.0
+
+require golang.org/x/text v0.13.0
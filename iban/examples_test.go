@@ -0,0 +1,91 @@
+package iban
+
+import "github.com/mavolin/standards/iso3166"
+
+// Code generated by tools/codegen/iban_format. DO NOT EDIT.
+
+var examples = []struct {
+	Country iso3166.Alpha2Code
+	IBAN    string
+}{
+	{Country: iso3166.AD, IBAN: "AD1200012030200359100100"},
+	{Country: iso3166.AE, IBAN: "AE070331234567890123456"},
+	{Country: iso3166.AL, IBAN: "AL47212110090000000235698741"},
+	{Country: iso3166.AT, IBAN: "AT611904300234573201"},
+	{Country: iso3166.AZ, IBAN: "AZ21NABZ00000000137010001944"},
+	{Country: iso3166.BA, IBAN: "BA391290079401028494"},
+	{Country: iso3166.BE, IBAN: "BE68539007547034"},
+	{Country: iso3166.BG, IBAN: "BG80BNBG96611020345678"},
+	{Country: iso3166.BH, IBAN: "BH67BMAG00001299123456"},
+	{Country: iso3166.BR, IBAN: "BR1800360305000010009795493C1"},
+	{Country: iso3166.BY, IBAN: "BY13NBRB3600900000002Z00AB00"},
+	{Country: iso3166.CH, IBAN: "CH9300762011623852957"},
+	{Country: iso3166.CR, IBAN: "CR05015202001026284066"},
+	{Country: iso3166.CY, IBAN: "CY17002001280000001200527600"},
+	{Country: iso3166.CZ, IBAN: "CZ6508000000192000145399"},
+	{Country: iso3166.DE, IBAN: "DE89370400440532013000"},
+	{Country: iso3166.DK, IBAN: "DK5000400440116243"},
+	{Country: iso3166.DO, IBAN: "DO28BAGR00000001212453611324"},
+	{Country: iso3166.EE, IBAN: "EE382200221020145685"},
+	{Country: iso3166.EG, IBAN: "EG380019000500000000263180002"},
+	{Country: iso3166.ES, IBAN: "ES9121000418450200051332"},
+	{Country: iso3166.FI, IBAN: "FI2112345600000785"},
+	{Country: iso3166.FO, IBAN: "FO6264600001631634"},
+	{Country: iso3166.FR, IBAN: "FR1420041010050500013M02606"},
+	{Country: iso3166.GB, IBAN: "GB29NWBK60161331926819"},
+	{Country: iso3166.GE, IBAN: "GE29NB0000000101904917"},
+	{Country: iso3166.GI, IBAN: "GI75NWBK000000007099453"},
+	{Country: iso3166.GL, IBAN: "GL8964710001000206"},
+	{Country: iso3166.GR, IBAN: "GR1601101250000000012300695"},
+	{Country: iso3166.GT, IBAN: "GT82TRAJ01020000001210029690"},
+	{Country: iso3166.HR, IBAN: "HR1210010051863000160"},
+	{Country: iso3166.HU, IBAN: "HU42117730161111101800000000"},
+	{Country: iso3166.IE, IBAN: "IE29AIBK93115212345678"},
+	{Country: iso3166.IL, IBAN: "IL620108000000099999999"},
+	{Country: iso3166.IQ, IBAN: "IQ98NBIQ850123456789012"},
+	{Country: iso3166.IS, IBAN: "IS140159260076545510730339"},
+	{Country: iso3166.IT, IBAN: "IT60X0542811101000000123456"},
+	{Country: iso3166.JO, IBAN: "JO94CBJO0010000000000131000302"},
+	{Country: iso3166.KW, IBAN: "KW81CBKU0000000000001234560101"},
+	{Country: iso3166.KZ, IBAN: "KZ86125KZT5004100100"},
+	{Country: iso3166.LB, IBAN: "LB62099900000001001901229114"},
+	{Country: iso3166.LC, IBAN: "LC55HEMM000100010012001200023015"},
+	{Country: iso3166.LI, IBAN: "LI21088100002324013AA"},
+	{Country: iso3166.LT, IBAN: "LT121000011101001000"},
+	{Country: iso3166.LU, IBAN: "LU280019400644750000"},
+	{Country: iso3166.LV, IBAN: "LV80BANK0000435195001"},
+	{Country: iso3166.LY, IBAN: "LY83002048000020100120361"},
+	{Country: iso3166.MC, IBAN: "MC5811222000010123456789030"},
+	{Country: iso3166.MD, IBAN: "MD24AG000225100013104168"},
+	{Country: iso3166.ME, IBAN: "ME25505000012345678951"},
+	{Country: iso3166.MK, IBAN: "MK07250120000058984"},
+	{Country: iso3166.MR, IBAN: "MR1300020001010000123456753"},
+	{Country: iso3166.MT, IBAN: "MT84MALT011000012345MTLCAST001S"},
+	{Country: iso3166.MU, IBAN: "MU17BOMM0101101030300200000MUR"},
+	{Country: iso3166.NL, IBAN: "NL91ABNA0417164300"},
+	{Country: iso3166.NO, IBAN: "NO9386011117947"},
+	{Country: iso3166.PK, IBAN: "PK36SCBL0000001123456702"},
+	{Country: iso3166.PL, IBAN: "PL61109010140000071219812874"},
+	{Country: iso3166.PS, IBAN: "PS92PALS000000000400123456702"},
+	{Country: iso3166.PT, IBAN: "PT50000201231234567890154"},
+	{Country: iso3166.QA, IBAN: "QA58DOHB00001234567890ABCDEFG"},
+	{Country: iso3166.RO, IBAN: "RO49AAAA1B31007593840000"},
+	{Country: iso3166.RS, IBAN: "RS35260005601001611379"},
+	{Country: iso3166.RU, IBAN: "RU0304452522540817810538091310419"},
+	{Country: iso3166.SA, IBAN: "SA0380000000608010167519"},
+	{Country: iso3166.SC, IBAN: "SC18SSCB11010000000000001497USD"},
+	{Country: iso3166.SD, IBAN: "SD2129010501234001"},
+	{Country: iso3166.SE, IBAN: "SE4550000000058398257466"},
+	{Country: iso3166.SI, IBAN: "SI56263300012039086"},
+	{Country: iso3166.SK, IBAN: "SK3112000000198742637541"},
+	{Country: iso3166.SM, IBAN: "SM86U0322509800000000270100"},
+	{Country: iso3166.ST, IBAN: "ST68000100010051845310112"},
+	{Country: iso3166.SV, IBAN: "SV62CENR00000000000000700025"},
+	{Country: iso3166.TL, IBAN: "TL380080012345678910157"},
+	{Country: iso3166.TN, IBAN: "TN5910006035183598478831"},
+	{Country: iso3166.TR, IBAN: "TR330006100519786457841326"},
+	{Country: iso3166.UA, IBAN: "UA213223130000026007233566001"},
+	{Country: iso3166.VA, IBAN: "VA59001123000012345678"},
+	{Country: iso3166.VG, IBAN: "VG96VPVG0000012345678901"},
+	{Country: iso3166.XK, IBAN: "XK051212012345678906"},
+}
@@ -0,0 +1,149 @@
+package iban
+
+import "github.com/mavolin/standards/iso3166"
+
+//go:generate go run github.com/mavolin/standards/tools/codegen/iban_format
+
+// Format is the format of the IBANs of a country.
+type Format struct {
+	// Country is the country the format belongs to.
+	Country iso3166.Alpha2Code
+	// Length is the length of the country's IBANs, including the country code
+	// and check digits.
+	Length int
+	// Fields are the fields that make up the country's BBAN, in the order
+	// they appear in the BBAN.
+	//
+	// A field that consists of characters of different classes, e.g. the
+	// bank code of Seychelles IBANs, is split into multiple FieldSpecs with
+	// the same name.
+	Fields []FieldSpec
+	// SEPA indicates whether the country is part of the Single Euro Payments
+	// Area.
+	SEPA bool
+	// Example is an example IBAN in compact form.
+	//
+	// It may be empty, if no example is available.
+	Example string
+}
+
+// BBANLength returns the length of the country's BBANs.
+func (f Format) BBANLength() int {
+	return f.Length - 4
+}
+
+// positions returns the positions of the characters of the BBAN belonging to
+// the field with the passed name.
+func (f Format) positions(name FieldName) []int {
+	var positions []int
+
+	var offset int
+	for _, spec := range f.Fields {
+		if spec.Name == name {
+			for i := 0; i < spec.Length; i++ {
+				positions = append(positions, offset+i)
+			}
+		}
+
+		offset += spec.Length
+	}
+
+	return positions
+}
+
+// classAt returns the class of the character of the BBAN at the passed
+// position.
+func (f Format) classAt(pos int) CharClass {
+	for _, spec := range f.Fields {
+		if pos < spec.Length {
+			return spec.Class
+		}
+
+		pos -= spec.Length
+	}
+
+	return 0
+}
+
+// FieldSpec describes a field of a BBAN.
+type FieldSpec struct {
+	// Name is the name of the field.
+	//
+	// It is empty for parts of the BBAN that aren't assigned to any field,
+	// e.g. the reserved character of Turkish IBANs.
+	Name FieldName
+	// Class is the class of characters allowed in the field.
+	Class CharClass
+	// Length is the number of characters of the field.
+	Length int
+}
+
+// CharClass is the class of characters allowed in a field of a BBAN.
+type CharClass uint8
+
+const (
+	// Numeric allows the digits 0-9.
+	Numeric CharClass = iota + 1
+	// Alpha allows the uppercase letters A-Z.
+	Alpha
+	// Alphanumeric allows both digits and uppercase letters.
+	Alphanumeric
+)
+
+// Contains reports whether c is a character of the class.
+func (class CharClass) Contains(c byte) bool {
+	switch class {
+	case Numeric:
+		return c >= '0' && c <= '9'
+	case Alpha:
+		return c >= 'A' && c <= 'Z'
+	case Alphanumeric:
+		return (c >= '0' && c <= '9') || (c >= 'A' && c <= 'Z')
+	default:
+		return false
+	}
+}
+
+func (class CharClass) String() string {
+	switch class {
+	case Numeric:
+		return "numeric"
+	case Alpha:
+		return "alphabetic"
+	case Alphanumeric:
+		return "alphanumeric"
+	default:
+		return "unknown"
+	}
+}
+
+const (
+	digits  = "0123456789"
+	letters = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+)
+
+// chars returns all characters of the class.
+func (class CharClass) chars() string {
+	switch class {
+	case Numeric:
+		return digits
+	case Alpha:
+		return letters
+	default:
+		return digits + letters
+	}
+}
+
+// FormatFor returns the IBAN format of the passed country.
+//
+// If the country does not use IBANs, or its format is unknown, ok is false.
+func FormatFor(country iso3166.Alpha2Code) (f Format, ok bool) {
+	f, ok = formats[country]
+	if !ok {
+		return Format{}, false
+	}
+
+	// don't let callers modify our copy
+	f.Fields = append([]FieldSpec(nil), f.Fields...)
+	return f, true
+}
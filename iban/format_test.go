@@ -0,0 +1,59 @@
+package iban
+
+import (
+	"testing"
+
+	"github.com/mavolin/standards/iso3166"
+)
+
+func TestFormatFor(t *testing.T) {
+	for country, f := range formats {
+		t.Run(country.Code, func(t *testing.T) {
+			if f.Country != country {
+				t.Errorf("expected Country to be %s, got %s", country.Code, f.Country.Code)
+			}
+
+			var bbanLength int
+			for _, spec := range f.Fields {
+				bbanLength += spec.Length
+			}
+
+			if bbanLength != f.BBANLength() {
+				t.Errorf("fields are %d characters long, but BBAN is %d characters long", bbanLength, f.BBANLength())
+			}
+		})
+	}
+}
+
+func TestParse_Fields(t *testing.T) {
+	testCases := []struct {
+		In     string
+		Expect IBAN
+	}{
+		{
+			In: "HU42117730161111101800000000",
+			Expect: IBAN{
+				CountryCode:      iso3166.HU,
+				Checksum:         42,
+				BBAN:             "117730161111101800000000",
+				BankCode:         "117",
+				BranchCode:       "7301",
+				AccountNumber:    "111110180000000",
+				NationalChecksum: "60",
+			},
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.In, func(t *testing.T) {
+			actual, err := Parse(c.In)
+			if err != nil {
+				t.Fatalf("Parse(%q): %s", c.In, err)
+			}
+
+			if actual != c.Expect {
+				t.Errorf("Parse(%q):\nexpected: %#v\ngot:      %#v", c.In, c.Expect, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,885 @@
+package iban
+
+import "github.com/mavolin/standards/iso3166"
+
+// Code generated by tools/codegen/iban_format. DO NOT EDIT.
+
+var formats = map[iso3166.Alpha2Code]Format{
+	iso3166.AD: {
+		Country: iso3166.AD,
+		Length:  24,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 4},
+			{Name: BranchCode, Class: Numeric, Length: 4},
+			{Name: AccountNumber, Class: Alphanumeric, Length: 12},
+		},
+		SEPA:    true,
+		Example: "AD1200012030200359100100",
+	},
+	iso3166.AE: {
+		Country: iso3166.AE,
+		Length:  23,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 3},
+			{Name: AccountNumber, Class: Numeric, Length: 16},
+		},
+		SEPA:    false,
+		Example: "AE070331234567890123456",
+	},
+	iso3166.AL: {
+		Country: iso3166.AL,
+		Length:  28,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 3},
+			{Name: BranchCode, Class: Numeric, Length: 4},
+			{Name: NationalChecksum, Class: Numeric, Length: 1},
+			{Name: AccountNumber, Class: Alphanumeric, Length: 16},
+		},
+		SEPA:    true,
+		Example: "AL47212110090000000235698741",
+	},
+	iso3166.AT: {
+		Country: iso3166.AT,
+		Length:  20,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 5},
+			{Name: AccountNumber, Class: Numeric, Length: 11},
+		},
+		SEPA:    true,
+		Example: "AT611904300234573201",
+	},
+	iso3166.AZ: {
+		Country: iso3166.AZ,
+		Length:  28,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Alpha, Length: 4},
+			{Name: AccountNumber, Class: Alphanumeric, Length: 20},
+		},
+		SEPA:    false,
+		Example: "AZ21NABZ00000000137010001944",
+	},
+	iso3166.BA: {
+		Country: iso3166.BA,
+		Length:  20,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 3},
+			{Name: BranchCode, Class: Numeric, Length: 3},
+			{Name: AccountNumber, Class: Numeric, Length: 8},
+			{Name: NationalChecksum, Class: Numeric, Length: 2},
+		},
+		SEPA:    false,
+		Example: "BA391290079401028494",
+	},
+	iso3166.BE: {
+		Country: iso3166.BE,
+		Length:  16,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 3},
+			{Name: AccountNumber, Class: Numeric, Length: 7},
+			{Name: NationalChecksum, Class: Numeric, Length: 2},
+		},
+		SEPA:    true,
+		Example: "BE68539007547034",
+	},
+	iso3166.BG: {
+		Country: iso3166.BG,
+		Length:  22,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Alpha, Length: 4},
+			{Name: BranchCode, Class: Numeric, Length: 4},
+			{Name: AccountType, Class: Numeric, Length: 2},
+			{Name: AccountNumber, Class: Alphanumeric, Length: 8},
+		},
+		SEPA:    true,
+		Example: "BG80BNBG96611020345678",
+	},
+	iso3166.BH: {
+		Country: iso3166.BH,
+		Length:  22,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Alpha, Length: 4},
+			{Name: AccountNumber, Class: Alphanumeric, Length: 14},
+		},
+		SEPA:    false,
+		Example: "BH67BMAG00001299123456",
+	},
+	iso3166.BR: {
+		Country: iso3166.BR,
+		Length:  29,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 8},
+			{Name: BranchCode, Class: Numeric, Length: 5},
+			{Name: AccountNumber, Class: Numeric, Length: 10},
+			{Name: AccountType, Class: Alpha, Length: 1},
+			{Name: OwnerAccountNumber, Class: Alphanumeric, Length: 1},
+		},
+		SEPA:    false,
+		Example: "BR1800360305000010009795493C1",
+	},
+	iso3166.BY: {
+		Country: iso3166.BY,
+		Length:  28,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Alphanumeric, Length: 4},
+			{Name: BalanceAccountNumber, Class: Numeric, Length: 4},
+			{Name: AccountNumber, Class: Alphanumeric, Length: 16},
+		},
+		SEPA:    false,
+		Example: "BY13NBRB3600900000002Z00AB00",
+	},
+	iso3166.CH: {
+		Country: iso3166.CH,
+		Length:  21,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 5},
+			{Name: AccountNumber, Class: Alphanumeric, Length: 12},
+		},
+		SEPA:    true,
+		Example: "CH9300762011623852957",
+	},
+	iso3166.CR: {
+		Country: iso3166.CR,
+		Length:  22,
+		Fields: []FieldSpec{
+			{Name: "", Class: Numeric, Length: 1},
+			{Name: BankCode, Class: Numeric, Length: 3},
+			{Name: AccountNumber, Class: Numeric, Length: 14},
+		},
+		SEPA:    false,
+		Example: "CR05015202001026284066",
+	},
+	iso3166.CY: {
+		Country: iso3166.CY,
+		Length:  28,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 3},
+			{Name: BranchCode, Class: Numeric, Length: 5},
+			{Name: AccountNumber, Class: Alphanumeric, Length: 16},
+		},
+		SEPA:    true,
+		Example: "CY17002001280000001200527600",
+	},
+	iso3166.CZ: {
+		Country: iso3166.CZ,
+		Length:  24,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 4},
+			{Name: AccountNumberPrefix, Class: Numeric, Length: 4},
+			{Name: BranchCode, Class: Numeric, Length: 2},
+			{Name: AccountNumber, Class: Numeric, Length: 10},
+		},
+		SEPA:    true,
+		Example: "CZ6508000000192000145399",
+	},
+	iso3166.DE: {
+		Country: iso3166.DE,
+		Length:  22,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 8},
+			{Name: AccountNumber, Class: Numeric, Length: 10},
+		},
+		SEPA:    true,
+		Example: "DE89370400440532013000",
+	},
+	iso3166.DK: {
+		Country: iso3166.DK,
+		Length:  18,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 4},
+			{Name: AccountNumber, Class: Numeric, Length: 9},
+			{Name: NationalChecksum, Class: Numeric, Length: 1},
+		},
+		SEPA:    true,
+		Example: "DK5000400440116243",
+	},
+	iso3166.DO: {
+		Country: iso3166.DO,
+		Length:  28,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Alpha, Length: 4},
+			{Name: AccountNumber, Class: Numeric, Length: 20},
+		},
+		SEPA:    false,
+		Example: "DO28BAGR00000001212453611324",
+	},
+	iso3166.EE: {
+		Country: iso3166.EE,
+		Length:  20,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 2},
+			{Name: BranchCode, Class: Numeric, Length: 2},
+			{Name: AccountNumber, Class: Numeric, Length: 11},
+			{Name: NationalChecksum, Class: Numeric, Length: 1},
+		},
+		SEPA:    true,
+		Example: "EE382200221020145685",
+	},
+	iso3166.EG: {
+		Country: iso3166.EG,
+		Length:  29,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 4},
+			{Name: BranchCode, Class: Numeric, Length: 4},
+			{Name: AccountNumber, Class: Numeric, Length: 17},
+		},
+		SEPA:    false,
+		Example: "EG380019000500000000263180002",
+	},
+	iso3166.ES: {
+		Country: iso3166.ES,
+		Length:  24,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 4},
+			{Name: BranchCode, Class: Numeric, Length: 4},
+			{Name: NationalChecksum, Class: Numeric, Length: 2},
+			{Name: AccountNumber, Class: Numeric, Length: 10},
+		},
+		SEPA:    true,
+		Example: "ES9121000418450200051332",
+	},
+	iso3166.FI: {
+		Country: iso3166.FI,
+		Length:  18,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 6},
+			{Name: AccountNumber, Class: Numeric, Length: 7},
+			{Name: NationalChecksum, Class: Numeric, Length: 1},
+		},
+		SEPA:    true,
+		Example: "FI2112345600000785",
+	},
+	iso3166.FO: {
+		Country: iso3166.FO,
+		Length:  18,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 4},
+			{Name: AccountNumber, Class: Numeric, Length: 9},
+			{Name: NationalChecksum, Class: Numeric, Length: 1},
+		},
+		SEPA:    false,
+		Example: "FO6264600001631634",
+	},
+	iso3166.FR: {
+		Country: iso3166.FR,
+		Length:  27,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 5},
+			{Name: BranchCode, Class: Numeric, Length: 5},
+			{Name: AccountNumber, Class: Alphanumeric, Length: 11},
+			{Name: NationalChecksum, Class: Numeric, Length: 2},
+		},
+		SEPA:    true,
+		Example: "FR1420041010050500013M02606",
+	},
+	iso3166.GB: {
+		Country: iso3166.GB,
+		Length:  22,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Alpha, Length: 4},
+			{Name: BranchCode, Class: Numeric, Length: 6},
+			{Name: AccountNumber, Class: Numeric, Length: 8},
+		},
+		SEPA:    true,
+		Example: "GB29NWBK60161331926819",
+	},
+	iso3166.GE: {
+		Country: iso3166.GE,
+		Length:  22,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Alphanumeric, Length: 2},
+			{Name: AccountNumber, Class: Numeric, Length: 16},
+		},
+		SEPA:    false,
+		Example: "GE29NB0000000101904917",
+	},
+	iso3166.GI: {
+		Country: iso3166.GI,
+		Length:  23,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Alpha, Length: 4},
+			{Name: AccountNumber, Class: Alphanumeric, Length: 15},
+		},
+		SEPA:    true,
+		Example: "GI75NWBK000000007099453",
+	},
+	iso3166.GL: {
+		Country: iso3166.GL,
+		Length:  18,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 4},
+			{Name: AccountNumber, Class: Numeric, Length: 9},
+			{Name: NationalChecksum, Class: Numeric, Length: 1},
+		},
+		SEPA:    false,
+		Example: "GL8964710001000206",
+	},
+	iso3166.GR: {
+		Country: iso3166.GR,
+		Length:  27,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 3},
+			{Name: BranchCode, Class: Numeric, Length: 4},
+			{Name: AccountNumber, Class: Alphanumeric, Length: 16},
+		},
+		SEPA:    true,
+		Example: "GR1601101250000000012300695",
+	},
+	iso3166.GT: {
+		Country: iso3166.GT,
+		Length:  28,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Alphanumeric, Length: 4},
+			{Name: CurrencyCode, Class: Alphanumeric, Length: 2},
+			{Name: AccountType, Class: Alphanumeric, Length: 2},
+			{Name: AccountNumber, Class: Alphanumeric, Length: 16},
+		},
+		SEPA:    false,
+		Example: "GT82TRAJ01020000001210029690",
+	},
+	iso3166.HR: {
+		Country: iso3166.HR,
+		Length:  21,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 7},
+			{Name: AccountNumber, Class: Numeric, Length: 10},
+		},
+		SEPA:    true,
+		Example: "HR1210010051863000160",
+	},
+	iso3166.HU: {
+		Country: iso3166.HU,
+		Length:  28,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 3},
+			{Name: BranchCode, Class: Numeric, Length: 4},
+			{Name: NationalChecksum, Class: Numeric, Length: 1},
+			{Name: AccountNumber, Class: Numeric, Length: 15},
+			{Name: NationalChecksum, Class: Numeric, Length: 1},
+		},
+		SEPA:    true,
+		Example: "HU42117730161111101800000000",
+	},
+	iso3166.IE: {
+		Country: iso3166.IE,
+		Length:  22,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Alphanumeric, Length: 4},
+			{Name: BankCode, Class: Numeric, Length: 6},
+			{Name: AccountNumber, Class: Numeric, Length: 8},
+		},
+		SEPA:    true,
+		Example: "IE29AIBK93115212345678",
+	},
+	iso3166.IL: {
+		Country: iso3166.IL,
+		Length:  23,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 3},
+			{Name: BranchCode, Class: Numeric, Length: 3},
+			{Name: AccountNumber, Class: Numeric, Length: 13},
+		},
+		SEPA:    false,
+		Example: "IL620108000000099999999",
+	},
+	iso3166.IQ: {
+		Country: iso3166.IQ,
+		Length:  23,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Alpha, Length: 4},
+			{Name: BranchCode, Class: Numeric, Length: 3},
+			{Name: AccountNumber, Class: Numeric, Length: 12},
+		},
+		SEPA:    false,
+		Example: "IQ98NBIQ850123456789012",
+	},
+	iso3166.IS: {
+		Country: iso3166.IS,
+		Length:  26,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 2},
+			{Name: BranchCode, Class: Numeric, Length: 2},
+			{Name: AccountType, Class: Numeric, Length: 2},
+			{Name: AccountNumber, Class: Numeric, Length: 6},
+			{Name: OwnerIdentificationNumber, Class: Numeric, Length: 10},
+		},
+		SEPA:    true,
+		Example: "IS140159260076545510730339",
+	},
+	iso3166.IT: {
+		Country: iso3166.IT,
+		Length:  27,
+		Fields: []FieldSpec{
+			{Name: NationalChecksum, Class: Alpha, Length: 1},
+			{Name: BankCode, Class: Numeric, Length: 5},
+			{Name: BranchCode, Class: Numeric, Length: 5},
+			{Name: AccountNumber, Class: Alphanumeric, Length: 12},
+		},
+		SEPA:    true,
+		Example: "IT60X0542811101000000123456",
+	},
+	iso3166.JO: {
+		Country: iso3166.JO,
+		Length:  30,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Alpha, Length: 4},
+			{Name: BranchCode, Class: Numeric, Length: 4},
+			{Name: AccountNumber, Class: Numeric, Length: 18},
+		},
+		SEPA:    false,
+		Example: "JO94CBJO0010000000000131000302",
+	},
+	iso3166.KW: {
+		Country: iso3166.KW,
+		Length:  30,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Alpha, Length: 4},
+			{Name: AccountNumber, Class: Alphanumeric, Length: 22},
+		},
+		SEPA:    false,
+		Example: "KW81CBKU0000000000001234560101",
+	},
+	iso3166.KZ: {
+		Country: iso3166.KZ,
+		Length:  20,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 3},
+			{Name: AccountNumber, Class: Alphanumeric, Length: 13},
+		},
+		SEPA:    false,
+		Example: "KZ86125KZT5004100100",
+	},
+	iso3166.LB: {
+		Country: iso3166.LB,
+		Length:  28,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 4},
+			{Name: AccountNumber, Class: Alphanumeric, Length: 20},
+		},
+		SEPA:    false,
+		Example: "LB62099900000001001901229114",
+	},
+	iso3166.LC: {
+		Country: iso3166.LC,
+		Length:  32,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Alpha, Length: 4},
+			{Name: AccountNumber, Class: Alphanumeric, Length: 24},
+		},
+		SEPA:    false,
+		Example: "LC55HEMM000100010012001200023015",
+	},
+	iso3166.LI: {
+		Country: iso3166.LI,
+		Length:  21,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 5},
+			{Name: AccountNumber, Class: Alphanumeric, Length: 12},
+		},
+		SEPA:    true,
+		Example: "LI21088100002324013AA",
+	},
+	iso3166.LT: {
+		Country: iso3166.LT,
+		Length:  20,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 5},
+			{Name: AccountNumber, Class: Numeric, Length: 11},
+		},
+		SEPA:    true,
+		Example: "LT121000011101001000",
+	},
+	iso3166.LU: {
+		Country: iso3166.LU,
+		Length:  20,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 3},
+			{Name: AccountNumber, Class: Alphanumeric, Length: 13},
+		},
+		SEPA:    true,
+		Example: "LU280019400644750000",
+	},
+	iso3166.LV: {
+		Country: iso3166.LV,
+		Length:  21,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Alpha, Length: 4},
+			{Name: AccountNumber, Class: Alphanumeric, Length: 13},
+		},
+		SEPA:    true,
+		Example: "LV80BANK0000435195001",
+	},
+	iso3166.LY: {
+		Country: iso3166.LY,
+		Length:  25,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 3},
+			{Name: BranchCode, Class: Numeric, Length: 3},
+			{Name: AccountNumber, Class: Numeric, Length: 15},
+		},
+		SEPA:    false,
+		Example: "LY83002048000020100120361",
+	},
+	iso3166.MC: {
+		Country: iso3166.MC,
+		Length:  27,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 5},
+			{Name: BranchCode, Class: Numeric, Length: 5},
+			{Name: AccountNumber, Class: Alphanumeric, Length: 11},
+			{Name: NationalChecksum, Class: Numeric, Length: 2},
+		},
+		SEPA:    true,
+		Example: "MC5811222000010123456789030",
+	},
+	iso3166.MD: {
+		Country: iso3166.MD,
+		Length:  24,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Alphanumeric, Length: 2},
+			{Name: AccountNumber, Class: Alphanumeric, Length: 18},
+		},
+		SEPA:    true,
+		Example: "MD24AG000225100013104168",
+	},
+	iso3166.ME: {
+		Country: iso3166.ME,
+		Length:  22,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 3},
+			{Name: AccountNumber, Class: Numeric, Length: 13},
+			{Name: NationalChecksum, Class: Numeric, Length: 2},
+		},
+		SEPA:    true,
+		Example: "ME25505000012345678951",
+	},
+	iso3166.MK: {
+		Country: iso3166.MK,
+		Length:  19,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 3},
+			{Name: AccountNumber, Class: Alphanumeric, Length: 10},
+			{Name: NationalChecksum, Class: Numeric, Length: 2},
+		},
+		SEPA:    true,
+		Example: "MK07250120000058984",
+	},
+	iso3166.MR: {
+		Country: iso3166.MR,
+		Length:  27,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 5},
+			{Name: BranchCode, Class: Numeric, Length: 5},
+			{Name: AccountNumber, Class: Numeric, Length: 11},
+			{Name: NationalChecksum, Class: Numeric, Length: 2},
+		},
+		SEPA:    false,
+		Example: "MR1300020001010000123456753",
+	},
+	iso3166.MT: {
+		Country: iso3166.MT,
+		Length:  31,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Alpha, Length: 4},
+			{Name: BranchCode, Class: Numeric, Length: 5},
+			{Name: AccountNumber, Class: Alphanumeric, Length: 18},
+		},
+		SEPA:    true,
+		Example: "MT84MALT011000012345MTLCAST001S",
+	},
+	iso3166.MU: {
+		Country: iso3166.MU,
+		Length:  30,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Alpha, Length: 4},
+			{Name: BankCode, Class: Numeric, Length: 2},
+			{Name: BranchCode, Class: Numeric, Length: 2},
+			{Name: AccountNumber, Class: Numeric, Length: 12},
+			{Name: "", Class: Numeric, Length: 3},
+			{Name: CurrencyCode, Class: Alpha, Length: 3},
+		},
+		SEPA:    false,
+		Example: "MU17BOMM0101101030300200000MUR",
+	},
+	iso3166.NL: {
+		Country: iso3166.NL,
+		Length:  18,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Alpha, Length: 4},
+			{Name: AccountNumber, Class: Numeric, Length: 10},
+		},
+		SEPA:    true,
+		Example: "NL91ABNA0417164300",
+	},
+	iso3166.NO: {
+		Country: iso3166.NO,
+		Length:  15,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 4},
+			{Name: AccountNumber, Class: Numeric, Length: 6},
+			{Name: NationalChecksum, Class: Numeric, Length: 1},
+		},
+		SEPA:    true,
+		Example: "NO9386011117947",
+	},
+	iso3166.PK: {
+		Country: iso3166.PK,
+		Length:  24,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Alphanumeric, Length: 4},
+			{Name: AccountNumber, Class: Numeric, Length: 16},
+		},
+		SEPA:    false,
+		Example: "PK36SCBL0000001123456702",
+	},
+	iso3166.PL: {
+		Country: iso3166.PL,
+		Length:  28,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 3},
+			{Name: BranchCode, Class: Numeric, Length: 4},
+			{Name: NationalChecksum, Class: Numeric, Length: 1},
+			{Name: AccountNumber, Class: Numeric, Length: 16},
+		},
+		SEPA:    true,
+		Example: "PL61109010140000071219812874",
+	},
+	iso3166.PS: {
+		Country: iso3166.PS,
+		Length:  29,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Alphanumeric, Length: 4},
+			{Name: AccountNumber, Class: Numeric, Length: 21},
+		},
+		SEPA:    false,
+		Example: "PS92PALS000000000400123456702",
+	},
+	iso3166.PT: {
+		Country: iso3166.PT,
+		Length:  25,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 4},
+			{Name: BranchCode, Class: Numeric, Length: 4},
+			{Name: AccountNumber, Class: Numeric, Length: 11},
+			{Name: NationalChecksum, Class: Numeric, Length: 2},
+		},
+		SEPA:    true,
+		Example: "PT50000201231234567890154",
+	},
+	iso3166.QA: {
+		Country: iso3166.QA,
+		Length:  29,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Alpha, Length: 4},
+			{Name: AccountNumber, Class: Alphanumeric, Length: 21},
+		},
+		SEPA:    false,
+		Example: "QA58DOHB00001234567890ABCDEFG",
+	},
+	iso3166.RO: {
+		Country: iso3166.RO,
+		Length:  24,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Alpha, Length: 4},
+			{Name: AccountNumber, Class: Alphanumeric, Length: 16},
+		},
+		SEPA:    true,
+		Example: "RO49AAAA1B31007593840000",
+	},
+	iso3166.RS: {
+		Country: iso3166.RS,
+		Length:  22,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 3},
+			{Name: AccountNumber, Class: Numeric, Length: 13},
+			{Name: NationalChecksum, Class: Numeric, Length: 2},
+		},
+		SEPA:    false,
+		Example: "RS35260005601001611379",
+	},
+	iso3166.RU: {
+		Country: iso3166.RU,
+		Length:  33,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 9},
+			{Name: BranchCode, Class: Numeric, Length: 5},
+			{Name: AccountNumber, Class: Alphanumeric, Length: 15},
+		},
+		SEPA:    false,
+		Example: "RU0304452522540817810538091310419",
+	},
+	iso3166.SA: {
+		Country: iso3166.SA,
+		Length:  24,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 2},
+			{Name: AccountNumber, Class: Alphanumeric, Length: 18},
+		},
+		SEPA:    false,
+		Example: "SA0380000000608010167519",
+	},
+	iso3166.SC: {
+		Country: iso3166.SC,
+		Length:  31,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Alpha, Length: 4},
+			{Name: BankCode, Class: Numeric, Length: 2},
+			{Name: BranchCode, Class: Numeric, Length: 2},
+			{Name: AccountNumber, Class: Numeric, Length: 16},
+			{Name: CurrencyCode, Class: Alpha, Length: 3},
+		},
+		SEPA:    false,
+		Example: "SC18SSCB11010000000000001497USD",
+	},
+	iso3166.SD: {
+		Country: iso3166.SD,
+		Length:  18,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 2},
+			{Name: AccountNumber, Class: Numeric, Length: 12},
+		},
+		SEPA:    false,
+		Example: "SD2129010501234001",
+	},
+	iso3166.SE: {
+		Country: iso3166.SE,
+		Length:  24,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 3},
+			{Name: AccountNumber, Class: Numeric, Length: 16},
+			{Name: NationalChecksum, Class: Numeric, Length: 1},
+		},
+		SEPA:    true,
+		Example: "SE4550000000058398257466",
+	},
+	iso3166.SI: {
+		Country: iso3166.SI,
+		Length:  19,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 2},
+			{Name: BranchCode, Class: Numeric, Length: 3},
+			{Name: AccountNumber, Class: Numeric, Length: 8},
+			{Name: NationalChecksum, Class: Numeric, Length: 2},
+		},
+		SEPA:    true,
+		Example: "SI56263300012039086",
+	},
+	iso3166.SK: {
+		Country: iso3166.SK,
+		Length:  24,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 4},
+			{Name: AccountNumberPrefix, Class: Numeric, Length: 4},
+			{Name: BranchCode, Class: Numeric, Length: 2},
+			{Name: AccountNumber, Class: Numeric, Length: 10},
+		},
+		SEPA:    true,
+		Example: "SK3112000000198742637541",
+	},
+	iso3166.SM: {
+		Country: iso3166.SM,
+		Length:  27,
+		Fields: []FieldSpec{
+			{Name: NationalChecksum, Class: Alpha, Length: 1},
+			{Name: BankCode, Class: Numeric, Length: 5},
+			{Name: BranchCode, Class: Numeric, Length: 5},
+			{Name: AccountNumber, Class: Alphanumeric, Length: 12},
+		},
+		SEPA:    true,
+		Example: "SM86U0322509800000000270100",
+	},
+	iso3166.ST: {
+		Country: iso3166.ST,
+		Length:  25,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 4},
+			{Name: BranchCode, Class: Numeric, Length: 4},
+			{Name: AccountNumber, Class: Numeric, Length: 13},
+		},
+		SEPA:    false,
+		Example: "ST68000100010051845310112",
+	},
+	iso3166.SV: {
+		Country: iso3166.SV,
+		Length:  28,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Alpha, Length: 4},
+			{Name: AccountNumber, Class: Numeric, Length: 20},
+		},
+		SEPA:    false,
+		Example: "SV62CENR00000000000000700025",
+	},
+	iso3166.TL: {
+		Country: iso3166.TL,
+		Length:  23,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 3},
+			{Name: AccountNumber, Class: Numeric, Length: 14},
+			{Name: NationalChecksum, Class: Numeric, Length: 2},
+		},
+		SEPA:    false,
+		Example: "TL380080012345678910157",
+	},
+	iso3166.TN: {
+		Country: iso3166.TN,
+		Length:  24,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 2},
+			{Name: BranchCode, Class: Numeric, Length: 3},
+			{Name: AccountNumber, Class: Numeric, Length: 13},
+			{Name: NationalChecksum, Class: Numeric, Length: 2},
+		},
+		SEPA:    false,
+		Example: "TN5910006035183598478831",
+	},
+	iso3166.TR: {
+		Country: iso3166.TR,
+		Length:  26,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 5},
+			{Name: "", Class: Alphanumeric, Length: 1},
+			{Name: AccountNumber, Class: Alphanumeric, Length: 16},
+		},
+		SEPA:    false,
+		Example: "TR330006100519786457841326",
+	},
+	iso3166.UA: {
+		Country: iso3166.UA,
+		Length:  29,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 6},
+			{Name: AccountNumber, Class: Alphanumeric, Length: 19},
+		},
+		SEPA:    false,
+		Example: "UA213223130000026007233566001",
+	},
+	iso3166.VA: {
+		Country: iso3166.VA,
+		Length:  22,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 3},
+			{Name: AccountNumber, Class: Numeric, Length: 15},
+		},
+		SEPA:    true,
+		Example: "VA59001123000012345678",
+	},
+	iso3166.VG: {
+		Country: iso3166.VG,
+		Length:  24,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Alphanumeric, Length: 4},
+			{Name: AccountNumber, Class: Numeric, Length: 16},
+		},
+		SEPA:    false,
+		Example: "VG96VPVG0000012345678901",
+	},
+	iso3166.XK: {
+		Country: iso3166.XK,
+		Length:  20,
+		Fields: []FieldSpec{
+			{Name: BankCode, Class: Numeric, Length: 4},
+			{Name: AccountNumber, Class: Numeric, Length: 12},
+		},
+		SEPA:    false,
+		Example: "XK051212012345678906",
+	},
+}
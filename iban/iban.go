@@ -1,5 +1,5 @@
-// Package iban provides parsing and validation for International Bank Account
-// Numbers (IBAN).
+// Package iban provides parsing, validation, and creation of International
+// Bank Account Numbers (IBAN).
 //
 // It can validate the country code, checksum, and country-specific length and
 // checksum (if the country has one).
@@ -9,14 +9,26 @@
 package iban
 
 import (
+	"database/sql"
+	"database/sql/driver"
 	"encoding"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
 	"strings"
 
+	"github.com/mavolin/standards/de/bankcode"
+	"github.com/mavolin/standards/internal/sqlutil"
 	"github.com/mavolin/standards/iso3166"
 )
 
 const maxLen = 34
 
+// ErrNoBankDirectory is returned by [IBAN.Bank], if there is no bank
+// directory for the IBAN's country.
+var ErrNoBankDirectory = errors.New("iban: no bank directory available for country")
+
 // IBAN represents an International Bank Account Number.
 type IBAN struct {
 	// CountryCode is the ISO 3166-1 alpha-2 country code of the IBAN.
@@ -46,27 +58,197 @@ type IBAN struct {
 //
 // If len(IBAN)%4 != 0, then the last group will be shorter than 4 characters.
 func (iban IBAN) String() string {
+	return group(iban.Compact())
+}
+
+// Masked pretty-prints the IBAN like [IBAN.String], but replaces all
+// characters of the BBAN with '*', except the last four characters of the
+// account number, e.g. "DE89 **** **** **** **30 00".
+//
+// If the country's BBAN format is unknown or has no account number, the last
+// four characters of the BBAN are kept instead.
+func (iban IBAN) Masked() string {
+	return group(iban.maskedCompact())
+}
+
+var _ fmt.GoStringer = IBAN{}
+
+// GoString returns the Go syntax representation of the IBAN, as printed by
+// %#v.
+func (iban IBAN) GoString() string {
+	return fmt.Sprintf("iban.IBAN{CountryCode:%#v, Checksum:%#v, BBAN:%q, BankCode:%q, BranchCode:%q, "+
+		"AccountType:%q, OwnerAccountNumber:%q, OwnerIdentificationNumber:%q, BalanceAccountNumber:%q, "+
+		"AccountNumberPrefix:%q, AccountNumber:%q, NationalChecksum:%q, CurrencyCode:%q}",
+		iban.CountryCode, iban.Checksum, iban.BBAN, iban.BankCode, iban.BranchCode,
+		iban.AccountType, iban.OwnerAccountNumber, iban.OwnerIdentificationNumber, iban.BalanceAccountNumber,
+		iban.AccountNumberPrefix, iban.AccountNumber, iban.NationalChecksum, iban.CurrencyCode)
+}
+
+var _ fmt.Formatter = IBAN{}
+
+// Format implements [fmt.Formatter].
+//
+// It supports the following verbs:
+//
+//	%s, %v  the IBAN in groups of four, like String
+//	%q      the same as %s, but quoted
+//	%m      the masked IBAN in groups of four, like Masked
+//
+// The '#' flag prints the IBAN in compact form instead, e.g. %#s prints the
+// same as Compact.
+// The '+' flag groups the BBAN by its fields instead of by four characters,
+// e.g. "DE89 37040044 0532013000".
+// Width and the '-' flag are respected as well.
+//
+// %#v prints the IBAN in Go syntax, like GoString.
+func (iban IBAN) Format(f fmt.State, verb rune) {
+	var s string
+	switch verb {
+	case 's', 'v', 'q':
+		if verb == 'v' && f.Flag('#') {
+			_, _ = io.WriteString(f, iban.GoString())
+			return
+		}
+
+		s = iban.Compact()
+	case 'm':
+		s = iban.maskedCompact()
+	default:
+		fmt.Fprintf(f, "%%!%c(iban.IBAN=%s)", verb, iban.String())
+		return
+	}
+
+	switch {
+	case f.Flag('#'):
+	case f.Flag('+'):
+		s = iban.groupByField(s)
+	default:
+		s = group(s)
+	}
+
+	if verb == 'q' {
+		s = strconv.Quote(s)
+	}
+
+	if w, ok := f.Width(); ok && w > len(s) {
+		pad := strings.Repeat(" ", w-len(s))
+		if f.Flag('-') {
+			s += pad
+		} else {
+			s = pad + s
+		}
+	}
+
+	_, _ = io.WriteString(f, s)
+}
+
+// maskedCompact returns the compact form of the IBAN with all characters of
+// the BBAN masked, except the last four characters of the account number.
+func (iban IBAN) maskedCompact() string {
+	compact := []byte(iban.Compact())
+	// the zero IBAN, or an IBAN constructed by hand, might not even have a
+	// BBAN to mask
+	if len(compact) < 4 {
+		return string(compact)
+	}
+
+	var keep []int
+	if f, ok := formats[iban.CountryCode]; ok {
+		keep = f.positions(AccountNumber)
+	}
+	if len(keep) == 0 {
+		for i := range iban.BBAN {
+			keep = append(keep, i)
+		}
+	}
+	if len(keep) > 4 {
+		keep = keep[len(keep)-4:]
+	}
+
+	bban := compact[4:]
+	for i := range bban {
+		if len(keep) > 0 && keep[0] == i {
+			keep = keep[1:]
+			continue
+		}
+
+		bban[i] = '*'
+	}
+
+	return string(compact)
+}
+
+// group inserts a space after every four characters of the passed compact
+// IBAN.
+func group(compact string) string {
 	var sb strings.Builder
 	// maxLen plus a space for every 4 characters
 	sb.Grow(maxLen + maxLen/4 + 1)
 
-	sb.WriteString(iban.CountryCode.Code)
-	sb.WriteString(twoDigitStr(int(iban.Checksum)))
-
-	for i := 0; i < len(iban.BBAN); i += 4 {
-		sb.WriteByte(' ')
+	for i := 0; i < len(compact); i += 4 {
+		if i > 0 {
+			sb.WriteByte(' ')
+		}
 
 		end := i + 4
-		if end > len(iban.BBAN) {
-			end = len(iban.BBAN)
+		if end > len(compact) {
+			end = len(compact)
+		}
+
+		sb.WriteString(compact[i:end])
+	}
+
+	return sb.String()
+}
+
+// groupByField inserts a space after the check digits and after each field of
+// the passed compact form of the IBAN.
+//
+// If the IBAN's BBAN format is unknown, the IBAN is grouped using group.
+func (iban IBAN) groupByField(compact string) string {
+	f, ok := formats[iban.CountryCode]
+	if !ok || len(compact) != f.Length {
+		return group(compact)
+	}
+
+	var sb strings.Builder
+	// the length plus a space before every field
+	sb.Grow(len(compact) + len(f.Fields))
+
+	sb.WriteString(compact[:4])
+
+	offset := 4
+	for i, spec := range f.Fields {
+		// don't separate the parts of a field that is split into multiple
+		// FieldSpecs
+		if i == 0 || f.Fields[i-1].Name != spec.Name {
+			sb.WriteByte(' ')
 		}
 
-		sb.WriteString(iban.BBAN[i:end])
+		sb.WriteString(compact[offset : offset+spec.Length])
+		offset += spec.Length
 	}
 
 	return sb.String()
 }
 
+// Bank resolves the bank of the IBAN.
+//
+// It is currently only available for German IBANs, for all other countries
+// ErrNoBankDirectory is returned.
+//
+// If the IBAN's bank code is marked for deletion, the bank identified by the
+// successor bank code is returned, as that is the bank payments will be
+// routed to.
+// See [bankcode.Resolve] for details.
+func (iban IBAN) Bank() (bankcode.Bank, error) {
+	if iban.CountryCode != iso3166.DE {
+		return bankcode.Bank{}, ErrNoBankDirectory
+	}
+
+	return bankcode.Resolve(bankcode.BankCode(iban.BankCode))
+}
+
 func (iban IBAN) Compact() string {
 	return iban.CountryCode.Code + twoDigitStr(int(iban.Checksum)) + iban.BBAN
 }
@@ -89,6 +271,46 @@ func (iban *IBAN) UnmarshalText(text []byte) error {
 	return nil
 }
 
+var _ sql.Scanner = (*IBAN)(nil)
+
+// Scan implements [sql.Scanner] for IBANs stored as text, e.g. in a
+// VARCHAR(34) column.
+// Like [Parse], it accepts IBANs with or without spaces, and validates their
+// checksums, so that corrupted rows are reported.
+//
+// NULL is scanned into the zero IBAN.
+func (iban *IBAN) Scan(src any) error {
+	s, ok, err := sqlutil.String(src, "iban", "IBAN")
+	if err != nil {
+		return err
+	} else if !ok {
+		*iban = IBAN{}
+		return nil
+	}
+
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+
+	*iban = parsed
+	return nil
+}
+
+var _ driver.Valuer = IBAN{}
+
+// Value implements [driver.Valuer] by storing the IBAN without spaces, as
+// returned by [IBAN.Compact].
+//
+// The zero IBAN is stored as NULL.
+func (iban IBAN) Value() (driver.Value, error) {
+	if iban == (IBAN{}) {
+		return nil, nil
+	}
+
+	return iban.Compact(), nil
+}
+
 func twoDigitStr(n int) string {
 	return string(rune('0'+n/10)) + string(rune('0'+n%10))
 }
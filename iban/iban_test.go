@@ -0,0 +1,151 @@
+package iban
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/mavolin/standards/iso3166"
+)
+
+func TestIBAN_Masked(t *testing.T) {
+	testCases := []struct {
+		In     string
+		Expect string
+	}{
+		{In: "DE89370400440532013000", Expect: "DE89 **** **** **** **30 00"},
+		{In: "FR1420041010050500013M02606", Expect: "FR14 **** **** **** **** *M02 6**"},
+		{In: "NL91ABNA0417164300", Expect: "NL91 **** **** **43 00"},
+		{In: "NO9386011117947", Expect: "NO93 **** **17 94*"},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.In, func(t *testing.T) {
+			iban, err := Parse(c.In)
+			if err != nil {
+				t.Fatalf("Parse(%q): %s", c.In, err)
+			}
+
+			if actual := iban.Masked(); actual != c.Expect {
+				t.Errorf("expected %q, got %q", c.Expect, actual)
+			}
+		})
+	}
+}
+
+func TestIBAN_Masked_zero(t *testing.T) {
+	if actual := (IBAN{}).Masked(); actual != "00" {
+		t.Errorf("expected %q, got %q", "00", actual)
+	}
+
+	for _, format := range []string{"%m", "%#m", "%+m"} {
+		if actual := fmt.Sprintf(format, IBAN{}); actual != "00" {
+			t.Errorf("fmt.Sprintf(%q): expected %q, got %q", format, "00", actual)
+		}
+	}
+}
+
+func TestIBAN_Format(t *testing.T) {
+	iban, err := Parse("DE89370400440532013000")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Format string
+		Expect string
+	}{
+		{Format: "%s", Expect: "DE89 3704 0044 0532 0130 00"},
+		{Format: "%v", Expect: "DE89 3704 0044 0532 0130 00"},
+		{Format: "%#s", Expect: "DE89370400440532013000"},
+		{Format: "%+s", Expect: "DE89 37040044 0532013000"},
+		{Format: "%q", Expect: `"DE89 3704 0044 0532 0130 00"`},
+		{Format: "%#q", Expect: `"DE89370400440532013000"`},
+		{Format: "%m", Expect: "DE89 **** **** **** **30 00"},
+		{Format: "%#m", Expect: "DE89**************3000"},
+		{Format: "%+m", Expect: "DE89 ******** ******3000"},
+		{Format: "%30s|", Expect: "   DE89 3704 0044 0532 0130 00|"},
+		{Format: "%-#24s|", Expect: "DE89370400440532013000  |"},
+		{Format: "%d", Expect: "%!d(iban.IBAN=DE89 3704 0044 0532 0130 00)"},
+		{
+			Format: "%#v",
+			Expect: `iban.IBAN{CountryCode:iso3166.Alpha2Code{Code:"DE", Status:0x1, Country:"Germany"}, ` +
+				`Checksum:0x59, BBAN:"370400440532013000", BankCode:"37040044", BranchCode:"", AccountType:"", ` +
+				`OwnerAccountNumber:"", OwnerIdentificationNumber:"", BalanceAccountNumber:"", ` +
+				`AccountNumberPrefix:"", AccountNumber:"0532013000", NationalChecksum:"", CurrencyCode:""}`,
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.Format, func(t *testing.T) {
+			if actual := fmt.Sprintf(c.Format, iban); actual != c.Expect {
+				t.Errorf("fmt.Sprintf(%q): expected %q, got %q", c.Format, c.Expect, actual)
+			}
+		})
+	}
+}
+
+func TestIBAN_Scan(t *testing.T) {
+	successCases := []struct {
+		Name   string
+		In     any
+		Expect string
+	}{
+		{Name: "string", In: "DE89370400440532013000", Expect: "DE89370400440532013000"},
+		{Name: "bytes", In: []byte("DE89 3704 0044 0532 0130 00"), Expect: "DE89370400440532013000"},
+		{Name: "padded", In: "NL91ABNA0417164300    ", Expect: "NL91ABNA0417164300"},
+	}
+
+	for _, c := range successCases {
+		t.Run(c.Name, func(t *testing.T) {
+			var iban IBAN
+			if err := iban.Scan(c.In); err != nil {
+				t.Fatalf("Scan(%#v): %s", c.In, err)
+			}
+
+			if actual := iban.Compact(); actual != c.Expect {
+				t.Errorf("expected %q, got %q", c.Expect, actual)
+			}
+		})
+	}
+
+	t.Run("null", func(t *testing.T) {
+		iban := IBAN{CountryCode: iso3166.DE, Checksum: 89, BBAN: "370400440532013000"}
+		if err := iban.Scan(nil); err != nil {
+			t.Fatalf("Scan(nil): %s", err)
+		}
+
+		if iban != (IBAN{}) {
+			t.Errorf("expected zero value, got %#v", iban)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var iban IBAN
+		if err := iban.Scan("DE88370400440532013000"); !errors.Is(err, ErrChecksum) {
+			t.Errorf("expected ErrChecksum, got %v", err)
+		}
+	})
+
+	t.Run("unsupported type", func(t *testing.T) {
+		var iban IBAN
+		if err := iban.Scan(int64(1)); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestIBAN_Value(t *testing.T) {
+	iban, err := Parse("DE89 3704 0044 0532 0130 00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if actual, err := iban.Value(); err != nil || actual != "DE89370400440532013000" {
+		t.Errorf("expected %q, got %#v (err: %v)", "DE89370400440532013000", actual, err)
+	}
+
+	if actual, err := (IBAN{}).Value(); err != nil || actual != nil {
+		t.Errorf("expected nil, got %#v (err: %v)", actual, err)
+	}
+}
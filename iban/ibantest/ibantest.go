@@ -0,0 +1,110 @@
+// Package ibantest provides utilities for testing with IBANs.
+package ibantest
+
+import (
+	"errors"
+	"math/rand"
+
+	"github.com/mavolin/standards/iban"
+	"github.com/mavolin/standards/iso3166"
+)
+
+// ErrNoValidIBAN is returned by [Generate], if it couldn't find a valid IBAN
+// for the passed fields.
+var ErrNoValidIBAN = errors.New("ibantest: could not generate a valid iban for the given fields")
+
+// maxAttempts is the maximum number of IBANs Generate generates, before
+// giving up.
+//
+// For most countries, the first attempt results in a valid IBAN.
+// Only countries whose national check digits are part of other fields, e.g.
+// the account numbers of German IBANs, require multiple attempts, as Generate
+// can't compute those check digits, but must guess them.
+const maxAttempts = 1000
+
+const (
+	digits  = "0123456789"
+	letters = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+)
+
+// Generate generates a random IBAN for the passed country using r.
+//
+// The generated IBAN conforms to the country's BBAN format, and has valid
+// national check digits, if the country has them, and valid check digits.
+// Note that while the generated IBANs are valid, they are not necessarily
+// assigned to an existing account, or even bank.
+//
+// By passing fields, parts of the IBAN can be fixed, e.g. to restrict the
+// generated IBANs to a specific bank code.
+// All fields of the country's format that are not passed are generated
+// randomly.
+//
+// If the country's BBAN format is unknown, Generate returns
+// [iban.ErrNoFormat].
+// If the passed fields are invalid, Generate returns the error returned by
+// [iban.New].
+// If Generate can't find an IBAN with valid national check digits, e.g.
+// because the national check digits of the passed fields are invalid, it
+// returns [ErrNoValidIBAN].
+func Generate(r *rand.Rand, country iso3166.Alpha2Code, fields ...iban.Field) (iban.IBAN, error) {
+	f, ok := iban.FormatFor(country)
+	if !ok {
+		return iban.IBAN{}, iban.ErrNoFormat
+	}
+
+	fixed := make(map[iban.FieldName]bool, len(fields))
+	for _, field := range fields {
+		fixed[field.Name] = true
+	}
+
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		// the fields we generate, followed by the fields that were passed
+		all := make([]iban.Field, 0, len(f.Fields)+len(fields))
+
+		for _, spec := range f.Fields {
+			// iban.New computes the national checksum for us, if it's a
+			// field of its own
+			if spec.Name == "" || spec.Name == iban.NationalChecksum || fixed[spec.Name] {
+				continue
+			}
+
+			// fields split into multiple specs are appended to
+			if n := len(all); n > 0 && all[n-1].Name == spec.Name {
+				all[n-1].Value += randomString(r, spec.Class, spec.Length)
+				continue
+			}
+
+			all = append(all, iban.Field{Name: spec.Name, Value: randomString(r, spec.Class, spec.Length)})
+		}
+
+		all = append(all, fields...)
+
+		generated, err := iban.New(country, all...)
+		if err == nil {
+			return generated, nil
+		} else if !errors.Is(err, iban.ErrNationalChecksum) {
+			return iban.IBAN{}, err
+		}
+	}
+
+	return iban.IBAN{}, ErrNoValidIBAN
+}
+
+func randomString(r *rand.Rand, class iban.CharClass, n int) string {
+	var chars string
+	switch class {
+	case iban.Numeric:
+		chars = digits
+	case iban.Alpha:
+		chars = letters
+	default:
+		chars = digits + letters
+	}
+
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = chars[r.Intn(len(chars))]
+	}
+
+	return string(b)
+}
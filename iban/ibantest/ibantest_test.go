@@ -0,0 +1,73 @@
+package ibantest
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/mavolin/standards/iban"
+	"github.com/mavolin/standards/iso3166"
+)
+
+func TestGenerate(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for a := 'A'; a <= 'Z'; a++ {
+		for b := 'A'; b <= 'Z'; b++ {
+			country, err := iso3166.ParseAlpha2(string(a) + string(b))
+			if err != nil {
+				continue
+			}
+
+			if _, ok := iban.FormatFor(country); !ok {
+				continue
+			}
+
+			t.Run(country.Code, func(t *testing.T) {
+				for i := 0; i < 25; i++ {
+					generated, err := Generate(r, country)
+					if err != nil {
+						t.Fatalf("Generate(%s): %s", country.Code, err)
+					}
+
+					if _, err := iban.Parse(generated.Compact()); err != nil {
+						t.Fatalf("Generate(%s): generated invalid IBAN %q: %s", country.Code, generated.Compact(), err)
+					}
+				}
+			})
+		}
+	}
+
+	t.Run("bank code", func(t *testing.T) {
+		for i := 0; i < 25; i++ {
+			generated, err := Generate(r, iso3166.DE, iban.Field{Name: iban.BankCode, Value: "37040044"})
+			if err != nil {
+				t.Fatalf("Generate: %s", err)
+			}
+
+			if generated.BankCode != "37040044" {
+				t.Fatalf("Generate: expected bank code 37040044, got %s", generated.BankCode)
+			}
+		}
+	})
+
+	t.Run("no format", func(t *testing.T) {
+		if _, err := Generate(r, iso3166.US); !errors.Is(err, iban.ErrNoFormat) {
+			t.Errorf("Generate: expected iban.ErrNoFormat, got %v", err)
+		}
+	})
+
+	t.Run("invalid field", func(t *testing.T) {
+		_, err := Generate(r, iso3166.DE, iban.Field{Name: iban.BankCode, Value: "123456789"})
+		if !errors.Is(err, iban.ErrField) {
+			t.Errorf("Generate: expected iban.ErrField, got %v", err)
+		}
+	})
+
+	t.Run("invalid national checksum", func(t *testing.T) {
+		_, err := Generate(r, iso3166.BE, iban.Field{Name: iban.NationalChecksum, Value: "00"})
+		if !errors.Is(err, ErrNoValidIBAN) {
+			t.Errorf("Generate: expected ErrNoValidIBAN, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,139 @@
+package iban
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/mavolin/standards/iso3166"
+)
+
+var (
+	// ErrNoNationalFormat is returned by [FromNational], if the national
+	// format of the country's account numbers is unknown.
+	ErrNoNationalFormat = errors.New("iban: no national format available for country")
+	// ErrNationalFormat is returned by [FromNational], if the account number
+	// doesn't consist of the parts of the country's national format.
+	ErrNationalFormat = errors.New("iban: account number does not match the country's national format")
+)
+
+// nationalFormat describes how the account numbers of a country are written
+// in their national, i.e. pre-IBAN, format.
+type nationalFormat struct {
+	// fields are the fields of the IBAN making up the national format, in
+	// the order they are written in.
+	fields []FieldName
+	// sep is the separator placed between the fields.
+	sep string
+}
+
+// nationalFormats are the national formats of the countries, whose national
+// account numbers consist of the fields of the BBAN.
+var nationalFormats = map[iso3166.Alpha2Code]nationalFormat{
+	// Bankleitzahl and Kontonummer
+	iso3166.AT: {fields: []FieldName{BankCode, AccountNumber}, sep: " "},
+	// https://www.ecbs.org/Download/Tr201v3.9.pdf (page 23)
+	iso3166.BE: {fields: []FieldName{BankCode, AccountNumber, NationalChecksum}, sep: "-"},
+	// clearing number (IID) and account number
+	iso3166.CH: {fields: []FieldName{BankCode, AccountNumber}, sep: " "},
+	// Bankleitzahl and Kontonummer
+	iso3166.DE: {fields: []FieldName{BankCode, AccountNumber}, sep: " "},
+	// Código Cuenta Cliente (CCC)
+	iso3166.ES: {fields: []FieldName{BankCode, BranchCode, NationalChecksum, AccountNumber}, sep: " "},
+	// Relevé d'Identité Bancaire (RIB)
+	iso3166.FR: {fields: []FieldName{BankCode, BranchCode, AccountNumber, NationalChecksum}, sep: " "},
+	// Coordinate Bancarie (CIN, ABI, CAB, and conto)
+	iso3166.IT: {fields: []FieldName{NationalChecksum, BankCode, BranchCode, AccountNumber}, sep: " "},
+	// clearing number (IID) and account number
+	iso3166.LI: {fields: []FieldName{BankCode, AccountNumber}, sep: " "},
+	// Relevé d'Identité Bancaire (RIB)
+	iso3166.MC: {fields: []FieldName{BankCode, BranchCode, AccountNumber, NationalChecksum}, sep: " "},
+	// Número de Identificação Bancária (NIB)
+	iso3166.PT: {fields: []FieldName{BankCode, BranchCode, AccountNumber, NationalChecksum}, sep: " "},
+	// Coordinate Bancarie (CIN, ABI, CAB, and conto)
+	iso3166.SM: {fields: []FieldName{NationalChecksum, BankCode, BranchCode, AccountNumber}, sep: " "},
+}
+
+// FromNational converts the passed account number, given in the national
+// format of the passed country, to an IBAN.
+//
+// The parts of the national account number may be separated by spaces,
+// hyphens, dots, or slashes.
+// If they are, parts shorter than their field are padded with leading zeros,
+// e.g. the German account number "37040044 532013000" becomes
+// "DE89 3704 0044 0532 0130 00".
+// If the parts are not separated, the account number must have the exact
+// length of the country's BBAN.
+//
+// Lowercase letters are converted to uppercase.
+//
+// National formats are available for AT, BE, CH, DE, ES, FR, IT, LI, MC, PT,
+// and SM, for all other countries, FromNational returns
+// [ErrNoNationalFormat].
+//
+// Like [New], FromNational validates the resulting IBAN, including the
+// national checksum.
+func FromNational(country iso3166.Alpha2Code, s string) (IBAN, error) {
+	nf, ok := nationalFormats[country]
+	if !ok {
+		return IBAN{}, ErrNoNationalFormat
+	}
+
+	parts := strings.FieldsFunc(strings.ToUpper(s), func(r rune) bool {
+		return r == ' ' || r == '-' || r == '.' || r == '/'
+	})
+
+	if len(parts) == 1 && len(nf.fields) > 1 {
+		parts = splitNational(formats[country], nf, parts[0])
+	}
+
+	if len(parts) != len(nf.fields) {
+		return IBAN{}, ErrNationalFormat
+	}
+
+	fields := make([]Field, len(nf.fields))
+	for i, name := range nf.fields {
+		fields[i] = Field{Name: name, Value: parts[i]}
+	}
+
+	return New(country, fields...)
+}
+
+// splitNational splits the passed unseparated national account number into
+// its parts.
+//
+// If s doesn't have the length of the BBAN, splitNational returns nil.
+func splitNational(f Format, nf nationalFormat, s string) []string {
+	if len(s) != f.BBANLength() {
+		return nil
+	}
+
+	parts := make([]string, len(nf.fields))
+	for i, name := range nf.fields {
+		n := len(f.positions(name))
+		parts[i] = s[:n]
+		s = s[n:]
+	}
+
+	return parts
+}
+
+// National returns the account number in the national format of the IBAN's
+// country, e.g. "539-0075470-34" for the Belgian IBAN
+// "BE68 5390 0754 7034".
+//
+// If the national format of the country is unknown, National returns an
+// empty string.
+// See [FromNational] for a list of supported countries.
+func (iban IBAN) National() string {
+	nf, ok := nationalFormats[iban.CountryCode]
+	if !ok {
+		return ""
+	}
+
+	parts := make([]string, len(nf.fields))
+	for i, name := range nf.fields {
+		parts[i] = iban.field(name)
+	}
+
+	return strings.Join(parts, nf.sep)
+}
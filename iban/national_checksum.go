@@ -7,7 +7,7 @@ import (
 	"github.com/mavolin/standards/iso3166"
 )
 
-var checksumFuncs = map[iso3166.Alpha2Code]func(IBAN) bool{
+var checksumFuncs = map[iso3166.Alpha2Code]func(IBAN) error{
 	// https://en.wikipedia.org/wiki/International_Bank_Account_Number#National_check_digits
 	// 2023-01-22
 	// https://bank-code.net/iban/country-list
@@ -22,42 +22,46 @@ var checksumFuncs = map[iso3166.Alpha2Code]func(IBAN) bool{
 	// BBAN, minus the national checksum.
 
 	// https://en.wikipedia.org/wiki/International_Bank_Account_Number#National_check_digits
-	iso3166.AL: func(iban IBAN) bool {
+	iso3166.AL: func(iban IBAN) error {
 		check := weighted(iban.BankCode+iban.BranchCode, 10, 9, 7, 3, 1)
 		if check != 0 {
 			check = 10 - check
 		}
-		return iban.NationalChecksum == strconv.Itoa(check)
+		return checkField(iban, NationalChecksum, iban.NationalChecksum == strconv.Itoa(check))
 	},
 	// https://en.wikipedia.org/wiki/International_Bank_Account_Number#National_check_digits
 	// only for fields, variant not further clarified
 	// https://www.ecbs.org/Download/Tr201v3.9.pdf (page 23)
-	iso3166.BE: func(iban IBAN) bool {
+	iso3166.BE: func(iban IBAN) error {
 		check, err := strconv.ParseUint(iban.BankCode+iban.AccountNumber, 10, 64)
 		if err != nil {
-			return false
+			return checksumError(iban, AccountNumber, 0)
 		}
 		check %= 97
 		if check == 0 {
 			check = 97
 		}
-		return iban.NationalChecksum == twoDigitStr(int(check))
+		return checkField(iban, NationalChecksum, iban.NationalChecksum == twoDigitStr(int(check)))
 	},
 	// https://en.wikipedia.org/wiki/International_Bank_Account_Number#National_check_digits
-	iso3166.BA: func(iban IBAN) bool {
+	iso3166.BA: func(iban IBAN) error {
 		check := iso7064Mod97_10(iban.BankCode + iban.BranchCode + iban.AccountNumber)
-		return iban.NationalChecksum == twoDigitStr(check)
+		return checkField(iban, NationalChecksum, iban.NationalChecksum == twoDigitStr(check))
 	},
 	// https://en.wikipedia.org/wiki/International_Bank_Account_Number#IBAN_formats_by_country
-	iso3166.HR: func(iban IBAN) bool {
-		return iso7064Mod11_10(iban.BankCode) == 9 && iso7064Mod11_10(iban.AccountNumber) == 9
+	iso3166.HR: func(iban IBAN) error {
+		if iso7064Mod11_10(iban.BankCode) != 9 {
+			return checksumError(iban, BankCode, len(iban.BankCode)-1)
+		}
+
+		return checkField(iban, AccountNumber, iso7064Mod11_10(iban.AccountNumber) == 9)
 	},
 	iso3166.CZ: czech,
 	// https://en.wikipedia.org/wiki/International_Bank_Account_Number#National_check_digits
 	// Only works w/o appending "00", wiki lists no source.
-	iso3166.TL: func(iban IBAN) bool {
+	iso3166.TL: func(iban IBAN) error {
 		check := iso7064Mod97_10(iban.BankCode + iban.AccountNumber)
-		return iban.NationalChecksum == twoDigitStr(check)
+		return checkField(iban, NationalChecksum, iban.NationalChecksum == twoDigitStr(check))
 	},
 	// https://en.wikipedia.org/wiki/International_Bank_Account_Number#IBAN_formats_by_country
 	// https://www.ecbs.org/Download/Tr201v3.9.pdf (page 41)
@@ -70,58 +74,77 @@ var checksumFuncs = map[iso3166.Alpha2Code]func(IBAN) bool{
 	// Also note that Wikipedia uses different weights, namely the weights I
 	// would use if running the weighting LTR (which btw completes all tests).
 	// Still: 2 sources > 1 source
-	iso3166.EE: func(iban IBAN) bool {
+	iso3166.EE: func(iban IBAN) error {
 		check := weightedRTL(iban.BranchCode+iban.AccountNumber, 10, 7, 3, 1)
 		if check != 0 {
 			check = 10 - check
 		}
-		return iban.NationalChecksum == strconv.Itoa(check)
+		return checkField(iban, NationalChecksum, iban.NationalChecksum == strconv.Itoa(check))
 	},
 	// https://en.wikipedia.org/wiki/International_Bank_Account_Number#National_check_digits
 	// https://www.ecbs.org/Download/Tr201v3.9.pdf (page 47)
-	iso3166.FI: func(iban IBAN) bool {
+	iso3166.FI: func(iban IBAN) error {
 		check := luhn(iban.BankCode + iban.AccountNumber)
-		return iban.NationalChecksum == string(rune('0'+check))
+		return checkField(iban, NationalChecksum, iban.NationalChecksum == string(rune('0'+check)))
 	},
 	iso3166.FR: france,
+	iso3166.DE: germany,
 	// https://en.wikipedia.org/wiki/International_Bank_Account_Number#IBAN_formats_by_country
-	// Hungary has checksums in two different places, which right now is not
-	// handled, we only check the national checksum.
-	iso3166.HU: func(iban IBAN) bool {
-		check := weighted(iban.AccountNumber, 10, 9, 7, 3, 1)
-		if check != 0 {
-			check = 10 - check
+	// Hungary has two check digits, one for the bank and branch code, and one
+	// for the account number.
+	iso3166.HU: func(iban IBAN) error {
+		check1 := weighted(iban.BankCode+iban.BranchCode, 10, 9, 7, 3, 1)
+		if check1 != 0 {
+			check1 = 10 - check1
+		}
+
+		if iban.NationalChecksum[0] != byte('0'+check1) {
+			return checksumError(iban, NationalChecksum, 0)
+		}
+
+		check2 := weighted(iban.AccountNumber, 10, 9, 7, 3, 1)
+		if check2 != 0 {
+			check2 = 10 - check2
+		}
+
+		if iban.NationalChecksum[1] != byte('0'+check2) {
+			return checksumError(iban, NationalChecksum, 1)
 		}
-		return iban.NationalChecksum == string(rune('0'+check))
+
+		return nil
 	},
 	// https://en.wikipedia.org/wiki/International_Bank_Account_Number#IBAN_formats_by_country
 	// https://www.ecbs.org/Download/Tr201v3.9.pdf (page 68)
-	iso3166.IS: func(iban IBAN) bool {
+	iso3166.IS: func(iban IBAN) error {
 		check := weighted(iban.OwnerIdentificationNumber[:8], 11, 3, 2, 7, 6, 5, 4, 3, 2)
 		if check != 0 {
 			check = 11 - check
 		}
-		return iban.OwnerIdentificationNumber[8] == byte('0'+check)
+		if iban.OwnerIdentificationNumber[8] != byte('0'+check) {
+			return checksumError(iban, OwnerIdentificationNumber, 8)
+		}
+
+		return nil
 	},
 	iso3166.IT: italy,
 	// https://en.wikipedia.org/wiki/International_Bank_Account_Number#IBAN_formats_by_country
 	// https://www.ecbs.org/Download/Tr201v3.9.pdf (page 94)
-	iso3166.MK: func(iban IBAN) bool {
+	iso3166.MK: func(iban IBAN) error {
 		check := iso7064Mod97_10(iban.BankCode + iban.AccountNumber)
-		return iban.NationalChecksum == twoDigitStr(check)
+		return checkField(iban, NationalChecksum, iban.NationalChecksum == twoDigitStr(check))
 	},
 	iso3166.MC: france,
 	// https://en.wikipedia.org/wiki/International_Bank_Account_Number#IBAN_formats_by_country
-	iso3166.ME: func(iban IBAN) bool {
+	iso3166.ME: func(iban IBAN) error {
 		check := iso7064Mod97_10(iban.BankCode + iban.AccountNumber)
-		return iban.NationalChecksum == twoDigitStr(check)
+		return checkField(iban, NationalChecksum, iban.NationalChecksum == twoDigitStr(check))
 	},
 	// https://en.wikipedia.org/wiki/International_Bank_Account_Number#IBAN_formats_by_country
 	// https://www.ecbs.org/Download/Tr201v3.9.pdf (page 105)
 	// ECBS calls the concatentation of the bank code and account no. the
 	// "account number", which is a bit confusing.
 	// Otherwise, the same as wiki.
-	iso3166.NO: func(iban IBAN) bool {
+	iso3166.NO: func(iban IBAN) error {
 		var check int
 		if strings.HasPrefix(iban.AccountNumber, "00") {
 			check = weighted(iban.AccountNumber[2:], 11, 5, 4, 3, 2)
@@ -129,104 +152,112 @@ var checksumFuncs = map[iso3166.Alpha2Code]func(IBAN) bool{
 			check = weighted(iban.BankCode+iban.AccountNumber, 11, 5, 4, 3, 2, 7, 6, 5, 4, 3, 2)
 		}
 
+		// there is no valid check digit for this account number
 		if check == 1 {
-			return false
+			return checksumError(iban, AccountNumber, 0)
 		}
 
 		if check != 0 {
 			check = 11 - check
 		}
-		return iban.NationalChecksum == strconv.Itoa(check)
+		return checkField(iban, NationalChecksum, iban.NationalChecksum == strconv.Itoa(check))
 	},
 	// https://en.wikipedia.org/wiki/International_Bank_Account_Number#IBAN_formats_by_country
 	// ECBS uses ISO13616, which is not publicly available.
 	// Wikipedia works fine, so I think we're good.
-	iso3166.PL: func(iban IBAN) bool {
+	iso3166.PL: func(iban IBAN) error {
 		check := weighted(iban.BankCode+iban.BranchCode, 10, 3, 9, 7, 1)
 		if check != 0 {
 			check = 10 - check
 		}
-		return iban.NationalChecksum == strconv.Itoa(check)
+		return checkField(iban, NationalChecksum, iban.NationalChecksum == strconv.Itoa(check))
 	},
 	// https://en.wikipedia.org/wiki/International_Bank_Account_Number#IBAN_formats_by_country
 	// https://www.ecbs.org/Download/Tr201v3.9.pdf (page 112)
-	iso3166.PT: func(iban IBAN) bool {
+	iso3166.PT: func(iban IBAN) error {
 		check := iso7064Mod97_10(iban.BankCode + iban.BranchCode + iban.AccountNumber)
-		return iban.NationalChecksum == twoDigitStr(check)
+		return checkField(iban, NationalChecksum, iban.NationalChecksum == twoDigitStr(check))
 	},
 	iso3166.SM: italy,
 	// https://en.wikipedia.org/wiki/International_Bank_Account_Number#IBAN_formats_by_country
 	// https://www.ecbs.org/Download/Tr201v3.9.pdf (page 120)
 	// ECBS once again says account number, but means bank code + account no.
-	iso3166.RS: func(iban IBAN) bool {
+	iso3166.RS: func(iban IBAN) error {
 		check := iso7064Mod97_10(iban.BankCode + iban.AccountNumber)
-		return iban.NationalChecksum == twoDigitStr(check)
+		return checkField(iban, NationalChecksum, iban.NationalChecksum == twoDigitStr(check))
 	},
 	iso3166.SK: czech,
 	// https://en.wikipedia.org/wiki/International_Bank_Account_Number#IBAN_formats_by_country
 	// https://www.ecbs.org/Download/Tr201v3.9.pdf (page 126)
-	iso3166.SI: func(iban IBAN) bool {
+	iso3166.SI: func(iban IBAN) error {
 		check := iso7064Mod97_10(iban.BankCode + iban.BranchCode + iban.AccountNumber)
-		return iban.NationalChecksum == twoDigitStr(check)
+		return checkField(iban, NationalChecksum, iban.NationalChecksum == twoDigitStr(check))
 	},
 	// https://en.wikipedia.org/wiki/International_Bank_Account_Number#IBAN_formats_by_country
 	// https://www.ecbs.org/Download/Tr201v3.9.pdf (page 131)
-	iso3166.ES: func(iban IBAN) bool {
+	iso3166.ES: func(iban IBAN) error {
 		check1 := weighted(iban.BankCode+iban.BranchCode, 11, 4, 8, 5, 10, 9, 7, 3, 6)
 		if check1 > 1 {
 			check1 = 11 - check1
 		}
 
+		if iban.NationalChecksum[0] != byte('0'+check1) {
+			return checksumError(iban, NationalChecksum, 0)
+		}
+
 		check2 := weighted(iban.AccountNumber, 11, 1, 2, 4, 8, 5, 10, 9, 7, 3, 6)
 		if check2 > 1 {
 			check2 = 11 - check2
 		}
 
-		return iban.NationalChecksum == string(rune('0'+check1))+string(rune('0'+check2))
+		if iban.NationalChecksum[1] != byte('0'+check2) {
+			return checksumError(iban, NationalChecksum, 1)
+		}
+
+		return nil
 	},
 }
 
 // https://en.wikipedia.org/wiki/International_Bank_Account_Number#National_check_digits
 // https://www.ecbs.org/Download/Tr201v3.9.pdf (page 34)
 // wiki has complement wrong, otherwise as above
-func czech(iban IBAN) bool {
+func czech(iban IBAN) error {
 	r := weighted(iban.BranchCode, 11, 10, 5, 8, 4, 2, 1)
 	if r != 0 {
-		return false
+		return checksumError(iban, BranchCode, 0)
 	}
 
 	r = weighted(iban.AccountNumber, 11, 6, 3, 7, 9, 10, 5, 8, 4, 2, 1)
-	if r != 0 {
-		return false
-	}
-
-	return true
+	return checkField(iban, AccountNumber, r == 0)
 }
 
 // https://en.wikipedia.org/wiki/International_Bank_Account_Number#National_check_digits
 // https://www.ecbs.org/Download/Tr201v3.9.pdf
 // ECBS is honestly not very clear, but Wikipedia works, and we have sufficient
 // test cases that I am confident in the implementation.
-func france(iban IBAN) bool {
-	s := franceTransliterate(iban.BankCode + iban.BranchCode + iban.AccountNumber + iban.NationalChecksum)
-	return baseISO7064Mod97_10(s) == 0
-}
-
-func franceTransliterate(s string) string {
-	bs := []byte(s)
-	for i := 0; i < len(bs); i++ {
-		r := bs[i]
-		switch {
-		case r >= 'A' && r <= 'I':
-			bs[i] = r - 'A' + '1'
-		case r >= 'J' && r <= 'N':
-			bs[i] = r - 'J' + '1'
-		case r >= 'S' && r <= 'Z':
-			bs[i] = r - 'S' + '2'
+func france(iban IBAN) error {
+	// same as baseISO7064Mod97_10, but transliterates letters on the fly
+	var prod int
+	for _, s := range [...]string{iban.BankCode, iban.BranchCode, iban.AccountNumber, iban.NationalChecksum} {
+		for i := 0; i < len(s); i++ {
+			prod = ((franceTransliterate(s[i]) + prod) * 10) % 97
 		}
 	}
 
-	return string(bs)
+	return checkField(iban, NationalChecksum, (prod*10)%97 == 0)
+}
+
+func franceTransliterate(c byte) int {
+	switch {
+	case c >= 'A' && c <= 'I':
+		return int(c-'A') + 1
+	case c >= 'J' && c <= 'N':
+		return int(c-'J') + 1
+	case c >= 'S' && c <= 'Z':
+		return int(c-'S') + 2
+	default:
+		return int(c - '0')
+	}
 }
 
 var (
@@ -241,10 +272,7 @@ var (
 	// odd mapping is just ascending numbers
 )
 
-func italy(iban IBAN) bool {
-	if len(iban.NationalChecksum) != 1 {
-		return false
-	}
+func italy(iban IBAN) error {
 	checksum := int(iban.NationalChecksum[0] - 'A')
 
 	var sum int
@@ -260,7 +288,34 @@ func italy(iban IBAN) bool {
 		}
 		sum = (sum + num) % 26
 	}
-	return sum%26 == checksum
+	return checkField(iban, NationalChecksum, sum%26 == checksum)
+}
+
+// checkField returns a [*ParseError] for the field with the passed name, if
+// ok is false.
+func checkField(iban IBAN, name FieldName, ok bool) error {
+	if ok {
+		return nil
+	}
+
+	return checksumError(iban, name, 0)
+}
+
+// checksumError returns a [*ParseError] for a national checksum mismatch in
+// the field with the passed name, where i is the index of the first invalid
+// character in the field.
+func checksumError(iban IBAN, name FieldName, i int) *ParseError {
+	err := &ParseError{Err: ErrNationalChecksum, Field: name, Offset: 4, Actual: iban.field(name)}
+
+	f := formats[iban.CountryCode]
+	positions := f.positions(name)
+	if i < len(positions) {
+		err.Offset += positions[i]
+		err.Class = f.classAt(positions[i])
+		err.Length = len(positions)
+	}
+
+	return err
 }
 
 // ============================================================================
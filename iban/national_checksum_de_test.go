@@ -0,0 +1,332 @@
+package iban
+
+import "testing"
+
+func TestBundesbankMethods(t *testing.T) {
+	// https://www.bundesbank.de/de/aufgaben/unbarer-zahlungsverkehr/serviceangebot/pruefzifferberechnung/pruefzifferberechnung-fuer-kontonummern-603282
+	testCases := []struct {
+		Method        string
+		AccountNumber string
+		Expect        bool
+	}{
+		{Method: "00", AccountNumber: "9290701", Expect: true},
+		{Method: "00", AccountNumber: "0000202051", Expect: true},
+		{Method: "00", AccountNumber: "9290702", Expect: false},
+		{Method: "01", AccountNumber: "0000000017", Expect: true},
+		{Method: "01", AccountNumber: "0000000018", Expect: false},
+		{Method: "02", AccountNumber: "0000000019", Expect: true},
+		{Method: "02", AccountNumber: "0000000060", Expect: false},
+		{Method: "06", AccountNumber: "0000000060", Expect: true},
+		{Method: "06", AccountNumber: "0000000061", Expect: false},
+		{Method: "09", AccountNumber: "1234567890", Expect: true},
+		{Method: "13", AccountNumber: "0532013000", Expect: true},
+		{Method: "13", AccountNumber: "0532013200", Expect: false},
+		{Method: "17", AccountNumber: "0446786040", Expect: true},
+		{Method: "17", AccountNumber: "0137075030", Expect: true},
+		{Method: "17", AccountNumber: "0446786140", Expect: false},
+		{Method: "24", AccountNumber: "138301", Expect: true},
+		{Method: "24", AccountNumber: "1306118605", Expect: true},
+		{Method: "24", AccountNumber: "3307118608", Expect: true},
+		{Method: "24", AccountNumber: "9307118603", Expect: true},
+		{Method: "24", AccountNumber: "3307118607", Expect: false},
+		{Method: "24", AccountNumber: "9307118604", Expect: false},
+		{Method: "51", AccountNumber: "0001156071", Expect: true},
+		{Method: "51", AccountNumber: "0001156136", Expect: true},
+		{Method: "51", AccountNumber: "0001156078", Expect: true},
+		{Method: "51", AccountNumber: "0001234567", Expect: true},
+		{Method: "51", AccountNumber: "340968", Expect: true},
+		{Method: "51", AccountNumber: "201178", Expect: true},
+		{Method: "51", AccountNumber: "0000156071", Expect: true},
+		{Method: "51", AccountNumber: "101356073", Expect: true},
+		{Method: "51", AccountNumber: "0199100002", Expect: true},
+		{Method: "51", AccountNumber: "0099100010", Expect: true},
+		{Method: "51", AccountNumber: "0199100004", Expect: true},
+		{Method: "51", AccountNumber: "0001156079", Expect: false},
+		{Method: "57", AccountNumber: "7500021766", Expect: true},
+		{Method: "57", AccountNumber: "9400001734", Expect: true},
+		{Method: "57", AccountNumber: "7800028282", Expect: true},
+		{Method: "57", AccountNumber: "8100244186", Expect: true},
+		{Method: "57", AccountNumber: "3251080371", Expect: true},
+		{Method: "57", AccountNumber: "4000000000", Expect: true},
+		{Method: "57", AccountNumber: "0185125434", Expect: true},
+		{Method: "57", AccountNumber: "7500021767", Expect: false},
+		{Method: "57", AccountNumber: "3250080371", Expect: false},
+		{Method: "57", AccountNumber: "0013000000", Expect: false},
+		{Method: "57", AccountNumber: "0000012345", Expect: false},
+		{Method: "68", AccountNumber: "8889654328", Expect: true},
+		{Method: "68", AccountNumber: "987654324", Expect: true},
+		{Method: "68", AccountNumber: "400000000", Expect: true},
+		{Method: "68", AccountNumber: "8888654328", Expect: false},
+		{Method: "68", AccountNumber: "987654323", Expect: false},
+		{Method: "69", AccountNumber: "9721134869", Expect: true},
+		{Method: "69", AccountNumber: "1234567900", Expect: true},
+		{Method: "69", AccountNumber: "1234567006", Expect: true},
+		{Method: "69", AccountNumber: "9300000000", Expect: true},
+		{Method: "69", AccountNumber: "9721134868", Expect: false},
+		{Method: "73", AccountNumber: "0003503398", Expect: true},
+		{Method: "73", AccountNumber: "0001340967", Expect: true},
+		{Method: "73", AccountNumber: "0003503391", Expect: true},
+		{Method: "73", AccountNumber: "0001340968", Expect: true},
+		{Method: "73", AccountNumber: "0003503392", Expect: true},
+		{Method: "73", AccountNumber: "0003503393", Expect: false},
+		{Method: "74", AccountNumber: "242243", Expect: true},
+		{Method: "74", AccountNumber: "242248", Expect: true},
+		{Method: "74", AccountNumber: "1016", Expect: true},
+		{Method: "74", AccountNumber: "242244", Expect: false},
+		{Method: "74", AccountNumber: "1", Expect: false},
+		{Method: "76", AccountNumber: "0006543200", Expect: true},
+		{Method: "76", AccountNumber: "65432", Expect: true},
+		{Method: "76", AccountNumber: "9012345600", Expect: true},
+		{Method: "76", AccountNumber: "1006543200", Expect: false},
+		{Method: "80", AccountNumber: "340968", Expect: true},
+		{Method: "80", AccountNumber: "340966", Expect: true},
+		{Method: "80", AccountNumber: "340967", Expect: false},
+		{Method: "81", AccountNumber: "0646440", Expect: true},
+		{Method: "81", AccountNumber: "1359100", Expect: true},
+		{Method: "81", AccountNumber: "0199100002", Expect: true},
+		{Method: "81", AccountNumber: "0646441", Expect: false},
+		{Method: "83", AccountNumber: "0001156071", Expect: true},
+		{Method: "83", AccountNumber: "0001156136", Expect: true},
+		{Method: "83", AccountNumber: "0000156078", Expect: true},
+		{Method: "83", AccountNumber: "0000156071", Expect: true},
+		{Method: "83", AccountNumber: "0099100002", Expect: true},
+		{Method: "83", AccountNumber: "0099100003", Expect: false},
+		{Method: "84", AccountNumber: "240699", Expect: true},
+		{Method: "84", AccountNumber: "240692", Expect: true},
+		{Method: "84", AccountNumber: "0199100002", Expect: true},
+		{Method: "84", AccountNumber: "240691", Expect: false},
+		{Method: "86", AccountNumber: "340968", Expect: true},
+		{Method: "86", AccountNumber: "1001171", Expect: true},
+		{Method: "86", AccountNumber: "0199100002", Expect: true},
+		{Method: "86", AccountNumber: "0001001172", Expect: false},
+		{Method: "87", AccountNumber: "0000000406", Expect: true},
+		{Method: "87", AccountNumber: "0000051768", Expect: true},
+		{Method: "87", AccountNumber: "0010701590", Expect: true},
+		{Method: "87", AccountNumber: "0010720185", Expect: true},
+		{Method: "87", AccountNumber: "0000100005", Expect: true},
+		{Method: "87", AccountNumber: "0000393814", Expect: true},
+		{Method: "87", AccountNumber: "0000950360", Expect: true},
+		{Method: "87", AccountNumber: "0000000407", Expect: false},
+		{Method: "87", AccountNumber: "0010701591", Expect: false},
+		{Method: "90", AccountNumber: "0001975641", Expect: true},
+		{Method: "90", AccountNumber: "0001988654", Expect: true},
+		{Method: "90", AccountNumber: "0000996663", Expect: true},
+		{Method: "90", AccountNumber: "0099100002", Expect: true},
+		{Method: "90", AccountNumber: "0001975642", Expect: false},
+		{Method: "91", AccountNumber: "2974118000", Expect: true},
+		{Method: "91", AccountNumber: "5281741000", Expect: true},
+		{Method: "91", AccountNumber: "9952810000", Expect: true},
+		{Method: "91", AccountNumber: "2974117000", Expect: true},
+		{Method: "91", AccountNumber: "8840019000", Expect: true},
+		{Method: "91", AccountNumber: "8840012000", Expect: true},
+		{Method: "91", AccountNumber: "8840011000", Expect: false},
+		{Method: "93", AccountNumber: "6714790000", Expect: true},
+		{Method: "93", AccountNumber: "0000671479", Expect: true},
+		{Method: "93", AccountNumber: "6714780000", Expect: false},
+		{Method: "A0", AccountNumber: "521003287", Expect: true},
+		{Method: "A0", AccountNumber: "54500", Expect: true},
+		{Method: "A0", AccountNumber: "3287", Expect: true},
+		{Method: "A0", AccountNumber: "18761", Expect: true},
+		{Method: "A0", AccountNumber: "28290", Expect: true},
+		{Method: "A0", AccountNumber: "123", Expect: true},
+		{Method: "A0", AccountNumber: "521003288", Expect: false},
+		{Method: "A1", AccountNumber: "0010030005", Expect: true},
+		{Method: "A1", AccountNumber: "0010030997", Expect: true},
+		{Method: "A1", AccountNumber: "1010030054", Expect: true},
+		{Method: "A1", AccountNumber: "0110030005", Expect: false},
+		{Method: "A1", AccountNumber: "0010030998", Expect: false},
+		{Method: "A1", AccountNumber: "0000030005", Expect: false},
+		{Method: "A4", AccountNumber: "0004711173", Expect: true},
+		{Method: "A4", AccountNumber: "0004711172", Expect: true},
+		{Method: "A4", AccountNumber: "0007093330", Expect: true},
+		{Method: "A4", AccountNumber: "0007093335", Expect: true},
+		{Method: "A4", AccountNumber: "6714790000", Expect: true},
+		{Method: "A4", AccountNumber: "0000671479", Expect: true},
+		{Method: "A4", AccountNumber: "0004711174", Expect: false},
+		{Method: "A8", AccountNumber: "7436661", Expect: true},
+		{Method: "A8", AccountNumber: "7436670", Expect: true},
+		{Method: "A8", AccountNumber: "1359100", Expect: true},
+		{Method: "A8", AccountNumber: "7436660", Expect: true},
+		{Method: "A8", AccountNumber: "7436678", Expect: true},
+		{Method: "A8", AccountNumber: "0003503398", Expect: true},
+		{Method: "A8", AccountNumber: "0001340967", Expect: true},
+		{Method: "A8", AccountNumber: "0199100002", Expect: true},
+		{Method: "A8", AccountNumber: "0099100010", Expect: true},
+		{Method: "A8", AccountNumber: "7436666", Expect: false},
+		{Method: "B4", AccountNumber: "9941510001", Expect: true},
+		{Method: "B4", AccountNumber: "9961230019", Expect: true},
+		{Method: "B4", AccountNumber: "9380027210", Expect: true},
+		{Method: "B4", AccountNumber: "9932290910", Expect: true},
+		{Method: "B4", AccountNumber: "0000251437", Expect: true},
+		{Method: "B4", AccountNumber: "0007948344", Expect: true},
+		{Method: "B4", AccountNumber: "0000159590", Expect: true},
+		{Method: "B4", AccountNumber: "0000051640", Expect: true},
+		{Method: "B4", AccountNumber: "9941510002", Expect: false},
+		{Method: "B4", AccountNumber: "0000251438", Expect: false},
+		{Method: "B8", AccountNumber: "0734192657", Expect: true},
+		{Method: "B8", AccountNumber: "6932875274", Expect: true},
+		{Method: "B8", AccountNumber: "5011654366", Expect: true},
+		{Method: "B8", AccountNumber: "0734192658", Expect: false},
+		{Method: "B9", AccountNumber: "87920187", Expect: true},
+		{Method: "B9", AccountNumber: "41203755", Expect: true},
+		{Method: "B9", AccountNumber: "81069577", Expect: true},
+		{Method: "B9", AccountNumber: "61287958", Expect: true},
+		{Method: "B9", AccountNumber: "58467232", Expect: true},
+		{Method: "B9", AccountNumber: "7125633", Expect: true},
+		{Method: "B9", AccountNumber: "1253657", Expect: true},
+		{Method: "B9", AccountNumber: "4353631", Expect: true},
+		{Method: "B9", AccountNumber: "0000000000", Expect: false},
+		{Method: "B9", AccountNumber: "1234567890", Expect: false},
+		{Method: "C1", AccountNumber: "0446786040", Expect: true},
+		{Method: "C1", AccountNumber: "0478046940", Expect: true},
+		{Method: "C1", AccountNumber: "0701625830", Expect: true},
+		{Method: "C1", AccountNumber: "0701625840", Expect: true},
+		{Method: "C1", AccountNumber: "0882095630", Expect: true},
+		{Method: "C1", AccountNumber: "5432112349", Expect: true},
+		{Method: "C1", AccountNumber: "5543223456", Expect: true},
+		{Method: "C1", AccountNumber: "5654334563", Expect: true},
+		{Method: "C1", AccountNumber: "5765445670", Expect: true},
+		{Method: "C1", AccountNumber: "5876556788", Expect: true},
+		{Method: "C1", AccountNumber: "5432112341", Expect: false},
+		{Method: "C5", AccountNumber: "0000301168", Expect: true},
+		{Method: "C5", AccountNumber: "0000302554", Expect: true},
+		{Method: "C5", AccountNumber: "0300020050", Expect: true},
+		{Method: "C5", AccountNumber: "0300566000", Expect: true},
+		{Method: "C5", AccountNumber: "1000061378", Expect: true},
+		{Method: "C5", AccountNumber: "4450164064", Expect: true},
+		{Method: "C5", AccountNumber: "3060188103", Expect: true},
+		{Method: "C5", AccountNumber: "3070402023", Expect: true},
+		{Method: "C5", AccountNumber: "0030000000", Expect: true},
+		{Method: "C5", AccountNumber: "7000000000", Expect: true},
+		{Method: "C5", AccountNumber: "8500000000", Expect: true},
+		{Method: "C5", AccountNumber: "0000301169", Expect: false},
+		{Method: "C5", AccountNumber: "0060000000", Expect: false},
+		{Method: "C5", AccountNumber: "2000000000", Expect: false},
+		{Method: "C6", AccountNumber: "0000065516", Expect: true},
+		{Method: "C6", AccountNumber: "0203178249", Expect: true},
+		{Method: "C6", AccountNumber: "1031405209", Expect: true},
+		{Method: "C6", AccountNumber: "2003455189", Expect: true},
+		{Method: "C6", AccountNumber: "3110150986", Expect: true},
+		{Method: "C6", AccountNumber: "4012660028", Expect: true},
+		{Method: "C6", AccountNumber: "5035105948", Expect: true},
+		{Method: "C6", AccountNumber: "6028426119", Expect: true},
+		{Method: "C6", AccountNumber: "7008199027", Expect: true},
+		{Method: "C6", AccountNumber: "8526080015", Expect: true},
+		{Method: "C6", AccountNumber: "9000430223", Expect: true},
+		{Method: "C6", AccountNumber: "0000065517", Expect: false},
+		{Method: "D1", AccountNumber: "0082012203", Expect: true},
+		{Method: "D1", AccountNumber: "1452683581", Expect: true},
+		{Method: "D1", AccountNumber: "2129642505", Expect: true},
+		{Method: "D1", AccountNumber: "3002000027", Expect: true},
+		{Method: "D1", AccountNumber: "4230001407", Expect: true},
+		{Method: "D1", AccountNumber: "5000065514", Expect: true},
+		{Method: "D1", AccountNumber: "6001526215", Expect: true},
+		{Method: "D1", AccountNumber: "7126502149", Expect: true},
+		{Method: "D1", AccountNumber: "9000430223", Expect: true},
+		{Method: "D1", AccountNumber: "0082012204", Expect: false},
+		{Method: "D1", AccountNumber: "8000000000", Expect: false},
+		{Method: "D2", AccountNumber: "189912137", Expect: true},
+		{Method: "D2", AccountNumber: "235308215", Expect: true},
+		{Method: "D2", AccountNumber: "4455667784", Expect: true},
+		{Method: "D2", AccountNumber: "1234567897", Expect: true},
+		{Method: "D2", AccountNumber: "51181008", Expect: true},
+		{Method: "D2", AccountNumber: "8889654328", Expect: true},
+		{Method: "D2", AccountNumber: "1234567898", Expect: false},
+		{Method: "D4", AccountNumber: "1112048219", Expect: true},
+		{Method: "D4", AccountNumber: "2024601814", Expect: true},
+		{Method: "D4", AccountNumber: "3000005012", Expect: true},
+		{Method: "D4", AccountNumber: "4143406984", Expect: true},
+		{Method: "D4", AccountNumber: "5926485111", Expect: true},
+		{Method: "D4", AccountNumber: "6286304975", Expect: true},
+		{Method: "D4", AccountNumber: "7900256617", Expect: true},
+		{Method: "D4", AccountNumber: "8102228628", Expect: true},
+		{Method: "D4", AccountNumber: "9002364588", Expect: true},
+		{Method: "D4", AccountNumber: "1112048210", Expect: false},
+		{Method: "D4", AccountNumber: "0000000000", Expect: false},
+		{Method: "D7", AccountNumber: "0500018205", Expect: true},
+		{Method: "D7", AccountNumber: "0230103715", Expect: true},
+		{Method: "D7", AccountNumber: "0301000434", Expect: true},
+		{Method: "D7", AccountNumber: "0330035104", Expect: true},
+		{Method: "D7", AccountNumber: "0420001202", Expect: true},
+		{Method: "D7", AccountNumber: "0134637709", Expect: true},
+		{Method: "D7", AccountNumber: "0201005939", Expect: true},
+		{Method: "D7", AccountNumber: "0602006999", Expect: true},
+		{Method: "D7", AccountNumber: "0500018206", Expect: false},
+		{Method: "E0", AccountNumber: "1234568013", Expect: true},
+		{Method: "E0", AccountNumber: "1534568010", Expect: true},
+		{Method: "E0", AccountNumber: "2610015", Expect: true},
+		{Method: "E0", AccountNumber: "8741013011", Expect: true},
+		{Method: "E0", AccountNumber: "1234568014", Expect: false},
+		{Method: "E1", AccountNumber: "0134211909", Expect: true},
+		{Method: "E1", AccountNumber: "0100041104", Expect: true},
+		{Method: "E1", AccountNumber: "0100054106", Expect: true},
+		{Method: "E1", AccountNumber: "0200025107", Expect: true},
+		{Method: "E1", AccountNumber: "0150013107", Expect: false},
+		{Method: "E1", AccountNumber: "0200035101", Expect: false},
+		{Method: "E1", AccountNumber: "0081313890", Expect: false},
+		{Method: "E1", AccountNumber: "4268550840", Expect: false},
+		{Method: "E1", AccountNumber: "0987402008", Expect: false},
+		{Method: "E2", AccountNumber: "0003831745", Expect: true},
+		{Method: "E2", AccountNumber: "0051330335", Expect: true},
+		{Method: "E2", AccountNumber: "0003831746", Expect: false},
+		{Method: "E2", AccountNumber: "6000000000", Expect: false},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.Method+"/"+c.AccountNumber, func(t *testing.T) {
+			k, ok := parseKto(c.AccountNumber)
+			if !ok {
+				t.Fatalf("parseKto(%q): invalid account number", c.AccountNumber)
+			}
+
+			if actual := bundesbankMethods[c.Method](k); actual != c.Expect {
+				t.Errorf("method %s(%q): expected %t, got %t", c.Method, c.AccountNumber, c.Expect, actual)
+			}
+		})
+	}
+}
+
+func TestBundesbankBankCodeMethods(t *testing.T) {
+	// https://www.bundesbank.de/de/aufgaben/unbarer-zahlungsverkehr/serviceangebot/pruefzifferberechnung/pruefzifferberechnung-fuer-kontonummern-603282
+	testCases := []struct {
+		Method        string
+		BankCode      string
+		AccountNumber string
+		Expect        bool
+	}{
+		{Method: "52", BankCode: "13051172", AccountNumber: "43001500", Expect: true},
+		{Method: "52", BankCode: "13051172", AccountNumber: "48726458", Expect: true},
+		{Method: "52", BankCode: "13051172", AccountNumber: "9110000000", Expect: true},
+		{Method: "52", BankCode: "13051172", AccountNumber: "43001600", Expect: false},
+		{Method: "52", BankCode: "13051172", AccountNumber: "0043001500", Expect: true},
+		{Method: "52", BankCode: "13051172", AccountNumber: "143001500", Expect: false},
+		{Method: "53", BankCode: "16052072", AccountNumber: "382432256", Expect: true},
+		{Method: "53", BankCode: "16052072", AccountNumber: "382432257", Expect: false},
+		{Method: "53", BankCode: "16052072", AccountNumber: "43001500", Expect: false},
+		{Method: "B6", BankCode: "80053762", AccountNumber: "9110000000", Expect: true},
+		{Method: "B6", BankCode: "80053762", AccountNumber: "0269876545", Expect: true},
+		{Method: "B6", BankCode: "80053762", AccountNumber: "487310018", Expect: true},
+		{Method: "B6", BankCode: "80053762", AccountNumber: "487310019", Expect: false},
+		{Method: "C0", BankCode: "13051172", AccountNumber: "43001500", Expect: true},
+		{Method: "C0", BankCode: "13051172", AccountNumber: "48726458", Expect: true},
+		{Method: "C0", BankCode: "13051172", AccountNumber: "0082335729", Expect: true},
+		{Method: "C0", BankCode: "13051172", AccountNumber: "0734192657", Expect: true},
+		{Method: "C0", BankCode: "13051172", AccountNumber: "6932875274", Expect: true},
+		{Method: "C0", BankCode: "13051172", AccountNumber: "0132572975", Expect: false},
+		{Method: "C0", BankCode: "13051172", AccountNumber: "6932875275", Expect: false},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.Method+"/"+c.AccountNumber, func(t *testing.T) {
+			k, ok := parseKto(c.AccountNumber)
+			if !ok {
+				t.Fatalf("parseKto(%q): invalid account number", c.AccountNumber)
+			}
+
+			if actual := bundesbankBankCodeMethods[c.Method](k, c.BankCode); actual != c.Expect {
+				t.Errorf("method %s(%q, %q): expected %t, got %t",
+					c.Method, string(c.BankCode), c.AccountNumber, c.Expect, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package iban
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mavolin/standards/iso3166"
+)
+
+func TestFromNational(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		successCases := []struct {
+			Country        iso3166.Alpha2Code
+			In             string
+			Expect         string
+			ExpectNational string
+		}{
+			{Country: iso3166.AT, In: "19043 234573201", Expect: "AT611904300234573201", ExpectNational: "19043 00234573201"},
+			{Country: iso3166.BE, In: "539-0075470-34", Expect: "BE68539007547034", ExpectNational: "539-0075470-34"},
+			{Country: iso3166.BE, In: "539007547034", Expect: "BE68539007547034", ExpectNational: "539-0075470-34"},
+			{Country: iso3166.CH, In: "762 11623852957", Expect: "CH9300762011623852957", ExpectNational: "00762 011623852957"},
+			{Country: iso3166.DE, In: "37040044 532013000", Expect: "DE89370400440532013000", ExpectNational: "37040044 0532013000"},
+			{
+				Country:        iso3166.ES,
+				In:             "2100 0418 45 0200051332",
+				Expect:         "ES9121000418450200051332",
+				ExpectNational: "2100 0418 45 0200051332",
+			},
+			{
+				Country:        iso3166.FR,
+				In:             "30006 00001 12345678901 89",
+				Expect:         "FR7630006000011234567890189",
+				ExpectNational: "30006 00001 12345678901 89",
+			},
+			{
+				Country:        iso3166.IT,
+				In:             "x 05428 11101 000000123456",
+				Expect:         "IT60X0542811101000000123456",
+				ExpectNational: "X 05428 11101 000000123456",
+			},
+			{
+				Country:        iso3166.PT,
+				In:             "0002.0123.12345678901.54",
+				Expect:         "PT50000201231234567890154",
+				ExpectNational: "0002 0123 12345678901 54",
+			},
+		}
+
+		for _, c := range successCases {
+			t.Run(c.In, func(t *testing.T) {
+				actual, err := FromNational(c.Country, c.In)
+				if err != nil {
+					t.Fatalf("FromNational(%s, %q): %s", c.Country.Code, c.In, err)
+				}
+
+				if actual.Compact() != c.Expect {
+					t.Errorf("FromNational(%s, %q): expected %q, got %q", c.Country.Code, c.In, c.Expect, actual.Compact())
+				}
+
+				if national := actual.National(); national != c.ExpectNational {
+					t.Errorf("National(): expected %q, got %q", c.ExpectNational, national)
+				}
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		failureCases := []struct {
+			Country iso3166.Alpha2Code
+			In      string
+			Expect  error
+		}{
+			{Country: iso3166.NL, In: "417164300", Expect: ErrNoNationalFormat},
+			{Country: iso3166.BE, In: "539-0075470", Expect: ErrNationalFormat},
+			{Country: iso3166.BE, In: "53900754703", Expect: ErrNationalFormat},
+			{Country: iso3166.BE, In: "539-0075470-35", Expect: ErrNationalChecksum},
+			{Country: iso3166.FR, In: "30006 00001 123456789012 89", Expect: ErrField},
+		}
+
+		for _, c := range failureCases {
+			t.Run(c.In, func(t *testing.T) {
+				_, err := FromNational(c.Country, c.In)
+				if !errors.Is(err, c.Expect) {
+					t.Errorf("FromNational(%s, %q): expected error %q, got %v", c.Country.Code, c.In, c.Expect, err)
+				}
+			})
+		}
+	})
+
+	t.Run("national formats", func(t *testing.T) {
+		for country, nf := range nationalFormats {
+			f := formats[country]
+
+			var n int
+			for _, name := range nf.fields {
+				n += len(f.positions(name))
+			}
+
+			if n != f.BBANLength() {
+				t.Errorf("%s: national format covers %d characters, but BBAN is %d characters long",
+					country.Code, n, f.BBANLength())
+			}
+		}
+	})
+}
@@ -0,0 +1,166 @@
+package iban
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mavolin/standards/iso3166"
+)
+
+var (
+	// ErrNoFormat is returned by [New], if the BBAN format of the country is
+	// unknown.
+	ErrNoFormat = errors.New("iban: no bban format available for country")
+	// ErrField is returned by [New], if a field is not part of the country's
+	// BBAN format, or if its value is too long.
+	ErrField = errors.New("iban: field does not fit country-specific format")
+)
+
+// FieldName is the name of a country-specific field of an IBAN.
+//
+// Each FieldName corresponds to the field of [IBAN] of the same name.
+type FieldName string
+
+const (
+	BankCode                  FieldName = "bankCode"
+	BranchCode                FieldName = "branchCode"
+	AccountType               FieldName = "accountType"
+	OwnerAccountNumber        FieldName = "ownerAccountNumber"
+	OwnerIdentificationNumber FieldName = "ownerIdentificationNumber"
+	BalanceAccountNumber      FieldName = "balanceAccountNumber"
+	AccountNumberPrefix       FieldName = "accountNumberPrefix"
+	AccountNumber             FieldName = "accountNumber"
+	NationalChecksum          FieldName = "checksum"
+	CurrencyCode              FieldName = "currencyCode"
+)
+
+// Field is the value of a country-specific field, used to create an IBAN
+// using [New].
+type Field struct {
+	Name  FieldName
+	Value string
+}
+
+// New creates a new IBAN for the passed country from the passed
+// country-specific fields.
+//
+// Spaces in the fields' values are ignored, and lowercase letters are
+// converted to uppercase.
+// Values shorter than the field are padded with leading zeros, e.g. a German
+// account number of "532013000" becomes "0532013000".
+// Parts of the BBAN that don't belong to any field are filled with zeros.
+// Fields that are not given are filled with zeros, as well.
+//
+// If the country has a national checksum, and the NationalChecksum field is
+// not given, New computes it.
+// For countries whose national check digits are part of other fields, e.g. the
+// account number of German IBANs, New validates the check digits instead.
+//
+// Finally, New computes the check digits of the IBAN and returns the IBAN as
+// if it were parsed using [Parse].
+//
+// New can only create IBANs for countries whose BBAN format is known, for
+// other countries it returns [ErrNoFormat].
+func New(country iso3166.Alpha2Code, fields ...Field) (IBAN, error) {
+	format, ok := formats[country]
+	if !ok {
+		return IBAN{}, ErrNoFormat
+	}
+
+	bban := make([]byte, format.BBANLength())
+	for i := range bban {
+		bban[i] = '0'
+	}
+
+	var hasChecksum bool
+
+	for _, f := range fields {
+		if f.Name == NationalChecksum {
+			hasChecksum = true
+		}
+
+		positions := format.positions(f.Name)
+		if len(positions) == 0 {
+			return IBAN{}, fmt.Errorf("%w: %s has no %s", ErrField, country.Code, f.Name)
+		}
+
+		val := strings.ToUpper(strings.ReplaceAll(f.Value, " ", ""))
+		if len(val) > len(positions) {
+			return IBAN{}, fmt.Errorf("%w: %s must be at most %d characters long", ErrField, f.Name, len(positions))
+		}
+
+		// pad with leading zeros
+		val = strings.Repeat("0", len(positions)-len(val)) + val
+
+		for i, pos := range positions {
+			bban[pos] = val[i]
+		}
+	}
+
+	checksumPositions := format.positions(NationalChecksum)
+	if _, ok := checksumFuncs[country]; !ok || hasChecksum || len(checksumPositions) == 0 {
+		return parseBBAN(country, string(bban))
+	}
+
+	// Instead of implementing the computation of each national check digit
+	// algorithm a second time, we simply try all possible check digits, and
+	// let Parse validate them.
+	// Even for two check digits, these are only 100 combinations, which is
+	// fast enough, and guarantees that New and Parse can't disagree.
+	var candidates func(i int) (IBAN, error)
+	candidates = func(i int) (IBAN, error) {
+		if i == len(checksumPositions) {
+			return parseBBAN(country, string(bban))
+		}
+
+		pos := checksumPositions[i]
+		chars := format.classAt(pos).chars()
+		for j := 0; j < len(chars); j++ {
+			bban[pos] = chars[j]
+
+			iban, err := candidates(i + 1)
+			if !errors.Is(err, ErrNationalChecksum) {
+				return iban, err
+			}
+		}
+
+		return IBAN{}, ErrNationalChecksum
+	}
+
+	return candidates(0)
+}
+
+// parseBBAN computes the check digits for the passed country and BBAN, and
+// parses the resulting IBAN.
+func parseBBAN(country iso3166.Alpha2Code, bban string) (IBAN, error) {
+	return Parse(country.Code + twoDigitStr(int(checkDigits(country, bban))) + bban)
+}
+
+// checkDigits computes the ISO 13616 check digits for the passed country and
+// BBAN.
+func checkDigits(country iso3166.Alpha2Code, bban string) uint8 {
+	// https://en.wikipedia.org/wiki/International_Bank_Account_Number#Generating_IBAN_check_digits
+	return uint8(98 - mod97(bban+country.Code+"00"))
+}
+
+// mod97 computes the remainder of the division of s by 97, where letters are
+// replaced by two digits, A = 10, B = 11, ..., Z = 35.
+func mod97(s string) int {
+	return continueMod97(0, s)
+}
+
+// continueMod97 computes the remainder of the division of the concatenation
+// of the number that has the passed remainder and s by 97.
+func continueMod97(remainder int, s string) int {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= 'A' && c <= 'Z' {
+			remainder = (remainder*100 + int(c-'A'+10)) % 97
+		} else {
+			remainder = (remainder*10 + int(c-'0')) % 97
+		}
+	}
+
+	return remainder
+}
@@ -0,0 +1,118 @@
+package iban
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mavolin/standards/iso3166"
+)
+
+func TestNew(t *testing.T) {
+	successCases := []struct {
+		Country iso3166.Alpha2Code
+		Fields  []Field
+		Expect  string
+	}{
+		{
+			Country: iso3166.DE,
+			Fields:  []Field{{BankCode, "37040044"}, {AccountNumber, "532013000"}},
+			Expect:  "DE89 3704 0044 0532 0130 00",
+		},
+		{
+			Country: iso3166.NL,
+			Fields:  []Field{{BankCode, "abna"}, {AccountNumber, "417164300"}},
+			Expect:  "NL91 ABNA 0417 1643 00",
+		},
+		{
+			Country: iso3166.BE,
+			Fields:  []Field{{BankCode, "539"}, {AccountNumber, "0075470"}},
+			Expect:  "BE68 5390 0754 7034",
+		},
+		{
+			Country: iso3166.FR,
+			Fields:  []Field{{BankCode, "30006"}, {BranchCode, "1"}, {AccountNumber, "12345678901"}},
+			Expect:  "FR76 3000 6000 0112 3456 7890 189",
+		},
+		{
+			Country: iso3166.ES,
+			Fields:  []Field{{BankCode, "2100"}, {BranchCode, "0418"}, {AccountNumber, "0200051332"}},
+			Expect:  "ES91 2100 0418 4502 0005 1332",
+		},
+		{
+			Country: iso3166.IT,
+			Fields:  []Field{{BankCode, "05428"}, {BranchCode, "11101"}, {AccountNumber, "123456"}},
+			Expect:  "IT60 X054 2811 1010 0000 0123 456",
+		},
+		{
+			Country: iso3166.IT,
+			Fields: []Field{
+				{BankCode, "05428"}, {BranchCode, "11101"}, {AccountNumber, "123456"}, {NationalChecksum, "X"},
+			},
+			Expect: "IT60 X054 2811 1010 0000 0123 456",
+		},
+	}
+
+	failureCases := []struct {
+		Name    string
+		Country iso3166.Alpha2Code
+		Fields  []Field
+		Expect  error
+	}{
+		{
+			Name:    "no format",
+			Country: iso3166.US,
+			Fields:  []Field{{AccountNumber, "123"}},
+			Expect:  ErrNoFormat,
+		},
+		{
+			Name:    "unknown field",
+			Country: iso3166.DE,
+			Fields:  []Field{{BranchCode, "123"}},
+			Expect:  ErrField,
+		},
+		{
+			Name:    "too long",
+			Country: iso3166.DE,
+			Fields:  []Field{{BankCode, "370400440"}},
+			Expect:  ErrField,
+		},
+		{
+			Name:    "national checksum",
+			Country: iso3166.DE,
+			Fields:  []Field{{BankCode, "37040044"}, {AccountNumber, "532013200"}},
+			Expect:  ErrNationalChecksum,
+		},
+		{
+			Name:    "wrong national checksum",
+			Country: iso3166.BE,
+			Fields:  []Field{{BankCode, "539"}, {AccountNumber, "0075470"}, {NationalChecksum, "35"}},
+			Expect:  ErrNationalChecksum,
+		},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		for _, c := range successCases {
+			t.Run(c.Expect, func(t *testing.T) {
+				iban, err := New(c.Country, c.Fields...)
+				if err != nil {
+					t.Fatalf("New(%s, %v): %s", c.Country.Code, c.Fields, err)
+				}
+
+				if iban.String() != c.Expect {
+					t.Errorf("New(%s, %v): expected %q, got %q", c.Country.Code, c.Fields, c.Expect, iban.String())
+				}
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		for _, c := range failureCases {
+			t.Run(c.Name, func(t *testing.T) {
+				_, err := New(c.Country, c.Fields...)
+				if !errors.Is(err, c.Expect) {
+					t.Errorf("New(%s, %v): expected %q, got %v", c.Country.Code, c.Fields, c.Expect, err)
+				}
+			})
+		}
+	})
+}
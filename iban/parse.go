@@ -2,7 +2,7 @@ package iban
 
 import (
 	"errors"
-	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/mavolin/standards/iso3166"
@@ -12,12 +12,77 @@ var (
 	ErrLength           = errors.New("iban: an iban must be at least 5 and at most 34 characters long")
 	ErrCountryCode      = errors.New("iban: invalid country code")
 	ErrChecksum         = errors.New("iban: checksum does not match")
+	ErrCountryLength    = errors.New("iban: iban does not have the length required by its country")
 	ErrCountrySpecific  = errors.New("iban: bban does not match country-specific rules")
 	ErrNationalChecksum = errors.New("iban: national checksum does not match")
 	ErrBBAN             = errors.New("iban: bban must consist of only letters and digits")
 )
 
-//go:generate go run github.com/mavolin/standards/tools/codegen/bban_regexp
+// ParseError is the error returned by [Parse], if the IBAN is invalid.
+//
+// It describes which part of the IBAN is invalid, and can be compared to the
+// sentinel errors of this package using [errors.Is].
+type ParseError struct {
+	// Err is the sentinel error describing the failure, e.g.
+	// [ErrNationalChecksum].
+	Err error
+	// Field is the name of the invalid field of the BBAN.
+	//
+	// It is empty, if the error is not caused by a single field, e.g. if the
+	// country code is invalid.
+	Field FieldName
+	// Offset is the byte offset of the first invalid character in the
+	// compact form of the IBAN, i.e. the uppercase IBAN without spaces.
+	Offset int
+	// Class is the class of characters expected at Offset.
+	//
+	// It is 0, if Parse doesn't know which characters are allowed.
+	Class CharClass
+	// Length is the expected length of the invalid field or part of the
+	// IBAN.
+	//
+	// It is 0, if the length is unknown.
+	Length int
+	// Actual is the actual value of the invalid field or part of the IBAN.
+	Actual string
+}
+
+var _ error = (*ParseError)(nil)
+
+func (err *ParseError) Error() string {
+	var sb strings.Builder
+	sb.WriteString(err.Err.Error())
+
+	if err.Field != "" {
+		sb.WriteString(": ")
+		sb.WriteString(string(err.Field))
+	}
+
+	sb.WriteString(" at offset ")
+	sb.WriteString(strconv.Itoa(err.Offset))
+
+	if err.Length > 0 || err.Class != 0 {
+		sb.WriteString(": expected ")
+		if err.Length > 0 {
+			sb.WriteString(strconv.Itoa(err.Length))
+			sb.WriteByte(' ')
+		}
+		if err.Class != 0 {
+			sb.WriteString(err.Class.String())
+			sb.WriteByte(' ')
+		}
+		sb.WriteString("characters")
+	}
+
+	sb.WriteString(", got ")
+	sb.WriteString(strconv.Quote(err.Actual))
+
+	return sb.String()
+}
+
+func (err *ParseError) Unwrap() error {
+	return err.Err
+}
 
 // Parse parses the passed IBAN.
 //
@@ -33,98 +98,173 @@ var (
 // IBAN does not exceed the 34-character limit.
 //
 // If country-specific rules are available, Parse will also validate the
-// country-specific length of the IBAN, the correct format (i.e. ensure that
+// country-specific length of the IBAN (before validating the checksum), the
+// correct format (i.e. ensure that
 // numeric parts only contain digits, and alphabetic parts only contain
 // letters), and the national checksum, if there is one.
-// See [IBAN formats by country] for the rules employed by Parse.
+// See [FormatFor] and [IBAN formats by country] for the rules employed by
+// Parse.
+//
+// # Errors
+//
+// All errors returned by Parse are of type [*ParseError], which can be
+// compared to this package's sentinel errors using [errors.Is].
 //
 // [IBAN formats by country]: https://en.wikipedia.org/wiki/International_Bank_Account_Number#IBAN_formats_by_country
 func Parse(s string) (IBAN, error) {
 	s = strings.ReplaceAll(s, " ", "")
 	s = strings.ToUpper(s)
 
-	if len(s) < 5 || len(s) > 34 {
-		return IBAN{}, ErrLength
+	return parse(s)
+}
+
+// parse parses the passed IBAN, which must already be free of spaces and in
+// uppercase.
+//
+// The fields of the returned IBAN and of returned [*ParseError]s are
+// substrings of s.
+func parse(s string) (IBAN, error) {
+	if len(s) < 5 || len(s) > maxLen {
+		offset := len(s)
+		if offset > maxLen {
+			offset = maxLen
+		}
+
+		return IBAN{}, &ParseError{Err: ErrLength, Offset: offset, Actual: s}
 	}
 
 	var iban IBAN
 
 	var err error
 	iban.CountryCode, err = iso3166.ParseAlpha2(s[:2])
-	if err != nil {
-		return IBAN{}, fmt.Errorf("iban: invalid country code: %w", err)
+	if err != nil || !iban.CountryCode.Status.IsAssigned() {
+		return IBAN{}, &ParseError{Err: ErrCountryCode, Class: Alpha, Length: 2, Actual: s[:2]}
 	}
-	if !iban.CountryCode.Status.IsAssigned() {
-		return IBAN{}, ErrCountryCode
+
+	format, hasFormat := formats[iban.CountryCode]
+	if hasFormat && len(s) != format.Length {
+		offset := len(s)
+		if offset > format.Length {
+			offset = format.Length
+		}
+
+		return IBAN{}, &ParseError{Err: ErrCountryLength, Offset: offset, Length: format.BBANLength(), Actual: s[4:]}
 	}
 
 	var ok bool
 	iban.Checksum, ok = parseTwoDigits(s[2:4])
-	if !ok {
-		return IBAN{}, ErrChecksum
-	}
-
-	if !isValidIBAN(s) {
-		return IBAN{}, ErrChecksum
+	if !ok || !isValidIBAN(s) {
+		return IBAN{}, &ParseError{Err: ErrChecksum, Offset: 2, Class: Numeric, Length: 2, Actual: s[2:4]}
 	}
 
 	iban.BBAN = s[4:]
 
-	bbanRegexp, _ := bbanRegexps[iban.CountryCode]
-	if bbanRegexp != nil {
-		matches := bbanRegexp.FindStringSubmatch(iban.BBAN)
-		if matches == nil {
-			return IBAN{}, ErrCountrySpecific
-		}
+	if hasFormat {
+		var start, offset int
+		for i, f := range format.Fields {
+			val := iban.BBAN[offset : offset+f.Length]
+			for j := 0; j < len(val); j++ {
+				if !f.Class.Contains(val[j]) {
+					return IBAN{}, &ParseError{
+						Err:    ErrCountrySpecific,
+						Field:  f.Name,
+						Offset: 4 + offset + j,
+						Class:  f.Class,
+						Length: f.Length,
+						Actual: val,
+					}
+				}
+			}
+
+			offset += f.Length
 
-		for i, name := range bbanRegexp.SubexpNames() {
-			if name == "" {
+			// a field consisting of characters of different classes is split
+			// into multiple consecutive FieldSpecs
+			if i+1 < len(format.Fields) && format.Fields[i+1].Name == f.Name {
 				continue
 			}
 
-			switch name {
-			case "balanceAccountNumber":
-				iban.BalanceAccountNumber = matches[i]
-			case "bankCode":
-				iban.BankCode += matches[i]
-			case "bicBankCode":
-				iban.BankCode += matches[i]
-			case "accountNumber":
-				iban.AccountNumber = matches[i]
-			case "ownerIdentificationNumber":
-				iban.OwnerIdentificationNumber = matches[i]
-			case "currencyCode":
-				iban.CurrencyCode = matches[i]
-			case "ownerAccountNumber":
-				iban.OwnerAccountNumber = matches[i]
-			case "accountNumberPrefix":
-				iban.AccountNumberPrefix = matches[i]
-			case "branchCode":
-				iban.BranchCode = matches[i]
-			case "accountType":
-				iban.AccountType = matches[i]
-			case "checksum":
-				iban.NationalChecksum = matches[i]
+			if p := iban.fieldPtr(f.Name); p != nil {
+				*p = join(*p, iban.BBAN[start:offset])
 			}
+			start = offset
 		}
 	} else {
-		for _, r := range iban.BBAN {
-			if (r < '0' || r > '9') && (r < 'A' || r > 'Z') {
-				return IBAN{}, ErrBBAN
+		for i := 0; i < len(iban.BBAN); i++ {
+			if !Alphanumeric.Contains(iban.BBAN[i]) {
+				return IBAN{}, &ParseError{Err: ErrBBAN, Offset: 4 + i, Class: Alphanumeric, Actual: iban.BBAN}
 			}
 		}
 	}
 
 	// check if we have a national checksum f
 	if f, ok := checksumFuncs[iban.CountryCode]; ok {
-		if !f(iban) {
-			return IBAN{}, ErrNationalChecksum
+		if err := f(iban); err != nil {
+			return IBAN{}, err
 		}
 	}
 
 	return iban, nil
 }
 
+// fieldPtr returns a pointer to the field with the passed name, or nil if
+// there is no such field.
+func (iban *IBAN) fieldPtr(name FieldName) *string {
+	switch name {
+	case BankCode:
+		return &iban.BankCode
+	case BranchCode:
+		return &iban.BranchCode
+	case AccountType:
+		return &iban.AccountType
+	case OwnerAccountNumber:
+		return &iban.OwnerAccountNumber
+	case OwnerIdentificationNumber:
+		return &iban.OwnerIdentificationNumber
+	case BalanceAccountNumber:
+		return &iban.BalanceAccountNumber
+	case AccountNumberPrefix:
+		return &iban.AccountNumberPrefix
+	case AccountNumber:
+		return &iban.AccountNumber
+	case NationalChecksum:
+		return &iban.NationalChecksum
+	case CurrencyCode:
+		return &iban.CurrencyCode
+	default:
+		return nil
+	}
+}
+
+// field returns the value of the field with the passed name.
+func (iban IBAN) field(name FieldName) string {
+	if p := iban.fieldPtr(name); p != nil {
+		return *p
+	}
+
+	return ""
+}
+
+const digitPairs = "00010203040506070809101112131415161718192021222324252627282930313233343536373839404142434445464748495051525354555657585960616263646566676869707172737475767778798081828384858687888990919293949596979899"
+
+// join returns a+b.
+//
+// The only field whose parts aren't adjacent are the two national check
+// digits of Hungarian IBANs.
+// To spare us an allocation, two single digits are joined using digitPairs.
+func join(a, b string) string {
+	if a == "" {
+		return b
+	}
+
+	if len(a) == 1 && len(b) == 1 && Numeric.Contains(a[0]) && Numeric.Contains(b[0]) {
+		i := int(a[0]-'0')*10 + int(b[0]-'0')
+		return digitPairs[2*i : 2*i+2]
+	}
+
+	return a + b
+}
+
 func parseTwoDigits(s string) (uint8, bool) {
 	digit1, ok := parseDigit(s[0])
 	if !ok {
@@ -150,33 +290,11 @@ func parseDigit(digit byte) (uint8, bool) {
 func isValidIBAN(s string) bool {
 	// https://en.wikipedia.org/wiki/International_Bank_Account_Number#Validating_the_IBAN
 	// https://en.wikipedia.org/wiki/Modulo_operation
-
-	// 1. Move the four initial characters to the end of the string.
-	s = s[4:] + s[:4]
-
-	// 2. Replace each letter in the string with two digits, thereby expanding the string, where A = 10, B = 11, ..., Z = 35.
-	for i := 0; i < len(s); i++ {
-		c := s[i]
-		if c >= 'A' && c <= 'Z' {
-			toDigit := c - 'A' + 10
-			s = s[:i] + twoDigitToString(toDigit) + s[i+1:]
-			i++ // we replaced a single character with two, so we need to skip
-		}
-	}
-
-	// 3. Interpret the string as a decimal integer and compute the remainder of that number on division by 97.
-	var remainder uint16
-	for _, r := range s {
-		remainder = remainder*10 + uint16(r-'0')
-		remainder %= 97
-	}
-
-	return remainder == 1
-}
-
-func twoDigitToString(i uint8) string {
-	//goland:noinspection GoVetIntToStringConversion
-	return string(i/10+'0') + string(i%10+'0')
+	//
+	// Instead of moving the first four characters to the end, and then
+	// computing the remainder of the entire string, we compute the remainder
+	// piece by piece, which spares us from building a new string.
+	return continueMod97(mod97(s[4:]), s[:4]) == 1
 }
 
 func IsValid(s string) bool {
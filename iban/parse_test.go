@@ -1,6 +1,9 @@
 package iban
 
-import "testing"
+import (
+	"errors"
+	"testing"
+)
 
 func TestParse(t *testing.T) {
 	// https://ibanvalidieren.de/beispiele.html
@@ -14,6 +17,7 @@ func TestParse(t *testing.T) {
 		{In: "DE02120300000000202051", Expect: "DE02 1203 0000 0000 2020 51"},
 		{In: "DE02500105170137075030", Expect: "DE02 5001 0517 0137 0750 30"},
 		{In: "DE88100900001234567892", Expect: "DE88 1009 0000 1234 5678 92"},
+		{In: "DE89370400440532013000", Expect: "DE89 3704 0044 0532 0130 00"},
 		{In: "AT026000000001349870", Expect: "AT02 6000 0000 0134 9870"},
 		{In: "AT021420020010147558", Expect: "AT02 1420 0200 1014 7558"},
 		{In: "AT023200000000641605", Expect: "AT02 3200 0000 0064 1605"},
@@ -102,3 +106,237 @@ func TestParse(t *testing.T) {
 		})
 	}
 }
+
+func TestParse_Examples(t *testing.T) {
+	for _, example := range examples {
+		t.Run(example.IBAN, func(t *testing.T) {
+			iban, err := Parse(example.IBAN)
+			if err != nil {
+				t.Fatalf("Parse(%q): %s", example.IBAN, err)
+			}
+
+			if iban.Compact() != example.IBAN {
+				t.Errorf("Parse(%q): expected %q, got %q", example.IBAN, example.IBAN, iban.Compact())
+			}
+
+			if !IsValid(example.IBAN) {
+				t.Errorf("IsValid(%q): expected true", example.IBAN)
+			}
+
+			bban := example.IBAN[4:]
+
+			// Make sure that IBANs of the wrong length are rejected, even if
+			// their checksum is correct.
+			for _, invalidBBAN := range []string{bban + "0", bban + "A", bban[:len(bban)-1]} {
+				invalid := example.Country.Code + twoDigitStr(int(checkDigits(example.Country, invalidBBAN))) + invalidBBAN
+				if !isValidIBAN(invalid) {
+					t.Fatalf("%q: expected checksum to be valid", invalid)
+				}
+
+				if _, err := Parse(invalid); !errors.Is(err, ErrCountryLength) {
+					t.Errorf("Parse(%q): expected ErrCountryLength, got %v", invalid, err)
+				}
+
+				if IsValid(invalid) {
+					t.Errorf("IsValid(%q): expected false", invalid)
+				}
+			}
+		})
+	}
+}
+
+func TestParse_Error(t *testing.T) {
+	testCases := []struct {
+		In     string
+		Expect ParseError
+	}{
+		{
+			In:     "DE89",
+			Expect: ParseError{Err: ErrLength, Offset: 4, Actual: "DE89"},
+		},
+		{
+			In:     "JJ89 3704 0044 0532 0130 00",
+			Expect: ParseError{Err: ErrCountryCode, Class: Alpha, Length: 2, Actual: "JJ"},
+		},
+		{
+			In:     "DE88 3704 0044 0532 0130 00",
+			Expect: ParseError{Err: ErrChecksum, Offset: 2, Class: Numeric, Length: 2, Actual: "88"},
+		},
+		{
+			In: "DE54 3704 0044 0532 0130 001",
+			Expect: ParseError{
+				Err:    ErrCountryLength,
+				Offset: 22,
+				Length: 18,
+				Actual: "3704004405320130001",
+			},
+		},
+		{
+			In: "NL77 AB1A 0417 1643 00",
+			Expect: ParseError{
+				Err:    ErrCountrySpecific,
+				Field:  BankCode,
+				Offset: 6,
+				Class:  Alpha,
+				Length: 4,
+				Actual: "AB1A",
+			},
+		},
+		{
+			In: "BE41 5390 0754 7035",
+			Expect: ParseError{
+				Err:    ErrNationalChecksum,
+				Field:  NationalChecksum,
+				Offset: 14,
+				Class:  Numeric,
+				Length: 2,
+				Actual: "35",
+			},
+		},
+		{
+			In: "HU17 1177 3017 1111 1018 0000 0000",
+			Expect: ParseError{
+				Err:    ErrNationalChecksum,
+				Field:  NationalChecksum,
+				Offset: 11,
+				Class:  Numeric,
+				Length: 2,
+				Actual: "70",
+			},
+		},
+		{
+			In: "HU15 1177 3016 1111 1018 0000 0001",
+			Expect: ParseError{
+				Err:    ErrNationalChecksum,
+				Field:  NationalChecksum,
+				Offset: 27,
+				Class:  Numeric,
+				Length: 2,
+				Actual: "61",
+			},
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.In, func(t *testing.T) {
+			_, err := Parse(c.In)
+
+			var perr *ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("Parse(%q): expected *ParseError, got %T (%v)", c.In, err, err)
+			}
+
+			if !errors.Is(err, c.Expect.Err) {
+				t.Errorf("Parse(%q): expected errors.Is(err, %v)", c.In, c.Expect.Err)
+			}
+
+			if *perr != c.Expect {
+				t.Errorf("Parse(%q):\nexpected: %+v\ngot:      %+v", c.In, c.Expect, *perr)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	inputs := []string{
+		"DE89370400440532013000",
+		"DE89 3704 0044 0532 0130 00",
+		"de89 3704 0044 0532 0130 00",
+		"DE88 3704 0044 0532 0130 00",
+		"DE54 3704 0044 0532 0130 001",
+		"NL77 AB1A 0417 1643 00",
+		"BE41 5390 0754 7035",
+		"HU15 1177 3016 1111 1018 0000 0001",
+		"JJ89 3704 0044 0532 0130 00",
+		"DE89",
+		"DE89 3704 0044 0532 0130 0000 0000 0000 0000",
+		"DE89 3704 0044 0532 0130 0ſ",
+	}
+	for _, f := range formats {
+		if f.Example != "" {
+			inputs = append(inputs, f.Example)
+		}
+	}
+
+	for _, in := range inputs {
+		t.Run(in, func(t *testing.T) {
+			_, expect := Parse(in)
+			actual := Validate([]byte(in))
+
+			if expect == nil || actual == nil {
+				if expect != actual {
+					t.Fatalf("Validate(%q): expected %v, got %v", in, expect, actual)
+				}
+				return
+			}
+
+			if *expect.(*ParseError) != *actual.(*ParseError) {
+				t.Errorf("Validate(%q):\nexpected: %+v\ngot:      %+v", in, expect, actual)
+			}
+		})
+	}
+
+	t.Run("allocations", func(t *testing.T) {
+		inputs := []string{"de89 3704 0044 0532 0130 00"}
+		for _, f := range formats {
+			if f.Example != "" {
+				inputs = append(inputs, f.Example)
+			}
+		}
+
+		for _, in := range inputs {
+			b := []byte(in)
+			if allocs := testing.AllocsPerRun(100, func() { _ = Validate(b) }); allocs != 0 {
+				t.Errorf("Validate(%q): expected no allocations, got %.0f", in, allocs)
+			}
+		}
+	})
+}
+
+var benchmarkIBANs = []string{
+	"DE89370400440532013000",
+	"DE89 3704 0044 0532 0130 00",
+	"FR1420041010050500013M02606",
+	"GB82WEST12345698765432",
+	"IT60X0542811101000000123456",
+	"NL91ABNA0417164300",
+}
+
+func BenchmarkParse(b *testing.B) {
+	var n int64
+	for _, s := range benchmarkIBANs {
+		n += int64(len(s))
+	}
+
+	b.SetBytes(n)
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		for _, s := range benchmarkIBANs {
+			if _, err := Parse(s); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkValidate(b *testing.B) {
+	ibans := make([][]byte, len(benchmarkIBANs))
+
+	var n int64
+	for i, s := range benchmarkIBANs {
+		ibans[i] = []byte(s)
+		n += int64(len(s))
+	}
+
+	b.SetBytes(n)
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		for _, iban := range ibans {
+			if err := Validate(iban); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package iban
+
+import (
+	"strings"
+
+	"github.com/mavolin/standards/iso3166"
+)
+
+// ValidatePrefix checks whether s is the beginning of a valid IBAN, i.e.
+// whether s can still become a valid IBAN by appending characters.
+// It is intended for validating user input while it is being typed.
+//
+// Like [Parse], ValidatePrefix ignores spaces and is case-insensitive.
+//
+// ValidatePrefix checks that the country code is valid, that the check
+// digits are digits, and that each character of the BBAN typed so far is of
+// the class required by the country's format.
+// Once s has the length required by its country, ValidatePrefix validates it
+// using Parse.
+//
+// remaining is the number of characters that still need to be appended for s
+// to become a complete IBAN.
+// If it is not yet known, because s doesn't contain a country code yet, or
+// because the country's format is unknown, or if err is not nil, remaining is
+// -1.
+//
+// If s cannot become a valid IBAN, ValidatePrefix returns a [*ParseError].
+func ValidatePrefix(s string) (remaining int, err error) {
+	s = strings.ReplaceAll(s, " ", "")
+	s = strings.ToUpper(s)
+
+	if len(s) > maxLen {
+		return -1, &ParseError{Err: ErrLength, Offset: maxLen, Actual: s}
+	}
+
+	for i := 0; i < len(s) && i < 2; i++ {
+		if !Alpha.Contains(s[i]) {
+			return -1, &ParseError{Err: ErrCountryCode, Offset: i, Class: Alpha, Length: 2, Actual: s[:minInt(len(s), 2)]}
+		}
+	}
+
+	if len(s) < 2 {
+		return -1, nil
+	}
+
+	country, err := iso3166.ParseAlpha2(s[:2])
+	if err != nil || !country.Status.IsAssigned() {
+		return -1, &ParseError{Err: ErrCountryCode, Class: Alpha, Length: 2, Actual: s[:2]}
+	}
+
+	for i := 2; i < len(s) && i < 4; i++ {
+		if !Numeric.Contains(s[i]) {
+			return -1, &ParseError{Err: ErrChecksum, Offset: i, Class: Numeric, Length: 2, Actual: s[2:minInt(len(s), 4)]}
+		}
+	}
+
+	// ISO 13616 check digits are always between 02 and 98
+	if len(s) >= 4 && (s[2:4] == "00" || s[2:4] == "01" || s[2:4] == "99") {
+		return -1, &ParseError{Err: ErrChecksum, Offset: 2, Class: Numeric, Length: 2, Actual: s[2:4]}
+	}
+
+	f, ok := formats[country]
+	if !ok {
+		for i := 4; i < len(s); i++ {
+			if !Alphanumeric.Contains(s[i]) {
+				return -1, &ParseError{Err: ErrBBAN, Offset: i, Class: Alphanumeric, Actual: s[4:]}
+			}
+		}
+
+		return -1, nil
+	}
+
+	if len(s) > f.Length {
+		return -1, &ParseError{Err: ErrCountryLength, Offset: f.Length, Length: f.BBANLength(), Actual: s[4:]}
+	}
+
+	bban := s[minInt(len(s), 4):]
+
+	var offset int
+	for _, spec := range f.Fields {
+		if offset >= len(bban) {
+			break
+		}
+
+		val := bban[offset:minInt(len(bban), offset+spec.Length)]
+		for i := 0; i < len(val); i++ {
+			if !spec.Class.Contains(val[i]) {
+				return -1, &ParseError{
+					Err:    ErrCountrySpecific,
+					Field:  spec.Name,
+					Offset: 4 + offset + i,
+					Class:  spec.Class,
+					Length: spec.Length,
+					Actual: val,
+				}
+			}
+		}
+
+		offset += spec.Length
+	}
+
+	if len(s) < f.Length {
+		return f.Length - len(s), nil
+	}
+
+	if _, err := parse(s); err != nil {
+		return -1, err
+	}
+
+	return 0, nil
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
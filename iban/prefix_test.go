@@ -0,0 +1,101 @@
+package iban
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidatePrefix(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		successCases := []struct {
+			In     string
+			Expect int
+		}{
+			{In: "", Expect: -1},
+			{In: "D", Expect: -1},
+			{In: "de", Expect: 20},
+			{In: "DE8", Expect: 19},
+			{In: "DE89 3704 0044", Expect: 10},
+			{In: "DE89 3704 0044 0532 0130 00", Expect: 0},
+			{In: "NL91 ABN", Expect: 11},
+			{In: "NL91 ABNA 04", Expect: 8},
+			// no format known
+			{In: "US05", Expect: -1},
+		}
+
+		for _, c := range successCases {
+			t.Run(c.In, func(t *testing.T) {
+				remaining, err := ValidatePrefix(c.In)
+				if err != nil {
+					t.Fatalf("ValidatePrefix(%q): %s", c.In, err)
+				}
+
+				if remaining != c.Expect {
+					t.Errorf("ValidatePrefix(%q): expected %d remaining characters, got %d", c.In, c.Expect, remaining)
+				}
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		failureCases := []struct {
+			In     string
+			Expect ParseError
+		}{
+			{
+				In:     "1",
+				Expect: ParseError{Err: ErrCountryCode, Class: Alpha, Length: 2, Actual: "1"},
+			},
+			{
+				In:     "JJ",
+				Expect: ParseError{Err: ErrCountryCode, Class: Alpha, Length: 2, Actual: "JJ"},
+			},
+			{
+				In:     "DEX",
+				Expect: ParseError{Err: ErrChecksum, Offset: 2, Class: Numeric, Length: 2, Actual: "X"},
+			},
+			{
+				In:     "DE99",
+				Expect: ParseError{Err: ErrChecksum, Offset: 2, Class: Numeric, Length: 2, Actual: "99"},
+			},
+			{
+				In: "NL91 AB1",
+				Expect: ParseError{
+					Err:    ErrCountrySpecific,
+					Field:  BankCode,
+					Offset: 6,
+					Class:  Alpha,
+					Length: 4,
+					Actual: "AB1",
+				},
+			},
+			{
+				In:     "DE89 3704 0044 0532 0130 001",
+				Expect: ParseError{Err: ErrCountryLength, Offset: 22, Length: 18, Actual: "3704004405320130001"},
+			},
+			{
+				In:     "DE88 3704 0044 0532 0130 00",
+				Expect: ParseError{Err: ErrChecksum, Offset: 2, Class: Numeric, Length: 2, Actual: "88"},
+			},
+		}
+
+		for _, c := range failureCases {
+			t.Run(c.In, func(t *testing.T) {
+				remaining, err := ValidatePrefix(c.In)
+
+				var perr *ParseError
+				if !errors.As(err, &perr) {
+					t.Fatalf("ValidatePrefix(%q): expected *ParseError, got %T (%v)", c.In, err, err)
+				}
+
+				if *perr != c.Expect {
+					t.Errorf("ValidatePrefix(%q):\nexpected: %+v\ngot:      %+v", c.In, c.Expect, *perr)
+				}
+
+				if remaining != -1 {
+					t.Errorf("ValidatePrefix(%q): expected -1 remaining characters, got %d", c.In, remaining)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,75 @@
+package iban
+
+import (
+	"sort"
+
+	"github.com/mavolin/standards/iso3166"
+)
+
+// IsSEPA reports whether the IBAN's country is part of the Single Euro
+// Payments Area (SEPA), i.e. whether SEPA credit transfers and direct debits
+// can be made to the IBAN.
+//
+// Territories that use the IBAN format of another country, but their own
+// country code, are part of SEPA, if they are listed in sepaTerritories.
+// This includes the French overseas departments, but not the French
+// territories in the Pacific, which are not part of SEPA.
+func (iban IBAN) IsSEPA() bool {
+	return isSEPA(iban.CountryCode)
+}
+
+// sepaTerritories maps the territories that are part of SEPA and use the
+// IBAN format of another country to that country.
+var sepaTerritories = map[iso3166.Alpha2Code]iso3166.Alpha2Code{
+	// Åland Islands
+	iso3166.AX: iso3166.FI,
+
+	// French overseas departments, Saint Barthélemy, Saint Martin, and
+	// Saint Pierre and Miquelon
+	iso3166.BL: iso3166.FR,
+	iso3166.GF: iso3166.FR,
+	iso3166.GP: iso3166.FR,
+	iso3166.MF: iso3166.FR,
+	iso3166.MQ: iso3166.FR,
+	iso3166.PM: iso3166.FR,
+	iso3166.RE: iso3166.FR,
+	iso3166.YT: iso3166.FR,
+
+	// Crown Dependencies
+	iso3166.GG: iso3166.GB,
+	iso3166.IM: iso3166.GB,
+	iso3166.JE: iso3166.GB,
+}
+
+func isSEPA(country iso3166.Alpha2Code) bool {
+	if parent, ok := sepaTerritories[country]; ok {
+		country = parent
+	}
+
+	return formats[country].SEPA
+}
+
+// SEPACountries returns the countries and territories whose IBANs are part of
+// the Single Euro Payments Area (SEPA), sorted by their country code.
+//
+// See [IBAN.IsSEPA] for more information.
+func SEPACountries() []iso3166.Alpha2Code {
+	countries := make([]iso3166.Alpha2Code, 0, len(formats)+len(sepaTerritories))
+	for country, f := range formats {
+		if f.SEPA {
+			countries = append(countries, country)
+		}
+	}
+
+	for territory := range sepaTerritories {
+		if isSEPA(territory) {
+			countries = append(countries, territory)
+		}
+	}
+
+	sort.Slice(countries, func(i, j int) bool {
+		return countries[i].Code < countries[j].Code
+	})
+
+	return countries
+}
@@ -0,0 +1,53 @@
+package iban
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestIBAN_IsSEPA(t *testing.T) {
+	testCases := []struct {
+		In     string
+		Expect bool
+	}{
+		{In: "DE89370400440532013000", Expect: true},
+		{In: "CH9300762011623852957", Expect: true},
+		{In: "GB82WEST12345698765432", Expect: true},
+		{In: "SM76P0854009812123456789123", Expect: true},
+		{In: "TR330006100519786457841326", Expect: false},
+		{In: "SA0380000000608010167519", Expect: false},
+		// territories using the IBAN format of another country
+		{In: "GP7310107001011234567890129", Expect: true},
+		{In: "RE0710107001011234567890129", Expect: true},
+		{In: "AX2112345600000785", Expect: true},
+		{In: "JE24BARC20000055779911", Expect: true},
+		{In: "NC4910107001011234567890129", Expect: false},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.In, func(t *testing.T) {
+			iban, err := Parse(c.In)
+			if err != nil {
+				t.Fatalf("Parse(%q): %s", c.In, err)
+			}
+
+			if actual := iban.IsSEPA(); actual != c.Expect {
+				t.Errorf("expected %t, got %t", c.Expect, actual)
+			}
+		})
+	}
+}
+
+func TestSEPACountries(t *testing.T) {
+	countries := SEPACountries()
+
+	// 27 EU member states, 3 further EEA member states, 11 others, and 12
+	// territories
+	if len(countries) != 53 {
+		t.Errorf("expected 53 countries, got %d", len(countries))
+	}
+
+	if !sort.SliceIsSorted(countries, func(i, j int) bool { return countries[i].Code < countries[j].Code }) {
+		t.Error("expected countries to be sorted")
+	}
+}
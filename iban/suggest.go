@@ -0,0 +1,77 @@
+package iban
+
+import "strings"
+
+// Suggest returns the valid IBANs that differ from s by a single typo.
+//
+// A typo is either a single character that was substituted with another
+// character, or two adjacent characters that were swapped.
+// Each suggested IBAN passes all checks of [Parse], i.e. its checksum, its
+// country's format, and its national checksum, if there is one.
+//
+// Like Parse, Suggest ignores spaces and is case-insensitive.
+// The returned IBANs are sorted by the position of the typo and never include
+// s itself.
+// If no suggestions can be made, Suggest returns nil.
+//
+// Note that a single typo usually results in multiple suggestions, as
+// IBAN checksums are only guaranteed to detect, but not to correct typos.
+func Suggest(s string) []IBAN {
+	s = strings.ReplaceAll(s, " ", "")
+	s = strings.ToUpper(s)
+
+	if len(s) < 5 || len(s) > maxLen {
+		return nil
+	}
+
+	var suggestions []IBAN
+	seen := make(map[string]struct{})
+
+	try := func(candidate []byte) {
+		iban, err := Parse(string(candidate))
+		if err != nil {
+			return
+		}
+
+		compact := iban.Compact()
+		if _, ok := seen[compact]; ok || compact == s {
+			return
+		}
+
+		seen[compact] = struct{}{}
+		suggestions = append(suggestions, iban)
+	}
+
+	candidate := []byte(s)
+
+	for i := 0; i < len(candidate); i++ {
+		// substitutions
+		chars := Alphanumeric.chars()
+		switch {
+		case i < 2: // country code
+			chars = Alpha.chars()
+		case i < 4: // check digits
+			chars = Numeric.chars()
+		}
+
+		orig := candidate[i]
+		for j := 0; j < len(chars); j++ {
+			if chars[j] == orig {
+				continue
+			}
+
+			candidate[i] = chars[j]
+			try(candidate)
+		}
+		candidate[i] = orig
+
+		// transpositions
+		if i+1 < len(candidate) && candidate[i] != candidate[i+1] {
+			candidate[i], candidate[i+1] = candidate[i+1], candidate[i]
+			try(candidate)
+			candidate[i], candidate[i+1] = candidate[i+1], candidate[i]
+		}
+	}
+
+	return suggestions
+}
@@ -0,0 +1,55 @@
+package iban
+
+import "testing"
+
+func TestSuggest(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		In     string
+		Expect string
+	}{
+		{Name: "substitution", In: "DE89 3704 0044 0532 0180 00", Expect: "DE89370400440532013000"},
+		{Name: "substitution check digits", In: "DE88 3704 0044 0532 0130 00", Expect: "DE89370400440532013000"},
+		{Name: "substitution country code", In: "DR89 3704 0044 0532 0130 00", Expect: "DE89370400440532013000"},
+		{Name: "transposition", In: "DE89 3704 0044 0523 0130 00", Expect: "DE89370400440532013000"},
+		{Name: "transposition letters", In: "gb82 wset 1234 5698 7654 32", Expect: "GB82WEST12345698765432"},
+		{Name: "national checksum", In: "BE68 5390 0754 7035", Expect: "BE68539007547034"},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.Name, func(t *testing.T) {
+			suggestions := Suggest(c.In)
+
+			var found bool
+			for _, s := range suggestions {
+				if _, err := Parse(s.Compact()); err != nil {
+					t.Errorf("Suggest(%q): suggested invalid IBAN %q: %s", c.In, s.Compact(), err)
+				}
+
+				if s.Compact() == c.Expect {
+					found = true
+				}
+			}
+
+			if !found {
+				t.Errorf("Suggest(%q): expected %q to be suggested, got %v", c.In, c.Expect, suggestions)
+			}
+		})
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		const in = "DE89370400440532013000"
+
+		for _, s := range Suggest(in) {
+			if s.Compact() == in {
+				t.Errorf("Suggest(%q): expected input not to be suggested", in)
+			}
+		}
+	})
+
+	t.Run("too short", func(t *testing.T) {
+		if suggestions := Suggest("DE89"); suggestions != nil {
+			t.Errorf("Suggest(%q): expected nil, got %v", "DE89", suggestions)
+		}
+	})
+}
@@ -0,0 +1,95 @@
+package iban
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+// bufPool holds the buffers Validate uses to normalize IBANs.
+var bufPool = sync.Pool{
+	New: func() any { return new([maxLen]byte) },
+}
+
+// Validate validates the passed IBAN.
+//
+// It applies the exact same rules as [Parse] and returns the same errors, but
+// is optimized for validating large amounts of IBANs:
+// For valid IBANs consisting only of ASCII characters, Validate does not
+// allocate.
+func Validate(b []byte) error {
+	n, ok := compactLen(b)
+	if !ok || n > maxLen {
+		// let Parse deal with non-ASCII input and produce the error for the
+		// invalid length, both are rare enough that we don't need to optimize
+		// for them
+		_, err := Parse(string(b))
+		return err
+	}
+
+	var err error
+	if n == len(b) && !hasLower(b) {
+		// b is already in compact form
+		_, err = parse(unsafeString(b))
+	} else {
+		buf := bufPool.Get().(*[maxLen]byte)
+		defer bufPool.Put(buf)
+
+		compact := buf[:0]
+		for _, c := range b {
+			if c == ' ' {
+				continue
+			} else if c >= 'a' && c <= 'z' {
+				c -= 'a' - 'A'
+			}
+
+			compact = append(compact, c)
+		}
+
+		_, err = parse(unsafeString(compact))
+	}
+
+	if err != nil {
+		// perr references b or buf, which the caller may modify after we
+		// return, or which we will reuse
+		var perr *ParseError
+		if errors.As(err, &perr) {
+			perr.Actual = strings.Clone(perr.Actual)
+		}
+	}
+
+	return err
+}
+
+// compactLen returns the length of b without spaces, and whether b consists
+// only of ASCII characters.
+func compactLen(b []byte) (n int, ascii bool) {
+	for _, c := range b {
+		if c >= 0x80 {
+			return 0, false
+		} else if c != ' ' {
+			n++
+		}
+	}
+
+	return n, true
+}
+
+func hasLower(b []byte) bool {
+	for _, c := range b {
+		if c >= 'a' && c <= 'z' {
+			return true
+		}
+	}
+
+	return false
+}
+
+// unsafeString returns a string sharing its memory with b.
+//
+// b must not be modified while the string is in use, and the string must not
+// be used after b is modified.
+func unsafeString(b []byte) string {
+	return *(*string)(unsafe.Pointer(&b))
+}
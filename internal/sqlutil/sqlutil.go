@@ -0,0 +1,29 @@
+// Package sqlutil provides utilities for implementing sql.Scanner.
+package sqlutil
+
+import (
+	"fmt"
+	"strings"
+)
+
+// String converts src, as passed to sql.Scanner.Scan, to a string.
+//
+// Trailing spaces are removed, so that values stored in fixed-length columns,
+// such as CHAR(11), which some databases pad with spaces, can be parsed.
+//
+// If src is nil, i.e. the value is NULL, ok is false.
+// If src is neither a string nor a []byte, String returns an error prefixed
+// with pkg, e.g. "de/tin", stating that src can't be scanned into the type
+// typ, e.g. "TIN".
+func String(src any, pkg, typ string) (s string, ok bool, err error) {
+	switch src := src.(type) {
+	case nil:
+		return "", false, nil
+	case string:
+		return strings.TrimRight(src, " "), true, nil
+	case []byte:
+		return strings.TrimRight(string(src), " "), true, nil
+	default:
+		return "", false, fmt.Errorf("%s: cannot scan %T into %s", pkg, src, typ)
+	}
+}
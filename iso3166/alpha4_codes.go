@@ -0,0 +1,283 @@
+package iso3166
+
+import "time"
+
+// Code generated by tools/codegen/iso3166-3. DO NOT EDIT.
+
+var alpha4Codes = map[string]Alpha4Code{
+	"AIDJ": {
+		Code:       "AIDJ",
+		Alpha2:     AI,
+		Alpha3:     "AFI",
+		Numeric:    "262",
+		Country:    "French Afars and Issas",
+		ValidUntil: time.Date(1978, 1, 1, 0, 0, 0, 0, time.UTC),
+		Successors: []Alpha2Code{DJ},
+	},
+	"ANHH": {
+		Code:       "ANHH",
+		Alpha2:     AN,
+		Alpha3:     "ANT",
+		Numeric:    "530",
+		Country:    "Netherlands Antilles",
+		ValidUntil: time.Date(2010, 12, 15, 0, 0, 0, 0, time.UTC),
+		Successors: []Alpha2Code{BQ, CW, SX},
+	},
+	"BQAQ": {
+		Code:       "BQAQ",
+		Alpha2:     BQ,
+		Alpha3:     "ATB",
+		Country:    "British Antarctic Territory",
+		ValidUntil: time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC),
+		Successors: []Alpha2Code{AQ},
+	},
+	"BUMM": {
+		Code:       "BUMM",
+		Alpha2:     BU,
+		Alpha3:     "BUR",
+		Numeric:    "104",
+		Country:    "Burma, Socialist Republic of the Union of",
+		ValidUntil: time.Date(1989, 12, 5, 0, 0, 0, 0, time.UTC),
+		Successors: []Alpha2Code{MM},
+	},
+	"BYAA": {
+		Code:       "BYAA",
+		Alpha2:     BY,
+		Alpha3:     "BYS",
+		Numeric:    "112",
+		Country:    "Byelorussian SSR Soviet Socialist Republic",
+		ValidUntil: time.Date(1992, 6, 15, 0, 0, 0, 0, time.UTC),
+		Successors: []Alpha2Code{BY},
+	},
+	"CSHH": {
+		Code:       "CSHH",
+		Alpha2:     CS,
+		Alpha3:     "CSK",
+		Numeric:    "200",
+		Country:    "Czechoslovakia, Czechoslovak Socialist Republic",
+		ValidUntil: time.Date(1993, 6, 15, 0, 0, 0, 0, time.UTC),
+		Successors: []Alpha2Code{CZ, SK},
+	},
+	"CSXX": {
+		Code:       "CSXX",
+		Alpha2:     CS,
+		Alpha3:     "SCG",
+		Numeric:    "891",
+		Country:    "Serbia and Montenegro",
+		ValidFrom:  time.Date(2003, 7, 23, 0, 0, 0, 0, time.UTC),
+		ValidUntil: time.Date(2006, 9, 26, 0, 0, 0, 0, time.UTC),
+		Successors: []Alpha2Code{ME, RS},
+	},
+	"CTKI": {
+		Code:       "CTKI",
+		Alpha2:     CT,
+		Alpha3:     "CTE",
+		Numeric:    "128",
+		Country:    "Canton and Enderbury Islands",
+		ValidUntil: time.Date(1985, 1, 1, 0, 0, 0, 0, time.UTC),
+		Successors: []Alpha2Code{KI},
+	},
+	"DDDE": {
+		Code:       "DDDE",
+		Alpha2:     DD,
+		Alpha3:     "DDR",
+		Numeric:    "278",
+		Country:    "German Democratic Republic",
+		ValidUntil: time.Date(1990, 10, 30, 0, 0, 0, 0, time.UTC),
+		Successors: []Alpha2Code{DE},
+	},
+	"DYBJ": {
+		Code:       "DYBJ",
+		Alpha2:     DY,
+		Alpha3:     "DHY",
+		Numeric:    "204",
+		Country:    "Dahomey",
+		ValidUntil: time.Date(1978, 1, 1, 0, 0, 0, 0, time.UTC),
+		Successors: []Alpha2Code{BJ},
+	},
+	"FQHH": {
+		Code:       "FQHH",
+		Alpha2:     FQ,
+		Alpha3:     "ATF",
+		Country:    "French Southern and Antarctic Territories",
+		ValidUntil: time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC),
+		Successors: []Alpha2Code{AQ, TF},
+	},
+	"FXFR": {
+		Code:       "FXFR",
+		Alpha2:     FX,
+		Alpha3:     "FXX",
+		Numeric:    "249",
+		Country:    "France, Metropolitan",
+		ValidUntil: time.Date(1997, 7, 14, 0, 0, 0, 0, time.UTC),
+		Successors: []Alpha2Code{FR},
+	},
+	"GEHH": {
+		Code:       "GEHH",
+		Alpha2:     GE,
+		Alpha3:     "GEL",
+		Numeric:    "296",
+		Country:    "Gilbert and Ellice Islands",
+		ValidUntil: time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC),
+		Successors: []Alpha2Code{KI, TV},
+	},
+	"HVBF": {
+		Code:       "HVBF",
+		Alpha2:     HV,
+		Alpha3:     "HVO",
+		Numeric:    "854",
+		Country:    "Upper Volta, Republic of",
+		ValidUntil: time.Date(1985, 1, 1, 0, 0, 0, 0, time.UTC),
+		Successors: []Alpha2Code{BF},
+	},
+	"JTUM": {
+		Code:       "JTUM",
+		Alpha2:     JT,
+		Alpha3:     "JTN",
+		Numeric:    "396",
+		Country:    "Johnston Island",
+		ValidUntil: time.Date(1987, 1, 1, 0, 0, 0, 0, time.UTC),
+		Successors: []Alpha2Code{UM},
+	},
+	"MIUM": {
+		Code:       "MIUM",
+		Alpha2:     MI,
+		Alpha3:     "MID",
+		Numeric:    "488",
+		Country:    "Midway Islands",
+		ValidUntil: time.Date(1987, 1, 1, 0, 0, 0, 0, time.UTC),
+		Successors: []Alpha2Code{UM},
+	},
+	"NHVU": {
+		Code:       "NHVU",
+		Alpha2:     NH,
+		Alpha3:     "NHB",
+		Numeric:    "548",
+		Country:    "New Hebrides",
+		ValidUntil: time.Date(1981, 1, 1, 0, 0, 0, 0, time.UTC),
+		Successors: []Alpha2Code{VU},
+	},
+	"NQAQ": {
+		Code:       "NQAQ",
+		Alpha2:     NQ,
+		Alpha3:     "ATN",
+		Numeric:    "216",
+		Country:    "Dronning Maud Land",
+		ValidUntil: time.Date(1984, 1, 1, 0, 0, 0, 0, time.UTC),
+		Successors: []Alpha2Code{AQ},
+	},
+	"NTHH": {
+		Code:       "NTHH",
+		Alpha2:     NT,
+		Alpha3:     "NTZ",
+		Numeric:    "536",
+		Country:    "Neutral Zone",
+		ValidUntil: time.Date(1993, 7, 12, 0, 0, 0, 0, time.UTC),
+		Successors: []Alpha2Code{IQ, SA},
+	},
+	"PCHH": {
+		Code:       "PCHH",
+		Alpha2:     PC,
+		Alpha3:     "PCI",
+		Numeric:    "582",
+		Country:    "Pacific Islands (trust territory)",
+		ValidUntil: time.Date(1987, 1, 1, 0, 0, 0, 0, time.UTC),
+		Successors: []Alpha2Code{FM, MH, MP, PW},
+	},
+	"PUUM": {
+		Code:       "PUUM",
+		Alpha2:     PU,
+		Alpha3:     "PUS",
+		Numeric:    "849",
+		Country:    "US Miscellaneous Pacific Islands",
+		ValidUntil: time.Date(1987, 1, 1, 0, 0, 0, 0, time.UTC),
+		Successors: []Alpha2Code{UM},
+	},
+	"PZPA": {
+		Code:       "PZPA",
+		Alpha2:     PZ,
+		Alpha3:     "PCZ",
+		Country:    "Panama Canal Zone",
+		ValidUntil: time.Date(1981, 1, 1, 0, 0, 0, 0, time.UTC),
+		Successors: []Alpha2Code{PA},
+	},
+	"RHZW": {
+		Code:       "RHZW",
+		Alpha2:     RH,
+		Alpha3:     "RHO",
+		Numeric:    "716",
+		Country:    "Southern Rhodesia",
+		ValidUntil: time.Date(1981, 1, 1, 0, 0, 0, 0, time.UTC),
+		Successors: []Alpha2Code{ZW},
+	},
+	"SKIN": {
+		Code:       "SKIN",
+		Alpha2:     SK,
+		Alpha3:     "SKM",
+		Country:    "Sikkim",
+		ValidUntil: time.Date(1976, 1, 1, 0, 0, 0, 0, time.UTC),
+		Successors: []Alpha2Code{IN},
+	},
+	"SUHH": {
+		Code:       "SUHH",
+		Alpha2:     SU,
+		Alpha3:     "SUN",
+		Numeric:    "810",
+		Country:    "USSR, Union of Soviet Socialist Republics",
+		ValidUntil: time.Date(1992, 8, 30, 0, 0, 0, 0, time.UTC),
+		Successors: []Alpha2Code{AM, AZ, EE, GE, KG, KZ, LT, LV, MD, RU, TJ, TM, UZ},
+	},
+	"TPTL": {
+		Code:       "TPTL",
+		Alpha2:     TP,
+		Alpha3:     "TMP",
+		Numeric:    "626",
+		Country:    "East Timor",
+		ValidUntil: time.Date(2002, 5, 20, 0, 0, 0, 0, time.UTC),
+		Successors: []Alpha2Code{TL},
+	},
+	"VDVN": {
+		Code:       "VDVN",
+		Alpha2:     VD,
+		Alpha3:     "VDR",
+		Country:    "Viet-Nam, Democratic Republic of",
+		ValidUntil: time.Date(1978, 1, 1, 0, 0, 0, 0, time.UTC),
+		Successors: []Alpha2Code{VN},
+	},
+	"WKUM": {
+		Code:       "WKUM",
+		Alpha2:     WK,
+		Alpha3:     "WAK",
+		Numeric:    "872",
+		Country:    "Wake Island",
+		ValidUntil: time.Date(1987, 1, 1, 0, 0, 0, 0, time.UTC),
+		Successors: []Alpha2Code{UM},
+	},
+	"YDYE": {
+		Code:       "YDYE",
+		Alpha2:     YD,
+		Alpha3:     "YMD",
+		Numeric:    "720",
+		Country:    "Yemen, Democratic, People's Democratic Republic of",
+		ValidUntil: time.Date(1990, 8, 14, 0, 0, 0, 0, time.UTC),
+		Successors: []Alpha2Code{YE},
+	},
+	"YUCS": {
+		Code:       "YUCS",
+		Alpha2:     YU,
+		Alpha3:     "YUG",
+		Numeric:    "891",
+		Country:    "Yugoslavia, (Socialist) Federal Republic of",
+		ValidUntil: time.Date(2003, 7, 23, 0, 0, 0, 0, time.UTC),
+		Successors: []Alpha2Code{CS},
+	},
+	"ZRCD": {
+		Code:       "ZRCD",
+		Alpha2:     ZR,
+		Alpha3:     "ZAR",
+		Numeric:    "180",
+		Country:    "Zaire, Republic of",
+		ValidUntil: time.Date(1997, 7, 14, 0, 0, 0, 0, time.UTC),
+		Successors: []Alpha2Code{CD},
+	},
+}
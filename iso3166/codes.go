@@ -360,7 +360,7 @@ var (
 	}
 	CT = Alpha2Code{
 		Code:    "CT",
-		Status:  5,
+		Status:  6,
 		Country: "Canton and Enderbury Islands",
 	}
 	CU = Alpha2Code{
@@ -410,7 +410,7 @@ var (
 	}
 	DD = Alpha2Code{
 		Code:    "DD",
-		Status:  5,
+		Status:  6,
 		Country: "German Democratic Republic",
 	}
 	DE = Alpha2Code{
@@ -735,7 +735,7 @@ var (
 	}
 	FQ = Alpha2Code{
 		Code:    "FQ",
-		Status:  5,
+		Status:  6,
 		Country: "French Southern and Antarctic Territories",
 	}
 	FR = Alpha2Code{
@@ -1020,7 +1020,7 @@ var (
 	}
 	HV = Alpha2Code{
 		Code:    "HV",
-		Status:  5,
+		Status:  6,
 		Country: "Upper Volta",
 	}
 	HW = Alpha2Code{
@@ -1270,7 +1270,7 @@ var (
 	}
 	JT = Alpha2Code{
 		Code:    "JT",
-		Status:  5,
+		Status:  6,
 		Country: "Johnston Island",
 	}
 	JU = Alpha2Code{
@@ -1605,7 +1605,7 @@ var (
 	}
 	MI = Alpha2Code{
 		Code:    "MI",
-		Status:  5,
+		Status:  6,
 		Country: "Midway Islands",
 	}
 	MJ = Alpha2Code{
@@ -1730,7 +1730,7 @@ var (
 	}
 	NH = Alpha2Code{
 		Code:    "NH",
-		Status:  5,
+		Status:  6,
 		Country: "New Hebrides",
 	}
 	NI = Alpha2Code{
@@ -1775,7 +1775,7 @@ var (
 	}
 	NQ = Alpha2Code{
 		Code:    "NQ",
-		Status:  5,
+		Status:  6,
 		Country: "Dronning Maud Land",
 	}
 	NR = Alpha2Code{
@@ -1965,7 +1965,7 @@ var (
 	}
 	PC = Alpha2Code{
 		Code:    "PC",
-		Status:  5,
+		Status:  6,
 		Country: "Pacific Islands (Trust Territory)",
 	}
 	PD = Alpha2Code{
@@ -2055,7 +2055,7 @@ var (
 	}
 	PU = Alpha2Code{
 		Code:    "PU",
-		Status:  5,
+		Status:  6,
 		Country: "United States Miscellaneous Pacific Islands",
 	}
 	PV = Alpha2Code{
@@ -2080,7 +2080,7 @@ var (
 	}
 	PZ = Alpha2Code{
 		Code:    "PZ",
-		Status:  5,
+		Status:  6,
 		Country: "Panama Canal Zone",
 	}
 	QA = Alpha2Code{
@@ -2750,7 +2750,7 @@ var (
 	}
 	VD = Alpha2Code{
 		Code:    "VD",
-		Status:  5,
+		Status:  6,
 		Country: "Viet-Nam, Democratic Republic of",
 	}
 	VE = Alpha2Code{
@@ -2915,7 +2915,7 @@ var (
 	}
 	WK = Alpha2Code{
 		Code:    "WK",
-		Status:  5,
+		Status:  6,
 		Country: "Wake Island",
 	}
 	WL = Alpha2Code{
@@ -3140,7 +3140,7 @@ var (
 	}
 	YD = Alpha2Code{
 		Code:    "YD",
-		Status:  5,
+		Status:  6,
 		Country: "Yemen, Democratic",
 	}
 	YE = Alpha2Code{
@@ -4063,3 +4063,1570 @@ var alpha2Codes = map[string]Alpha2Code{
 	"ZY": ZY,
 	"ZZ": ZZ,
 }
+
+var alpha3Codes = map[string]Alpha3Code{
+	"ASC": {Code: "ASC", Status: 3, Country: "Ascension Island"},
+	"AND": {Code: "AND", Status: 1, Country: "Andorra"},
+	"ARE": {Code: "ARE", Status: 1, Country: "United Arab Emirates (the)"},
+	"AFG": {Code: "AFG", Status: 1, Country: "Afghanistan"},
+	"ATG": {Code: "ATG", Status: 1, Country: "Antigua and Barbuda"},
+	"AIA": {Code: "AIA", Status: 1, Country: "Anguilla"},
+	"ALB": {Code: "ALB", Status: 1, Country: "Albania"},
+	"ARM": {Code: "ARM", Status: 1, Country: "Armenia"},
+	"ANT": {Code: "ANT", Status: 4, Country: "Netherlands Antilles"},
+	"AGO": {Code: "AGO", Status: 1, Country: "Angola"},
+	"ATA": {Code: "ATA", Status: 1, Country: "Antarctica"},
+	"ARG": {Code: "ARG", Status: 1, Country: "Argentina"},
+	"ASM": {Code: "ASM", Status: 1, Country: "American Samoa"},
+	"AUT": {Code: "AUT", Status: 1, Country: "Austria"},
+	"AUS": {Code: "AUS", Status: 1, Country: "Australia"},
+	"ABW": {Code: "ABW", Status: 1, Country: "Aruba"},
+	"ALA": {Code: "ALA", Status: 1, Country: "Åland Islands"},
+	"AZE": {Code: "AZE", Status: 1, Country: "Azerbaijan"},
+	"BIH": {Code: "BIH", Status: 1, Country: "Bosnia and Herzegovina"},
+	"BRB": {Code: "BRB", Status: 1, Country: "Barbados"},
+	"BGD": {Code: "BGD", Status: 1, Country: "Bangladesh"},
+	"BEL": {Code: "BEL", Status: 1, Country: "Belgium"},
+	"BFA": {Code: "BFA", Status: 1, Country: "Burkina Faso"},
+	"BGR": {Code: "BGR", Status: 1, Country: "Bulgaria"},
+	"BHR": {Code: "BHR", Status: 1, Country: "Bahrain"},
+	"BDI": {Code: "BDI", Status: 1, Country: "Burundi"},
+	"BEN": {Code: "BEN", Status: 1, Country: "Benin"},
+	"BLM": {Code: "BLM", Status: 1, Country: "Saint Barthélemy"},
+	"BMU": {Code: "BMU", Status: 1, Country: "Bermuda"},
+	"BRN": {Code: "BRN", Status: 1, Country: "Brunei Darussalam"},
+	"BOL": {Code: "BOL", Status: 1, Country: "Bolivia (Plurinational State of)"},
+	"BES": {Code: "BES", Status: 1, Country: "Bonaire, Sint Eustatius and Saba"},
+	"BRA": {Code: "BRA", Status: 1, Country: "Brazil"},
+	"BHS": {Code: "BHS", Status: 1, Country: "Bahamas (the)"},
+	"BTN": {Code: "BTN", Status: 1, Country: "Bhutan"},
+	"BUR": {Code: "BUR", Status: 4, Country: "Burma"},
+	"BVT": {Code: "BVT", Status: 1, Country: "Bouvet Island"},
+	"BWA": {Code: "BWA", Status: 1, Country: "Botswana"},
+	"BLR": {Code: "BLR", Status: 1, Country: "Belarus"},
+	"BLZ": {Code: "BLZ", Status: 1, Country: "Belize"},
+	"CAN": {Code: "CAN", Status: 1, Country: "Canada"},
+	"CCK": {Code: "CCK", Status: 1, Country: "Cocos (Keeling) Islands (the)"},
+	"COD": {Code: "COD", Status: 1, Country: "Congo (the Democratic Republic of the)"},
+	"CAF": {Code: "CAF", Status: 1, Country: "Central African Republic (the)"},
+	"COG": {Code: "COG", Status: 1, Country: "Congo (the)"},
+	"CHE": {Code: "CHE", Status: 1, Country: "Switzerland"},
+	"CIV": {Code: "CIV", Status: 1, Country: "Côte d'Ivoire"},
+	"COK": {Code: "COK", Status: 1, Country: "Cook Islands (the)"},
+	"CHL": {Code: "CHL", Status: 1, Country: "Chile"},
+	"CMR": {Code: "CMR", Status: 1, Country: "Cameroon"},
+	"CHN": {Code: "CHN", Status: 1, Country: "China"},
+	"COL": {Code: "COL", Status: 1, Country: "Colombia"},
+	"CPT": {Code: "CPT", Status: 3, Country: "Clipperton Island"},
+	"CRI": {Code: "CRI", Status: 1, Country: "Costa Rica"},
+	"SCG": {Code: "SCG", Status: 4, Country: "Serbia and Montenegro"},
+	"CUB": {Code: "CUB", Status: 1, Country: "Cuba"},
+	"CPV": {Code: "CPV", Status: 1, Country: "Cabo Verde"},
+	"CUW": {Code: "CUW", Status: 1, Country: "Curaçao"},
+	"CXR": {Code: "CXR", Status: 1, Country: "Christmas Island"},
+	"CYP": {Code: "CYP", Status: 1, Country: "Cyprus"},
+	"CZE": {Code: "CZE", Status: 1, Country: "Czechia"},
+	"DEU": {Code: "DEU", Status: 1, Country: "Germany"},
+	"DGA": {Code: "DGA", Status: 3, Country: "Diego Garcia"},
+	"DJI": {Code: "DJI", Status: 1, Country: "Djibouti"},
+	"DNK": {Code: "DNK", Status: 1, Country: "Denmark"},
+	"DMA": {Code: "DMA", Status: 1, Country: "Dominica"},
+	"DOM": {Code: "DOM", Status: 1, Country: "Dominican Republic (the)"},
+	"DZA": {Code: "DZA", Status: 1, Country: "Algeria"},
+	"ECU": {Code: "ECU", Status: 1, Country: "Ecuador"},
+	"EST": {Code: "EST", Status: 1, Country: "Estonia"},
+	"EGY": {Code: "EGY", Status: 1, Country: "Egypt"},
+	"ESH": {Code: "ESH", Status: 1, Country: "Western Sahara*"},
+	"ERI": {Code: "ERI", Status: 1, Country: "Eritrea"},
+	"ESP": {Code: "ESP", Status: 1, Country: "Spain"},
+	"ETH": {Code: "ETH", Status: 1, Country: "Ethiopia"},
+	"FIN": {Code: "FIN", Status: 1, Country: "Finland"},
+	"FJI": {Code: "FJI", Status: 1, Country: "Fiji"},
+	"FLK": {Code: "FLK", Status: 1, Country: "Falkland Islands (the) [Malvinas]"},
+	"FSM": {Code: "FSM", Status: 1, Country: "Micronesia (Federated States of)"},
+	"FRO": {Code: "FRO", Status: 1, Country: "Faroe Islands (the)"},
+	"FRA": {Code: "FRA", Status: 1, Country: "France"},
+	"FXX": {Code: "FXX", Status: 3, Country: "France, Metropolitan"},
+	"GAB": {Code: "GAB", Status: 1, Country: "Gabon"},
+	"GBR": {Code: "GBR", Status: 1, Country: "United Kingdom of Great Britain and Northern Ireland (the)"},
+	"GRD": {Code: "GRD", Status: 1, Country: "Grenada"},
+	"GEO": {Code: "GEO", Status: 1, Country: "Georgia"},
+	"GUF": {Code: "GUF", Status: 1, Country: "French Guiana"},
+	"GGY": {Code: "GGY", Status: 1, Country: "Guernsey"},
+	"GHA": {Code: "GHA", Status: 1, Country: "Ghana"},
+	"GIB": {Code: "GIB", Status: 1, Country: "Gibraltar"},
+	"GRL": {Code: "GRL", Status: 1, Country: "Greenland"},
+	"GMB": {Code: "GMB", Status: 1, Country: "Gambia (the)"},
+	"GIN": {Code: "GIN", Status: 1, Country: "Guinea"},
+	"GLP": {Code: "GLP", Status: 1, Country: "Guadeloupe"},
+	"GNQ": {Code: "GNQ", Status: 1, Country: "Equatorial Guinea"},
+	"GRC": {Code: "GRC", Status: 1, Country: "Greece"},
+	"SGS": {Code: "SGS", Status: 1, Country: "South Georgia and the South Sandwich Islands"},
+	"GTM": {Code: "GTM", Status: 1, Country: "Guatemala"},
+	"GUM": {Code: "GUM", Status: 1, Country: "Guam"},
+	"GNB": {Code: "GNB", Status: 1, Country: "Guinea-Bissau"},
+	"GUY": {Code: "GUY", Status: 1, Country: "Guyana"},
+	"HKG": {Code: "HKG", Status: 1, Country: "Hong Kong"},
+	"HMD": {Code: "HMD", Status: 1, Country: "Heard Island and McDonald Islands"},
+	"HND": {Code: "HND", Status: 1, Country: "Honduras"},
+	"HRV": {Code: "HRV", Status: 1, Country: "Croatia"},
+	"HTI": {Code: "HTI", Status: 1, Country: "Haiti"},
+	"HUN": {Code: "HUN", Status: 1, Country: "Hungary"},
+	"IDN": {Code: "IDN", Status: 1, Country: "Indonesia"},
+	"IRL": {Code: "IRL", Status: 1, Country: "Ireland"},
+	"ISR": {Code: "ISR", Status: 1, Country: "Israel"},
+	"IMN": {Code: "IMN", Status: 1, Country: "Isle of Man"},
+	"IND": {Code: "IND", Status: 1, Country: "India"},
+	"IOT": {Code: "IOT", Status: 1, Country: "British Indian Ocean Territory (the)"},
+	"IRQ": {Code: "IRQ", Status: 1, Country: "Iraq"},
+	"IRN": {Code: "IRN", Status: 1, Country: "Iran (Islamic Republic of)"},
+	"ISL": {Code: "ISL", Status: 1, Country: "Iceland"},
+	"ITA": {Code: "ITA", Status: 1, Country: "Italy"},
+	"JEY": {Code: "JEY", Status: 1, Country: "Jersey"},
+	"JAM": {Code: "JAM", Status: 1, Country: "Jamaica"},
+	"JOR": {Code: "JOR", Status: 1, Country: "Jordan"},
+	"JPN": {Code: "JPN", Status: 1, Country: "Japan"},
+	"KEN": {Code: "KEN", Status: 1, Country: "Kenya"},
+	"KGZ": {Code: "KGZ", Status: 1, Country: "Kyrgyzstan"},
+	"KHM": {Code: "KHM", Status: 1, Country: "Cambodia"},
+	"KIR": {Code: "KIR", Status: 1, Country: "Kiribati"},
+	"COM": {Code: "COM", Status: 1, Country: "Comoros (the)"},
+	"KNA": {Code: "KNA", Status: 1, Country: "Saint Kitts and Nevis"},
+	"PRK": {Code: "PRK", Status: 1, Country: "Korea (the Democratic People's Republic of)"},
+	"KOR": {Code: "KOR", Status: 1, Country: "Korea (the Republic of)"},
+	"KWT": {Code: "KWT", Status: 1, Country: "Kuwait"},
+	"CYM": {Code: "CYM", Status: 1, Country: "Cayman Islands (the)"},
+	"KAZ": {Code: "KAZ", Status: 1, Country: "Kazakhstan"},
+	"LAO": {Code: "LAO", Status: 1, Country: "Lao People's Democratic Republic (the)"},
+	"LBN": {Code: "LBN", Status: 1, Country: "Lebanon"},
+	"LCA": {Code: "LCA", Status: 1, Country: "Saint Lucia"},
+	"LIE": {Code: "LIE", Status: 1, Country: "Liechtenstein"},
+	"LKA": {Code: "LKA", Status: 1, Country: "Sri Lanka"},
+	"LBR": {Code: "LBR", Status: 1, Country: "Liberia"},
+	"LSO": {Code: "LSO", Status: 1, Country: "Lesotho"},
+	"LTU": {Code: "LTU", Status: 1, Country: "Lithuania"},
+	"LUX": {Code: "LUX", Status: 1, Country: "Luxembourg"},
+	"LVA": {Code: "LVA", Status: 1, Country: "Latvia"},
+	"LBY": {Code: "LBY", Status: 1, Country: "Libya"},
+	"MAR": {Code: "MAR", Status: 1, Country: "Morocco"},
+	"MCO": {Code: "MCO", Status: 1, Country: "Monaco"},
+	"MDA": {Code: "MDA", Status: 1, Country: "Moldova (the Republic of)"},
+	"MNE": {Code: "MNE", Status: 1, Country: "Montenegro"},
+	"MAF": {Code: "MAF", Status: 1, Country: "Saint Martin (French part)"},
+	"MDG": {Code: "MDG", Status: 1, Country: "Madagascar"},
+	"MHL": {Code: "MHL", Status: 1, Country: "Marshall Islands (the)"},
+	"MKD": {Code: "MKD", Status: 1, Country: "North Macedonia"},
+	"MLI": {Code: "MLI", Status: 1, Country: "Mali"},
+	"MMR": {Code: "MMR", Status: 1, Country: "Myanmar"},
+	"MNG": {Code: "MNG", Status: 1, Country: "Mongolia"},
+	"MAC": {Code: "MAC", Status: 1, Country: "Macao"},
+	"MNP": {Code: "MNP", Status: 1, Country: "Northern Mariana Islands (the)"},
+	"MTQ": {Code: "MTQ", Status: 1, Country: "Martinique"},
+	"MRT": {Code: "MRT", Status: 1, Country: "Mauritania"},
+	"MSR": {Code: "MSR", Status: 1, Country: "Montserrat"},
+	"MLT": {Code: "MLT", Status: 1, Country: "Malta"},
+	"MUS": {Code: "MUS", Status: 1, Country: "Mauritius"},
+	"MDV": {Code: "MDV", Status: 1, Country: "Maldives"},
+	"MWI": {Code: "MWI", Status: 1, Country: "Malawi"},
+	"MEX": {Code: "MEX", Status: 1, Country: "Mexico"},
+	"MYS": {Code: "MYS", Status: 1, Country: "Malaysia"},
+	"MOZ": {Code: "MOZ", Status: 1, Country: "Mozambique"},
+	"NAM": {Code: "NAM", Status: 1, Country: "Namibia"},
+	"NCL": {Code: "NCL", Status: 1, Country: "New Caledonia"},
+	"NER": {Code: "NER", Status: 1, Country: "Niger (the)"},
+	"NFK": {Code: "NFK", Status: 1, Country: "Norfolk Island"},
+	"NGA": {Code: "NGA", Status: 1, Country: "Nigeria"},
+	"NIC": {Code: "NIC", Status: 1, Country: "Nicaragua"},
+	"NLD": {Code: "NLD", Status: 1, Country: "Netherlands (the)"},
+	"NOR": {Code: "NOR", Status: 1, Country: "Norway"},
+	"NPL": {Code: "NPL", Status: 1, Country: "Nepal"},
+	"NRU": {Code: "NRU", Status: 1, Country: "Nauru"},
+	"NTZ": {Code: "NTZ", Status: 4, Country: "Neutral Zone"},
+	"NIU": {Code: "NIU", Status: 1, Country: "Niue"},
+	"NZL": {Code: "NZL", Status: 1, Country: "New Zealand"},
+	"OMN": {Code: "OMN", Status: 1, Country: "Oman"},
+	"PAN": {Code: "PAN", Status: 1, Country: "Panama"},
+	"PER": {Code: "PER", Status: 1, Country: "Peru"},
+	"PYF": {Code: "PYF", Status: 1, Country: "French Polynesia"},
+	"PNG": {Code: "PNG", Status: 1, Country: "Papua New Guinea"},
+	"PHL": {Code: "PHL", Status: 1, Country: "Philippines (the)"},
+	"PAK": {Code: "PAK", Status: 1, Country: "Pakistan"},
+	"POL": {Code: "POL", Status: 1, Country: "Poland"},
+	"SPM": {Code: "SPM", Status: 1, Country: "Saint Pierre and Miquelon"},
+	"PCN": {Code: "PCN", Status: 1, Country: "Pitcairn"},
+	"PRI": {Code: "PRI", Status: 1, Country: "Puerto Rico"},
+	"PSE": {Code: "PSE", Status: 1, Country: "Palestine, State of"},
+	"PRT": {Code: "PRT", Status: 1, Country: "Portugal"},
+	"PLW": {Code: "PLW", Status: 1, Country: "Palau"},
+	"PRY": {Code: "PRY", Status: 1, Country: "Paraguay"},
+	"QAT": {Code: "QAT", Status: 1, Country: "Qatar"},
+	"REU": {Code: "REU", Status: 1, Country: "Réunion"},
+	"ROU": {Code: "ROU", Status: 1, Country: "Romania"},
+	"SRB": {Code: "SRB", Status: 1, Country: "Serbia"},
+	"RUS": {Code: "RUS", Status: 1, Country: "Russian Federation (the)"},
+	"RWA": {Code: "RWA", Status: 1, Country: "Rwanda"},
+	"SAU": {Code: "SAU", Status: 1, Country: "Saudi Arabia"},
+	"SLB": {Code: "SLB", Status: 1, Country: "Solomon Islands"},
+	"SYC": {Code: "SYC", Status: 1, Country: "Seychelles"},
+	"SDN": {Code: "SDN", Status: 1, Country: "Sudan (the)"},
+	"SWE": {Code: "SWE", Status: 1, Country: "Sweden"},
+	"SGP": {Code: "SGP", Status: 1, Country: "Singapore"},
+	"SHN": {Code: "SHN", Status: 1, Country: "Saint Helena, Ascension and Tristan da Cunha"},
+	"SVN": {Code: "SVN", Status: 1, Country: "Slovenia"},
+	"SJM": {Code: "SJM", Status: 1, Country: "Svalbard and Jan Mayen"},
+	"SVK": {Code: "SVK", Status: 1, Country: "Slovakia"},
+	"SLE": {Code: "SLE", Status: 1, Country: "Sierra Leone"},
+	"SMR": {Code: "SMR", Status: 1, Country: "San Marino"},
+	"SEN": {Code: "SEN", Status: 1, Country: "Senegal"},
+	"SOM": {Code: "SOM", Status: 1, Country: "Somalia"},
+	"SUR": {Code: "SUR", Status: 1, Country: "Suriname"},
+	"SSD": {Code: "SSD", Status: 1, Country: "South Sudan"},
+	"STP": {Code: "STP", Status: 1, Country: "Sao Tome and Principe"},
+	"SUN": {Code: "SUN", Status: 3, Country: "USSR"},
+	"SLV": {Code: "SLV", Status: 1, Country: "El Salvador"},
+	"SXM": {Code: "SXM", Status: 1, Country: "Sint Maarten (Dutch part)"},
+	"SYR": {Code: "SYR", Status: 1, Country: "Syrian Arab Republic (the)"},
+	"SWZ": {Code: "SWZ", Status: 1, Country: "Eswatini"},
+	"TAA": {Code: "TAA", Status: 3, Country: "Tristan da Cunha"},
+	"TCA": {Code: "TCA", Status: 1, Country: "Turks and Caicos Islands (the)"},
+	"TCD": {Code: "TCD", Status: 1, Country: "Chad"},
+	"ATF": {Code: "ATF", Status: 1, Country: "French Southern Territories (the)"},
+	"TGO": {Code: "TGO", Status: 1, Country: "Togo"},
+	"THA": {Code: "THA", Status: 1, Country: "Thailand"},
+	"TJK": {Code: "TJK", Status: 1, Country: "Tajikistan"},
+	"TKL": {Code: "TKL", Status: 1, Country: "Tokelau"},
+	"TLS": {Code: "TLS", Status: 1, Country: "Timor-Leste"},
+	"TKM": {Code: "TKM", Status: 1, Country: "Turkmenistan"},
+	"TUN": {Code: "TUN", Status: 1, Country: "Tunisia"},
+	"TON": {Code: "TON", Status: 1, Country: "Tonga"},
+	"TMP": {Code: "TMP", Status: 4, Country: "East Timor"},
+	"TUR": {Code: "TUR", Status: 1, Country: "Türkiye"},
+	"TTO": {Code: "TTO", Status: 1, Country: "Trinidad and Tobago"},
+	"TUV": {Code: "TUV", Status: 1, Country: "Tuvalu"},
+	"TWN": {Code: "TWN", Status: 1, Country: "Taiwan (Province of China)"},
+	"TZA": {Code: "TZA", Status: 1, Country: "Tanzania, the United Republic of"},
+	"UKR": {Code: "UKR", Status: 1, Country: "Ukraine"},
+	"UGA": {Code: "UGA", Status: 1, Country: "Uganda"},
+	"UMI": {Code: "UMI", Status: 1, Country: "United States Minor Outlying Islands (the)"},
+	"USA": {Code: "USA", Status: 1, Country: "United States of America (the)"},
+	"URY": {Code: "URY", Status: 1, Country: "Uruguay"},
+	"UZB": {Code: "UZB", Status: 1, Country: "Uzbekistan"},
+	"VAT": {Code: "VAT", Status: 1, Country: "Holy See (the)"},
+	"VCT": {Code: "VCT", Status: 1, Country: "Saint Vincent and the Grenadines"},
+	"VEN": {Code: "VEN", Status: 1, Country: "Venezuela (Bolivarian Republic of)"},
+	"VGB": {Code: "VGB", Status: 1, Country: "Virgin Islands (British)"},
+	"VIR": {Code: "VIR", Status: 1, Country: "Virgin Islands (U.S.)"},
+	"VNM": {Code: "VNM", Status: 1, Country: "Viet Nam"},
+	"VUT": {Code: "VUT", Status: 1, Country: "Vanuatu"},
+	"WLF": {Code: "WLF", Status: 1, Country: "Wallis and Futuna"},
+	"WSM": {Code: "WSM", Status: 1, Country: "Samoa"},
+	"YEM": {Code: "YEM", Status: 1, Country: "Yemen"},
+	"MYT": {Code: "MYT", Status: 1, Country: "Mayotte"},
+	"YUG": {Code: "YUG", Status: 4, Country: "Yugoslavia"},
+	"ZAF": {Code: "ZAF", Status: 1, Country: "South Africa"},
+	"ZMB": {Code: "ZMB", Status: 1, Country: "Zambia"},
+	"ZAR": {Code: "ZAR", Status: 4, Country: "Zaire"},
+	"ZWE": {Code: "ZWE", Status: 1, Country: "Zimbabwe"},
+}
+
+var numericCodes = map[string]NumericCode{
+	"004": {Code: "004", Status: 1, Country: "Afghanistan"},
+	"008": {Code: "008", Status: 1, Country: "Albania"},
+	"010": {Code: "010", Status: 1, Country: "Antarctica"},
+	"012": {Code: "012", Status: 1, Country: "Algeria"},
+	"016": {Code: "016", Status: 1, Country: "American Samoa"},
+	"020": {Code: "020", Status: 1, Country: "Andorra"},
+	"024": {Code: "024", Status: 1, Country: "Angola"},
+	"028": {Code: "028", Status: 1, Country: "Antigua and Barbuda"},
+	"031": {Code: "031", Status: 1, Country: "Azerbaijan"},
+	"032": {Code: "032", Status: 1, Country: "Argentina"},
+	"036": {Code: "036", Status: 1, Country: "Australia"},
+	"040": {Code: "040", Status: 1, Country: "Austria"},
+	"044": {Code: "044", Status: 1, Country: "Bahamas (the)"},
+	"048": {Code: "048", Status: 1, Country: "Bahrain"},
+	"050": {Code: "050", Status: 1, Country: "Bangladesh"},
+	"051": {Code: "051", Status: 1, Country: "Armenia"},
+	"052": {Code: "052", Status: 1, Country: "Barbados"},
+	"056": {Code: "056", Status: 1, Country: "Belgium"},
+	"060": {Code: "060", Status: 1, Country: "Bermuda"},
+	"064": {Code: "064", Status: 1, Country: "Bhutan"},
+	"068": {Code: "068", Status: 1, Country: "Bolivia (Plurinational State of)"},
+	"070": {Code: "070", Status: 1, Country: "Bosnia and Herzegovina"},
+	"072": {Code: "072", Status: 1, Country: "Botswana"},
+	"074": {Code: "074", Status: 1, Country: "Bouvet Island"},
+	"076": {Code: "076", Status: 1, Country: "Brazil"},
+	"084": {Code: "084", Status: 1, Country: "Belize"},
+	"086": {Code: "086", Status: 1, Country: "British Indian Ocean Territory (the)"},
+	"090": {Code: "090", Status: 1, Country: "Solomon Islands"},
+	"092": {Code: "092", Status: 1, Country: "Virgin Islands (British)"},
+	"096": {Code: "096", Status: 1, Country: "Brunei Darussalam"},
+	"100": {Code: "100", Status: 1, Country: "Bulgaria"},
+	"104": {Code: "104", Status: 1, Country: "Myanmar"},
+	"108": {Code: "108", Status: 1, Country: "Burundi"},
+	"112": {Code: "112", Status: 1, Country: "Belarus"},
+	"116": {Code: "116", Status: 1, Country: "Cambodia"},
+	"120": {Code: "120", Status: 1, Country: "Cameroon"},
+	"124": {Code: "124", Status: 1, Country: "Canada"},
+	"132": {Code: "132", Status: 1, Country: "Cabo Verde"},
+	"136": {Code: "136", Status: 1, Country: "Cayman Islands (the)"},
+	"140": {Code: "140", Status: 1, Country: "Central African Republic (the)"},
+	"144": {Code: "144", Status: 1, Country: "Sri Lanka"},
+	"148": {Code: "148", Status: 1, Country: "Chad"},
+	"152": {Code: "152", Status: 1, Country: "Chile"},
+	"156": {Code: "156", Status: 1, Country: "China"},
+	"158": {Code: "158", Status: 1, Country: "Taiwan (Province of China)"},
+	"162": {Code: "162", Status: 1, Country: "Christmas Island"},
+	"166": {Code: "166", Status: 1, Country: "Cocos (Keeling) Islands (the)"},
+	"170": {Code: "170", Status: 1, Country: "Colombia"},
+	"174": {Code: "174", Status: 1, Country: "Comoros (the)"},
+	"175": {Code: "175", Status: 1, Country: "Mayotte"},
+	"178": {Code: "178", Status: 1, Country: "Congo (the)"},
+	"180": {Code: "180", Status: 1, Country: "Congo (the Democratic Republic of the)"},
+	"184": {Code: "184", Status: 1, Country: "Cook Islands (the)"},
+	"188": {Code: "188", Status: 1, Country: "Costa Rica"},
+	"191": {Code: "191", Status: 1, Country: "Croatia"},
+	"192": {Code: "192", Status: 1, Country: "Cuba"},
+	"196": {Code: "196", Status: 1, Country: "Cyprus"},
+	"203": {Code: "203", Status: 1, Country: "Czechia"},
+	"204": {Code: "204", Status: 1, Country: "Benin"},
+	"208": {Code: "208", Status: 1, Country: "Denmark"},
+	"212": {Code: "212", Status: 1, Country: "Dominica"},
+	"214": {Code: "214", Status: 1, Country: "Dominican Republic (the)"},
+	"218": {Code: "218", Status: 1, Country: "Ecuador"},
+	"222": {Code: "222", Status: 1, Country: "El Salvador"},
+	"226": {Code: "226", Status: 1, Country: "Equatorial Guinea"},
+	"231": {Code: "231", Status: 1, Country: "Ethiopia"},
+	"232": {Code: "232", Status: 1, Country: "Eritrea"},
+	"233": {Code: "233", Status: 1, Country: "Estonia"},
+	"234": {Code: "234", Status: 1, Country: "Faroe Islands (the)"},
+	"238": {Code: "238", Status: 1, Country: "Falkland Islands (the) [Malvinas]"},
+	"239": {Code: "239", Status: 1, Country: "South Georgia and the South Sandwich Islands"},
+	"242": {Code: "242", Status: 1, Country: "Fiji"},
+	"246": {Code: "246", Status: 1, Country: "Finland"},
+	"248": {Code: "248", Status: 1, Country: "Åland Islands"},
+	"249": {Code: "249", Status: 3, Country: "France, Metropolitan"},
+	"250": {Code: "250", Status: 1, Country: "France"},
+	"254": {Code: "254", Status: 1, Country: "French Guiana"},
+	"258": {Code: "258", Status: 1, Country: "French Polynesia"},
+	"260": {Code: "260", Status: 1, Country: "French Southern Territories (the)"},
+	"262": {Code: "262", Status: 1, Country: "Djibouti"},
+	"266": {Code: "266", Status: 1, Country: "Gabon"},
+	"268": {Code: "268", Status: 1, Country: "Georgia"},
+	"270": {Code: "270", Status: 1, Country: "Gambia (the)"},
+	"275": {Code: "275", Status: 1, Country: "Palestine, State of"},
+	"276": {Code: "276", Status: 1, Country: "Germany"},
+	"288": {Code: "288", Status: 1, Country: "Ghana"},
+	"292": {Code: "292", Status: 1, Country: "Gibraltar"},
+	"296": {Code: "296", Status: 1, Country: "Kiribati"},
+	"300": {Code: "300", Status: 1, Country: "Greece"},
+	"304": {Code: "304", Status: 1, Country: "Greenland"},
+	"308": {Code: "308", Status: 1, Country: "Grenada"},
+	"312": {Code: "312", Status: 1, Country: "Guadeloupe"},
+	"316": {Code: "316", Status: 1, Country: "Guam"},
+	"320": {Code: "320", Status: 1, Country: "Guatemala"},
+	"324": {Code: "324", Status: 1, Country: "Guinea"},
+	"328": {Code: "328", Status: 1, Country: "Guyana"},
+	"332": {Code: "332", Status: 1, Country: "Haiti"},
+	"334": {Code: "334", Status: 1, Country: "Heard Island and McDonald Islands"},
+	"336": {Code: "336", Status: 1, Country: "Holy See (the)"},
+	"340": {Code: "340", Status: 1, Country: "Honduras"},
+	"344": {Code: "344", Status: 1, Country: "Hong Kong"},
+	"348": {Code: "348", Status: 1, Country: "Hungary"},
+	"352": {Code: "352", Status: 1, Country: "Iceland"},
+	"356": {Code: "356", Status: 1, Country: "India"},
+	"360": {Code: "360", Status: 1, Country: "Indonesia"},
+	"364": {Code: "364", Status: 1, Country: "Iran (Islamic Republic of)"},
+	"368": {Code: "368", Status: 1, Country: "Iraq"},
+	"372": {Code: "372", Status: 1, Country: "Ireland"},
+	"376": {Code: "376", Status: 1, Country: "Israel"},
+	"380": {Code: "380", Status: 1, Country: "Italy"},
+	"384": {Code: "384", Status: 1, Country: "Côte d'Ivoire"},
+	"388": {Code: "388", Status: 1, Country: "Jamaica"},
+	"392": {Code: "392", Status: 1, Country: "Japan"},
+	"398": {Code: "398", Status: 1, Country: "Kazakhstan"},
+	"400": {Code: "400", Status: 1, Country: "Jordan"},
+	"404": {Code: "404", Status: 1, Country: "Kenya"},
+	"408": {Code: "408", Status: 1, Country: "Korea (the Democratic People's Republic of)"},
+	"410": {Code: "410", Status: 1, Country: "Korea (the Republic of)"},
+	"414": {Code: "414", Status: 1, Country: "Kuwait"},
+	"417": {Code: "417", Status: 1, Country: "Kyrgyzstan"},
+	"418": {Code: "418", Status: 1, Country: "Lao People's Democratic Republic (the)"},
+	"422": {Code: "422", Status: 1, Country: "Lebanon"},
+	"426": {Code: "426", Status: 1, Country: "Lesotho"},
+	"428": {Code: "428", Status: 1, Country: "Latvia"},
+	"430": {Code: "430", Status: 1, Country: "Liberia"},
+	"434": {Code: "434", Status: 1, Country: "Libya"},
+	"438": {Code: "438", Status: 1, Country: "Liechtenstein"},
+	"440": {Code: "440", Status: 1, Country: "Lithuania"},
+	"442": {Code: "442", Status: 1, Country: "Luxembourg"},
+	"446": {Code: "446", Status: 1, Country: "Macao"},
+	"450": {Code: "450", Status: 1, Country: "Madagascar"},
+	"454": {Code: "454", Status: 1, Country: "Malawi"},
+	"458": {Code: "458", Status: 1, Country: "Malaysia"},
+	"462": {Code: "462", Status: 1, Country: "Maldives"},
+	"466": {Code: "466", Status: 1, Country: "Mali"},
+	"470": {Code: "470", Status: 1, Country: "Malta"},
+	"474": {Code: "474", Status: 1, Country: "Martinique"},
+	"478": {Code: "478", Status: 1, Country: "Mauritania"},
+	"480": {Code: "480", Status: 1, Country: "Mauritius"},
+	"484": {Code: "484", Status: 1, Country: "Mexico"},
+	"492": {Code: "492", Status: 1, Country: "Monaco"},
+	"496": {Code: "496", Status: 1, Country: "Mongolia"},
+	"498": {Code: "498", Status: 1, Country: "Moldova (the Republic of)"},
+	"499": {Code: "499", Status: 1, Country: "Montenegro"},
+	"500": {Code: "500", Status: 1, Country: "Montserrat"},
+	"504": {Code: "504", Status: 1, Country: "Morocco"},
+	"508": {Code: "508", Status: 1, Country: "Mozambique"},
+	"512": {Code: "512", Status: 1, Country: "Oman"},
+	"516": {Code: "516", Status: 1, Country: "Namibia"},
+	"520": {Code: "520", Status: 1, Country: "Nauru"},
+	"524": {Code: "524", Status: 1, Country: "Nepal"},
+	"528": {Code: "528", Status: 1, Country: "Netherlands (the)"},
+	"530": {Code: "530", Status: 4, Country: "Netherlands Antilles"},
+	"531": {Code: "531", Status: 1, Country: "Curaçao"},
+	"533": {Code: "533", Status: 1, Country: "Aruba"},
+	"534": {Code: "534", Status: 1, Country: "Sint Maarten (Dutch part)"},
+	"535": {Code: "535", Status: 1, Country: "Bonaire, Sint Eustatius and Saba"},
+	"536": {Code: "536", Status: 4, Country: "Neutral Zone"},
+	"540": {Code: "540", Status: 1, Country: "New Caledonia"},
+	"548": {Code: "548", Status: 1, Country: "Vanuatu"},
+	"554": {Code: "554", Status: 1, Country: "New Zealand"},
+	"558": {Code: "558", Status: 1, Country: "Nicaragua"},
+	"562": {Code: "562", Status: 1, Country: "Niger (the)"},
+	"566": {Code: "566", Status: 1, Country: "Nigeria"},
+	"570": {Code: "570", Status: 1, Country: "Niue"},
+	"574": {Code: "574", Status: 1, Country: "Norfolk Island"},
+	"578": {Code: "578", Status: 1, Country: "Norway"},
+	"580": {Code: "580", Status: 1, Country: "Northern Mariana Islands (the)"},
+	"581": {Code: "581", Status: 1, Country: "United States Minor Outlying Islands (the)"},
+	"583": {Code: "583", Status: 1, Country: "Micronesia (Federated States of)"},
+	"584": {Code: "584", Status: 1, Country: "Marshall Islands (the)"},
+	"585": {Code: "585", Status: 1, Country: "Palau"},
+	"586": {Code: "586", Status: 1, Country: "Pakistan"},
+	"591": {Code: "591", Status: 1, Country: "Panama"},
+	"598": {Code: "598", Status: 1, Country: "Papua New Guinea"},
+	"600": {Code: "600", Status: 1, Country: "Paraguay"},
+	"604": {Code: "604", Status: 1, Country: "Peru"},
+	"608": {Code: "608", Status: 1, Country: "Philippines (the)"},
+	"612": {Code: "612", Status: 1, Country: "Pitcairn"},
+	"616": {Code: "616", Status: 1, Country: "Poland"},
+	"620": {Code: "620", Status: 1, Country: "Portugal"},
+	"624": {Code: "624", Status: 1, Country: "Guinea-Bissau"},
+	"626": {Code: "626", Status: 1, Country: "Timor-Leste"},
+	"630": {Code: "630", Status: 1, Country: "Puerto Rico"},
+	"634": {Code: "634", Status: 1, Country: "Qatar"},
+	"638": {Code: "638", Status: 1, Country: "Réunion"},
+	"642": {Code: "642", Status: 1, Country: "Romania"},
+	"643": {Code: "643", Status: 1, Country: "Russian Federation (the)"},
+	"646": {Code: "646", Status: 1, Country: "Rwanda"},
+	"652": {Code: "652", Status: 1, Country: "Saint Barthélemy"},
+	"654": {Code: "654", Status: 1, Country: "Saint Helena, Ascension and Tristan da Cunha"},
+	"659": {Code: "659", Status: 1, Country: "Saint Kitts and Nevis"},
+	"660": {Code: "660", Status: 1, Country: "Anguilla"},
+	"662": {Code: "662", Status: 1, Country: "Saint Lucia"},
+	"663": {Code: "663", Status: 1, Country: "Saint Martin (French part)"},
+	"666": {Code: "666", Status: 1, Country: "Saint Pierre and Miquelon"},
+	"670": {Code: "670", Status: 1, Country: "Saint Vincent and the Grenadines"},
+	"674": {Code: "674", Status: 1, Country: "San Marino"},
+	"678": {Code: "678", Status: 1, Country: "Sao Tome and Principe"},
+	"682": {Code: "682", Status: 1, Country: "Saudi Arabia"},
+	"686": {Code: "686", Status: 1, Country: "Senegal"},
+	"688": {Code: "688", Status: 1, Country: "Serbia"},
+	"690": {Code: "690", Status: 1, Country: "Seychelles"},
+	"694": {Code: "694", Status: 1, Country: "Sierra Leone"},
+	"702": {Code: "702", Status: 1, Country: "Singapore"},
+	"703": {Code: "703", Status: 1, Country: "Slovakia"},
+	"704": {Code: "704", Status: 1, Country: "Viet Nam"},
+	"705": {Code: "705", Status: 1, Country: "Slovenia"},
+	"706": {Code: "706", Status: 1, Country: "Somalia"},
+	"710": {Code: "710", Status: 1, Country: "South Africa"},
+	"716": {Code: "716", Status: 1, Country: "Zimbabwe"},
+	"724": {Code: "724", Status: 1, Country: "Spain"},
+	"728": {Code: "728", Status: 1, Country: "South Sudan"},
+	"729": {Code: "729", Status: 1, Country: "Sudan (the)"},
+	"732": {Code: "732", Status: 1, Country: "Western Sahara*"},
+	"740": {Code: "740", Status: 1, Country: "Suriname"},
+	"744": {Code: "744", Status: 1, Country: "Svalbard and Jan Mayen"},
+	"748": {Code: "748", Status: 1, Country: "Eswatini"},
+	"752": {Code: "752", Status: 1, Country: "Sweden"},
+	"756": {Code: "756", Status: 1, Country: "Switzerland"},
+	"760": {Code: "760", Status: 1, Country: "Syrian Arab Republic (the)"},
+	"762": {Code: "762", Status: 1, Country: "Tajikistan"},
+	"764": {Code: "764", Status: 1, Country: "Thailand"},
+	"768": {Code: "768", Status: 1, Country: "Togo"},
+	"772": {Code: "772", Status: 1, Country: "Tokelau"},
+	"776": {Code: "776", Status: 1, Country: "Tonga"},
+	"780": {Code: "780", Status: 1, Country: "Trinidad and Tobago"},
+	"784": {Code: "784", Status: 1, Country: "United Arab Emirates (the)"},
+	"788": {Code: "788", Status: 1, Country: "Tunisia"},
+	"792": {Code: "792", Status: 1, Country: "Türkiye"},
+	"795": {Code: "795", Status: 1, Country: "Turkmenistan"},
+	"796": {Code: "796", Status: 1, Country: "Turks and Caicos Islands (the)"},
+	"798": {Code: "798", Status: 1, Country: "Tuvalu"},
+	"800": {Code: "800", Status: 1, Country: "Uganda"},
+	"804": {Code: "804", Status: 1, Country: "Ukraine"},
+	"807": {Code: "807", Status: 1, Country: "North Macedonia"},
+	"810": {Code: "810", Status: 3, Country: "USSR"},
+	"818": {Code: "818", Status: 1, Country: "Egypt"},
+	"826": {Code: "826", Status: 1, Country: "United Kingdom of Great Britain and Northern Ireland (the)"},
+	"831": {Code: "831", Status: 1, Country: "Guernsey"},
+	"832": {Code: "832", Status: 1, Country: "Jersey"},
+	"833": {Code: "833", Status: 1, Country: "Isle of Man"},
+	"834": {Code: "834", Status: 1, Country: "Tanzania, the United Republic of"},
+	"840": {Code: "840", Status: 1, Country: "United States of America (the)"},
+	"850": {Code: "850", Status: 1, Country: "Virgin Islands (U.S.)"},
+	"854": {Code: "854", Status: 1, Country: "Burkina Faso"},
+	"858": {Code: "858", Status: 1, Country: "Uruguay"},
+	"860": {Code: "860", Status: 1, Country: "Uzbekistan"},
+	"862": {Code: "862", Status: 1, Country: "Venezuela (Bolivarian Republic of)"},
+	"876": {Code: "876", Status: 1, Country: "Wallis and Futuna"},
+	"882": {Code: "882", Status: 1, Country: "Samoa"},
+	"887": {Code: "887", Status: 1, Country: "Yemen"},
+	"891": {Code: "891", Status: 4, Country: "Serbia and Montenegro"},
+	"894": {Code: "894", Status: 1, Country: "Zambia"},
+}
+
+var alpha2ToAlpha3 = map[string]string{
+	"AC": "ASC",
+	"AD": "AND",
+	"AE": "ARE",
+	"AF": "AFG",
+	"AG": "ATG",
+	"AI": "AIA",
+	"AL": "ALB",
+	"AM": "ARM",
+	"AN": "ANT",
+	"AO": "AGO",
+	"AQ": "ATA",
+	"AR": "ARG",
+	"AS": "ASM",
+	"AT": "AUT",
+	"AU": "AUS",
+	"AW": "ABW",
+	"AX": "ALA",
+	"AZ": "AZE",
+	"BA": "BIH",
+	"BB": "BRB",
+	"BD": "BGD",
+	"BE": "BEL",
+	"BF": "BFA",
+	"BG": "BGR",
+	"BH": "BHR",
+	"BI": "BDI",
+	"BJ": "BEN",
+	"BL": "BLM",
+	"BM": "BMU",
+	"BN": "BRN",
+	"BO": "BOL",
+	"BQ": "BES",
+	"BR": "BRA",
+	"BS": "BHS",
+	"BT": "BTN",
+	"BU": "BUR",
+	"BV": "BVT",
+	"BW": "BWA",
+	"BY": "BLR",
+	"BZ": "BLZ",
+	"CA": "CAN",
+	"CC": "CCK",
+	"CD": "COD",
+	"CF": "CAF",
+	"CG": "COG",
+	"CH": "CHE",
+	"CI": "CIV",
+	"CK": "COK",
+	"CL": "CHL",
+	"CM": "CMR",
+	"CN": "CHN",
+	"CO": "COL",
+	"CP": "CPT",
+	"CR": "CRI",
+	"CS": "SCG",
+	"CU": "CUB",
+	"CV": "CPV",
+	"CW": "CUW",
+	"CX": "CXR",
+	"CY": "CYP",
+	"CZ": "CZE",
+	"DE": "DEU",
+	"DG": "DGA",
+	"DJ": "DJI",
+	"DK": "DNK",
+	"DM": "DMA",
+	"DO": "DOM",
+	"DZ": "DZA",
+	"EC": "ECU",
+	"EE": "EST",
+	"EG": "EGY",
+	"EH": "ESH",
+	"ER": "ERI",
+	"ES": "ESP",
+	"ET": "ETH",
+	"FI": "FIN",
+	"FJ": "FJI",
+	"FK": "FLK",
+	"FM": "FSM",
+	"FO": "FRO",
+	"FR": "FRA",
+	"FX": "FXX",
+	"GA": "GAB",
+	"GB": "GBR",
+	"GD": "GRD",
+	"GE": "GEO",
+	"GF": "GUF",
+	"GG": "GGY",
+	"GH": "GHA",
+	"GI": "GIB",
+	"GL": "GRL",
+	"GM": "GMB",
+	"GN": "GIN",
+	"GP": "GLP",
+	"GQ": "GNQ",
+	"GR": "GRC",
+	"GS": "SGS",
+	"GT": "GTM",
+	"GU": "GUM",
+	"GW": "GNB",
+	"GY": "GUY",
+	"HK": "HKG",
+	"HM": "HMD",
+	"HN": "HND",
+	"HR": "HRV",
+	"HT": "HTI",
+	"HU": "HUN",
+	"ID": "IDN",
+	"IE": "IRL",
+	"IL": "ISR",
+	"IM": "IMN",
+	"IN": "IND",
+	"IO": "IOT",
+	"IQ": "IRQ",
+	"IR": "IRN",
+	"IS": "ISL",
+	"IT": "ITA",
+	"JE": "JEY",
+	"JM": "JAM",
+	"JO": "JOR",
+	"JP": "JPN",
+	"KE": "KEN",
+	"KG": "KGZ",
+	"KH": "KHM",
+	"KI": "KIR",
+	"KM": "COM",
+	"KN": "KNA",
+	"KP": "PRK",
+	"KR": "KOR",
+	"KW": "KWT",
+	"KY": "CYM",
+	"KZ": "KAZ",
+	"LA": "LAO",
+	"LB": "LBN",
+	"LC": "LCA",
+	"LI": "LIE",
+	"LK": "LKA",
+	"LR": "LBR",
+	"LS": "LSO",
+	"LT": "LTU",
+	"LU": "LUX",
+	"LV": "LVA",
+	"LY": "LBY",
+	"MA": "MAR",
+	"MC": "MCO",
+	"MD": "MDA",
+	"ME": "MNE",
+	"MF": "MAF",
+	"MG": "MDG",
+	"MH": "MHL",
+	"MK": "MKD",
+	"ML": "MLI",
+	"MM": "MMR",
+	"MN": "MNG",
+	"MO": "MAC",
+	"MP": "MNP",
+	"MQ": "MTQ",
+	"MR": "MRT",
+	"MS": "MSR",
+	"MT": "MLT",
+	"MU": "MUS",
+	"MV": "MDV",
+	"MW": "MWI",
+	"MX": "MEX",
+	"MY": "MYS",
+	"MZ": "MOZ",
+	"NA": "NAM",
+	"NC": "NCL",
+	"NE": "NER",
+	"NF": "NFK",
+	"NG": "NGA",
+	"NI": "NIC",
+	"NL": "NLD",
+	"NO": "NOR",
+	"NP": "NPL",
+	"NR": "NRU",
+	"NT": "NTZ",
+	"NU": "NIU",
+	"NZ": "NZL",
+	"OM": "OMN",
+	"PA": "PAN",
+	"PE": "PER",
+	"PF": "PYF",
+	"PG": "PNG",
+	"PH": "PHL",
+	"PK": "PAK",
+	"PL": "POL",
+	"PM": "SPM",
+	"PN": "PCN",
+	"PR": "PRI",
+	"PS": "PSE",
+	"PT": "PRT",
+	"PW": "PLW",
+	"PY": "PRY",
+	"QA": "QAT",
+	"RE": "REU",
+	"RO": "ROU",
+	"RS": "SRB",
+	"RU": "RUS",
+	"RW": "RWA",
+	"SA": "SAU",
+	"SB": "SLB",
+	"SC": "SYC",
+	"SD": "SDN",
+	"SE": "SWE",
+	"SG": "SGP",
+	"SH": "SHN",
+	"SI": "SVN",
+	"SJ": "SJM",
+	"SK": "SVK",
+	"SL": "SLE",
+	"SM": "SMR",
+	"SN": "SEN",
+	"SO": "SOM",
+	"SR": "SUR",
+	"SS": "SSD",
+	"ST": "STP",
+	"SU": "SUN",
+	"SV": "SLV",
+	"SX": "SXM",
+	"SY": "SYR",
+	"SZ": "SWZ",
+	"TA": "TAA",
+	"TC": "TCA",
+	"TD": "TCD",
+	"TF": "ATF",
+	"TG": "TGO",
+	"TH": "THA",
+	"TJ": "TJK",
+	"TK": "TKL",
+	"TL": "TLS",
+	"TM": "TKM",
+	"TN": "TUN",
+	"TO": "TON",
+	"TP": "TMP",
+	"TR": "TUR",
+	"TT": "TTO",
+	"TV": "TUV",
+	"TW": "TWN",
+	"TZ": "TZA",
+	"UA": "UKR",
+	"UG": "UGA",
+	"UM": "UMI",
+	"US": "USA",
+	"UY": "URY",
+	"UZ": "UZB",
+	"VA": "VAT",
+	"VC": "VCT",
+	"VE": "VEN",
+	"VG": "VGB",
+	"VI": "VIR",
+	"VN": "VNM",
+	"VU": "VUT",
+	"WF": "WLF",
+	"WS": "WSM",
+	"YE": "YEM",
+	"YT": "MYT",
+	"YU": "YUG",
+	"ZA": "ZAF",
+	"ZM": "ZMB",
+	"ZR": "ZAR",
+	"ZW": "ZWE",
+}
+
+var alpha2ToNumeric = map[string]string{
+	"AD": "020",
+	"AE": "784",
+	"AF": "004",
+	"AG": "028",
+	"AI": "660",
+	"AL": "008",
+	"AM": "051",
+	"AN": "530",
+	"AO": "024",
+	"AQ": "010",
+	"AR": "032",
+	"AS": "016",
+	"AT": "040",
+	"AU": "036",
+	"AW": "533",
+	"AX": "248",
+	"AZ": "031",
+	"BA": "070",
+	"BB": "052",
+	"BD": "050",
+	"BE": "056",
+	"BF": "854",
+	"BG": "100",
+	"BH": "048",
+	"BI": "108",
+	"BJ": "204",
+	"BL": "652",
+	"BM": "060",
+	"BN": "096",
+	"BO": "068",
+	"BQ": "535",
+	"BR": "076",
+	"BS": "044",
+	"BT": "064",
+	"BV": "074",
+	"BW": "072",
+	"BY": "112",
+	"BZ": "084",
+	"CA": "124",
+	"CC": "166",
+	"CD": "180",
+	"CF": "140",
+	"CG": "178",
+	"CH": "756",
+	"CI": "384",
+	"CK": "184",
+	"CL": "152",
+	"CM": "120",
+	"CN": "156",
+	"CO": "170",
+	"CR": "188",
+	"CS": "891",
+	"CU": "192",
+	"CV": "132",
+	"CW": "531",
+	"CX": "162",
+	"CY": "196",
+	"CZ": "203",
+	"DE": "276",
+	"DJ": "262",
+	"DK": "208",
+	"DM": "212",
+	"DO": "214",
+	"DZ": "012",
+	"EC": "218",
+	"EE": "233",
+	"EG": "818",
+	"EH": "732",
+	"ER": "232",
+	"ES": "724",
+	"ET": "231",
+	"FI": "246",
+	"FJ": "242",
+	"FK": "238",
+	"FM": "583",
+	"FO": "234",
+	"FR": "250",
+	"FX": "249",
+	"GA": "266",
+	"GB": "826",
+	"GD": "308",
+	"GE": "268",
+	"GF": "254",
+	"GG": "831",
+	"GH": "288",
+	"GI": "292",
+	"GL": "304",
+	"GM": "270",
+	"GN": "324",
+	"GP": "312",
+	"GQ": "226",
+	"GR": "300",
+	"GS": "239",
+	"GT": "320",
+	"GU": "316",
+	"GW": "624",
+	"GY": "328",
+	"HK": "344",
+	"HM": "334",
+	"HN": "340",
+	"HR": "191",
+	"HT": "332",
+	"HU": "348",
+	"ID": "360",
+	"IE": "372",
+	"IL": "376",
+	"IM": "833",
+	"IN": "356",
+	"IO": "086",
+	"IQ": "368",
+	"IR": "364",
+	"IS": "352",
+	"IT": "380",
+	"JE": "832",
+	"JM": "388",
+	"JO": "400",
+	"JP": "392",
+	"KE": "404",
+	"KG": "417",
+	"KH": "116",
+	"KI": "296",
+	"KM": "174",
+	"KN": "659",
+	"KP": "408",
+	"KR": "410",
+	"KW": "414",
+	"KY": "136",
+	"KZ": "398",
+	"LA": "418",
+	"LB": "422",
+	"LC": "662",
+	"LI": "438",
+	"LK": "144",
+	"LR": "430",
+	"LS": "426",
+	"LT": "440",
+	"LU": "442",
+	"LV": "428",
+	"LY": "434",
+	"MA": "504",
+	"MC": "492",
+	"MD": "498",
+	"ME": "499",
+	"MF": "663",
+	"MG": "450",
+	"MH": "584",
+	"MK": "807",
+	"ML": "466",
+	"MM": "104",
+	"MN": "496",
+	"MO": "446",
+	"MP": "580",
+	"MQ": "474",
+	"MR": "478",
+	"MS": "500",
+	"MT": "470",
+	"MU": "480",
+	"MV": "462",
+	"MW": "454",
+	"MX": "484",
+	"MY": "458",
+	"MZ": "508",
+	"NA": "516",
+	"NC": "540",
+	"NE": "562",
+	"NF": "574",
+	"NG": "566",
+	"NI": "558",
+	"NL": "528",
+	"NO": "578",
+	"NP": "524",
+	"NR": "520",
+	"NT": "536",
+	"NU": "570",
+	"NZ": "554",
+	"OM": "512",
+	"PA": "591",
+	"PE": "604",
+	"PF": "258",
+	"PG": "598",
+	"PH": "608",
+	"PK": "586",
+	"PL": "616",
+	"PM": "666",
+	"PN": "612",
+	"PR": "630",
+	"PS": "275",
+	"PT": "620",
+	"PW": "585",
+	"PY": "600",
+	"QA": "634",
+	"RE": "638",
+	"RO": "642",
+	"RS": "688",
+	"RU": "643",
+	"RW": "646",
+	"SA": "682",
+	"SB": "090",
+	"SC": "690",
+	"SD": "729",
+	"SE": "752",
+	"SG": "702",
+	"SH": "654",
+	"SI": "705",
+	"SJ": "744",
+	"SK": "703",
+	"SL": "694",
+	"SM": "674",
+	"SN": "686",
+	"SO": "706",
+	"SR": "740",
+	"SS": "728",
+	"ST": "678",
+	"SU": "810",
+	"SV": "222",
+	"SX": "534",
+	"SY": "760",
+	"SZ": "748",
+	"TC": "796",
+	"TD": "148",
+	"TF": "260",
+	"TG": "768",
+	"TH": "764",
+	"TJ": "762",
+	"TK": "772",
+	"TL": "626",
+	"TM": "795",
+	"TN": "788",
+	"TO": "776",
+	"TR": "792",
+	"TT": "780",
+	"TV": "798",
+	"TW": "158",
+	"TZ": "834",
+	"UA": "804",
+	"UG": "800",
+	"UM": "581",
+	"US": "840",
+	"UY": "858",
+	"UZ": "860",
+	"VA": "336",
+	"VC": "670",
+	"VE": "862",
+	"VG": "092",
+	"VI": "850",
+	"VN": "704",
+	"VU": "548",
+	"WF": "876",
+	"WS": "882",
+	"YE": "887",
+	"YT": "175",
+	"YU": "891",
+	"ZA": "710",
+	"ZM": "894",
+	"ZW": "716",
+}
+
+var alpha3ToAlpha2 = map[string]string{
+	"ASC": "AC",
+	"AND": "AD",
+	"ARE": "AE",
+	"AFG": "AF",
+	"ATG": "AG",
+	"AIA": "AI",
+	"ALB": "AL",
+	"ARM": "AM",
+	"ANT": "AN",
+	"AGO": "AO",
+	"ATA": "AQ",
+	"ARG": "AR",
+	"ASM": "AS",
+	"AUT": "AT",
+	"AUS": "AU",
+	"ABW": "AW",
+	"ALA": "AX",
+	"AZE": "AZ",
+	"BIH": "BA",
+	"BRB": "BB",
+	"BGD": "BD",
+	"BEL": "BE",
+	"BFA": "BF",
+	"BGR": "BG",
+	"BHR": "BH",
+	"BDI": "BI",
+	"BEN": "BJ",
+	"BLM": "BL",
+	"BMU": "BM",
+	"BRN": "BN",
+	"BOL": "BO",
+	"BES": "BQ",
+	"BRA": "BR",
+	"BHS": "BS",
+	"BTN": "BT",
+	"BUR": "BU",
+	"BVT": "BV",
+	"BWA": "BW",
+	"BLR": "BY",
+	"BLZ": "BZ",
+	"CAN": "CA",
+	"CCK": "CC",
+	"COD": "CD",
+	"CAF": "CF",
+	"COG": "CG",
+	"CHE": "CH",
+	"CIV": "CI",
+	"COK": "CK",
+	"CHL": "CL",
+	"CMR": "CM",
+	"CHN": "CN",
+	"COL": "CO",
+	"CPT": "CP",
+	"CRI": "CR",
+	"SCG": "CS",
+	"CUB": "CU",
+	"CPV": "CV",
+	"CUW": "CW",
+	"CXR": "CX",
+	"CYP": "CY",
+	"CZE": "CZ",
+	"DEU": "DE",
+	"DGA": "DG",
+	"DJI": "DJ",
+	"DNK": "DK",
+	"DMA": "DM",
+	"DOM": "DO",
+	"DZA": "DZ",
+	"ECU": "EC",
+	"EST": "EE",
+	"EGY": "EG",
+	"ESH": "EH",
+	"ERI": "ER",
+	"ESP": "ES",
+	"ETH": "ET",
+	"FIN": "FI",
+	"FJI": "FJ",
+	"FLK": "FK",
+	"FSM": "FM",
+	"FRO": "FO",
+	"FRA": "FR",
+	"FXX": "FX",
+	"GAB": "GA",
+	"GBR": "GB",
+	"GRD": "GD",
+	"GEO": "GE",
+	"GUF": "GF",
+	"GGY": "GG",
+	"GHA": "GH",
+	"GIB": "GI",
+	"GRL": "GL",
+	"GMB": "GM",
+	"GIN": "GN",
+	"GLP": "GP",
+	"GNQ": "GQ",
+	"GRC": "GR",
+	"SGS": "GS",
+	"GTM": "GT",
+	"GUM": "GU",
+	"GNB": "GW",
+	"GUY": "GY",
+	"HKG": "HK",
+	"HMD": "HM",
+	"HND": "HN",
+	"HRV": "HR",
+	"HTI": "HT",
+	"HUN": "HU",
+	"IDN": "ID",
+	"IRL": "IE",
+	"ISR": "IL",
+	"IMN": "IM",
+	"IND": "IN",
+	"IOT": "IO",
+	"IRQ": "IQ",
+	"IRN": "IR",
+	"ISL": "IS",
+	"ITA": "IT",
+	"JEY": "JE",
+	"JAM": "JM",
+	"JOR": "JO",
+	"JPN": "JP",
+	"KEN": "KE",
+	"KGZ": "KG",
+	"KHM": "KH",
+	"KIR": "KI",
+	"COM": "KM",
+	"KNA": "KN",
+	"PRK": "KP",
+	"KOR": "KR",
+	"KWT": "KW",
+	"CYM": "KY",
+	"KAZ": "KZ",
+	"LAO": "LA",
+	"LBN": "LB",
+	"LCA": "LC",
+	"LIE": "LI",
+	"LKA": "LK",
+	"LBR": "LR",
+	"LSO": "LS",
+	"LTU": "LT",
+	"LUX": "LU",
+	"LVA": "LV",
+	"LBY": "LY",
+	"MAR": "MA",
+	"MCO": "MC",
+	"MDA": "MD",
+	"MNE": "ME",
+	"MAF": "MF",
+	"MDG": "MG",
+	"MHL": "MH",
+	"MKD": "MK",
+	"MLI": "ML",
+	"MMR": "MM",
+	"MNG": "MN",
+	"MAC": "MO",
+	"MNP": "MP",
+	"MTQ": "MQ",
+	"MRT": "MR",
+	"MSR": "MS",
+	"MLT": "MT",
+	"MUS": "MU",
+	"MDV": "MV",
+	"MWI": "MW",
+	"MEX": "MX",
+	"MYS": "MY",
+	"MOZ": "MZ",
+	"NAM": "NA",
+	"NCL": "NC",
+	"NER": "NE",
+	"NFK": "NF",
+	"NGA": "NG",
+	"NIC": "NI",
+	"NLD": "NL",
+	"NOR": "NO",
+	"NPL": "NP",
+	"NRU": "NR",
+	"NTZ": "NT",
+	"NIU": "NU",
+	"NZL": "NZ",
+	"OMN": "OM",
+	"PAN": "PA",
+	"PER": "PE",
+	"PYF": "PF",
+	"PNG": "PG",
+	"PHL": "PH",
+	"PAK": "PK",
+	"POL": "PL",
+	"SPM": "PM",
+	"PCN": "PN",
+	"PRI": "PR",
+	"PSE": "PS",
+	"PRT": "PT",
+	"PLW": "PW",
+	"PRY": "PY",
+	"QAT": "QA",
+	"REU": "RE",
+	"ROU": "RO",
+	"SRB": "RS",
+	"RUS": "RU",
+	"RWA": "RW",
+	"SAU": "SA",
+	"SLB": "SB",
+	"SYC": "SC",
+	"SDN": "SD",
+	"SWE": "SE",
+	"SGP": "SG",
+	"SHN": "SH",
+	"SVN": "SI",
+	"SJM": "SJ",
+	"SVK": "SK",
+	"SLE": "SL",
+	"SMR": "SM",
+	"SEN": "SN",
+	"SOM": "SO",
+	"SUR": "SR",
+	"SSD": "SS",
+	"STP": "ST",
+	"SUN": "SU",
+	"SLV": "SV",
+	"SXM": "SX",
+	"SYR": "SY",
+	"SWZ": "SZ",
+	"TAA": "TA",
+	"TCA": "TC",
+	"TCD": "TD",
+	"ATF": "TF",
+	"TGO": "TG",
+	"THA": "TH",
+	"TJK": "TJ",
+	"TKL": "TK",
+	"TLS": "TL",
+	"TKM": "TM",
+	"TUN": "TN",
+	"TON": "TO",
+	"TMP": "TP",
+	"TUR": "TR",
+	"TTO": "TT",
+	"TUV": "TV",
+	"TWN": "TW",
+	"TZA": "TZ",
+	"UKR": "UA",
+	"UGA": "UG",
+	"UMI": "UM",
+	"USA": "US",
+	"URY": "UY",
+	"UZB": "UZ",
+	"VAT": "VA",
+	"VCT": "VC",
+	"VEN": "VE",
+	"VGB": "VG",
+	"VIR": "VI",
+	"VNM": "VN",
+	"VUT": "VU",
+	"WLF": "WF",
+	"WSM": "WS",
+	"YEM": "YE",
+	"MYT": "YT",
+	"YUG": "YU",
+	"ZAF": "ZA",
+	"ZMB": "ZM",
+	"ZAR": "ZR",
+	"ZWE": "ZW",
+}
+
+var numericToAlpha2 = map[string]string{
+	"004": "AF",
+	"008": "AL",
+	"010": "AQ",
+	"012": "DZ",
+	"016": "AS",
+	"020": "AD",
+	"024": "AO",
+	"028": "AG",
+	"031": "AZ",
+	"032": "AR",
+	"036": "AU",
+	"040": "AT",
+	"044": "BS",
+	"048": "BH",
+	"050": "BD",
+	"051": "AM",
+	"052": "BB",
+	"056": "BE",
+	"060": "BM",
+	"064": "BT",
+	"068": "BO",
+	"070": "BA",
+	"072": "BW",
+	"074": "BV",
+	"076": "BR",
+	"084": "BZ",
+	"086": "IO",
+	"090": "SB",
+	"092": "VG",
+	"096": "BN",
+	"100": "BG",
+	"104": "MM",
+	"108": "BI",
+	"112": "BY",
+	"116": "KH",
+	"120": "CM",
+	"124": "CA",
+	"132": "CV",
+	"136": "KY",
+	"140": "CF",
+	"144": "LK",
+	"148": "TD",
+	"152": "CL",
+	"156": "CN",
+	"158": "TW",
+	"162": "CX",
+	"166": "CC",
+	"170": "CO",
+	"174": "KM",
+	"175": "YT",
+	"178": "CG",
+	"180": "CD",
+	"184": "CK",
+	"188": "CR",
+	"191": "HR",
+	"192": "CU",
+	"196": "CY",
+	"203": "CZ",
+	"204": "BJ",
+	"208": "DK",
+	"212": "DM",
+	"214": "DO",
+	"218": "EC",
+	"222": "SV",
+	"226": "GQ",
+	"231": "ET",
+	"232": "ER",
+	"233": "EE",
+	"234": "FO",
+	"238": "FK",
+	"239": "GS",
+	"242": "FJ",
+	"246": "FI",
+	"248": "AX",
+	"249": "FX",
+	"250": "FR",
+	"254": "GF",
+	"258": "PF",
+	"260": "TF",
+	"262": "DJ",
+	"266": "GA",
+	"268": "GE",
+	"270": "GM",
+	"275": "PS",
+	"276": "DE",
+	"288": "GH",
+	"292": "GI",
+	"296": "KI",
+	"300": "GR",
+	"304": "GL",
+	"308": "GD",
+	"312": "GP",
+	"316": "GU",
+	"320": "GT",
+	"324": "GN",
+	"328": "GY",
+	"332": "HT",
+	"334": "HM",
+	"336": "VA",
+	"340": "HN",
+	"344": "HK",
+	"348": "HU",
+	"352": "IS",
+	"356": "IN",
+	"360": "ID",
+	"364": "IR",
+	"368": "IQ",
+	"372": "IE",
+	"376": "IL",
+	"380": "IT",
+	"384": "CI",
+	"388": "JM",
+	"392": "JP",
+	"398": "KZ",
+	"400": "JO",
+	"404": "KE",
+	"408": "KP",
+	"410": "KR",
+	"414": "KW",
+	"417": "KG",
+	"418": "LA",
+	"422": "LB",
+	"426": "LS",
+	"428": "LV",
+	"430": "LR",
+	"434": "LY",
+	"438": "LI",
+	"440": "LT",
+	"442": "LU",
+	"446": "MO",
+	"450": "MG",
+	"454": "MW",
+	"458": "MY",
+	"462": "MV",
+	"466": "ML",
+	"470": "MT",
+	"474": "MQ",
+	"478": "MR",
+	"480": "MU",
+	"484": "MX",
+	"492": "MC",
+	"496": "MN",
+	"498": "MD",
+	"499": "ME",
+	"500": "MS",
+	"504": "MA",
+	"508": "MZ",
+	"512": "OM",
+	"516": "NA",
+	"520": "NR",
+	"524": "NP",
+	"528": "NL",
+	"530": "AN",
+	"531": "CW",
+	"533": "AW",
+	"534": "SX",
+	"535": "BQ",
+	"536": "NT",
+	"540": "NC",
+	"548": "VU",
+	"554": "NZ",
+	"558": "NI",
+	"562": "NE",
+	"566": "NG",
+	"570": "NU",
+	"574": "NF",
+	"578": "NO",
+	"580": "MP",
+	"581": "UM",
+	"583": "FM",
+	"584": "MH",
+	"585": "PW",
+	"586": "PK",
+	"591": "PA",
+	"598": "PG",
+	"600": "PY",
+	"604": "PE",
+	"608": "PH",
+	"612": "PN",
+	"616": "PL",
+	"620": "PT",
+	"624": "GW",
+	"626": "TL",
+	"630": "PR",
+	"634": "QA",
+	"638": "RE",
+	"642": "RO",
+	"643": "RU",
+	"646": "RW",
+	"652": "BL",
+	"654": "SH",
+	"659": "KN",
+	"660": "AI",
+	"662": "LC",
+	"663": "MF",
+	"666": "PM",
+	"670": "VC",
+	"674": "SM",
+	"678": "ST",
+	"682": "SA",
+	"686": "SN",
+	"688": "RS",
+	"690": "SC",
+	"694": "SL",
+	"702": "SG",
+	"703": "SK",
+	"704": "VN",
+	"705": "SI",
+	"706": "SO",
+	"710": "ZA",
+	"716": "ZW",
+	"724": "ES",
+	"728": "SS",
+	"729": "SD",
+	"732": "EH",
+	"740": "SR",
+	"744": "SJ",
+	"748": "SZ",
+	"752": "SE",
+	"756": "CH",
+	"760": "SY",
+	"762": "TJ",
+	"764": "TH",
+	"768": "TG",
+	"772": "TK",
+	"776": "TO",
+	"780": "TT",
+	"784": "AE",
+	"788": "TN",
+	"792": "TR",
+	"795": "TM",
+	"796": "TC",
+	"798": "TV",
+	"800": "UG",
+	"804": "UA",
+	"807": "MK",
+	"810": "SU",
+	"818": "EG",
+	"826": "GB",
+	"831": "GG",
+	"832": "JE",
+	"833": "IM",
+	"834": "TZ",
+	"840": "US",
+	"850": "VI",
+	"854": "BF",
+	"858": "UY",
+	"860": "UZ",
+	"862": "VE",
+	"876": "WF",
+	"882": "WS",
+	"887": "YE",
+	"891": "CS",
+	"894": "ZM",
+}
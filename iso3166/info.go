@@ -0,0 +1,256 @@
+package iso3166
+
+// Code generated by tools/codegen/iso3166_info. DO NOT EDIT.
+
+var infos = map[string]Info{
+	"AD": {CallingCode: "376", Currency: "EUR", TLD: ".ad", Continent: Europe, SEPA: true},
+	"AE": {CallingCode: "971", Currency: "AED", TLD: ".ae", Continent: Asia},
+	"AF": {CallingCode: "93", Currency: "AFN", TLD: ".af", Continent: Asia},
+	"AG": {CallingCode: "1", Currency: "XCD", TLD: ".ag", Continent: NorthAmerica},
+	"AI": {CallingCode: "1", Currency: "XCD", TLD: ".ai", Continent: NorthAmerica},
+	"AL": {CallingCode: "355", Currency: "ALL", TLD: ".al", Continent: Europe, SEPA: true},
+	"AM": {CallingCode: "374", Currency: "AMD", TLD: ".am", Continent: Asia},
+	"AO": {CallingCode: "244", Currency: "AOA", TLD: ".ao", Continent: Africa},
+	"AQ": {CallingCode: "", Currency: "", TLD: ".aq", Continent: Antarctica},
+	"AR": {CallingCode: "54", Currency: "ARS", TLD: ".ar", Continent: SouthAmerica},
+	"AS": {CallingCode: "1", Currency: "USD", TLD: ".as", Continent: Oceania},
+	"AT": {CallingCode: "43", Currency: "EUR", TLD: ".at", Continent: Europe, EU: true, EEA: true, Eurozone: true, SEPA: true},
+	"AU": {CallingCode: "61", Currency: "AUD", TLD: ".au", Continent: Oceania},
+	"AW": {CallingCode: "297", Currency: "AWG", TLD: ".aw", Continent: NorthAmerica},
+	"AX": {CallingCode: "358", Currency: "EUR", TLD: ".ax", Continent: Europe, SEPA: true},
+	"AZ": {CallingCode: "994", Currency: "AZN", TLD: ".az", Continent: Asia},
+	"BA": {CallingCode: "387", Currency: "BAM", TLD: ".ba", Continent: Europe},
+	"BB": {CallingCode: "1", Currency: "BBD", TLD: ".bb", Continent: NorthAmerica},
+	"BD": {CallingCode: "880", Currency: "BDT", TLD: ".bd", Continent: Asia},
+	"BE": {CallingCode: "32", Currency: "EUR", TLD: ".be", Continent: Europe, EU: true, EEA: true, Eurozone: true, SEPA: true},
+	"BF": {CallingCode: "226", Currency: "XOF", TLD: ".bf", Continent: Africa},
+	"BG": {CallingCode: "359", Currency: "EUR", TLD: ".bg", Continent: Europe, EU: true, EEA: true, Eurozone: true, SEPA: true},
+	"BH": {CallingCode: "973", Currency: "BHD", TLD: ".bh", Continent: Asia},
+	"BI": {CallingCode: "257", Currency: "BIF", TLD: ".bi", Continent: Africa},
+	"BJ": {CallingCode: "229", Currency: "XOF", TLD: ".bj", Continent: Africa},
+	"BL": {CallingCode: "590", Currency: "EUR", TLD: "", Continent: NorthAmerica, SEPA: true},
+	"BM": {CallingCode: "1", Currency: "BMD", TLD: ".bm", Continent: NorthAmerica},
+	"BN": {CallingCode: "673", Currency: "BND", TLD: ".bn", Continent: Asia},
+	"BO": {CallingCode: "591", Currency: "BOB", TLD: ".bo", Continent: SouthAmerica},
+	"BQ": {CallingCode: "599", Currency: "USD", TLD: "", Continent: NorthAmerica},
+	"BR": {CallingCode: "55", Currency: "BRL", TLD: ".br", Continent: SouthAmerica},
+	"BS": {CallingCode: "1", Currency: "BSD", TLD: ".bs", Continent: NorthAmerica},
+	"BT": {CallingCode: "975", Currency: "BTN", TLD: ".bt", Continent: Asia},
+	"BV": {CallingCode: "", Currency: "NOK", TLD: ".bv", Continent: Antarctica},
+	"BW": {CallingCode: "267", Currency: "BWP", TLD: ".bw", Continent: Africa},
+	"BY": {CallingCode: "375", Currency: "BYN", TLD: ".by", Continent: Europe},
+	"BZ": {CallingCode: "501", Currency: "BZD", TLD: ".bz", Continent: NorthAmerica},
+	"CA": {CallingCode: "1", Currency: "CAD", TLD: ".ca", Continent: NorthAmerica},
+	"CC": {CallingCode: "61", Currency: "AUD", TLD: ".cc", Continent: Oceania},
+	"CD": {CallingCode: "243", Currency: "CDF", TLD: ".cd", Continent: Africa},
+	"CF": {CallingCode: "236", Currency: "XAF", TLD: ".cf", Continent: Africa},
+	"CG": {CallingCode: "242", Currency: "XAF", TLD: ".cg", Continent: Africa},
+	"CH": {CallingCode: "41", Currency: "CHF", TLD: ".ch", Continent: Europe, SEPA: true},
+	"CI": {CallingCode: "225", Currency: "XOF", TLD: ".ci", Continent: Africa},
+	"CK": {CallingCode: "682", Currency: "NZD", TLD: ".ck", Continent: Oceania},
+	"CL": {CallingCode: "56", Currency: "CLP", TLD: ".cl", Continent: SouthAmerica},
+	"CM": {CallingCode: "237", Currency: "XAF", TLD: ".cm", Continent: Africa},
+	"CN": {CallingCode: "86", Currency: "CNY", TLD: ".cn", Continent: Asia},
+	"CO": {CallingCode: "57", Currency: "COP", TLD: ".co", Continent: SouthAmerica},
+	"CR": {CallingCode: "506", Currency: "CRC", TLD: ".cr", Continent: NorthAmerica},
+	"CU": {CallingCode: "53", Currency: "CUP", TLD: ".cu", Continent: NorthAmerica},
+	"CV": {CallingCode: "238", Currency: "CVE", TLD: ".cv", Continent: Africa},
+	"CW": {CallingCode: "599", Currency: "XCG", TLD: ".cw", Continent: NorthAmerica},
+	"CX": {CallingCode: "61", Currency: "AUD", TLD: ".cx", Continent: Oceania},
+	"CY": {CallingCode: "357", Currency: "EUR", TLD: ".cy", Continent: Asia, EU: true, EEA: true, Eurozone: true, SEPA: true},
+	"CZ": {CallingCode: "420", Currency: "CZK", TLD: ".cz", Continent: Europe, EU: true, EEA: true, SEPA: true},
+	"DE": {CallingCode: "49", Currency: "EUR", TLD: ".de", Continent: Europe, EU: true, EEA: true, Eurozone: true, SEPA: true},
+	"DJ": {CallingCode: "253", Currency: "DJF", TLD: ".dj", Continent: Africa},
+	"DK": {CallingCode: "45", Currency: "DKK", TLD: ".dk", Continent: Europe, EU: true, EEA: true, SEPA: true},
+	"DM": {CallingCode: "1", Currency: "XCD", TLD: ".dm", Continent: NorthAmerica},
+	"DO": {CallingCode: "1", Currency: "DOP", TLD: ".do", Continent: NorthAmerica},
+	"DZ": {CallingCode: "213", Currency: "DZD", TLD: ".dz", Continent: Africa},
+	"EC": {CallingCode: "593", Currency: "USD", TLD: ".ec", Continent: SouthAmerica},
+	"EE": {CallingCode: "372", Currency: "EUR", TLD: ".ee", Continent: Europe, EU: true, EEA: true, Eurozone: true, SEPA: true},
+	"EG": {CallingCode: "20", Currency: "EGP", TLD: ".eg", Continent: Africa},
+	"EH": {CallingCode: "212", Currency: "MAD", TLD: "", Continent: Africa},
+	"ER": {CallingCode: "291", Currency: "ERN", TLD: ".er", Continent: Africa},
+	"ES": {CallingCode: "34", Currency: "EUR", TLD: ".es", Continent: Europe, EU: true, EEA: true, Eurozone: true, SEPA: true},
+	"ET": {CallingCode: "251", Currency: "ETB", TLD: ".et", Continent: Africa},
+	"FI": {CallingCode: "358", Currency: "EUR", TLD: ".fi", Continent: Europe, EU: true, EEA: true, Eurozone: true, SEPA: true},
+	"FJ": {CallingCode: "679", Currency: "FJD", TLD: ".fj", Continent: Oceania},
+	"FK": {CallingCode: "500", Currency: "FKP", TLD: ".fk", Continent: SouthAmerica},
+	"FM": {CallingCode: "691", Currency: "USD", TLD: ".fm", Continent: Oceania},
+	"FO": {CallingCode: "298", Currency: "DKK", TLD: ".fo", Continent: Europe},
+	"FR": {CallingCode: "33", Currency: "EUR", TLD: ".fr", Continent: Europe, EU: true, EEA: true, Eurozone: true, SEPA: true},
+	"GA": {CallingCode: "241", Currency: "XAF", TLD: ".ga", Continent: Africa},
+	"GB": {CallingCode: "44", Currency: "GBP", TLD: ".uk", Continent: Europe, SEPA: true},
+	"GD": {CallingCode: "1", Currency: "XCD", TLD: ".gd", Continent: NorthAmerica},
+	"GE": {CallingCode: "995", Currency: "GEL", TLD: ".ge", Continent: Asia},
+	"GF": {CallingCode: "594", Currency: "EUR", TLD: ".gf", Continent: SouthAmerica, SEPA: true},
+	"GG": {CallingCode: "44", Currency: "GBP", TLD: ".gg", Continent: Europe, SEPA: true},
+	"GH": {CallingCode: "233", Currency: "GHS", TLD: ".gh", Continent: Africa},
+	"GI": {CallingCode: "350", Currency: "GIP", TLD: ".gi", Continent: Europe, SEPA: true},
+	"GL": {CallingCode: "299", Currency: "DKK", TLD: ".gl", Continent: NorthAmerica},
+	"GM": {CallingCode: "220", Currency: "GMD", TLD: ".gm", Continent: Africa},
+	"GN": {CallingCode: "224", Currency: "GNF", TLD: ".gn", Continent: Africa},
+	"GP": {CallingCode: "590", Currency: "EUR", TLD: ".gp", Continent: NorthAmerica, SEPA: true},
+	"GQ": {CallingCode: "240", Currency: "XAF", TLD: ".gq", Continent: Africa},
+	"GR": {CallingCode: "30", Currency: "EUR", TLD: ".gr", Continent: Europe, EU: true, EEA: true, Eurozone: true, SEPA: true},
+	"GS": {CallingCode: "500", Currency: "GBP", TLD: ".gs", Continent: Antarctica},
+	"GT": {CallingCode: "502", Currency: "GTQ", TLD: ".gt", Continent: NorthAmerica},
+	"GU": {CallingCode: "1", Currency: "USD", TLD: ".gu", Continent: Oceania},
+	"GW": {CallingCode: "245", Currency: "XOF", TLD: ".gw", Continent: Africa},
+	"GY": {CallingCode: "592", Currency: "GYD", TLD: ".gy", Continent: SouthAmerica},
+	"HK": {CallingCode: "852", Currency: "HKD", TLD: ".hk", Continent: Asia},
+	"HM": {CallingCode: "", Currency: "AUD", TLD: ".hm", Continent: Antarctica},
+	"HN": {CallingCode: "504", Currency: "HNL", TLD: ".hn", Continent: NorthAmerica},
+	"HR": {CallingCode: "385", Currency: "EUR", TLD: ".hr", Continent: Europe, EU: true, EEA: true, Eurozone: true, SEPA: true},
+	"HT": {CallingCode: "509", Currency: "HTG", TLD: ".ht", Continent: NorthAmerica},
+	"HU": {CallingCode: "36", Currency: "HUF", TLD: ".hu", Continent: Europe, EU: true, EEA: true, SEPA: true},
+	"ID": {CallingCode: "62", Currency: "IDR", TLD: ".id", Continent: Asia},
+	"IE": {CallingCode: "353", Currency: "EUR", TLD: ".ie", Continent: Europe, EU: true, EEA: true, Eurozone: true, SEPA: true},
+	"IL": {CallingCode: "972", Currency: "ILS", TLD: ".il", Continent: Asia},
+	"IM": {CallingCode: "44", Currency: "GBP", TLD: ".im", Continent: Europe, SEPA: true},
+	"IN": {CallingCode: "91", Currency: "INR", TLD: ".in", Continent: Asia},
+	"IO": {CallingCode: "246", Currency: "USD", TLD: ".io", Continent: Asia},
+	"IQ": {CallingCode: "964", Currency: "IQD", TLD: ".iq", Continent: Asia},
+	"IR": {CallingCode: "98", Currency: "IRR", TLD: ".ir", Continent: Asia},
+	"IS": {CallingCode: "354", Currency: "ISK", TLD: ".is", Continent: Europe, EEA: true, SEPA: true},
+	"IT": {CallingCode: "39", Currency: "EUR", TLD: ".it", Continent: Europe, EU: true, EEA: true, Eurozone: true, SEPA: true},
+	"JE": {CallingCode: "44", Currency: "GBP", TLD: ".je", Continent: Europe, SEPA: true},
+	"JM": {CallingCode: "1", Currency: "JMD", TLD: ".jm", Continent: NorthAmerica},
+	"JO": {CallingCode: "962", Currency: "JOD", TLD: ".jo", Continent: Asia},
+	"JP": {CallingCode: "81", Currency: "JPY", TLD: ".jp", Continent: Asia},
+	"KE": {CallingCode: "254", Currency: "KES", TLD: ".ke", Continent: Africa},
+	"KG": {CallingCode: "996", Currency: "KGS", TLD: ".kg", Continent: Asia},
+	"KH": {CallingCode: "855", Currency: "KHR", TLD: ".kh", Continent: Asia},
+	"KI": {CallingCode: "686", Currency: "AUD", TLD: ".ki", Continent: Oceania},
+	"KM": {CallingCode: "269", Currency: "KMF", TLD: ".km", Continent: Africa},
+	"KN": {CallingCode: "1", Currency: "XCD", TLD: ".kn", Continent: NorthAmerica},
+	"KP": {CallingCode: "850", Currency: "KPW", TLD: ".kp", Continent: Asia},
+	"KR": {CallingCode: "82", Currency: "KRW", TLD: ".kr", Continent: Asia},
+	"KW": {CallingCode: "965", Currency: "KWD", TLD: ".kw", Continent: Asia},
+	"KY": {CallingCode: "1", Currency: "KYD", TLD: ".ky", Continent: NorthAmerica},
+	"KZ": {CallingCode: "7", Currency: "KZT", TLD: ".kz", Continent: Asia},
+	"LA": {CallingCode: "856", Currency: "LAK", TLD: ".la", Continent: Asia},
+	"LB": {CallingCode: "961", Currency: "LBP", TLD: ".lb", Continent: Asia},
+	"LC": {CallingCode: "1", Currency: "XCD", TLD: ".lc", Continent: NorthAmerica},
+	"LI": {CallingCode: "423", Currency: "CHF", TLD: ".li", Continent: Europe, EEA: true, SEPA: true},
+	"LK": {CallingCode: "94", Currency: "LKR", TLD: ".lk", Continent: Asia},
+	"LR": {CallingCode: "231", Currency: "LRD", TLD: ".lr", Continent: Africa},
+	"LS": {CallingCode: "266", Currency: "ZAR", TLD: ".ls", Continent: Africa},
+	"LT": {CallingCode: "370", Currency: "EUR", TLD: ".lt", Continent: Europe, EU: true, EEA: true, Eurozone: true, SEPA: true},
+	"LU": {CallingCode: "352", Currency: "EUR", TLD: ".lu", Continent: Europe, EU: true, EEA: true, Eurozone: true, SEPA: true},
+	"LV": {CallingCode: "371", Currency: "EUR", TLD: ".lv", Continent: Europe, EU: true, EEA: true, Eurozone: true, SEPA: true},
+	"LY": {CallingCode: "218", Currency: "LYD", TLD: ".ly", Continent: Africa},
+	"MA": {CallingCode: "212", Currency: "MAD", TLD: ".ma", Continent: Africa},
+	"MC": {CallingCode: "377", Currency: "EUR", TLD: ".mc", Continent: Europe, SEPA: true},
+	"MD": {CallingCode: "373", Currency: "MDL", TLD: ".md", Continent: Europe, SEPA: true},
+	"ME": {CallingCode: "382", Currency: "EUR", TLD: ".me", Continent: Europe, SEPA: true},
+	"MF": {CallingCode: "590", Currency: "EUR", TLD: "", Continent: NorthAmerica, SEPA: true},
+	"MG": {CallingCode: "261", Currency: "MGA", TLD: ".mg", Continent: Africa},
+	"MH": {CallingCode: "692", Currency: "USD", TLD: ".mh", Continent: Oceania},
+	"MK": {CallingCode: "389", Currency: "MKD", TLD: ".mk", Continent: Europe, SEPA: true},
+	"ML": {CallingCode: "223", Currency: "XOF", TLD: ".ml", Continent: Africa},
+	"MM": {CallingCode: "95", Currency: "MMK", TLD: ".mm", Continent: Asia},
+	"MN": {CallingCode: "976", Currency: "MNT", TLD: ".mn", Continent: Asia},
+	"MO": {CallingCode: "853", Currency: "MOP", TLD: ".mo", Continent: Asia},
+	"MP": {CallingCode: "1", Currency: "USD", TLD: ".mp", Continent: Oceania},
+	"MQ": {CallingCode: "596", Currency: "EUR", TLD: ".mq", Continent: NorthAmerica, SEPA: true},
+	"MR": {CallingCode: "222", Currency: "MRU", TLD: ".mr", Continent: Africa},
+	"MS": {CallingCode: "1", Currency: "XCD", TLD: ".ms", Continent: NorthAmerica},
+	"MT": {CallingCode: "356", Currency: "EUR", TLD: ".mt", Continent: Europe, EU: true, EEA: true, Eurozone: true, SEPA: true},
+	"MU": {CallingCode: "230", Currency: "MUR", TLD: ".mu", Continent: Africa},
+	"MV": {CallingCode: "960", Currency: "MVR", TLD: ".mv", Continent: Asia},
+	"MW": {CallingCode: "265", Currency: "MWK", TLD: ".mw", Continent: Africa},
+	"MX": {CallingCode: "52", Currency: "MXN", TLD: ".mx", Continent: NorthAmerica},
+	"MY": {CallingCode: "60", Currency: "MYR", TLD: ".my", Continent: Asia},
+	"MZ": {CallingCode: "258", Currency: "MZN", TLD: ".mz", Continent: Africa},
+	"NA": {CallingCode: "264", Currency: "NAD", TLD: ".na", Continent: Africa},
+	"NC": {CallingCode: "687", Currency: "XPF", TLD: ".nc", Continent: Oceania},
+	"NE": {CallingCode: "227", Currency: "XOF", TLD: ".ne", Continent: Africa},
+	"NF": {CallingCode: "672", Currency: "AUD", TLD: ".nf", Continent: Oceania},
+	"NG": {CallingCode: "234", Currency: "NGN", TLD: ".ng", Continent: Africa},
+	"NI": {CallingCode: "505", Currency: "NIO", TLD: ".ni", Continent: NorthAmerica},
+	"NL": {CallingCode: "31", Currency: "EUR", TLD: ".nl", Continent: Europe, EU: true, EEA: true, Eurozone: true, SEPA: true},
+	"NO": {CallingCode: "47", Currency: "NOK", TLD: ".no", Continent: Europe, EEA: true, SEPA: true},
+	"NP": {CallingCode: "977", Currency: "NPR", TLD: ".np", Continent: Asia},
+	"NR": {CallingCode: "674", Currency: "AUD", TLD: ".nr", Continent: Oceania},
+	"NU": {CallingCode: "683", Currency: "NZD", TLD: ".nu", Continent: Oceania},
+	"NZ": {CallingCode: "64", Currency: "NZD", TLD: ".nz", Continent: Oceania},
+	"OM": {CallingCode: "968", Currency: "OMR", TLD: ".om", Continent: Asia},
+	"PA": {CallingCode: "507", Currency: "PAB", TLD: ".pa", Continent: NorthAmerica},
+	"PE": {CallingCode: "51", Currency: "PEN", TLD: ".pe", Continent: SouthAmerica},
+	"PF": {CallingCode: "689", Currency: "XPF", TLD: ".pf", Continent: Oceania},
+	"PG": {CallingCode: "675", Currency: "PGK", TLD: ".pg", Continent: Oceania},
+	"PH": {CallingCode: "63", Currency: "PHP", TLD: ".ph", Continent: Asia},
+	"PK": {CallingCode: "92", Currency: "PKR", TLD: ".pk", Continent: Asia},
+	"PL": {CallingCode: "48", Currency: "PLN", TLD: ".pl", Continent: Europe, EU: true, EEA: true, SEPA: true},
+	"PM": {CallingCode: "508", Currency: "EUR", TLD: ".pm", Continent: NorthAmerica, SEPA: true},
+	"PN": {CallingCode: "64", Currency: "NZD", TLD: ".pn", Continent: Oceania},
+	"PR": {CallingCode: "1", Currency: "USD", TLD: ".pr", Continent: NorthAmerica},
+	"PS": {CallingCode: "970", Currency: "ILS", TLD: ".ps", Continent: Asia},
+	"PT": {CallingCode: "351", Currency: "EUR", TLD: ".pt", Continent: Europe, EU: true, EEA: true, Eurozone: true, SEPA: true},
+	"PW": {CallingCode: "680", Currency: "USD", TLD: ".pw", Continent: Oceania},
+	"PY": {CallingCode: "595", Currency: "PYG", TLD: ".py", Continent: SouthAmerica},
+	"QA": {CallingCode: "974", Currency: "QAR", TLD: ".qa", Continent: Asia},
+	"RE": {CallingCode: "262", Currency: "EUR", TLD: ".re", Continent: Africa, SEPA: true},
+	"RO": {CallingCode: "40", Currency: "RON", TLD: ".ro", Continent: Europe, EU: true, EEA: true, SEPA: true},
+	"RS": {CallingCode: "381", Currency: "RSD", TLD: ".rs", Continent: Europe},
+	"RU": {CallingCode: "7", Currency: "RUB", TLD: ".ru", Continent: Europe},
+	"RW": {CallingCode: "250", Currency: "RWF", TLD: ".rw", Continent: Africa},
+	"SA": {CallingCode: "966", Currency: "SAR", TLD: ".sa", Continent: Asia},
+	"SB": {CallingCode: "677", Currency: "SBD", TLD: ".sb", Continent: Oceania},
+	"SC": {CallingCode: "248", Currency: "SCR", TLD: ".sc", Continent: Africa},
+	"SD": {CallingCode: "249", Currency: "SDG", TLD: ".sd", Continent: Africa},
+	"SE": {CallingCode: "46", Currency: "SEK", TLD: ".se", Continent: Europe, EU: true, EEA: true, SEPA: true},
+	"SG": {CallingCode: "65", Currency: "SGD", TLD: ".sg", Continent: Asia},
+	"SH": {CallingCode: "290", Currency: "SHP", TLD: ".sh", Continent: Africa},
+	"SI": {CallingCode: "386", Currency: "EUR", TLD: ".si", Continent: Europe, EU: true, EEA: true, Eurozone: true, SEPA: true},
+	"SJ": {CallingCode: "47", Currency: "NOK", TLD: ".sj", Continent: Europe},
+	"SK": {CallingCode: "421", Currency: "EUR", TLD: ".sk", Continent: Europe, EU: true, EEA: true, Eurozone: true, SEPA: true},
+	"SL": {CallingCode: "232", Currency: "SLE", TLD: ".sl", Continent: Africa},
+	"SM": {CallingCode: "378", Currency: "EUR", TLD: ".sm", Continent: Europe, SEPA: true},
+	"SN": {CallingCode: "221", Currency: "XOF", TLD: ".sn", Continent: Africa},
+	"SO": {CallingCode: "252", Currency: "SOS", TLD: ".so", Continent: Africa},
+	"SR": {CallingCode: "597", Currency: "SRD", TLD: ".sr", Continent: SouthAmerica},
+	"SS": {CallingCode: "211", Currency: "SSP", TLD: ".ss", Continent: Africa},
+	"ST": {CallingCode: "239", Currency: "STN", TLD: ".st", Continent: Africa},
+	"SV": {CallingCode: "503", Currency: "USD", TLD: ".sv", Continent: NorthAmerica},
+	"SX": {CallingCode: "1", Currency: "XCG", TLD: ".sx", Continent: NorthAmerica},
+	"SY": {CallingCode: "963", Currency: "SYP", TLD: ".sy", Continent: Asia},
+	"SZ": {CallingCode: "268", Currency: "SZL", TLD: ".sz", Continent: Africa},
+	"TC": {CallingCode: "1", Currency: "USD", TLD: ".tc", Continent: NorthAmerica},
+	"TD": {CallingCode: "235", Currency: "XAF", TLD: ".td", Continent: Africa},
+	"TF": {CallingCode: "", Currency: "EUR", TLD: ".tf", Continent: Antarctica},
+	"TG": {CallingCode: "228", Currency: "XOF", TLD: ".tg", Continent: Africa},
+	"TH": {CallingCode: "66", Currency: "THB", TLD: ".th", Continent: Asia},
+	"TJ": {CallingCode: "992", Currency: "TJS", TLD: ".tj", Continent: Asia},
+	"TK": {CallingCode: "690", Currency: "NZD", TLD: ".tk", Continent: Oceania},
+	"TL": {CallingCode: "670", Currency: "USD", TLD: ".tl", Continent: Asia},
+	"TM": {CallingCode: "993", Currency: "TMT", TLD: ".tm", Continent: Asia},
+	"TN": {CallingCode: "216", Currency: "TND", TLD: ".tn", Continent: Africa},
+	"TO": {CallingCode: "676", Currency: "TOP", TLD: ".to", Continent: Oceania},
+	"TR": {CallingCode: "90", Currency: "TRY", TLD: ".tr", Continent: Asia},
+	"TT": {CallingCode: "1", Currency: "TTD", TLD: ".tt", Continent: NorthAmerica},
+	"TV": {CallingCode: "688", Currency: "AUD", TLD: ".tv", Continent: Oceania},
+	"TW": {CallingCode: "886", Currency: "TWD", TLD: ".tw", Continent: Asia},
+	"TZ": {CallingCode: "255", Currency: "TZS", TLD: ".tz", Continent: Africa},
+	"UA": {CallingCode: "380", Currency: "UAH", TLD: ".ua", Continent: Europe},
+	"UG": {CallingCode: "256", Currency: "UGX", TLD: ".ug", Continent: Africa},
+	"UM": {CallingCode: "", Currency: "USD", TLD: "", Continent: Oceania},
+	"US": {CallingCode: "1", Currency: "USD", TLD: ".us", Continent: NorthAmerica},
+	"UY": {CallingCode: "598", Currency: "UYU", TLD: ".uy", Continent: SouthAmerica},
+	"UZ": {CallingCode: "998", Currency: "UZS", TLD: ".uz", Continent: Asia},
+	"VA": {CallingCode: "39", Currency: "EUR", TLD: ".va", Continent: Europe, SEPA: true},
+	"VC": {CallingCode: "1", Currency: "XCD", TLD: ".vc", Continent: NorthAmerica},
+	"VE": {CallingCode: "58", Currency: "VES", TLD: ".ve", Continent: SouthAmerica},
+	"VG": {CallingCode: "1", Currency: "USD", TLD: ".vg", Continent: NorthAmerica},
+	"VI": {CallingCode: "1", Currency: "USD", TLD: ".vi", Continent: NorthAmerica},
+	"VN": {CallingCode: "84", Currency: "VND", TLD: ".vn", Continent: Asia},
+	"VU": {CallingCode: "678", Currency: "VUV", TLD: ".vu", Continent: Oceania},
+	"WF": {CallingCode: "681", Currency: "XPF", TLD: ".wf", Continent: Oceania},
+	"WS": {CallingCode: "685", Currency: "WST", TLD: ".ws", Continent: Oceania},
+	"XK": {CallingCode: "383", Currency: "EUR", TLD: "", Continent: Europe},
+	"YE": {CallingCode: "967", Currency: "YER", TLD: ".ye", Continent: Asia},
+	"YT": {CallingCode: "262", Currency: "EUR", TLD: ".yt", Continent: Africa, SEPA: true},
+	"ZA": {CallingCode: "27", Currency: "ZAR", TLD: ".za", Continent: Africa},
+	"ZM": {CallingCode: "260", Currency: "ZMW", TLD: ".zm", Continent: Africa},
+	"ZW": {CallingCode: "263", Currency: "ZWG", TLD: ".zw", Continent: Africa},
+}
@@ -1,8 +1,22 @@
 package iso3166
 
-import "encoding"
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"sort"
+	"strconv"
+	"time"
+
+	"golang.org/x/text/language"
+
+	"github.com/mavolin/standards/internal/sqlutil"
+)
 
 //go:generate go run github.com/mavolin/standards/tools/codegen/iso3166-1
+//go:generate go run github.com/mavolin/standards/tools/codegen/iso3166-3
+//go:generate go run github.com/mavolin/standards/tools/codegen/iso3166_names
+//go:generate go run github.com/mavolin/standards/tools/codegen/iso3166_info
 
 // ============================================================================
 // Alpha2Code
@@ -14,9 +28,118 @@ type Alpha2Code struct {
 	// Status is the status of the code.
 	Status Status
 	// Country is the name of the country it belongs to.
+	//
+	// It is the English short name assigned by ISO, e.g.
+	// "Korea (the Republic of)".
+	// Use [Alpha2Code.Name] or [Alpha2Code.CommonName] to get the name
+	// commonly used, e.g. "South Korea".
 	Country string
 }
 
+// nameLanguages are the languages names are available in, in the order they
+// are stored in names.
+var nameLanguages = []language.Tag{
+	language.English,
+	language.German,
+	language.French,
+	language.Spanish,
+	language.Italian,
+}
+
+var nameMatcher = language.NewMatcher(nameLanguages)
+
+// Name returns the name of the country in the passed language, as commonly
+// used in that language, e.g. "Südkorea" for KR in German.
+//
+// Names are available in English, German, French, Spanish, and Italian, and
+// are taken from the Unicode CLDR.
+// If none of them matches lang, the English name is returned.
+//
+// Names are available for all officially assigned codes and XK (Kosovo).
+// For all other codes, Name returns c.Country.
+func (c Alpha2Code) Name(lang language.Tag) string {
+	localized, ok := names[c.Code]
+	if !ok {
+		return c.Country
+	}
+
+	_, i, _ := nameMatcher.Match(lang)
+	return localized[i]
+}
+
+// CommonName returns the English name of the country, as commonly used, e.g.
+// "South Korea" instead of ISO's "Korea (the Republic of)".
+//
+// It is short for c.Name(language.English).
+func (c Alpha2Code) CommonName() string {
+	return c.Name(language.English)
+}
+
+// Alpha3 returns the alpha-3 code of the same country, e.g. DEU for DE.
+//
+// If there is no alpha-3 code for c, Alpha3 returns false.
+func (c Alpha2Code) Alpha3() (Alpha3Code, bool) {
+	alpha3, ok := alpha2ToAlpha3[c.Code]
+	if !ok {
+		return Alpha3Code{}, false
+	}
+
+	return alpha3Codes[alpha3], true
+}
+
+// Numeric returns the numeric code of the same country, e.g. 276 for DE.
+//
+// If there is no numeric code for c, Numeric returns false.
+func (c Alpha2Code) Numeric() (NumericCode, bool) {
+	numeric, ok := alpha2ToNumeric[c.Code]
+	if !ok {
+		return NumericCode{}, false
+	}
+
+	return numericCodes[numeric], true
+}
+
+// Successors returns the ISO 3166-3 codes of the formerly used country names
+// that used c, sorted by the date they were withdrawn.
+// Each of them lists the countries that succeeded the former country.
+//
+// For example, CS was used by Czechoslovakia until 1993, succeeded by CZ
+// and SK, and then by Serbia and Montenegro from 2003 until 2006, succeeded
+// by ME and RS.
+//
+// If c was never used by a formerly used country name, Successors returns
+// nil.
+// The same is true, if c is officially assigned, even if it was used by a
+// formerly used country name before, as c is no longer a code for the former
+// country.
+// For example, AI was used by the French Afars and Issas until 1977, but is
+// now assigned to Anguilla, which did not succeed them.
+func (c Alpha2Code) Successors() []Alpha4Code {
+	if c.Status == OfficiallyAssigned {
+		return nil
+	}
+
+	var codes []Alpha4Code
+	for _, code := range alpha4Codes {
+		if code.Alpha2 == c {
+			codes = append(codes, code)
+		}
+	}
+
+	sort.Slice(codes, func(i, j int) bool { return codes[i].ValidUntil.Before(codes[j].ValidUntil) })
+	return codes
+}
+
+// Info returns information about the country of c, such as its calling code,
+// currency, and its membership in the EU.
+//
+// Info is available for all officially assigned codes and XK.
+// For all other codes, Info returns false.
+func (c Alpha2Code) Info() (Info, bool) {
+	i, ok := infos[c.Code]
+	return i, ok
+}
+
 func (c Alpha2Code) String() string {
 	return c.Code
 }
@@ -43,6 +166,456 @@ func (c *Alpha2Code) UnmarshalText(text []byte) error {
 	return nil
 }
 
+var _ sql.Scanner = (*Alpha2Code)(nil)
+
+// Scan implements [sql.Scanner] for alpha-2 codes stored as text, e.g. in a
+// CHAR(2) column.
+// Lowercase codes are accepted as well.
+//
+// NULL is scanned into the zero Alpha2Code.
+func (c *Alpha2Code) Scan(src any) error {
+	s, ok, err := sqlutil.String(src, "iso3166", "Alpha2Code")
+	if err != nil {
+		return err
+	} else if !ok {
+		*c = Alpha2Code{}
+		return nil
+	}
+
+	parsed, err := ParseAlpha2(s)
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+	return nil
+}
+
+var _ driver.Valuer = Alpha2Code{}
+
+// Value implements [driver.Valuer] by storing the two uppercase letters of the
+// code.
+// The status and country name are not stored, Scan restores them.
+//
+// The zero Alpha2Code is stored as NULL.
+func (c Alpha2Code) Value() (driver.Value, error) {
+	if c == (Alpha2Code{}) {
+		return nil, nil
+	}
+
+	return c.Compact(), nil
+}
+
+// ============================================================================
+// Alpha3Code
+// ======================================================================================
+
+type Alpha3Code struct {
+	// Code is the uppercase ISO 3166-1 alpha-3 code.
+	Code string
+	// Status is the status of the code.
+	Status Status
+	// Country is the name of the country it belongs to.
+	Country string
+}
+
+// Alpha2 returns the alpha-2 code of the same country, e.g. DE for DEU.
+//
+// If c is not a valid alpha-3 code, Alpha2 returns false.
+func (c Alpha3Code) Alpha2() (Alpha2Code, bool) {
+	alpha2, ok := alpha3ToAlpha2[c.Code]
+	if !ok {
+		return Alpha2Code{}, false
+	}
+
+	return alpha2Codes[alpha2], true
+}
+
+// Numeric returns the numeric code of the same country, e.g. 276 for DEU.
+//
+// If there is no numeric code for c, Numeric returns false.
+func (c Alpha3Code) Numeric() (NumericCode, bool) {
+	alpha2, ok := c.Alpha2()
+	if !ok {
+		return NumericCode{}, false
+	}
+
+	return alpha2.Numeric()
+}
+
+func (c Alpha3Code) String() string {
+	return c.Code
+}
+
+func (c Alpha3Code) Compact() string {
+	return c.Code
+}
+
+var _ encoding.TextMarshaler = Alpha3Code{}
+
+func (c Alpha3Code) MarshalText() ([]byte, error) {
+	return []byte(c.Compact()), nil
+}
+
+var _ encoding.TextUnmarshaler = (*Alpha3Code)(nil)
+
+func (c *Alpha3Code) UnmarshalText(text []byte) error {
+	code, err := ParseAlpha3(string(text))
+	if err != nil {
+		return err
+	}
+
+	*c = code
+	return nil
+}
+
+var _ sql.Scanner = (*Alpha3Code)(nil)
+
+// Scan implements [sql.Scanner] for alpha-3 codes stored as text, e.g. in a
+// CHAR(3) column.
+// Lowercase codes are accepted as well.
+//
+// NULL is scanned into the zero Alpha3Code.
+func (c *Alpha3Code) Scan(src any) error {
+	s, ok, err := sqlutil.String(src, "iso3166", "Alpha3Code")
+	if err != nil {
+		return err
+	} else if !ok {
+		*c = Alpha3Code{}
+		return nil
+	}
+
+	parsed, err := ParseAlpha3(s)
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+	return nil
+}
+
+var _ driver.Valuer = Alpha3Code{}
+
+// Value implements [driver.Valuer] by storing the three uppercase letters of
+// the code.
+// The status and country name are not stored, Scan restores them.
+//
+// The zero Alpha3Code is stored as NULL.
+func (c Alpha3Code) Value() (driver.Value, error) {
+	if c == (Alpha3Code{}) {
+		return nil, nil
+	}
+
+	return c.Compact(), nil
+}
+
+// ============================================================================
+// NumericCode
+// ======================================================================================
+
+type NumericCode struct {
+	// Code is the three digit ISO 3166-1 numeric code, including leading
+	// zeros, e.g. "004".
+	Code string
+	// Status is the status of the code.
+	Status Status
+	// Country is the name of the country it belongs to.
+	Country string
+}
+
+// Alpha2 returns the alpha-2 code of the same country, e.g. DE for 276.
+//
+// If c is not a valid numeric code, Alpha2 returns false.
+func (c NumericCode) Alpha2() (Alpha2Code, bool) {
+	alpha2, ok := numericToAlpha2[c.Code]
+	if !ok {
+		return Alpha2Code{}, false
+	}
+
+	return alpha2Codes[alpha2], true
+}
+
+// Alpha3 returns the alpha-3 code of the same country, e.g. DEU for 276.
+//
+// If there is no alpha-3 code for c, Alpha3 returns false.
+func (c NumericCode) Alpha3() (Alpha3Code, bool) {
+	alpha2, ok := c.Alpha2()
+	if !ok {
+		return Alpha3Code{}, false
+	}
+
+	return alpha2.Alpha3()
+}
+
+func (c NumericCode) String() string {
+	return c.Code
+}
+
+func (c NumericCode) Compact() string {
+	return c.Code
+}
+
+var _ encoding.TextMarshaler = NumericCode{}
+
+func (c NumericCode) MarshalText() ([]byte, error) {
+	return []byte(c.Compact()), nil
+}
+
+var _ encoding.TextUnmarshaler = (*NumericCode)(nil)
+
+func (c *NumericCode) UnmarshalText(text []byte) error {
+	code, err := ParseNumeric(string(text))
+	if err != nil {
+		return err
+	}
+
+	*c = code
+	return nil
+}
+
+var _ sql.Scanner = (*NumericCode)(nil)
+
+// Scan implements [sql.Scanner].
+//
+// src may either be an int64, or a string or []byte, which are parsed using
+// [ParseNumeric].
+//
+// If src is NULL, c is set to its zero value.
+func (c *NumericCode) Scan(src any) error {
+	if n, ok := src.(int64); ok {
+		if n < 0 || n > 999 {
+			return ErrInvalidNumeric
+		}
+
+		src = strconv.FormatInt(n, 10)
+	}
+
+	s, ok, err := sqlutil.String(src, "iso3166", "NumericCode")
+	if err != nil {
+		return err
+	} else if !ok {
+		*c = NumericCode{}
+		return nil
+	}
+
+	parsed, err := ParseNumeric(s)
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+	return nil
+}
+
+var _ driver.Valuer = NumericCode{}
+
+// Value implements [driver.Valuer] by storing the three digits of the code
+// as text, including leading zeros, e.g. "004".
+// Scan also accepts integers, so the code may also be stored in an integer
+// column.
+//
+// The zero NumericCode is stored as NULL.
+func (c NumericCode) Value() (driver.Value, error) {
+	if c == (NumericCode{}) {
+		return nil, nil
+	}
+
+	return c.Compact(), nil
+}
+
+// ============================================================================
+// Alpha4Code
+// ======================================================================================
+
+// Alpha4Code is an ISO 3166-3 code for a formerly used country name.
+//
+// The first two letters are the alpha-2 code the country used, and the last
+// two letters are the alpha-2 code of the successor, if there is a single
+// one, or "HH", "XX", or "AA", if not.
+type Alpha4Code struct {
+	// Code is the uppercase ISO 3166-3 four-letter code, e.g. "DDDE".
+	Code string
+	// Alpha2 is the alpha-2 code used by the country.
+	//
+	// It may have been reassigned since.
+	Alpha2 Alpha2Code
+	// Alpha3 is the alpha-3 code used by the country.
+	Alpha3 string
+	// Numeric is the numeric code used by the country, if it had one.
+	Numeric string
+	// Country is the name of the country.
+	Country string
+	// ValidFrom is the date from which the code was used for the country.
+	//
+	// If the code was used since the first edition of ISO 3166, it is the
+	// zero time.
+	ValidFrom time.Time
+	// ValidUntil is the date the code was withdrawn, i.e. the first day it
+	// was no longer used for the country.
+	//
+	// For codes withdrawn before 1986, only the year of the withdrawal is
+	// known, in which case ValidUntil is the first day of the following
+	// year.
+	ValidUntil time.Time
+	// Successors are the alpha-2 codes of the countries that succeeded the
+	// country.
+	Successors []Alpha2Code
+}
+
+// ValidAt reports whether the code was used for the country at t.
+func (c Alpha4Code) ValidAt(t time.Time) bool {
+	return !t.Before(c.ValidFrom) && t.Before(c.ValidUntil)
+}
+
+func (c Alpha4Code) String() string {
+	return c.Code
+}
+
+func (c Alpha4Code) Compact() string {
+	return c.Code
+}
+
+var _ encoding.TextMarshaler = Alpha4Code{}
+
+func (c Alpha4Code) MarshalText() ([]byte, error) {
+	return []byte(c.Compact()), nil
+}
+
+var _ encoding.TextUnmarshaler = (*Alpha4Code)(nil)
+
+func (c *Alpha4Code) UnmarshalText(text []byte) error {
+	code, err := ParseAlpha4(string(text))
+	if err != nil {
+		return err
+	}
+
+	*c = code
+	return nil
+}
+
+var _ sql.Scanner = (*Alpha4Code)(nil)
+
+// Scan implements [sql.Scanner] for alpha-4 codes stored as text, e.g. in a
+// CHAR(4) column.
+// Lowercase codes are accepted as well.
+//
+// NULL is scanned into the zero Alpha4Code.
+func (c *Alpha4Code) Scan(src any) error {
+	s, ok, err := sqlutil.String(src, "iso3166", "Alpha4Code")
+	if err != nil {
+		return err
+	} else if !ok {
+		*c = Alpha4Code{}
+		return nil
+	}
+
+	parsed, err := ParseAlpha4(s)
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+	return nil
+}
+
+var _ driver.Valuer = Alpha4Code{}
+
+// Value implements [driver.Valuer] by storing the four uppercase letters of
+// the code.
+// The other fields are not stored, Scan restores them.
+//
+// The zero Alpha4Code is stored as NULL.
+func (c Alpha4Code) Value() (driver.Value, error) {
+	if c.Code == "" {
+		return nil, nil
+	}
+
+	return c.Code, nil
+}
+
+// ============================================================================
+// Info
+// ======================================================================================
+
+// Info contains information about a country.
+type Info struct {
+	// CallingCode is the E.164 country calling code, without the leading '+',
+	// e.g. "49".
+	//
+	// Countries sharing a calling code, such as the members of the North
+	// American Numbering Plan, all use the shared code, e.g. "1".
+	// If the country has no calling code, CallingCode is empty.
+	CallingCode string
+	// Currency is the ISO 4217 code of the country's default currency, e.g.
+	// "EUR".
+	//
+	// If the country has no currency, Currency is empty.
+	Currency string
+	// TLD is the country code top-level domain, including the leading dot,
+	// e.g. ".de".
+	//
+	// If no ccTLD is delegated for the country, TLD is empty.
+	TLD string
+	// Continent is the continent the country is located on.
+	Continent Continent
+
+	// EU is true, if the country is a member state of the European Union.
+	//
+	// Territories with their own alpha-2 code, such as GP, are not considered
+	// members, even if they are part of the EU.
+	EU bool
+	// EEA is true, if the country is a member state of the European Economic
+	// Area.
+	//
+	// Like EU, it is false for territories with their own alpha-2 code.
+	EEA bool
+	// Eurozone is true, if the country is a member state of the European
+	// Union that uses the euro.
+	Eurozone bool
+	// SEPA is true, if the country is part of the Single Euro Payments Area.
+	//
+	// Unlike EU and EEA, SEPA is also true for the territories that are part
+	// of SEPA, such as GP or JE.
+	SEPA bool
+}
+
+// ============================================================================
+// Continent
+// ======================================================================================
+
+type Continent uint8
+
+const (
+	Africa Continent = iota + 1
+	Antarctica
+	Asia
+	Europe
+	NorthAmerica
+	Oceania
+	SouthAmerica
+)
+
+func (c Continent) String() string {
+	switch c {
+	case Africa:
+		return "Africa"
+	case Antarctica:
+		return "Antarctica"
+	case Asia:
+		return "Asia"
+	case Europe:
+		return "Europe"
+	case NorthAmerica:
+		return "North America"
+	case Oceania:
+		return "Oceania"
+	case SouthAmerica:
+		return "South America"
+	default:
+		return "unknown"
+	}
+}
+
 // ============================================================================
 // Status
 // ======================================================================================
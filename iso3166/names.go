@@ -0,0 +1,256 @@
+package iso3166
+
+// Code generated by tools/codegen/iso3166_names. DO NOT EDIT.
+
+var names = map[string][5]string{
+	"AD": {"Andorra", "Andorra", "Andorre", "Andorra", "Andorra"},
+	"AE": {"United Arab Emirates", "Vereinigte Arabische Emirate", "Émirats arabes unis", "Emiratos Árabes Unidos", "Emirati Arabi Uniti"},
+	"AF": {"Afghanistan", "Afghanistan", "Afghanistan", "Afganistán", "Afghanistan"},
+	"AG": {"Antigua & Barbuda", "Antigua und Barbuda", "Antigua-et-Barbuda", "Antigua y Barbuda", "Antigua e Barbuda"},
+	"AI": {"Anguilla", "Anguilla", "Anguilla", "Anguila", "Anguilla"},
+	"AL": {"Albania", "Albanien", "Albanie", "Albania", "Albania"},
+	"AM": {"Armenia", "Armenien", "Arménie", "Armenia", "Armenia"},
+	"AO": {"Angola", "Angola", "Angola", "Angola", "Angola"},
+	"AQ": {"Antarctica", "Antarktis", "Antarctique", "Antártida", "Antartide"},
+	"AR": {"Argentina", "Argentinien", "Argentine", "Argentina", "Argentina"},
+	"AS": {"American Samoa", "Amerikanisch-Samoa", "Samoa américaines", "Samoa Americana", "Samoa americane"},
+	"AT": {"Austria", "Österreich", "Autriche", "Austria", "Austria"},
+	"AU": {"Australia", "Australien", "Australie", "Australia", "Australia"},
+	"AW": {"Aruba", "Aruba", "Aruba", "Aruba", "Aruba"},
+	"AX": {"Åland Islands", "Ålandinseln", "Îles Åland", "Islas Åland", "Isole Åland"},
+	"AZ": {"Azerbaijan", "Aserbaidschan", "Azerbaïdjan", "Azerbaiyán", "Azerbaigian"},
+	"BA": {"Bosnia & Herzegovina", "Bosnien und Herzegowina", "Bosnie-Herzégovine", "Bosnia y Herzegovina", "Bosnia ed Erzegovina"},
+	"BB": {"Barbados", "Barbados", "Barbade", "Barbados", "Barbados"},
+	"BD": {"Bangladesh", "Bangladesch", "Bangladesh", "Bangladés", "Bangladesh"},
+	"BE": {"Belgium", "Belgien", "Belgique", "Bélgica", "Belgio"},
+	"BF": {"Burkina Faso", "Burkina Faso", "Burkina Faso", "Burkina Faso", "Burkina Faso"},
+	"BG": {"Bulgaria", "Bulgarien", "Bulgarie", "Bulgaria", "Bulgaria"},
+	"BH": {"Bahrain", "Bahrain", "Bahreïn", "Baréin", "Bahrein"},
+	"BI": {"Burundi", "Burundi", "Burundi", "Burundi", "Burundi"},
+	"BJ": {"Benin", "Benin", "Bénin", "Benín", "Benin"},
+	"BL": {"St. Barthélemy", "St. Barthélemy", "Saint-Barthélemy", "San Bartolomé", "Saint-Barthélemy"},
+	"BM": {"Bermuda", "Bermuda", "Bermudes", "Bermudas", "Bermuda"},
+	"BN": {"Brunei", "Brunei Darussalam", "Brunéi Darussalam", "Brunéi", "Brunei"},
+	"BO": {"Bolivia", "Bolivien", "Bolivie", "Bolivia", "Bolivia"},
+	"BQ": {"Caribbean Netherlands", "Bonaire, Sint Eustatius und Saba", "Pays-Bas caribéens", "Caribe neerlandés", "Caraibi olandesi"},
+	"BR": {"Brazil", "Brasilien", "Brésil", "Brasil", "Brasile"},
+	"BS": {"Bahamas", "Bahamas", "Bahamas", "Bahamas", "Bahamas"},
+	"BT": {"Bhutan", "Bhutan", "Bhoutan", "Bután", "Bhutan"},
+	"BV": {"Bouvet Island", "Bouvetinsel", "Île Bouvet", "Isla Bouvet", "Isola Bouvet"},
+	"BW": {"Botswana", "Botsuana", "Botswana", "Botsuana", "Botswana"},
+	"BY": {"Belarus", "Belarus", "Biélorussie", "Bielorrusia", "Bielorussia"},
+	"BZ": {"Belize", "Belize", "Belize", "Belice", "Belize"},
+	"CA": {"Canada", "Kanada", "Canada", "Canadá", "Canada"},
+	"CC": {"Cocos (Keeling) Islands", "Kokosinseln", "Îles Cocos", "Islas Cocos", "Isole Cocos (Keeling)"},
+	"CD": {"Congo - Kinshasa", "Kongo-Kinshasa", "Congo-Kinshasa", "República Democrática del Congo", "Congo - Kinshasa"},
+	"CF": {"Central African Republic", "Zentralafrikanische Republik", "République centrafricaine", "República Centroafricana", "Repubblica Centrafricana"},
+	"CG": {"Congo - Brazzaville", "Kongo-Brazzaville", "Congo-Brazzaville", "República del Congo", "Congo-Brazzaville"},
+	"CH": {"Switzerland", "Schweiz", "Suisse", "Suiza", "Svizzera"},
+	"CI": {"Côte d’Ivoire", "Côte d’Ivoire", "Côte d’Ivoire", "Côte d’Ivoire", "Costa d’Avorio"},
+	"CK": {"Cook Islands", "Cookinseln", "Îles Cook", "Islas Cook", "Isole Cook"},
+	"CL": {"Chile", "Chile", "Chili", "Chile", "Cile"},
+	"CM": {"Cameroon", "Kamerun", "Cameroun", "Camerún", "Camerun"},
+	"CN": {"China", "China", "Chine", "China", "Cina"},
+	"CO": {"Colombia", "Kolumbien", "Colombie", "Colombia", "Colombia"},
+	"CR": {"Costa Rica", "Costa Rica", "Costa Rica", "Costa Rica", "Costa Rica"},
+	"CU": {"Cuba", "Kuba", "Cuba", "Cuba", "Cuba"},
+	"CV": {"Cape Verde", "Cabo Verde", "Cap-Vert", "Cabo Verde", "Capo Verde"},
+	"CW": {"Curaçao", "Curaçao", "Curaçao", "Curazao", "Curaçao"},
+	"CX": {"Christmas Island", "Weihnachtsinsel", "Île Christmas", "Isla de Navidad", "Isola Christmas"},
+	"CY": {"Cyprus", "Zypern", "Chypre", "Chipre", "Cipro"},
+	"CZ": {"Czechia", "Tschechien", "Tchéquie", "Chequia", "Cechia"},
+	"DE": {"Germany", "Deutschland", "Allemagne", "Alemania", "Germania"},
+	"DJ": {"Djibouti", "Dschibuti", "Djibouti", "Yibuti", "Gibuti"},
+	"DK": {"Denmark", "Dänemark", "Danemark", "Dinamarca", "Danimarca"},
+	"DM": {"Dominica", "Dominica", "Dominique", "Dominica", "Dominica"},
+	"DO": {"Dominican Republic", "Dominikanische Republik", "République dominicaine", "República Dominicana", "Repubblica Dominicana"},
+	"DZ": {"Algeria", "Algerien", "Algérie", "Argelia", "Algeria"},
+	"EC": {"Ecuador", "Ecuador", "Équateur", "Ecuador", "Ecuador"},
+	"EE": {"Estonia", "Estland", "Estonie", "Estonia", "Estonia"},
+	"EG": {"Egypt", "Ägypten", "Égypte", "Egipto", "Egitto"},
+	"EH": {"Western Sahara", "Westsahara", "Sahara occidental", "Sáhara Occidental", "Sahara occidentale"},
+	"ER": {"Eritrea", "Eritrea", "Érythrée", "Eritrea", "Eritrea"},
+	"ES": {"Spain", "Spanien", "Espagne", "España", "Spagna"},
+	"ET": {"Ethiopia", "Äthiopien", "Éthiopie", "Etiopía", "Etiopia"},
+	"FI": {"Finland", "Finnland", "Finlande", "Finlandia", "Finlandia"},
+	"FJ": {"Fiji", "Fidschi", "Fidji", "Fiyi", "Figi"},
+	"FK": {"Falkland Islands", "Falklandinseln", "Îles Malouines", "Islas Malvinas", "Isole Falkland"},
+	"FM": {"Micronesia", "Mikronesien", "États fédérés de Micronésie", "Micronesia", "Micronesia"},
+	"FO": {"Faroe Islands", "Färöer", "Îles Féroé", "Islas Feroe", "Isole Fær Øer"},
+	"FR": {"France", "Frankreich", "France", "Francia", "Francia"},
+	"GA": {"Gabon", "Gabun", "Gabon", "Gabón", "Gabon"},
+	"GB": {"United Kingdom", "Vereinigtes Königreich", "Royaume-Uni", "Reino Unido", "Regno Unito"},
+	"GD": {"Grenada", "Grenada", "Grenade", "Granada", "Grenada"},
+	"GE": {"Georgia", "Georgien", "Géorgie", "Georgia", "Georgia"},
+	"GF": {"French Guiana", "Französisch-Guayana", "Guyane française", "Guayana Francesa", "Guyana francese"},
+	"GG": {"Guernsey", "Guernsey", "Guernesey", "Guernsey", "Guernsey"},
+	"GH": {"Ghana", "Ghana", "Ghana", "Ghana", "Ghana"},
+	"GI": {"Gibraltar", "Gibraltar", "Gibraltar", "Gibraltar", "Gibilterra"},
+	"GL": {"Greenland", "Grönland", "Groenland", "Groenlandia", "Groenlandia"},
+	"GM": {"Gambia", "Gambia", "Gambie", "Gambia", "Gambia"},
+	"GN": {"Guinea", "Guinea", "Guinée", "Guinea", "Guinea"},
+	"GP": {"Guadeloupe", "Guadeloupe", "Guadeloupe", "Guadalupe", "Guadalupa"},
+	"GQ": {"Equatorial Guinea", "Äquatorialguinea", "Guinée équatoriale", "Guinea Ecuatorial", "Guinea Equatoriale"},
+	"GR": {"Greece", "Griechenland", "Grèce", "Grecia", "Grecia"},
+	"GS": {"South Georgia & South Sandwich Islands", "Südgeorgien und die Südlichen Sandwichinseln", "Géorgie du Sud et îles Sandwich du Sud", "Islas Georgia del Sur y Sandwich del Sur", "Georgia del Sud e Sandwich australi"},
+	"GT": {"Guatemala", "Guatemala", "Guatemala", "Guatemala", "Guatemala"},
+	"GU": {"Guam", "Guam", "Guam", "Guam", "Guam"},
+	"GW": {"Guinea-Bissau", "Guinea-Bissau", "Guinée-Bissau", "Guinea-Bisáu", "Guinea-Bissau"},
+	"GY": {"Guyana", "Guyana", "Guyana", "Guyana", "Guyana"},
+	"HK": {"Hong Kong SAR China", "Sonderverwaltungsregion Hongkong", "R.A.S. chinoise de Hong Kong", "RAE de Hong Kong (China)", "RAS di Hong Kong"},
+	"HM": {"Heard & McDonald Islands", "Heard und McDonaldinseln", "Îles Heard et McDonald", "Islas Heard y McDonald", "Isole Heard e McDonald"},
+	"HN": {"Honduras", "Honduras", "Honduras", "Honduras", "Honduras"},
+	"HR": {"Croatia", "Kroatien", "Croatie", "Croacia", "Croazia"},
+	"HT": {"Haiti", "Haiti", "Haïti", "Haití", "Haiti"},
+	"HU": {"Hungary", "Ungarn", "Hongrie", "Hungría", "Ungheria"},
+	"ID": {"Indonesia", "Indonesien", "Indonésie", "Indonesia", "Indonesia"},
+	"IE": {"Ireland", "Irland", "Irlande", "Irlanda", "Irlanda"},
+	"IL": {"Israel", "Israel", "Israël", "Israel", "Israele"},
+	"IM": {"Isle of Man", "Isle of Man", "Île de Man", "Isla de Man", "Isola di Man"},
+	"IN": {"India", "Indien", "Inde", "India", "India"},
+	"IO": {"British Indian Ocean Territory", "Britisches Territorium im Indischen Ozean", "Territoire britannique de l’océan Indien", "Territorio Británico del Océano Índico", "Territorio britannico dell’Oceano Indiano"},
+	"IQ": {"Iraq", "Irak", "Irak", "Irak", "Iraq"},
+	"IR": {"Iran", "Iran", "Iran", "Irán", "Iran"},
+	"IS": {"Iceland", "Island", "Islande", "Islandia", "Islanda"},
+	"IT": {"Italy", "Italien", "Italie", "Italia", "Italia"},
+	"JE": {"Jersey", "Jersey", "Jersey", "Jersey", "Jersey"},
+	"JM": {"Jamaica", "Jamaika", "Jamaïque", "Jamaica", "Giamaica"},
+	"JO": {"Jordan", "Jordanien", "Jordanie", "Jordania", "Giordania"},
+	"JP": {"Japan", "Japan", "Japon", "Japón", "Giappone"},
+	"KE": {"Kenya", "Kenia", "Kenya", "Kenia", "Kenya"},
+	"KG": {"Kyrgyzstan", "Kirgisistan", "Kirghizistan", "Kirguistán", "Kirghizistan"},
+	"KH": {"Cambodia", "Kambodscha", "Cambodge", "Camboya", "Cambogia"},
+	"KI": {"Kiribati", "Kiribati", "Kiribati", "Kiribati", "Kiribati"},
+	"KM": {"Comoros", "Komoren", "Comores", "Comoras", "Comore"},
+	"KN": {"St. Kitts & Nevis", "St. Kitts und Nevis", "Saint-Christophe-et-Niévès", "San Cristóbal y Nieves", "Saint Kitts e Nevis"},
+	"KP": {"North Korea", "Nordkorea", "Corée du Nord", "Corea del Norte", "Corea del Nord"},
+	"KR": {"South Korea", "Südkorea", "Corée du Sud", "Corea del Sur", "Corea del Sud"},
+	"KW": {"Kuwait", "Kuwait", "Koweït", "Kuwait", "Kuwait"},
+	"KY": {"Cayman Islands", "Kaimaninseln", "Îles Caïmans", "Islas Caimán", "Isole Cayman"},
+	"KZ": {"Kazakhstan", "Kasachstan", "Kazakhstan", "Kazajistán", "Kazakistan"},
+	"LA": {"Laos", "Laos", "Laos", "Laos", "Laos"},
+	"LB": {"Lebanon", "Libanon", "Liban", "Líbano", "Libano"},
+	"LC": {"St. Lucia", "St. Lucia", "Sainte-Lucie", "Santa Lucía", "Saint Lucia"},
+	"LI": {"Liechtenstein", "Liechtenstein", "Liechtenstein", "Liechtenstein", "Liechtenstein"},
+	"LK": {"Sri Lanka", "Sri Lanka", "Sri Lanka", "Sri Lanka", "Sri Lanka"},
+	"LR": {"Liberia", "Liberia", "Libéria", "Liberia", "Liberia"},
+	"LS": {"Lesotho", "Lesotho", "Lesotho", "Lesoto", "Lesotho"},
+	"LT": {"Lithuania", "Litauen", "Lituanie", "Lituania", "Lituania"},
+	"LU": {"Luxembourg", "Luxemburg", "Luxembourg", "Luxemburgo", "Lussemburgo"},
+	"LV": {"Latvia", "Lettland", "Lettonie", "Letonia", "Lettonia"},
+	"LY": {"Libya", "Libyen", "Libye", "Libia", "Libia"},
+	"MA": {"Morocco", "Marokko", "Maroc", "Marruecos", "Marocco"},
+	"MC": {"Monaco", "Monaco", "Monaco", "Mónaco", "Monaco"},
+	"MD": {"Moldova", "Republik Moldau", "Moldavie", "Moldavia", "Moldavia"},
+	"ME": {"Montenegro", "Montenegro", "Monténégro", "Montenegro", "Montenegro"},
+	"MF": {"St. Martin", "St. Martin", "Saint-Martin", "San Martín", "Saint Martin"},
+	"MG": {"Madagascar", "Madagaskar", "Madagascar", "Madagascar", "Madagascar"},
+	"MH": {"Marshall Islands", "Marshallinseln", "Îles Marshall", "Islas Marshall", "Isole Marshall"},
+	"MK": {"Macedonia", "Mazedonien", "Macédoine", "Macedonia", "Repubblica di Macedonia"},
+	"ML": {"Mali", "Mali", "Mali", "Mali", "Mali"},
+	"MM": {"Myanmar (Burma)", "Myanmar", "Myanmar (Birmanie)", "Myanmar (Birmania)", "Myanmar (Birmania)"},
+	"MN": {"Mongolia", "Mongolei", "Mongolie", "Mongolia", "Mongolia"},
+	"MO": {"Macau SAR China", "Sonderverwaltungsregion Macau", "R.A.S. chinoise de Macao", "RAE de Macao (China)", "RAS di Macao"},
+	"MP": {"Northern Mariana Islands", "Nördliche Marianen", "Îles Mariannes du Nord", "Islas Marianas del Norte", "Isole Marianne settentrionali"},
+	"MQ": {"Martinique", "Martinique", "Martinique", "Martinica", "Martinica"},
+	"MR": {"Mauritania", "Mauretanien", "Mauritanie", "Mauritania", "Mauritania"},
+	"MS": {"Montserrat", "Montserrat", "Montserrat", "Montserrat", "Montserrat"},
+	"MT": {"Malta", "Malta", "Malte", "Malta", "Malta"},
+	"MU": {"Mauritius", "Mauritius", "Maurice", "Mauricio", "Mauritius"},
+	"MV": {"Maldives", "Malediven", "Maldives", "Maldivas", "Maldive"},
+	"MW": {"Malawi", "Malawi", "Malawi", "Malaui", "Malawi"},
+	"MX": {"Mexico", "Mexiko", "Mexique", "México", "Messico"},
+	"MY": {"Malaysia", "Malaysia", "Malaisie", "Malasia", "Malaysia"},
+	"MZ": {"Mozambique", "Mosambik", "Mozambique", "Mozambique", "Mozambico"},
+	"NA": {"Namibia", "Namibia", "Namibie", "Namibia", "Namibia"},
+	"NC": {"New Caledonia", "Neukaledonien", "Nouvelle-Calédonie", "Nueva Caledonia", "Nuova Caledonia"},
+	"NE": {"Niger", "Niger", "Niger", "Níger", "Niger"},
+	"NF": {"Norfolk Island", "Norfolkinsel", "Île Norfolk", "Isla Norfolk", "Isola Norfolk"},
+	"NG": {"Nigeria", "Nigeria", "Nigéria", "Nigeria", "Nigeria"},
+	"NI": {"Nicaragua", "Nicaragua", "Nicaragua", "Nicaragua", "Nicaragua"},
+	"NL": {"Netherlands", "Niederlande", "Pays-Bas", "Países Bajos", "Paesi Bassi"},
+	"NO": {"Norway", "Norwegen", "Norvège", "Noruega", "Norvegia"},
+	"NP": {"Nepal", "Nepal", "Népal", "Nepal", "Nepal"},
+	"NR": {"Nauru", "Nauru", "Nauru", "Nauru", "Nauru"},
+	"NU": {"Niue", "Niue", "Niue", "Niue", "Niue"},
+	"NZ": {"New Zealand", "Neuseeland", "Nouvelle-Zélande", "Nueva Zelanda", "Nuova Zelanda"},
+	"OM": {"Oman", "Oman", "Oman", "Omán", "Oman"},
+	"PA": {"Panama", "Panama", "Panama", "Panamá", "Panamá"},
+	"PE": {"Peru", "Peru", "Pérou", "Perú", "Perù"},
+	"PF": {"French Polynesia", "Französisch-Polynesien", "Polynésie française", "Polinesia Francesa", "Polinesia francese"},
+	"PG": {"Papua New Guinea", "Papua-Neuguinea", "Papouasie-Nouvelle-Guinée", "Papúa Nueva Guinea", "Papua Nuova Guinea"},
+	"PH": {"Philippines", "Philippinen", "Philippines", "Filipinas", "Filippine"},
+	"PK": {"Pakistan", "Pakistan", "Pakistan", "Pakistán", "Pakistan"},
+	"PL": {"Poland", "Polen", "Pologne", "Polonia", "Polonia"},
+	"PM": {"St. Pierre & Miquelon", "St. Pierre und Miquelon", "Saint-Pierre-et-Miquelon", "San Pedro y Miquelón", "Saint-Pierre e Miquelon"},
+	"PN": {"Pitcairn Islands", "Pitcairninseln", "Îles Pitcairn", "Islas Pitcairn", "Isole Pitcairn"},
+	"PR": {"Puerto Rico", "Puerto Rico", "Porto Rico", "Puerto Rico", "Portorico"},
+	"PS": {"Palestinian Territories", "Palästinensische Autonomiegebiete", "Territoires palestiniens", "Territorios Palestinos", "Territori palestinesi"},
+	"PT": {"Portugal", "Portugal", "Portugal", "Portugal", "Portogallo"},
+	"PW": {"Palau", "Palau", "Palaos", "Palaos", "Palau"},
+	"PY": {"Paraguay", "Paraguay", "Paraguay", "Paraguay", "Paraguay"},
+	"QA": {"Qatar", "Katar", "Qatar", "Catar", "Qatar"},
+	"RE": {"Réunion", "Réunion", "La Réunion", "Reunión", "Riunione"},
+	"RO": {"Romania", "Rumänien", "Roumanie", "Rumanía", "Romania"},
+	"RS": {"Serbia", "Serbien", "Serbie", "Serbia", "Serbia"},
+	"RU": {"Russia", "Russland", "Russie", "Rusia", "Russia"},
+	"RW": {"Rwanda", "Ruanda", "Rwanda", "Ruanda", "Ruanda"},
+	"SA": {"Saudi Arabia", "Saudi-Arabien", "Arabie saoudite", "Arabia Saudí", "Arabia Saudita"},
+	"SB": {"Solomon Islands", "Salomonen", "Îles Salomon", "Islas Salomón", "Isole Salomone"},
+	"SC": {"Seychelles", "Seychellen", "Seychelles", "Seychelles", "Seychelles"},
+	"SD": {"Sudan", "Sudan", "Soudan", "Sudán", "Sudan"},
+	"SE": {"Sweden", "Schweden", "Suède", "Suecia", "Svezia"},
+	"SG": {"Singapore", "Singapur", "Singapour", "Singapur", "Singapore"},
+	"SH": {"St. Helena", "St. Helena", "Sainte-Hélène", "Santa Elena", "Sant’Elena"},
+	"SI": {"Slovenia", "Slowenien", "Slovénie", "Eslovenia", "Slovenia"},
+	"SJ": {"Svalbard & Jan Mayen", "Spitzbergen und Jan Mayen", "Svalbard et Jan Mayen", "Svalbard y Jan Mayen", "Svalbard e Jan Mayen"},
+	"SK": {"Slovakia", "Slowakei", "Slovaquie", "Eslovaquia", "Slovacchia"},
+	"SL": {"Sierra Leone", "Sierra Leone", "Sierra Leone", "Sierra Leona", "Sierra Leone"},
+	"SM": {"San Marino", "San Marino", "Saint-Marin", "San Marino", "San Marino"},
+	"SN": {"Senegal", "Senegal", "Sénégal", "Senegal", "Senegal"},
+	"SO": {"Somalia", "Somalia", "Somalie", "Somalia", "Somalia"},
+	"SR": {"Suriname", "Suriname", "Suriname", "Surinam", "Suriname"},
+	"SS": {"South Sudan", "Südsudan", "Soudan du Sud", "Sudán del Sur", "Sud Sudan"},
+	"ST": {"São Tomé & Príncipe", "São Tomé und Príncipe", "Sao Tomé-et-Principe", "Santo Tomé y Príncipe", "São Tomé e Príncipe"},
+	"SV": {"El Salvador", "El Salvador", "Salvador", "El Salvador", "El Salvador"},
+	"SX": {"Sint Maarten", "Sint Maarten", "Saint-Martin (partie néerlandaise)", "Sint Maarten", "Sint Maarten"},
+	"SY": {"Syria", "Syrien", "Syrie", "Siria", "Siria"},
+	"SZ": {"Swaziland", "Swasiland", "Swaziland", "Suazilandia", "Swaziland"},
+	"TC": {"Turks & Caicos Islands", "Turks- und Caicosinseln", "Îles Turques-et-Caïques", "Islas Turcas y Caicos", "Isole Turks e Caicos"},
+	"TD": {"Chad", "Tschad", "Tchad", "Chad", "Ciad"},
+	"TF": {"French Southern Territories", "Französische Süd- und Antarktisgebiete", "Terres australes françaises", "Territorios Australes Franceses", "Terre australi francesi"},
+	"TG": {"Togo", "Togo", "Togo", "Togo", "Togo"},
+	"TH": {"Thailand", "Thailand", "Thaïlande", "Tailandia", "Thailandia"},
+	"TJ": {"Tajikistan", "Tadschikistan", "Tadjikistan", "Tayikistán", "Tagikistan"},
+	"TK": {"Tokelau", "Tokelau", "Tokélaou", "Tokelau", "Tokelau"},
+	"TL": {"Timor-Leste", "Timor-Leste", "Timor oriental", "Timor-Leste", "Timor Est"},
+	"TM": {"Turkmenistan", "Turkmenistan", "Turkménistan", "Turkmenistán", "Turkmenistan"},
+	"TN": {"Tunisia", "Tunesien", "Tunisie", "Túnez", "Tunisia"},
+	"TO": {"Tonga", "Tonga", "Tonga", "Tonga", "Tonga"},
+	"TR": {"Turkey", "Türkei", "Turquie", "Turquía", "Turchia"},
+	"TT": {"Trinidad & Tobago", "Trinidad und Tobago", "Trinité-et-Tobago", "Trinidad y Tobago", "Trinidad e Tobago"},
+	"TV": {"Tuvalu", "Tuvalu", "Tuvalu", "Tuvalu", "Tuvalu"},
+	"TW": {"Taiwan", "Taiwan", "Taïwan", "Taiwán", "Taiwan"},
+	"TZ": {"Tanzania", "Tansania", "Tanzanie", "Tanzania", "Tanzania"},
+	"UA": {"Ukraine", "Ukraine", "Ukraine", "Ucrania", "Ucraina"},
+	"UG": {"Uganda", "Uganda", "Ouganda", "Uganda", "Uganda"},
+	"UM": {"U.S. Outlying Islands", "Amerikanische Überseeinseln", "Îles mineures éloignées des États-Unis", "Islas menores alejadas de EE. UU.", "Altre isole americane del Pacifico"},
+	"US": {"United States", "Vereinigte Staaten", "États-Unis", "Estados Unidos", "Stati Uniti"},
+	"UY": {"Uruguay", "Uruguay", "Uruguay", "Uruguay", "Uruguay"},
+	"UZ": {"Uzbekistan", "Usbekistan", "Ouzbékistan", "Uzbekistán", "Uzbekistan"},
+	"VA": {"Vatican City", "Vatikanstadt", "État de la Cité du Vatican", "Ciudad del Vaticano", "Città del Vaticano"},
+	"VC": {"St. Vincent & Grenadines", "St. Vincent und die Grenadinen", "Saint-Vincent-et-les-Grenadines", "San Vicente y las Granadinas", "Saint Vincent e Grenadine"},
+	"VE": {"Venezuela", "Venezuela", "Venezuela", "Venezuela", "Venezuela"},
+	"VG": {"British Virgin Islands", "Britische Jungferninseln", "Îles Vierges britanniques", "Islas Vírgenes Británicas", "Isole Vergini Britanniche"},
+	"VI": {"U.S. Virgin Islands", "Amerikanische Jungferninseln", "Îles Vierges des États-Unis", "Islas Vírgenes de EE. UU.", "Isole Vergini Americane"},
+	"VN": {"Vietnam", "Vietnam", "Vietnam", "Vietnam", "Vietnam"},
+	"VU": {"Vanuatu", "Vanuatu", "Vanuatu", "Vanuatu", "Vanuatu"},
+	"WF": {"Wallis & Futuna", "Wallis und Futuna", "Wallis-et-Futuna", "Wallis y Futuna", "Wallis e Futuna"},
+	"WS": {"Samoa", "Samoa", "Samoa", "Samoa", "Samoa"},
+	"XK": {"Kosovo", "Kosovo", "Kosovo", "Kosovo", "Kosovo"},
+	"YE": {"Yemen", "Jemen", "Yémen", "Yemen", "Yemen"},
+	"YT": {"Mayotte", "Mayotte", "Mayotte", "Mayotte", "Mayotte"},
+	"ZA": {"South Africa", "Südafrika", "Afrique du Sud", "Sudáfrica", "Sudafrica"},
+	"ZM": {"Zambia", "Sambia", "Zambie", "Zambia", "Zambia"},
+	"ZW": {"Zimbabwe", "Simbabwe", "Zimbabwe", "Zimbabue", "Zimbabwe"},
+}
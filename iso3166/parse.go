@@ -5,7 +5,12 @@ import (
 	"strings"
 )
 
-var ErrInvalidAlpha2 = errors.New("invalid alpha-2 code")
+var (
+	ErrInvalidAlpha2  = errors.New("invalid alpha-2 code")
+	ErrInvalidAlpha3  = errors.New("invalid alpha-3 code")
+	ErrInvalidNumeric = errors.New("invalid numeric code")
+	ErrInvalidAlpha4  = errors.New("invalid alpha-4 code")
+)
 
 // ParseAlpha2 parses an ISO 3166-1 alpha-2 code.
 //
@@ -31,3 +36,80 @@ func IsValidAlpha2(s string) bool {
 	_, err := ParseAlpha2(s)
 	return err == nil
 }
+
+// ParseAlpha3 parses an ISO 3166-1 alpha-3 code.
+//
+// Before parsing, the code is converted to uppercase.
+//
+// If ParseAlpha3 returns without an error, the code is considered
+// syntactically valid.
+// Refer to [Alpha3Code.Status] to see if the code is in use.
+func ParseAlpha3(s string) (Alpha3Code, error) {
+	s = strings.ToUpper(s)
+
+	c, ok := alpha3Codes[s]
+	if !ok {
+		return Alpha3Code{}, ErrInvalidAlpha3
+	}
+
+	return c, nil
+}
+
+// IsValidAlpha3 validates an ISO 3166-1 alpha-3 code, according to the
+// rules laid out in [ParseAlpha3].
+func IsValidAlpha3(s string) bool {
+	_, err := ParseAlpha3(s)
+	return err == nil
+}
+
+// ParseNumeric parses an ISO 3166-1 numeric code.
+//
+// Leading zeros may be omitted, i.e. "4" is parsed as "004".
+//
+// If ParseNumeric returns without an error, the code is considered
+// syntactically valid.
+// Refer to [NumericCode.Status] to see if the code is in use.
+func ParseNumeric(s string) (NumericCode, error) {
+	if len(s) == 0 || len(s) > 3 {
+		return NumericCode{}, ErrInvalidNumeric
+	}
+
+	s = strings.Repeat("0", 3-len(s)) + s
+
+	c, ok := numericCodes[s]
+	if !ok {
+		return NumericCode{}, ErrInvalidNumeric
+	}
+
+	return c, nil
+}
+
+// IsValidNumeric validates an ISO 3166-1 numeric code, according to the
+// rules laid out in [ParseNumeric].
+func IsValidNumeric(s string) bool {
+	_, err := ParseNumeric(s)
+	return err == nil
+}
+
+// ParseAlpha4 parses an ISO 3166-3 alpha-4 code.
+//
+// Before parsing, the code is converted to uppercase.
+//
+// If ParseAlpha4 returns without an error, the code is listed in ISO 3166-3.
+func ParseAlpha4(s string) (Alpha4Code, error) {
+	s = strings.ToUpper(s)
+
+	c, ok := alpha4Codes[s]
+	if !ok {
+		return Alpha4Code{}, ErrInvalidAlpha4
+	}
+
+	return c, nil
+}
+
+// IsValidAlpha4 validates an ISO 3166-3 alpha-4 code, according to the
+// rules laid out in [ParseAlpha4].
+func IsValidAlpha4(s string) bool {
+	_, err := ParseAlpha4(s)
+	return err == nil
+}
@@ -0,0 +1,549 @@
+package iso3166
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+func TestConversion(t *testing.T) {
+	testCases := []struct {
+		Alpha2  string
+		Alpha3  string
+		Numeric string
+	}{
+		{Alpha2: "DE", Alpha3: "DEU", Numeric: "276"},
+		{Alpha2: "US", Alpha3: "USA", Numeric: "840"},
+		{Alpha2: "AF", Alpha3: "AFG", Numeric: "004"},
+		{Alpha2: "AQ", Alpha3: "ATA", Numeric: "010"},
+		{Alpha2: "SS", Alpha3: "SSD", Numeric: "728"},
+		{Alpha2: "AN", Alpha3: "ANT", Numeric: "530"},
+		{Alpha2: "FX", Alpha3: "FXX", Numeric: "249"},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.Alpha2, func(t *testing.T) {
+			alpha2, err := ParseAlpha2(c.Alpha2)
+			if err != nil {
+				t.Fatalf("ParseAlpha2(%q): %s", c.Alpha2, err)
+			}
+
+			alpha3, err := ParseAlpha3(c.Alpha3)
+			if err != nil {
+				t.Fatalf("ParseAlpha3(%q): %s", c.Alpha3, err)
+			}
+
+			numeric, err := ParseNumeric(c.Numeric)
+			if err != nil {
+				t.Fatalf("ParseNumeric(%q): %s", c.Numeric, err)
+			}
+
+			if alpha3.Status != alpha2.Status || numeric.Status != alpha2.Status {
+				t.Errorf("expected status %s, got %s (alpha-3) and %s (numeric)", alpha2.Status, alpha3.Status, numeric.Status)
+			}
+
+			if actual, ok := alpha2.Alpha3(); !ok || actual != alpha3 {
+				t.Errorf("%s.Alpha3: expected %s, got %s", alpha2, alpha3, actual)
+			}
+			if actual, ok := alpha2.Numeric(); !ok || actual != numeric {
+				t.Errorf("%s.Numeric: expected %s, got %s", alpha2, numeric, actual)
+			}
+
+			if actual, ok := alpha3.Alpha2(); !ok || actual != alpha2 {
+				t.Errorf("%s.Alpha2: expected %s, got %s", alpha3, alpha2, actual)
+			}
+			if actual, ok := alpha3.Numeric(); !ok || actual != numeric {
+				t.Errorf("%s.Numeric: expected %s, got %s", alpha3, numeric, actual)
+			}
+
+			if actual, ok := numeric.Alpha2(); !ok || actual != alpha2 {
+				t.Errorf("%s.Alpha2: expected %s, got %s", numeric, alpha2, actual)
+			}
+			if actual, ok := numeric.Alpha3(); !ok || actual != alpha3 {
+				t.Errorf("%s.Alpha3: expected %s, got %s", numeric, alpha3, actual)
+			}
+		})
+	}
+}
+
+func TestParseNumeric(t *testing.T) {
+	successCases := []struct {
+		In     string
+		Expect string
+	}{
+		{In: "276", Expect: "276"},
+		{In: "4", Expect: "004"},
+		{In: "04", Expect: "004"},
+	}
+
+	for _, c := range successCases {
+		t.Run(c.In, func(t *testing.T) {
+			actual, err := ParseNumeric(c.In)
+			if err != nil {
+				t.Fatalf("ParseNumeric(%q): %s", c.In, err)
+			}
+
+			if actual.Code != c.Expect {
+				t.Errorf("expected %q, got %q", c.Expect, actual.Code)
+			}
+		})
+	}
+
+	failureCases := []string{"", "0004", "999", "abc", "+12"}
+
+	for _, c := range failureCases {
+		t.Run(c, func(t *testing.T) {
+			if _, err := ParseNumeric(c); err == nil {
+				t.Errorf("ParseNumeric(%q): expected an error", c)
+			}
+		})
+	}
+}
+
+func TestAlpha2Code_Alpha3(t *testing.T) {
+	// user-assigned and most reserved codes have no alpha-3 or numeric code
+	for _, c := range []Alpha2Code{XK, UK, EU, ZZ} {
+		if alpha3, ok := c.Alpha3(); ok {
+			t.Errorf("%s.Alpha3: expected no code, got %s", c, alpha3)
+		}
+		if numeric, ok := c.Numeric(); ok {
+			t.Errorf("%s.Numeric: expected no code, got %s", c, numeric)
+		}
+	}
+}
+
+func TestAlpha2Code_Successors(t *testing.T) {
+	testCases := []struct {
+		In     Alpha2Code
+		Expect [][]Alpha2Code
+	}{
+		{In: DD, Expect: [][]Alpha2Code{{DE}}},
+		{In: CS, Expect: [][]Alpha2Code{{CZ, SK}, {ME, RS}}},
+		{In: YU, Expect: [][]Alpha2Code{{CS}}},
+		{In: SU, Expect: [][]Alpha2Code{{AM, AZ, EE, GE, KG, KZ, LT, LV, MD, RU, TJ, TM, UZ}}},
+		{In: DE, Expect: nil},
+		// reassigned to Anguilla, formerly used by the French Afars and Issas
+		{In: AI, Expect: nil},
+		// formerly used by the Byelorussian SSR
+		{In: BY, Expect: nil},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.In.Code, func(t *testing.T) {
+			actual := c.In.Successors()
+			if len(actual) != len(c.Expect) {
+				t.Fatalf("expected %d former countries, got %d", len(c.Expect), len(actual))
+			}
+
+			for i, former := range actual {
+				if former.Alpha2 != c.In {
+					t.Errorf("%s: expected alpha-2 code %s, got %s", former.Code, c.In, former.Alpha2)
+				}
+
+				if len(former.Successors) != len(c.Expect[i]) {
+					t.Fatalf("%s: expected successors %v, got %v", former.Code, c.Expect[i], former.Successors)
+				}
+
+				for j, successor := range former.Successors {
+					if successor != c.Expect[i][j] {
+						t.Errorf("%s: expected successors %v, got %v", former.Code, c.Expect[i], former.Successors)
+						break
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestAlpha4Code_ValidAt(t *testing.T) {
+	cshh, err := ParseAlpha4("CSHH")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	csxx, err := ParseAlpha4("csxx")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Date       time.Time
+		CSHH, CSXX bool
+	}{
+		{Date: time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC), CSHH: true},
+		{Date: time.Date(1993, 6, 14, 0, 0, 0, 0, time.UTC), CSHH: true},
+		{Date: time.Date(1993, 6, 15, 0, 0, 0, 0, time.UTC)},
+		{Date: time.Date(2003, 7, 23, 0, 0, 0, 0, time.UTC), CSXX: true},
+		{Date: time.Date(2006, 9, 26, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.Date.Format("2006-01-02"), func(t *testing.T) {
+			if actual := cshh.ValidAt(c.Date); actual != c.CSHH {
+				t.Errorf("CSHH: expected %t, got %t", c.CSHH, actual)
+			}
+
+			if actual := csxx.ValidAt(c.Date); actual != c.CSXX {
+				t.Errorf("CSXX: expected %t, got %t", c.CSXX, actual)
+			}
+		})
+	}
+}
+
+func TestAlpha2Code_Name(t *testing.T) {
+	testCases := []struct {
+		Code   Alpha2Code
+		Lang   string
+		Expect string
+	}{
+		{Code: KR, Lang: "en", Expect: "South Korea"},
+		{Code: KR, Lang: "de", Expect: "Südkorea"},
+		{Code: KR, Lang: "de-CH", Expect: "Südkorea"},
+		{Code: DE, Lang: "fr", Expect: "Allemagne"},
+		{Code: DE, Lang: "es-MX", Expect: "Alemania"},
+		{Code: DE, Lang: "it", Expect: "Germania"},
+		{Code: NL, Lang: "ja", Expect: "Netherlands"},
+		{Code: XK, Lang: "de", Expect: "Kosovo"},
+		{Code: CS, Lang: "de", Expect: CS.Country},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.Code.Code+"/"+c.Lang, func(t *testing.T) {
+			if actual := c.Code.Name(language.MustParse(c.Lang)); actual != c.Expect {
+				t.Errorf("expected %q, got %q", c.Expect, actual)
+			}
+		})
+	}
+
+	if actual := KR.CommonName(); actual != "South Korea" {
+		t.Errorf("CommonName: expected %q, got %q", "South Korea", actual)
+	}
+}
+
+func TestAlpha2Code_Info(t *testing.T) {
+	successCases := []struct {
+		Code   Alpha2Code
+		Expect Info
+	}{
+		{
+			Code: DE,
+			Expect: Info{
+				CallingCode: "49", Currency: "EUR", TLD: ".de", Continent: Europe,
+				EU: true, EEA: true, Eurozone: true, SEPA: true,
+			},
+		},
+		{
+			Code:   GB,
+			Expect: Info{CallingCode: "44", Currency: "GBP", TLD: ".uk", Continent: Europe, SEPA: true},
+		},
+		{
+			Code:   CH,
+			Expect: Info{CallingCode: "41", Currency: "CHF", TLD: ".ch", Continent: Europe, SEPA: true},
+		},
+		{
+			Code:   NO,
+			Expect: Info{CallingCode: "47", Currency: "NOK", TLD: ".no", Continent: Europe, EEA: true, SEPA: true},
+		},
+		{
+			Code:   GP,
+			Expect: Info{CallingCode: "590", Currency: "EUR", TLD: ".gp", Continent: NorthAmerica, SEPA: true},
+		},
+		{
+			Code:   US,
+			Expect: Info{CallingCode: "1", Currency: "USD", TLD: ".us", Continent: NorthAmerica},
+		},
+		{
+			Code:   AQ,
+			Expect: Info{TLD: ".aq", Continent: Antarctica},
+		},
+	}
+
+	for _, c := range successCases {
+		t.Run(c.Code.Code, func(t *testing.T) {
+			actual, ok := c.Code.Info()
+			if !ok {
+				t.Fatal("expected info to be available")
+			}
+
+			if actual != c.Expect {
+				t.Errorf("expected %+v, got %+v", c.Expect, actual)
+			}
+		})
+	}
+
+	failureCases := []Alpha2Code{AA, CS, EU}
+
+	for _, c := range failureCases {
+		t.Run(c.Code, func(t *testing.T) {
+			if actual, ok := c.Info(); ok {
+				t.Errorf("expected no info, got %+v", actual)
+			}
+		})
+	}
+}
+
+func TestAlpha2Code_Scan(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		In     any
+		Expect Alpha2Code
+	}{
+		{Name: "string", In: "DE", Expect: DE},
+		{Name: "lowercase", In: "us", Expect: US},
+		{Name: "bytes", In: []byte("FR"), Expect: FR},
+		{Name: "null", In: nil, Expect: Alpha2Code{}},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.Name, func(t *testing.T) {
+			code := AT
+			if err := code.Scan(c.In); err != nil {
+				t.Fatalf("Scan(%#v): %s", c.In, err)
+			}
+
+			if code != c.Expect {
+				t.Errorf("expected %#v, got %#v", c.Expect, code)
+			}
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		var code Alpha2Code
+		if err := code.Scan("D1"); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestAlpha2Code_Value(t *testing.T) {
+	v, err := DE.Value()
+	if err != nil {
+		t.Fatalf("Value: %s", err)
+	}
+	if v != "DE" {
+		t.Errorf("expected %q, got %#v", "DE", v)
+	}
+
+	var scanned Alpha2Code
+	if err := scanned.Scan(v); err != nil {
+		t.Fatalf("Scan(%#v): %s", v, err)
+	}
+	if scanned != DE {
+		t.Errorf("expected %#v after round trip, got %#v", DE, scanned)
+	}
+
+	if actual, err := (Alpha2Code{}).Value(); err != nil || actual != nil {
+		t.Errorf("expected nil, got %#v (err: %v)", actual, err)
+	}
+}
+
+func TestAlpha3Code_Scan(t *testing.T) {
+	deu, err := ParseAlpha3("DEU")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name   string
+		In     any
+		Expect Alpha3Code
+	}{
+		{Name: "string", In: "DEU", Expect: deu},
+		{Name: "lowercase", In: "deu", Expect: deu},
+		{Name: "bytes", In: []byte("DEU"), Expect: deu},
+		{Name: "null", In: nil, Expect: Alpha3Code{}},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.Name, func(t *testing.T) {
+			code, err := ParseAlpha3("USA")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := code.Scan(c.In); err != nil {
+				t.Fatalf("Scan(%#v): %s", c.In, err)
+			}
+
+			if code != c.Expect {
+				t.Errorf("expected %#v, got %#v", c.Expect, code)
+			}
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		var code Alpha3Code
+		if err := code.Scan("XXX"); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestAlpha3Code_Value(t *testing.T) {
+	deu, err := ParseAlpha3("deu")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v, err := deu.Value()
+	if err != nil {
+		t.Fatalf("Value: %s", err)
+	}
+	if v != "DEU" {
+		t.Errorf("expected %q, got %#v", "DEU", v)
+	}
+
+	var scanned Alpha3Code
+	if err := scanned.Scan(v); err != nil {
+		t.Fatalf("Scan(%#v): %s", v, err)
+	}
+	if scanned != deu {
+		t.Errorf("expected %#v after round trip, got %#v", deu, scanned)
+	}
+
+	if actual, err := (Alpha3Code{}).Value(); err != nil || actual != nil {
+		t.Errorf("expected nil, got %#v (err: %v)", actual, err)
+	}
+}
+
+func TestNumericCode_Scan(t *testing.T) {
+	afg, err := ParseNumeric("004")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name   string
+		In     any
+		Expect NumericCode
+	}{
+		{Name: "int64", In: int64(4), Expect: afg},
+		{Name: "string", In: "004", Expect: afg},
+		{Name: "bytes", In: []byte("004"), Expect: afg},
+		{Name: "null", In: nil, Expect: NumericCode{}},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.Name, func(t *testing.T) {
+			code, err := ParseNumeric("276")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := code.Scan(c.In); err != nil {
+				t.Fatalf("Scan(%#v): %s", c.In, err)
+			}
+
+			if code != c.Expect {
+				t.Errorf("expected %#v, got %#v", c.Expect, code)
+			}
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, in := range []any{int64(-4), int64(1000), "999"} {
+			var code NumericCode
+			if err := code.Scan(in); err == nil {
+				t.Errorf("Scan(%#v): expected an error", in)
+			}
+		}
+	})
+}
+
+func TestNumericCode_Value(t *testing.T) {
+	afg, err := ParseNumeric("004")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v, err := afg.Value()
+	if err != nil {
+		t.Fatalf("Value: %s", err)
+	}
+	if v != "004" {
+		t.Errorf("expected %q, got %#v", "004", v)
+	}
+
+	var scanned NumericCode
+	if err := scanned.Scan(v); err != nil {
+		t.Fatalf("Scan(%#v): %s", v, err)
+	}
+	if scanned != afg {
+		t.Errorf("expected %#v after round trip, got %#v", afg, scanned)
+	}
+
+	if actual, err := (NumericCode{}).Value(); err != nil || actual != nil {
+		t.Errorf("expected nil, got %#v (err: %v)", actual, err)
+	}
+}
+
+func TestAlpha4Code_Scan(t *testing.T) {
+	cshh, err := ParseAlpha4("CSHH")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name   string
+		In     any
+		Expect Alpha4Code
+	}{
+		{Name: "string", In: "CSHH", Expect: cshh},
+		{Name: "lowercase", In: "cshh", Expect: cshh},
+		{Name: "bytes", In: []byte("CSHH"), Expect: cshh},
+		{Name: "null", In: nil, Expect: Alpha4Code{}},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.Name, func(t *testing.T) {
+			code, err := ParseAlpha4("CSXX")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := code.Scan(c.In); err != nil {
+				t.Fatalf("Scan(%#v): %s", c.In, err)
+			}
+
+			if !reflect.DeepEqual(code, c.Expect) {
+				t.Errorf("expected %+v, got %+v", c.Expect, code)
+			}
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		var code Alpha4Code
+		if err := code.Scan("DEUX"); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestAlpha4Code_Value(t *testing.T) {
+	cshh, err := ParseAlpha4("CSHH")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v, err := cshh.Value()
+	if err != nil {
+		t.Fatalf("Value: %s", err)
+	}
+	if v != "CSHH" {
+		t.Errorf("expected %q, got %#v", "CSHH", v)
+	}
+
+	var scanned Alpha4Code
+	if err := scanned.Scan(v); err != nil {
+		t.Fatalf("Scan(%#v): %s", v, err)
+	}
+	if !reflect.DeepEqual(scanned, cshh) {
+		t.Errorf("expected %+v after round trip, got %+v", cshh, scanned)
+	}
+
+	if actual, err := (Alpha4Code{}).Value(); err != nil || actual != nil {
+		t.Errorf("expected nil, got %#v (err: %v)", actual, err)
+	}
+}
@@ -0,0 +1,163 @@
+// Package subdivision provides parsing and validation of ISO 3166-2
+// subdivision codes, e.g. "DE-BY" for Bavaria.
+package subdivision
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/mavolin/standards/internal/sqlutil"
+	"github.com/mavolin/standards/iso3166"
+)
+
+//go:generate go run github.com/mavolin/standards/tools/codegen/iso3166-2
+
+var ErrInvalid = errors.New("iso3166/subdivision: invalid subdivision code")
+
+// Subdivision is an ISO 3166-2 subdivision of a country, such as a state or
+// a province.
+type Subdivision struct {
+	// Code is the uppercase ISO 3166-2 code, consisting of the country's
+	// alpha-2 code and a code of up to three alphanumeric characters,
+	// separated by a hyphen, e.g. "DE-BY".
+	Code string
+	// Country is the country the subdivision belongs to.
+	Country iso3166.Alpha2Code
+	// Name is the name of the subdivision in one of the country's official
+	// languages, e.g. "Bayern".
+	Name string
+	// Category is the category of the subdivision, e.g. "State", "Region",
+	// or "Metropolitan department".
+	//
+	// Categories are given in English, and are specific to the country, e.g.
+	// German states are of category "Land", and U.S. states are of category
+	// "State".
+	Category string
+	// ParentCode is the code of the subdivision this subdivision is part
+	// of, if any.
+	//
+	// For example, French departments are part of regions, so the
+	// ParentCode of "FR-33" (Gironde) is "FR-NAQ" (Nouvelle-Aquitaine).
+	ParentCode string
+}
+
+// Parse parses an ISO 3166-2 subdivision code.
+//
+// Before parsing, the code is converted to uppercase.
+//
+// If Parse returns without an error, the code is currently assigned.
+func Parse(s string) (Subdivision, error) {
+	s = strings.ToUpper(s)
+
+	sub, ok := subdivisions[s]
+	if !ok {
+		return Subdivision{}, ErrInvalid
+	}
+
+	return sub, nil
+}
+
+// IsValid checks whether s is a valid subdivision code, as defined by
+// [Parse].
+func IsValid(s string) bool {
+	_, err := Parse(s)
+	return err == nil
+}
+
+// OfCountry returns the subdivisions of the passed country, sorted by their
+// code.
+//
+// If the country has no subdivisions, OfCountry returns nil.
+func OfCountry(country iso3166.Alpha2Code) []Subdivision {
+	var subs []Subdivision
+	for _, sub := range subdivisions {
+		if sub.Country == country {
+			subs = append(subs, sub)
+		}
+	}
+
+	sort.Slice(subs, func(i, j int) bool { return subs[i].Code < subs[j].Code })
+	return subs
+}
+
+// Parent returns the subdivision this subdivision is part of.
+//
+// If the subdivision has no parent, Parent returns false.
+func (sub Subdivision) Parent() (Subdivision, bool) {
+	if sub.ParentCode == "" {
+		return Subdivision{}, false
+	}
+
+	parent, ok := subdivisions[sub.ParentCode]
+	return parent, ok
+}
+
+func (sub Subdivision) String() string {
+	return sub.Code
+}
+
+func (sub Subdivision) Compact() string {
+	return sub.Code
+}
+
+var _ encoding.TextMarshaler = Subdivision{}
+
+func (sub Subdivision) MarshalText() ([]byte, error) {
+	return []byte(sub.Compact()), nil
+}
+
+var _ encoding.TextUnmarshaler = (*Subdivision)(nil)
+
+func (sub *Subdivision) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+
+	*sub = parsed
+	return nil
+}
+
+var _ sql.Scanner = (*Subdivision)(nil)
+
+// Scan implements [sql.Scanner] for subdivision codes stored as text, e.g.
+// "DE-BE".
+// As Parse only accepts codes that are currently assigned, Scan fails for
+// rows storing a code that has since been withdrawn.
+//
+// NULL is scanned into the zero Subdivision.
+func (sub *Subdivision) Scan(src any) error {
+	s, ok, err := sqlutil.String(src, "iso3166/subdivision", "Subdivision")
+	if err != nil {
+		return err
+	} else if !ok {
+		*sub = Subdivision{}
+		return nil
+	}
+
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+
+	*sub = parsed
+	return nil
+}
+
+var _ driver.Valuer = Subdivision{}
+
+// Value implements [driver.Valuer] by storing the full subdivision code,
+// including the country code, e.g. "DE-BE".
+//
+// The zero Subdivision is stored as NULL.
+func (sub Subdivision) Value() (driver.Value, error) {
+	if sub == (Subdivision{}) {
+		return nil, nil
+	}
+
+	return sub.Code, nil
+}
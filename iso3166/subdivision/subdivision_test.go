@@ -0,0 +1,199 @@
+package subdivision
+
+import (
+	"testing"
+
+	"github.com/mavolin/standards/iso3166"
+)
+
+func TestParse(t *testing.T) {
+	successCases := []struct {
+		In     string
+		Expect Subdivision
+	}{
+		{
+			In:     "DE-BY",
+			Expect: Subdivision{Code: "DE-BY", Country: iso3166.DE, Name: "Bayern", Category: "Land"},
+		},
+		{
+			In:     "us-ca",
+			Expect: Subdivision{Code: "US-CA", Country: iso3166.US, Name: "California", Category: "State"},
+		},
+		{
+			In: "FR-69M",
+			Expect: Subdivision{
+				Code:       "FR-69M",
+				Country:    iso3166.FR,
+				Name:       "Métropole de Lyon",
+				Category:   "Metropolitan collectivity with special status",
+				ParentCode: "FR-ARA",
+			},
+		},
+		{
+			In: "FR-6AE",
+			Expect: Subdivision{
+				Code:       "FR-6AE",
+				Country:    iso3166.FR,
+				Name:       "Alsace",
+				Category:   "European collectivity",
+				ParentCode: "FR-GES",
+			},
+		},
+		{
+			In: "FR-75C",
+			Expect: Subdivision{
+				Code:       "FR-75C",
+				Country:    iso3166.FR,
+				Name:       "Paris",
+				Category:   "Metropolitan collectivity with special status",
+				ParentCode: "FR-IDF",
+			},
+		},
+	}
+
+	for _, c := range successCases {
+		t.Run(c.In, func(t *testing.T) {
+			actual, err := Parse(c.In)
+			if err != nil {
+				t.Fatalf("Parse(%q): %s", c.In, err)
+			}
+
+			if actual != c.Expect {
+				t.Errorf("Parse(%q): expected %+v, got %+v", c.In, c.Expect, actual)
+			}
+		})
+	}
+
+	failureCases := []string{"", "DE", "DE-", "DE-XX", "XX-BY", "DEBY", "FR-75"}
+
+	for _, c := range failureCases {
+		t.Run(c, func(t *testing.T) {
+			if _, err := Parse(c); err == nil {
+				t.Errorf("Parse(%q): expected an error", c)
+			}
+		})
+	}
+}
+
+func TestSubdivision_Parent(t *testing.T) {
+	sub, err := Parse("FR-75C")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parent, ok := sub.Parent()
+	if !ok {
+		t.Fatal("expected FR-75C to have a parent")
+	}
+
+	if parent.Code != "FR-IDF" {
+		t.Errorf("expected parent FR-IDF, got %s", parent.Code)
+	}
+
+	if _, ok := parent.Parent(); ok {
+		t.Error("expected FR-IDF to have no parent")
+	}
+}
+
+func TestOfCountry(t *testing.T) {
+	subs := OfCountry(iso3166.DE)
+	if len(subs) != 16 {
+		t.Fatalf("expected 16 German states, got %d", len(subs))
+	}
+
+	for i, sub := range subs {
+		if sub.Country != iso3166.DE {
+			t.Errorf("%s: expected country DE, got %s", sub.Code, sub.Country)
+		}
+
+		if i > 0 && subs[i-1].Code >= sub.Code {
+			t.Errorf("expected subdivisions to be sorted, but %s comes before %s", subs[i-1].Code, sub.Code)
+		}
+	}
+
+	if subs := OfCountry(iso3166.AQ); subs != nil {
+		t.Errorf("expected no subdivisions for AQ, got %d", len(subs))
+	}
+}
+
+func TestSubdivisions(t *testing.T) {
+	// every parent should be a subdivision of the same country
+	for code, sub := range subdivisions {
+		if code != sub.Code {
+			t.Errorf("%s: key doesn't match code %s", code, sub.Code)
+		}
+
+		if sub.ParentCode == "" {
+			continue
+		}
+
+		parent, ok := sub.Parent()
+		if !ok {
+			t.Errorf("%s: unknown parent %s", code, sub.ParentCode)
+		} else if parent.Country != sub.Country {
+			t.Errorf("%s: parent %s belongs to a different country", code, parent.Code)
+		}
+	}
+}
+
+func TestSubdivision_Scan(t *testing.T) {
+	by := Subdivision{Code: "DE-BY", Country: iso3166.DE, Name: "Bayern", Category: "Land"}
+
+	testCases := []struct {
+		Name   string
+		In     any
+		Expect Subdivision
+	}{
+		{Name: "string", In: "DE-BY", Expect: by},
+		{Name: "lowercase", In: "de-by", Expect: by},
+		{Name: "bytes", In: []byte("DE-BY"), Expect: by},
+		{Name: "null", In: nil, Expect: Subdivision{}},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.Name, func(t *testing.T) {
+			sub := Subdivision{Code: "US-CA", Country: iso3166.US, Name: "California", Category: "State"}
+			if err := sub.Scan(c.In); err != nil {
+				t.Fatalf("Scan(%#v): %s", c.In, err)
+			}
+
+			if sub != c.Expect {
+				t.Errorf("expected %+v, got %+v", c.Expect, sub)
+			}
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		var sub Subdivision
+		if err := sub.Scan("DE-XX"); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestSubdivision_Value(t *testing.T) {
+	sub, err := Parse("fr-75c")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v, err := sub.Value()
+	if err != nil {
+		t.Fatalf("Value: %s", err)
+	}
+	if v != "FR-75C" {
+		t.Errorf("expected %q, got %#v", "FR-75C", v)
+	}
+
+	var scanned Subdivision
+	if err := scanned.Scan(v); err != nil {
+		t.Fatalf("Scan(%#v): %s", v, err)
+	}
+	if scanned != sub {
+		t.Errorf("expected %+v after round trip, got %+v", sub, scanned)
+	}
+
+	if actual, err := (Subdivision{}).Value(); err != nil || actual != nil {
+		t.Errorf("expected nil, got %#v (err: %v)", actual, err)
+	}
+}
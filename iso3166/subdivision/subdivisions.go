@@ -0,0 +1,5137 @@
+package subdivision
+
+import "github.com/mavolin/standards/iso3166"
+
+// Code generated by tools/codegen/iso3166-2. DO NOT EDIT.
+
+var subdivisions = map[string]Subdivision{
+	"AD-02":  {Code: "AD-02", Country: iso3166.AD, Name: "Canillo", Category: "Parish"},
+	"AD-03":  {Code: "AD-03", Country: iso3166.AD, Name: "Encamp", Category: "Parish"},
+	"AD-04":  {Code: "AD-04", Country: iso3166.AD, Name: "La Massana", Category: "Parish"},
+	"AD-05":  {Code: "AD-05", Country: iso3166.AD, Name: "Ordino", Category: "Parish"},
+	"AD-06":  {Code: "AD-06", Country: iso3166.AD, Name: "Sant Julià de Lòria", Category: "Parish"},
+	"AD-07":  {Code: "AD-07", Country: iso3166.AD, Name: "Andorra la Vella", Category: "Parish"},
+	"AD-08":  {Code: "AD-08", Country: iso3166.AD, Name: "Escaldes-Engordany", Category: "Parish"},
+	"AE-AJ":  {Code: "AE-AJ", Country: iso3166.AE, Name: "‘Ajmān", Category: "Emirate"},
+	"AE-AZ":  {Code: "AE-AZ", Country: iso3166.AE, Name: "Abū Z̧aby", Category: "Emirate"},
+	"AE-DU":  {Code: "AE-DU", Country: iso3166.AE, Name: "Dubayy", Category: "Emirate"},
+	"AE-FU":  {Code: "AE-FU", Country: iso3166.AE, Name: "Al Fujayrah", Category: "Emirate"},
+	"AE-RK":  {Code: "AE-RK", Country: iso3166.AE, Name: "Ra’s al Khaymah", Category: "Emirate"},
+	"AE-SH":  {Code: "AE-SH", Country: iso3166.AE, Name: "Ash Shāriqah", Category: "Emirate"},
+	"AE-UQ":  {Code: "AE-UQ", Country: iso3166.AE, Name: "Umm al Qaywayn", Category: "Emirate"},
+	"AF-BAL": {Code: "AF-BAL", Country: iso3166.AF, Name: "Balkh", Category: "Province"},
+	"AF-BAM": {Code: "AF-BAM", Country: iso3166.AF, Name: "Bāmyān", Category: "Province"},
+	"AF-BDG": {Code: "AF-BDG", Country: iso3166.AF, Name: "Bādghīs", Category: "Province"},
+	"AF-BDS": {Code: "AF-BDS", Country: iso3166.AF, Name: "Badakhshān", Category: "Province"},
+	"AF-BGL": {Code: "AF-BGL", Country: iso3166.AF, Name: "Baghlān", Category: "Province"},
+	"AF-DAY": {Code: "AF-DAY", Country: iso3166.AF, Name: "Dāykundī", Category: "Province"},
+	"AF-FRA": {Code: "AF-FRA", Country: iso3166.AF, Name: "Farāh", Category: "Province"},
+	"AF-FYB": {Code: "AF-FYB", Country: iso3166.AF, Name: "Fāryāb", Category: "Province"},
+	"AF-GHA": {Code: "AF-GHA", Country: iso3166.AF, Name: "Ghaznī", Category: "Province"},
+	"AF-GHO": {Code: "AF-GHO", Country: iso3166.AF, Name: "Ghōr", Category: "Province"},
+	"AF-HEL": {Code: "AF-HEL", Country: iso3166.AF, Name: "Helmand", Category: "Province"},
+	"AF-HER": {Code: "AF-HER", Country: iso3166.AF, Name: "Herāt", Category: "Province"},
+	"AF-JOW": {Code: "AF-JOW", Country: iso3166.AF, Name: "Jowzjān", Category: "Province"},
+	"AF-KAB": {Code: "AF-KAB", Country: iso3166.AF, Name: "Kābul", Category: "Province"},
+	"AF-KAN": {Code: "AF-KAN", Country: iso3166.AF, Name: "Kandahār", Category: "Province"},
+	"AF-KAP": {Code: "AF-KAP", Country: iso3166.AF, Name: "Kāpīsā", Category: "Province"},
+	"AF-KDZ": {Code: "AF-KDZ", Country: iso3166.AF, Name: "Kunduz", Category: "Province"},
+	"AF-KHO": {Code: "AF-KHO", Country: iso3166.AF, Name: "Khōst", Category: "Province"},
+	"AF-KNR": {Code: "AF-KNR", Country: iso3166.AF, Name: "Kunaṟ", Category: "Province"},
+	"AF-LAG": {Code: "AF-LAG", Country: iso3166.AF, Name: "Laghmān", Category: "Province"},
+	"AF-LOG": {Code: "AF-LOG", Country: iso3166.AF, Name: "Lōgar", Category: "Province"},
+	"AF-NAN": {Code: "AF-NAN", Country: iso3166.AF, Name: "Nangarhār", Category: "Province"},
+	"AF-NIM": {Code: "AF-NIM", Country: iso3166.AF, Name: "Nīmrōz", Category: "Province"},
+	"AF-NUR": {Code: "AF-NUR", Country: iso3166.AF, Name: "Nūristān", Category: "Province"},
+	"AF-PAN": {Code: "AF-PAN", Country: iso3166.AF, Name: "Panjshayr", Category: "Province"},
+	"AF-PAR": {Code: "AF-PAR", Country: iso3166.AF, Name: "Parwān", Category: "Province"},
+	"AF-PIA": {Code: "AF-PIA", Country: iso3166.AF, Name: "Paktiyā", Category: "Province"},
+	"AF-PKA": {Code: "AF-PKA", Country: iso3166.AF, Name: "Paktīkā", Category: "Province"},
+	"AF-SAM": {Code: "AF-SAM", Country: iso3166.AF, Name: "Samangān", Category: "Province"},
+	"AF-SAR": {Code: "AF-SAR", Country: iso3166.AF, Name: "Sar-e Pul", Category: "Province"},
+	"AF-TAK": {Code: "AF-TAK", Country: iso3166.AF, Name: "Takhār", Category: "Province"},
+	"AF-URU": {Code: "AF-URU", Country: iso3166.AF, Name: "Uruzgān", Category: "Province"},
+	"AF-WAR": {Code: "AF-WAR", Country: iso3166.AF, Name: "Wardak", Category: "Province"},
+	"AF-ZAB": {Code: "AF-ZAB", Country: iso3166.AF, Name: "Zābul", Category: "Province"},
+	"AG-03":  {Code: "AG-03", Country: iso3166.AG, Name: "Saint George", Category: "Parish"},
+	"AG-04":  {Code: "AG-04", Country: iso3166.AG, Name: "Saint John", Category: "Parish"},
+	"AG-05":  {Code: "AG-05", Country: iso3166.AG, Name: "Saint Mary", Category: "Parish"},
+	"AG-06":  {Code: "AG-06", Country: iso3166.AG, Name: "Saint Paul", Category: "Parish"},
+	"AG-07":  {Code: "AG-07", Country: iso3166.AG, Name: "Saint Peter", Category: "Parish"},
+	"AG-08":  {Code: "AG-08", Country: iso3166.AG, Name: "Saint Philip", Category: "Parish"},
+	"AG-10":  {Code: "AG-10", Country: iso3166.AG, Name: "Barbuda", Category: "Dependency"},
+	"AG-11":  {Code: "AG-11", Country: iso3166.AG, Name: "Redonda", Category: "Dependency"},
+	"AL-01":  {Code: "AL-01", Country: iso3166.AL, Name: "Berat", Category: "County"},
+	"AL-02":  {Code: "AL-02", Country: iso3166.AL, Name: "Durrës", Category: "County"},
+	"AL-03":  {Code: "AL-03", Country: iso3166.AL, Name: "Elbasan", Category: "County"},
+	"AL-04":  {Code: "AL-04", Country: iso3166.AL, Name: "Fier", Category: "County"},
+	"AL-05":  {Code: "AL-05", Country: iso3166.AL, Name: "Gjirokastër", Category: "County"},
+	"AL-06":  {Code: "AL-06", Country: iso3166.AL, Name: "Korçë", Category: "County"},
+	"AL-07":  {Code: "AL-07", Country: iso3166.AL, Name: "Kukës", Category: "County"},
+	"AL-08":  {Code: "AL-08", Country: iso3166.AL, Name: "Lezhë", Category: "County"},
+	"AL-09":  {Code: "AL-09", Country: iso3166.AL, Name: "Dibër", Category: "County"},
+	"AL-10":  {Code: "AL-10", Country: iso3166.AL, Name: "Shkodër", Category: "County"},
+	"AL-11":  {Code: "AL-11", Country: iso3166.AL, Name: "Tiranë", Category: "County"},
+	"AL-12":  {Code: "AL-12", Country: iso3166.AL, Name: "Vlorë", Category: "County"},
+	"AM-AG":  {Code: "AM-AG", Country: iso3166.AM, Name: "Aragac̣otn", Category: "Region"},
+	"AM-AR":  {Code: "AM-AR", Country: iso3166.AM, Name: "Ararat", Category: "Region"},
+	"AM-AV":  {Code: "AM-AV", Country: iso3166.AM, Name: "Armavir", Category: "Region"},
+	"AM-ER":  {Code: "AM-ER", Country: iso3166.AM, Name: "Erevan", Category: "City"},
+	"AM-GR":  {Code: "AM-GR", Country: iso3166.AM, Name: "Geġark'unik'", Category: "Region"},
+	"AM-KT":  {Code: "AM-KT", Country: iso3166.AM, Name: "Kotayk'", Category: "Region"},
+	"AM-LO":  {Code: "AM-LO", Country: iso3166.AM, Name: "Loṙi", Category: "Region"},
+	"AM-SH":  {Code: "AM-SH", Country: iso3166.AM, Name: "Širak", Category: "Region"},
+	"AM-SU":  {Code: "AM-SU", Country: iso3166.AM, Name: "Syunik'", Category: "Region"},
+	"AM-TV":  {Code: "AM-TV", Country: iso3166.AM, Name: "Tavuš", Category: "Region"},
+	"AM-VD":  {Code: "AM-VD", Country: iso3166.AM, Name: "Vayoć Jor", Category: "Region"},
+	"AO-BGO": {Code: "AO-BGO", Country: iso3166.AO, Name: "Bengo", Category: "Province"},
+	"AO-BGU": {Code: "AO-BGU", Country: iso3166.AO, Name: "Benguela", Category: "Province"},
+	"AO-BIE": {Code: "AO-BIE", Country: iso3166.AO, Name: "Bié", Category: "Province"},
+	"AO-CAB": {Code: "AO-CAB", Country: iso3166.AO, Name: "Cabinda", Category: "Province"},
+	"AO-CCU": {Code: "AO-CCU", Country: iso3166.AO, Name: "Cuando Cubango", Category: "Province"},
+	"AO-CNN": {Code: "AO-CNN", Country: iso3166.AO, Name: "Cunene", Category: "Province"},
+	"AO-CNO": {Code: "AO-CNO", Country: iso3166.AO, Name: "Cuanza-Norte", Category: "Province"},
+	"AO-CUS": {Code: "AO-CUS", Country: iso3166.AO, Name: "Cuanza-Sul", Category: "Province"},
+	"AO-HUA": {Code: "AO-HUA", Country: iso3166.AO, Name: "Huambo", Category: "Province"},
+	"AO-HUI": {Code: "AO-HUI", Country: iso3166.AO, Name: "Huíla", Category: "Province"},
+	"AO-LNO": {Code: "AO-LNO", Country: iso3166.AO, Name: "Lunda-Norte", Category: "Province"},
+	"AO-LSU": {Code: "AO-LSU", Country: iso3166.AO, Name: "Lunda-Sul", Category: "Province"},
+	"AO-LUA": {Code: "AO-LUA", Country: iso3166.AO, Name: "Luanda", Category: "Province"},
+	"AO-MAL": {Code: "AO-MAL", Country: iso3166.AO, Name: "Malange", Category: "Province"},
+	"AO-MOX": {Code: "AO-MOX", Country: iso3166.AO, Name: "Moxico", Category: "Province"},
+	"AO-NAM": {Code: "AO-NAM", Country: iso3166.AO, Name: "Namibe", Category: "Province"},
+	"AO-UIG": {Code: "AO-UIG", Country: iso3166.AO, Name: "Uíge", Category: "Province"},
+	"AO-ZAI": {Code: "AO-ZAI", Country: iso3166.AO, Name: "Zaire", Category: "Province"},
+	"AR-A":   {Code: "AR-A", Country: iso3166.AR, Name: "Salta", Category: "Province"},
+	"AR-B":   {Code: "AR-B", Country: iso3166.AR, Name: "Buenos Aires", Category: "Province"},
+	"AR-C":   {Code: "AR-C", Country: iso3166.AR, Name: "Ciudad Autónoma de Buenos Aires", Category: "City"},
+	"AR-D":   {Code: "AR-D", Country: iso3166.AR, Name: "San Luis", Category: "Province"},
+	"AR-E":   {Code: "AR-E", Country: iso3166.AR, Name: "Entre Ríos", Category: "Province"},
+	"AR-F":   {Code: "AR-F", Country: iso3166.AR, Name: "La Rioja", Category: "Province"},
+	"AR-G":   {Code: "AR-G", Country: iso3166.AR, Name: "Santiago del Estero", Category: "Province"},
+	"AR-H":   {Code: "AR-H", Country: iso3166.AR, Name: "Chaco", Category: "Province"},
+	"AR-J":   {Code: "AR-J", Country: iso3166.AR, Name: "San Juan", Category: "Province"},
+	"AR-K":   {Code: "AR-K", Country: iso3166.AR, Name: "Catamarca", Category: "Province"},
+	"AR-L":   {Code: "AR-L", Country: iso3166.AR, Name: "La Pampa", Category: "Province"},
+	"AR-M":   {Code: "AR-M", Country: iso3166.AR, Name: "Mendoza", Category: "Province"},
+	"AR-N":   {Code: "AR-N", Country: iso3166.AR, Name: "Misiones", Category: "Province"},
+	"AR-P":   {Code: "AR-P", Country: iso3166.AR, Name: "Formosa", Category: "Province"},
+	"AR-Q":   {Code: "AR-Q", Country: iso3166.AR, Name: "Neuquén", Category: "Province"},
+	"AR-R":   {Code: "AR-R", Country: iso3166.AR, Name: "Río Negro", Category: "Province"},
+	"AR-S":   {Code: "AR-S", Country: iso3166.AR, Name: "Santa Fe", Category: "Province"},
+	"AR-T":   {Code: "AR-T", Country: iso3166.AR, Name: "Tucumán", Category: "Province"},
+	"AR-U":   {Code: "AR-U", Country: iso3166.AR, Name: "Chubut", Category: "Province"},
+	"AR-V":   {Code: "AR-V", Country: iso3166.AR, Name: "Tierra del Fuego", Category: "Province"},
+	"AR-W":   {Code: "AR-W", Country: iso3166.AR, Name: "Corrientes", Category: "Province"},
+	"AR-X":   {Code: "AR-X", Country: iso3166.AR, Name: "Córdoba", Category: "Province"},
+	"AR-Y":   {Code: "AR-Y", Country: iso3166.AR, Name: "Jujuy", Category: "Province"},
+	"AR-Z":   {Code: "AR-Z", Country: iso3166.AR, Name: "Santa Cruz", Category: "Province"},
+	"AT-1":   {Code: "AT-1", Country: iso3166.AT, Name: "Burgenland", Category: "State"},
+	"AT-2":   {Code: "AT-2", Country: iso3166.AT, Name: "Kärnten", Category: "State"},
+	"AT-3":   {Code: "AT-3", Country: iso3166.AT, Name: "Niederösterreich", Category: "State"},
+	"AT-4":   {Code: "AT-4", Country: iso3166.AT, Name: "Oberösterreich", Category: "State"},
+	"AT-5":   {Code: "AT-5", Country: iso3166.AT, Name: "Salzburg", Category: "State"},
+	"AT-6":   {Code: "AT-6", Country: iso3166.AT, Name: "Steiermark", Category: "State"},
+	"AT-7":   {Code: "AT-7", Country: iso3166.AT, Name: "Tirol", Category: "State"},
+	"AT-8":   {Code: "AT-8", Country: iso3166.AT, Name: "Vorarlberg", Category: "State"},
+	"AT-9":   {Code: "AT-9", Country: iso3166.AT, Name: "Wien", Category: "State"},
+	"AU-ACT": {Code: "AU-ACT", Country: iso3166.AU, Name: "Australian Capital Territory", Category: "Territory"},
+	"AU-NSW": {Code: "AU-NSW", Country: iso3166.AU, Name: "New South Wales", Category: "State"},
+	"AU-NT":  {Code: "AU-NT", Country: iso3166.AU, Name: "Northern Territory", Category: "Territory"},
+	"AU-QLD": {Code: "AU-QLD", Country: iso3166.AU, Name: "Queensland", Category: "State"},
+	"AU-SA":  {Code: "AU-SA", Country: iso3166.AU, Name: "South Australia", Category: "State"},
+	"AU-TAS": {Code: "AU-TAS", Country: iso3166.AU, Name: "Tasmania", Category: "State"},
+	"AU-VIC": {Code: "AU-VIC", Country: iso3166.AU, Name: "Victoria", Category: "State"},
+	"AU-WA":  {Code: "AU-WA", Country: iso3166.AU, Name: "Western Australia", Category: "State"},
+	"AZ-ABS": {Code: "AZ-ABS", Country: iso3166.AZ, Name: "Abşeron", Category: "Rayon"},
+	"AZ-AGA": {Code: "AZ-AGA", Country: iso3166.AZ, Name: "Ağstafa", Category: "Rayon"},
+	"AZ-AGC": {Code: "AZ-AGC", Country: iso3166.AZ, Name: "Ağcabədi", Category: "Rayon"},
+	"AZ-AGM": {Code: "AZ-AGM", Country: iso3166.AZ, Name: "Ağdam", Category: "Rayon"},
+	"AZ-AGS": {Code: "AZ-AGS", Country: iso3166.AZ, Name: "Ağdaş", Category: "Rayon"},
+	"AZ-AGU": {Code: "AZ-AGU", Country: iso3166.AZ, Name: "Ağsu", Category: "Rayon"},
+	"AZ-AST": {Code: "AZ-AST", Country: iso3166.AZ, Name: "Astara", Category: "Rayon"},
+	"AZ-BA":  {Code: "AZ-BA", Country: iso3166.AZ, Name: "Bakı", Category: "Municipality"},
+	"AZ-BAB": {Code: "AZ-BAB", Country: iso3166.AZ, Name: "Babək", Category: "Rayon", ParentCode: "AZ-NX"},
+	"AZ-BAL": {Code: "AZ-BAL", Country: iso3166.AZ, Name: "Balakən", Category: "Rayon"},
+	"AZ-BAR": {Code: "AZ-BAR", Country: iso3166.AZ, Name: "Bərdə", Category: "Rayon"},
+	"AZ-BEY": {Code: "AZ-BEY", Country: iso3166.AZ, Name: "Beyləqan", Category: "Rayon"},
+	"AZ-BIL": {Code: "AZ-BIL", Country: iso3166.AZ, Name: "Biləsuvar", Category: "Rayon"},
+	"AZ-CAB": {Code: "AZ-CAB", Country: iso3166.AZ, Name: "Cəbrayıl", Category: "Rayon"},
+	"AZ-CAL": {Code: "AZ-CAL", Country: iso3166.AZ, Name: "Cəlilabad", Category: "Rayon"},
+	"AZ-CUL": {Code: "AZ-CUL", Country: iso3166.AZ, Name: "Culfa", Category: "Rayon", ParentCode: "AZ-NX"},
+	"AZ-DAS": {Code: "AZ-DAS", Country: iso3166.AZ, Name: "Daşkəsən", Category: "Rayon"},
+	"AZ-FUZ": {Code: "AZ-FUZ", Country: iso3166.AZ, Name: "Füzuli", Category: "Rayon"},
+	"AZ-GA":  {Code: "AZ-GA", Country: iso3166.AZ, Name: "Gəncə", Category: "Municipality"},
+	"AZ-GAD": {Code: "AZ-GAD", Country: iso3166.AZ, Name: "Gədəbəy", Category: "Rayon"},
+	"AZ-GOR": {Code: "AZ-GOR", Country: iso3166.AZ, Name: "Goranboy", Category: "Rayon"},
+	"AZ-GOY": {Code: "AZ-GOY", Country: iso3166.AZ, Name: "Göyçay", Category: "Rayon"},
+	"AZ-GYG": {Code: "AZ-GYG", Country: iso3166.AZ, Name: "Göygöl", Category: "Rayon"},
+	"AZ-HAC": {Code: "AZ-HAC", Country: iso3166.AZ, Name: "Hacıqabul", Category: "Rayon"},
+	"AZ-IMI": {Code: "AZ-IMI", Country: iso3166.AZ, Name: "İmişli", Category: "Rayon"},
+	"AZ-ISM": {Code: "AZ-ISM", Country: iso3166.AZ, Name: "İsmayıllı", Category: "Rayon"},
+	"AZ-KAL": {Code: "AZ-KAL", Country: iso3166.AZ, Name: "Kəlbəcər", Category: "Rayon"},
+	"AZ-KAN": {Code: "AZ-KAN", Country: iso3166.AZ, Name: "Kǝngǝrli", Category: "Rayon", ParentCode: "AZ-NX"},
+	"AZ-KUR": {Code: "AZ-KUR", Country: iso3166.AZ, Name: "Kürdəmir", Category: "Rayon"},
+	"AZ-LA":  {Code: "AZ-LA", Country: iso3166.AZ, Name: "Lənkəran", Category: "Municipality"},
+	"AZ-LAC": {Code: "AZ-LAC", Country: iso3166.AZ, Name: "Laçın", Category: "Rayon"},
+	"AZ-LAN": {Code: "AZ-LAN", Country: iso3166.AZ, Name: "Lənkəran", Category: "Rayon"},
+	"AZ-LER": {Code: "AZ-LER", Country: iso3166.AZ, Name: "Lerik", Category: "Rayon"},
+	"AZ-MAS": {Code: "AZ-MAS", Country: iso3166.AZ, Name: "Masallı", Category: "Rayon"},
+	"AZ-MI":  {Code: "AZ-MI", Country: iso3166.AZ, Name: "Mingəçevir", Category: "Municipality"},
+	"AZ-NA":  {Code: "AZ-NA", Country: iso3166.AZ, Name: "Naftalan", Category: "Municipality"},
+	"AZ-NEF": {Code: "AZ-NEF", Country: iso3166.AZ, Name: "Neftçala", Category: "Rayon"},
+	"AZ-NV":  {Code: "AZ-NV", Country: iso3166.AZ, Name: "Naxçıvan", Category: "Municipality", ParentCode: "AZ-NX"},
+	"AZ-NX":  {Code: "AZ-NX", Country: iso3166.AZ, Name: "Naxçıvan", Category: "Autonomous republic"},
+	"AZ-OGU": {Code: "AZ-OGU", Country: iso3166.AZ, Name: "Oğuz", Category: "Rayon"},
+	"AZ-ORD": {Code: "AZ-ORD", Country: iso3166.AZ, Name: "Ordubad", Category: "Rayon", ParentCode: "AZ-NX"},
+	"AZ-QAB": {Code: "AZ-QAB", Country: iso3166.AZ, Name: "Qəbələ", Category: "Rayon"},
+	"AZ-QAX": {Code: "AZ-QAX", Country: iso3166.AZ, Name: "Qax", Category: "Rayon"},
+	"AZ-QAZ": {Code: "AZ-QAZ", Country: iso3166.AZ, Name: "Qazax", Category: "Rayon"},
+	"AZ-QBA": {Code: "AZ-QBA", Country: iso3166.AZ, Name: "Quba", Category: "Rayon"},
+	"AZ-QBI": {Code: "AZ-QBI", Country: iso3166.AZ, Name: "Qubadlı", Category: "Rayon"},
+	"AZ-QOB": {Code: "AZ-QOB", Country: iso3166.AZ, Name: "Qobustan", Category: "Rayon"},
+	"AZ-QUS": {Code: "AZ-QUS", Country: iso3166.AZ, Name: "Qusar", Category: "Rayon"},
+	"AZ-SA":  {Code: "AZ-SA", Country: iso3166.AZ, Name: "Şəki", Category: "Municipality"},
+	"AZ-SAB": {Code: "AZ-SAB", Country: iso3166.AZ, Name: "Sabirabad", Category: "Rayon"},
+	"AZ-SAD": {Code: "AZ-SAD", Country: iso3166.AZ, Name: "Sədərək", Category: "Rayon", ParentCode: "AZ-NX"},
+	"AZ-SAH": {Code: "AZ-SAH", Country: iso3166.AZ, Name: "Şahbuz", Category: "Rayon", ParentCode: "AZ-NX"},
+	"AZ-SAK": {Code: "AZ-SAK", Country: iso3166.AZ, Name: "Şəki", Category: "Rayon"},
+	"AZ-SAL": {Code: "AZ-SAL", Country: iso3166.AZ, Name: "Salyan", Category: "Rayon"},
+	"AZ-SAR": {Code: "AZ-SAR", Country: iso3166.AZ, Name: "Şərur", Category: "Rayon", ParentCode: "AZ-NX"},
+	"AZ-SAT": {Code: "AZ-SAT", Country: iso3166.AZ, Name: "Saatlı", Category: "Rayon"},
+	"AZ-SBN": {Code: "AZ-SBN", Country: iso3166.AZ, Name: "Şabran", Category: "Rayon"},
+	"AZ-SIY": {Code: "AZ-SIY", Country: iso3166.AZ, Name: "Siyəzən", Category: "Rayon"},
+	"AZ-SKR": {Code: "AZ-SKR", Country: iso3166.AZ, Name: "Şəmkir", Category: "Rayon"},
+	"AZ-SM":  {Code: "AZ-SM", Country: iso3166.AZ, Name: "Sumqayıt", Category: "Municipality"},
+	"AZ-SMI": {Code: "AZ-SMI", Country: iso3166.AZ, Name: "Şamaxı", Category: "Rayon"},
+	"AZ-SMX": {Code: "AZ-SMX", Country: iso3166.AZ, Name: "Samux", Category: "Rayon"},
+	"AZ-SR":  {Code: "AZ-SR", Country: iso3166.AZ, Name: "Şirvan", Category: "Municipality"},
+	"AZ-SUS": {Code: "AZ-SUS", Country: iso3166.AZ, Name: "Şuşa", Category: "Rayon"},
+	"AZ-TAR": {Code: "AZ-TAR", Country: iso3166.AZ, Name: "Tərtər", Category: "Rayon"},
+	"AZ-TOV": {Code: "AZ-TOV", Country: iso3166.AZ, Name: "Tovuz", Category: "Rayon"},
+	"AZ-UCA": {Code: "AZ-UCA", Country: iso3166.AZ, Name: "Ucar", Category: "Rayon"},
+	"AZ-XA":  {Code: "AZ-XA", Country: iso3166.AZ, Name: "Xankəndi", Category: "Municipality"},
+	"AZ-XAC": {Code: "AZ-XAC", Country: iso3166.AZ, Name: "Xaçmaz", Category: "Rayon"},
+	"AZ-XCI": {Code: "AZ-XCI", Country: iso3166.AZ, Name: "Xocalı", Category: "Rayon"},
+	"AZ-XIZ": {Code: "AZ-XIZ", Country: iso3166.AZ, Name: "Xızı", Category: "Rayon"},
+	"AZ-XVD": {Code: "AZ-XVD", Country: iso3166.AZ, Name: "Xocavənd", Category: "Rayon"},
+	"AZ-YAR": {Code: "AZ-YAR", Country: iso3166.AZ, Name: "Yardımlı", Category: "Rayon"},
+	"AZ-YE":  {Code: "AZ-YE", Country: iso3166.AZ, Name: "Yevlax", Category: "Municipality"},
+	"AZ-YEV": {Code: "AZ-YEV", Country: iso3166.AZ, Name: "Yevlax", Category: "Rayon"},
+	"AZ-ZAN": {Code: "AZ-ZAN", Country: iso3166.AZ, Name: "Zəngilan", Category: "Rayon"},
+	"AZ-ZAQ": {Code: "AZ-ZAQ", Country: iso3166.AZ, Name: "Zaqatala", Category: "Rayon"},
+	"AZ-ZAR": {Code: "AZ-ZAR", Country: iso3166.AZ, Name: "Zərdab", Category: "Rayon"},
+	"BA-BIH": {Code: "BA-BIH", Country: iso3166.BA, Name: "Federacija Bosne i Hercegovine", Category: "Entity"},
+	"BA-BRC": {Code: "BA-BRC", Country: iso3166.BA, Name: "Brčko distrikt", Category: "District with special status"},
+	"BA-SRP": {Code: "BA-SRP", Country: iso3166.BA, Name: "Republika Srpska", Category: "Entity"},
+	"BB-01":  {Code: "BB-01", Country: iso3166.BB, Name: "Christ Church", Category: "Parish"},
+	"BB-02":  {Code: "BB-02", Country: iso3166.BB, Name: "Saint Andrew", Category: "Parish"},
+	"BB-03":  {Code: "BB-03", Country: iso3166.BB, Name: "Saint George", Category: "Parish"},
+	"BB-04":  {Code: "BB-04", Country: iso3166.BB, Name: "Saint James", Category: "Parish"},
+	"BB-05":  {Code: "BB-05", Country: iso3166.BB, Name: "Saint John", Category: "Parish"},
+	"BB-06":  {Code: "BB-06", Country: iso3166.BB, Name: "Saint Joseph", Category: "Parish"},
+	"BB-07":  {Code: "BB-07", Country: iso3166.BB, Name: "Saint Lucy", Category: "Parish"},
+	"BB-08":  {Code: "BB-08", Country: iso3166.BB, Name: "Saint Michael", Category: "Parish"},
+	"BB-09":  {Code: "BB-09", Country: iso3166.BB, Name: "Saint Peter", Category: "Parish"},
+	"BB-10":  {Code: "BB-10", Country: iso3166.BB, Name: "Saint Philip", Category: "Parish"},
+	"BB-11":  {Code: "BB-11", Country: iso3166.BB, Name: "Saint Thomas", Category: "Parish"},
+	"BD-01":  {Code: "BD-01", Country: iso3166.BD, Name: "Bandarban", Category: "District", ParentCode: "BD-B"},
+	"BD-02":  {Code: "BD-02", Country: iso3166.BD, Name: "Barguna", Category: "District", ParentCode: "BD-A"},
+	"BD-03":  {Code: "BD-03", Country: iso3166.BD, Name: "Bogura", Category: "District", ParentCode: "BD-E"},
+	"BD-04":  {Code: "BD-04", Country: iso3166.BD, Name: "Brahmanbaria", Category: "District", ParentCode: "BD-B"},
+	"BD-05":  {Code: "BD-05", Country: iso3166.BD, Name: "Bagerhat", Category: "District", ParentCode: "BD-D"},
+	"BD-06":  {Code: "BD-06", Country: iso3166.BD, Name: "Barishal", Category: "District", ParentCode: "BD-A"},
+	"BD-07":  {Code: "BD-07", Country: iso3166.BD, Name: "Bhola", Category: "District", ParentCode: "BD-A"},
+	"BD-08":  {Code: "BD-08", Country: iso3166.BD, Name: "Cumilla", Category: "District", ParentCode: "BD-B"},
+	"BD-09":  {Code: "BD-09", Country: iso3166.BD, Name: "Chandpur", Category: "District", ParentCode: "BD-B"},
+	"BD-10":  {Code: "BD-10", Country: iso3166.BD, Name: "Chattogram", Category: "District", ParentCode: "BD-B"},
+	"BD-11":  {Code: "BD-11", Country: iso3166.BD, Name: "Cox's Bazar", Category: "District", ParentCode: "BD-B"},
+	"BD-12":  {Code: "BD-12", Country: iso3166.BD, Name: "Chuadanga", Category: "District", ParentCode: "BD-D"},
+	"BD-13":  {Code: "BD-13", Country: iso3166.BD, Name: "Dhaka", Category: "District", ParentCode: "BD-C"},
+	"BD-14":  {Code: "BD-14", Country: iso3166.BD, Name: "Dinajpur", Category: "District", ParentCode: "BD-F"},
+	"BD-15":  {Code: "BD-15", Country: iso3166.BD, Name: "Faridpur", Category: "District", ParentCode: "BD-C"},
+	"BD-16":  {Code: "BD-16", Country: iso3166.BD, Name: "Feni", Category: "District", ParentCode: "BD-B"},
+	"BD-17":  {Code: "BD-17", Country: iso3166.BD, Name: "Gopalganj", Category: "District", ParentCode: "BD-C"},
+	"BD-18":  {Code: "BD-18", Country: iso3166.BD, Name: "Gazipur", Category: "District", ParentCode: "BD-C"},
+	"BD-19":  {Code: "BD-19", Country: iso3166.BD, Name: "Gaibandha", Category: "District", ParentCode: "BD-F"},
+	"BD-20":  {Code: "BD-20", Country: iso3166.BD, Name: "Habiganj", Category: "District", ParentCode: "BD-G"},
+	"BD-21":  {Code: "BD-21", Country: iso3166.BD, Name: "Jamalpur", Category: "District", ParentCode: "BD-H"},
+	"BD-22":  {Code: "BD-22", Country: iso3166.BD, Name: "Jashore", Category: "District", ParentCode: "BD-D"},
+	"BD-23":  {Code: "BD-23", Country: iso3166.BD, Name: "Jhenaidah", Category: "District", ParentCode: "BD-D"},
+	"BD-24":  {Code: "BD-24", Country: iso3166.BD, Name: "Joypurhat", Category: "District", ParentCode: "BD-E"},
+	"BD-25":  {Code: "BD-25", Country: iso3166.BD, Name: "Jhalakathi", Category: "District", ParentCode: "BD-A"},
+	"BD-26":  {Code: "BD-26", Country: iso3166.BD, Name: "Kishoreganj", Category: "District", ParentCode: "BD-C"},
+	"BD-27":  {Code: "BD-27", Country: iso3166.BD, Name: "Khulna", Category: "District", ParentCode: "BD-D"},
+	"BD-28":  {Code: "BD-28", Country: iso3166.BD, Name: "Kurigram", Category: "District", ParentCode: "BD-F"},
+	"BD-29":  {Code: "BD-29", Country: iso3166.BD, Name: "Khagrachhari", Category: "District", ParentCode: "BD-B"},
+	"BD-30":  {Code: "BD-30", Country: iso3166.BD, Name: "Kushtia", Category: "District", ParentCode: "BD-D"},
+	"BD-31":  {Code: "BD-31", Country: iso3166.BD, Name: "Lakshmipur", Category: "District", ParentCode: "BD-B"},
+	"BD-32":  {Code: "BD-32", Country: iso3166.BD, Name: "Lalmonirhat", Category: "District", ParentCode: "BD-F"},
+	"BD-33":  {Code: "BD-33", Country: iso3166.BD, Name: "Manikganj", Category: "District", ParentCode: "BD-C"},
+	"BD-34":  {Code: "BD-34", Country: iso3166.BD, Name: "Mymensingh", Category: "District", ParentCode: "BD-H"},
+	"BD-35":  {Code: "BD-35", Country: iso3166.BD, Name: "Munshiganj", Category: "District", ParentCode: "BD-C"},
+	"BD-36":  {Code: "BD-36", Country: iso3166.BD, Name: "Madaripur", Category: "District", ParentCode: "BD-C"},
+	"BD-37":  {Code: "BD-37", Country: iso3166.BD, Name: "Magura", Category: "District", ParentCode: "BD-D"},
+	"BD-38":  {Code: "BD-38", Country: iso3166.BD, Name: "Moulvibazar", Category: "District", ParentCode: "BD-G"},
+	"BD-39":  {Code: "BD-39", Country: iso3166.BD, Name: "Meherpur", Category: "District", ParentCode: "BD-D"},
+	"BD-40":  {Code: "BD-40", Country: iso3166.BD, Name: "Narayanganj", Category: "District", ParentCode: "BD-C"},
+	"BD-41":  {Code: "BD-41", Country: iso3166.BD, Name: "Netrakona", Category: "District", ParentCode: "BD-H"},
+	"BD-42":  {Code: "BD-42", Country: iso3166.BD, Name: "Narsingdi", Category: "District", ParentCode: "BD-C"},
+	"BD-43":  {Code: "BD-43", Country: iso3166.BD, Name: "Narail", Category: "District", ParentCode: "BD-D"},
+	"BD-44":  {Code: "BD-44", Country: iso3166.BD, Name: "Natore", Category: "District", ParentCode: "BD-E"},
+	"BD-45":  {Code: "BD-45", Country: iso3166.BD, Name: "Chapai Nawabganj", Category: "District", ParentCode: "BD-E"},
+	"BD-46":  {Code: "BD-46", Country: iso3166.BD, Name: "Nilphamari", Category: "District", ParentCode: "BD-F"},
+	"BD-47":  {Code: "BD-47", Country: iso3166.BD, Name: "Noakhali", Category: "District", ParentCode: "BD-B"},
+	"BD-48":  {Code: "BD-48", Country: iso3166.BD, Name: "Naogaon", Category: "District", ParentCode: "BD-E"},
+	"BD-49":  {Code: "BD-49", Country: iso3166.BD, Name: "Pabna", Category: "District", ParentCode: "BD-E"},
+	"BD-50":  {Code: "BD-50", Country: iso3166.BD, Name: "Pirojpur", Category: "District", ParentCode: "BD-A"},
+	"BD-51":  {Code: "BD-51", Country: iso3166.BD, Name: "Patuakhali", Category: "District", ParentCode: "BD-A"},
+	"BD-52":  {Code: "BD-52", Country: iso3166.BD, Name: "Panchagarh", Category: "District", ParentCode: "BD-F"},
+	"BD-53":  {Code: "BD-53", Country: iso3166.BD, Name: "Rajbari", Category: "District", ParentCode: "BD-C"},
+	"BD-54":  {Code: "BD-54", Country: iso3166.BD, Name: "Rajshahi", Category: "District", ParentCode: "BD-E"},
+	"BD-55":  {Code: "BD-55", Country: iso3166.BD, Name: "Rangpur", Category: "District", ParentCode: "BD-F"},
+	"BD-56":  {Code: "BD-56", Country: iso3166.BD, Name: "Rangamati", Category: "District", ParentCode: "BD-B"},
+	"BD-57":  {Code: "BD-57", Country: iso3166.BD, Name: "Sherpur", Category: "District", ParentCode: "BD-H"},
+	"BD-58":  {Code: "BD-58", Country: iso3166.BD, Name: "Satkhira", Category: "District", ParentCode: "BD-D"},
+	"BD-59":  {Code: "BD-59", Country: iso3166.BD, Name: "Sirajganj", Category: "District", ParentCode: "BD-E"},
+	"BD-60":  {Code: "BD-60", Country: iso3166.BD, Name: "Sylhet", Category: "District", ParentCode: "BD-G"},
+	"BD-61":  {Code: "BD-61", Country: iso3166.BD, Name: "Sunamganj", Category: "District", ParentCode: "BD-G"},
+	"BD-62":  {Code: "BD-62", Country: iso3166.BD, Name: "Shariatpur", Category: "District", ParentCode: "BD-C"},
+	"BD-63":  {Code: "BD-63", Country: iso3166.BD, Name: "Tangail", Category: "District", ParentCode: "BD-C"},
+	"BD-64":  {Code: "BD-64", Country: iso3166.BD, Name: "Thakurgaon", Category: "District", ParentCode: "BD-F"},
+	"BD-A":   {Code: "BD-A", Country: iso3166.BD, Name: "Barishal", Category: "Division"},
+	"BD-B":   {Code: "BD-B", Country: iso3166.BD, Name: "Chattogram", Category: "Division"},
+	"BD-C":   {Code: "BD-C", Country: iso3166.BD, Name: "Dhaka", Category: "Division"},
+	"BD-D":   {Code: "BD-D", Country: iso3166.BD, Name: "Khulna", Category: "Division"},
+	"BD-E":   {Code: "BD-E", Country: iso3166.BD, Name: "Rajshahi", Category: "Division"},
+	"BD-F":   {Code: "BD-F", Country: iso3166.BD, Name: "Rangpur", Category: "Division"},
+	"BD-G":   {Code: "BD-G", Country: iso3166.BD, Name: "Sylhet", Category: "Division"},
+	"BD-H":   {Code: "BD-H", Country: iso3166.BD, Name: "Mymensingh", Category: "Division"},
+	"BE-BRU": {Code: "BE-BRU", Country: iso3166.BE, Name: "Brussels Hoofdstedelijk Gewest", Category: "Region"},
+	"BE-VAN": {Code: "BE-VAN", Country: iso3166.BE, Name: "Antwerpen", Category: "Province", ParentCode: "BE-VLG"},
+	"BE-VBR": {Code: "BE-VBR", Country: iso3166.BE, Name: "Vlaams-Brabant", Category: "Province", ParentCode: "BE-VLG"},
+	"BE-VLG": {Code: "BE-VLG", Country: iso3166.BE, Name: "Vlaams Gewest", Category: "Region"},
+	"BE-VLI": {Code: "BE-VLI", Country: iso3166.BE, Name: "Limburg", Category: "Province", ParentCode: "BE-VLG"},
+	"BE-VOV": {Code: "BE-VOV", Country: iso3166.BE, Name: "Oost-Vlaanderen", Category: "Province", ParentCode: "BE-VLG"},
+	"BE-VWV": {Code: "BE-VWV", Country: iso3166.BE, Name: "West-Vlaanderen", Category: "Province", ParentCode: "BE-VLG"},
+	"BE-WAL": {Code: "BE-WAL", Country: iso3166.BE, Name: "wallonne, Région", Category: "Region"},
+	"BE-WBR": {Code: "BE-WBR", Country: iso3166.BE, Name: "Brabant wallon", Category: "Province", ParentCode: "BE-WAL"},
+	"BE-WHT": {Code: "BE-WHT", Country: iso3166.BE, Name: "Hainaut", Category: "Province", ParentCode: "BE-WAL"},
+	"BE-WLG": {Code: "BE-WLG", Country: iso3166.BE, Name: "Liège", Category: "Province", ParentCode: "BE-WAL"},
+	"BE-WLX": {Code: "BE-WLX", Country: iso3166.BE, Name: "Luxembourg", Category: "Province", ParentCode: "BE-WAL"},
+	"BE-WNA": {Code: "BE-WNA", Country: iso3166.BE, Name: "Namur", Category: "Province", ParentCode: "BE-WAL"},
+	"BF-01":  {Code: "BF-01", Country: iso3166.BF, Name: "Boucle du Mouhoun", Category: "Region"},
+	"BF-02":  {Code: "BF-02", Country: iso3166.BF, Name: "Cascades", Category: "Region"},
+	"BF-03":  {Code: "BF-03", Country: iso3166.BF, Name: "Centre", Category: "Region"},
+	"BF-04":  {Code: "BF-04", Country: iso3166.BF, Name: "Centre-Est", Category: "Region"},
+	"BF-05":  {Code: "BF-05", Country: iso3166.BF, Name: "Centre-Nord", Category: "Region"},
+	"BF-06":  {Code: "BF-06", Country: iso3166.BF, Name: "Centre-Ouest", Category: "Region"},
+	"BF-07":  {Code: "BF-07", Country: iso3166.BF, Name: "Centre-Sud", Category: "Region"},
+	"BF-08":  {Code: "BF-08", Country: iso3166.BF, Name: "Est", Category: "Region"},
+	"BF-09":  {Code: "BF-09", Country: iso3166.BF, Name: "Hauts-Bassins", Category: "Region"},
+	"BF-10":  {Code: "BF-10", Country: iso3166.BF, Name: "Nord", Category: "Region"},
+	"BF-11":  {Code: "BF-11", Country: iso3166.BF, Name: "Plateau-Central", Category: "Region"},
+	"BF-12":  {Code: "BF-12", Country: iso3166.BF, Name: "Sahel", Category: "Region"},
+	"BF-13":  {Code: "BF-13", Country: iso3166.BF, Name: "Sud-Ouest", Category: "Region"},
+	"BF-BAL": {Code: "BF-BAL", Country: iso3166.BF, Name: "Balé", Category: "Province", ParentCode: "BF-01"},
+	"BF-BAM": {Code: "BF-BAM", Country: iso3166.BF, Name: "Bam", Category: "Province", ParentCode: "BF-05"},
+	"BF-BAN": {Code: "BF-BAN", Country: iso3166.BF, Name: "Banwa", Category: "Province", ParentCode: "BF-01"},
+	"BF-BAZ": {Code: "BF-BAZ", Country: iso3166.BF, Name: "Bazèga", Category: "Province", ParentCode: "BF-07"},
+	"BF-BGR": {Code: "BF-BGR", Country: iso3166.BF, Name: "Bougouriba", Category: "Province", ParentCode: "BF-13"},
+	"BF-BLG": {Code: "BF-BLG", Country: iso3166.BF, Name: "Boulgou", Category: "Province", ParentCode: "BF-04"},
+	"BF-BLK": {Code: "BF-BLK", Country: iso3166.BF, Name: "Boulkiemdé", Category: "Province", ParentCode: "BF-06"},
+	"BF-COM": {Code: "BF-COM", Country: iso3166.BF, Name: "Comoé", Category: "Province", ParentCode: "BF-02"},
+	"BF-GAN": {Code: "BF-GAN", Country: iso3166.BF, Name: "Ganzourgou", Category: "Province", ParentCode: "BF-11"},
+	"BF-GNA": {Code: "BF-GNA", Country: iso3166.BF, Name: "Gnagna", Category: "Province", ParentCode: "BF-08"},
+	"BF-GOU": {Code: "BF-GOU", Country: iso3166.BF, Name: "Gourma", Category: "Province", ParentCode: "BF-08"},
+	"BF-HOU": {Code: "BF-HOU", Country: iso3166.BF, Name: "Houet", Category: "Province", ParentCode: "BF-09"},
+	"BF-IOB": {Code: "BF-IOB", Country: iso3166.BF, Name: "Ioba", Category: "Province", ParentCode: "BF-13"},
+	"BF-KAD": {Code: "BF-KAD", Country: iso3166.BF, Name: "Kadiogo", Category: "Province", ParentCode: "BF-03"},
+	"BF-KEN": {Code: "BF-KEN", Country: iso3166.BF, Name: "Kénédougou", Category: "Province", ParentCode: "BF-09"},
+	"BF-KMD": {Code: "BF-KMD", Country: iso3166.BF, Name: "Komondjari", Category: "Province", ParentCode: "BF-08"},
+	"BF-KMP": {Code: "BF-KMP", Country: iso3166.BF, Name: "Kompienga", Category: "Province", ParentCode: "BF-08"},
+	"BF-KOP": {Code: "BF-KOP", Country: iso3166.BF, Name: "Koulpélogo", Category: "Province", ParentCode: "BF-04"},
+	"BF-KOS": {Code: "BF-KOS", Country: iso3166.BF, Name: "Kossi", Category: "Province", ParentCode: "BF-01"},
+	"BF-KOT": {Code: "BF-KOT", Country: iso3166.BF, Name: "Kouritenga", Category: "Province", ParentCode: "BF-04"},
+	"BF-KOW": {Code: "BF-KOW", Country: iso3166.BF, Name: "Kourwéogo", Category: "Province", ParentCode: "BF-11"},
+	"BF-LER": {Code: "BF-LER", Country: iso3166.BF, Name: "Léraba", Category: "Province", ParentCode: "BF-02"},
+	"BF-LOR": {Code: "BF-LOR", Country: iso3166.BF, Name: "Loroum", Category: "Province", ParentCode: "BF-10"},
+	"BF-MOU": {Code: "BF-MOU", Country: iso3166.BF, Name: "Mouhoun", Category: "Province", ParentCode: "BF-01"},
+	"BF-NAM": {Code: "BF-NAM", Country: iso3166.BF, Name: "Namentenga", Category: "Province", ParentCode: "BF-05"},
+	"BF-NAO": {Code: "BF-NAO", Country: iso3166.BF, Name: "Nahouri", Category: "Province", ParentCode: "BF-07"},
+	"BF-NAY": {Code: "BF-NAY", Country: iso3166.BF, Name: "Nayala", Category: "Province", ParentCode: "BF-01"},
+	"BF-NOU": {Code: "BF-NOU", Country: iso3166.BF, Name: "Noumbiel", Category: "Province", ParentCode: "BF-13"},
+	"BF-OUB": {Code: "BF-OUB", Country: iso3166.BF, Name: "Oubritenga", Category: "Province", ParentCode: "BF-11"},
+	"BF-OUD": {Code: "BF-OUD", Country: iso3166.BF, Name: "Oudalan", Category: "Province", ParentCode: "BF-12"},
+	"BF-PAS": {Code: "BF-PAS", Country: iso3166.BF, Name: "Passoré", Category: "Province", ParentCode: "BF-10"},
+	"BF-PON": {Code: "BF-PON", Country: iso3166.BF, Name: "Poni", Category: "Province", ParentCode: "BF-13"},
+	"BF-SEN": {Code: "BF-SEN", Country: iso3166.BF, Name: "Séno", Category: "Province", ParentCode: "BF-12"},
+	"BF-SIS": {Code: "BF-SIS", Country: iso3166.BF, Name: "Sissili", Category: "Province", ParentCode: "BF-06"},
+	"BF-SMT": {Code: "BF-SMT", Country: iso3166.BF, Name: "Sanmatenga", Category: "Province", ParentCode: "BF-05"},
+	"BF-SNG": {Code: "BF-SNG", Country: iso3166.BF, Name: "Sanguié", Category: "Province", ParentCode: "BF-06"},
+	"BF-SOM": {Code: "BF-SOM", Country: iso3166.BF, Name: "Soum", Category: "Province", ParentCode: "BF-12"},
+	"BF-SOR": {Code: "BF-SOR", Country: iso3166.BF, Name: "Sourou", Category: "Province", ParentCode: "BF-01"},
+	"BF-TAP": {Code: "BF-TAP", Country: iso3166.BF, Name: "Tapoa", Category: "Province", ParentCode: "BF-08"},
+	"BF-TUI": {Code: "BF-TUI", Country: iso3166.BF, Name: "Tuy", Category: "Province", ParentCode: "BF-09"},
+	"BF-YAG": {Code: "BF-YAG", Country: iso3166.BF, Name: "Yagha", Category: "Province", ParentCode: "BF-12"},
+	"BF-YAT": {Code: "BF-YAT", Country: iso3166.BF, Name: "Yatenga", Category: "Province", ParentCode: "BF-10"},
+	"BF-ZIR": {Code: "BF-ZIR", Country: iso3166.BF, Name: "Ziro", Category: "Province", ParentCode: "BF-06"},
+	"BF-ZON": {Code: "BF-ZON", Country: iso3166.BF, Name: "Zondoma", Category: "Province", ParentCode: "BF-10"},
+	"BF-ZOU": {Code: "BF-ZOU", Country: iso3166.BF, Name: "Zoundwéogo", Category: "Province", ParentCode: "BF-07"},
+	"BG-01":  {Code: "BG-01", Country: iso3166.BG, Name: "Blagoevgrad", Category: "District"},
+	"BG-02":  {Code: "BG-02", Country: iso3166.BG, Name: "Burgas", Category: "District"},
+	"BG-03":  {Code: "BG-03", Country: iso3166.BG, Name: "Varna", Category: "District"},
+	"BG-04":  {Code: "BG-04", Country: iso3166.BG, Name: "Veliko Tarnovo", Category: "District"},
+	"BG-05":  {Code: "BG-05", Country: iso3166.BG, Name: "Vidin", Category: "District"},
+	"BG-06":  {Code: "BG-06", Country: iso3166.BG, Name: "Vratsa", Category: "District"},
+	"BG-07":  {Code: "BG-07", Country: iso3166.BG, Name: "Gabrovo", Category: "District"},
+	"BG-08":  {Code: "BG-08", Country: iso3166.BG, Name: "Dobrich", Category: "District"},
+	"BG-09":  {Code: "BG-09", Country: iso3166.BG, Name: "Kardzhali", Category: "District"},
+	"BG-10":  {Code: "BG-10", Country: iso3166.BG, Name: "Kyustendil", Category: "District"},
+	"BG-11":  {Code: "BG-11", Country: iso3166.BG, Name: "Lovech", Category: "District"},
+	"BG-12":  {Code: "BG-12", Country: iso3166.BG, Name: "Montana", Category: "District"},
+	"BG-13":  {Code: "BG-13", Country: iso3166.BG, Name: "Pazardzhik", Category: "District"},
+	"BG-14":  {Code: "BG-14", Country: iso3166.BG, Name: "Pernik", Category: "District"},
+	"BG-15":  {Code: "BG-15", Country: iso3166.BG, Name: "Pleven", Category: "District"},
+	"BG-16":  {Code: "BG-16", Country: iso3166.BG, Name: "Plovdiv", Category: "District"},
+	"BG-17":  {Code: "BG-17", Country: iso3166.BG, Name: "Razgrad", Category: "District"},
+	"BG-18":  {Code: "BG-18", Country: iso3166.BG, Name: "Ruse", Category: "District"},
+	"BG-19":  {Code: "BG-19", Country: iso3166.BG, Name: "Silistra", Category: "District"},
+	"BG-20":  {Code: "BG-20", Country: iso3166.BG, Name: "Sliven", Category: "District"},
+	"BG-21":  {Code: "BG-21", Country: iso3166.BG, Name: "Smolyan", Category: "District"},
+	"BG-22":  {Code: "BG-22", Country: iso3166.BG, Name: "Sofia (stolitsa)", Category: "District"},
+	"BG-23":  {Code: "BG-23", Country: iso3166.BG, Name: "Sofia", Category: "District"},
+	"BG-24":  {Code: "BG-24", Country: iso3166.BG, Name: "Stara Zagora", Category: "District"},
+	"BG-25":  {Code: "BG-25", Country: iso3166.BG, Name: "Targovishte", Category: "District"},
+	"BG-26":  {Code: "BG-26", Country: iso3166.BG, Name: "Haskovo", Category: "District"},
+	"BG-27":  {Code: "BG-27", Country: iso3166.BG, Name: "Shumen", Category: "District"},
+	"BG-28":  {Code: "BG-28", Country: iso3166.BG, Name: "Yambol", Category: "District"},
+	"BH-13":  {Code: "BH-13", Country: iso3166.BH, Name: "Al ‘Āşimah", Category: "Governorate"},
+	"BH-14":  {Code: "BH-14", Country: iso3166.BH, Name: "Al Janūbīyah", Category: "Governorate"},
+	"BH-15":  {Code: "BH-15", Country: iso3166.BH, Name: "Al Muḩarraq", Category: "Governorate"},
+	"BH-17":  {Code: "BH-17", Country: iso3166.BH, Name: "Ash Shamālīyah", Category: "Governorate"},
+	"BI-BB":  {Code: "BI-BB", Country: iso3166.BI, Name: "Bubanza", Category: "Province"},
+	"BI-BL":  {Code: "BI-BL", Country: iso3166.BI, Name: "Bujumbura Rural", Category: "Province"},
+	"BI-BM":  {Code: "BI-BM", Country: iso3166.BI, Name: "Bujumbura Mairie", Category: "Province"},
+	"BI-BR":  {Code: "BI-BR", Country: iso3166.BI, Name: "Bururi", Category: "Province"},
+	"BI-CA":  {Code: "BI-CA", Country: iso3166.BI, Name: "Cankuzo", Category: "Province"},
+	"BI-CI":  {Code: "BI-CI", Country: iso3166.BI, Name: "Cibitoke", Category: "Province"},
+	"BI-GI":  {Code: "BI-GI", Country: iso3166.BI, Name: "Gitega", Category: "Province"},
+	"BI-KI":  {Code: "BI-KI", Country: iso3166.BI, Name: "Kirundo", Category: "Province"},
+	"BI-KR":  {Code: "BI-KR", Country: iso3166.BI, Name: "Karuzi", Category: "Province"},
+	"BI-KY":  {Code: "BI-KY", Country: iso3166.BI, Name: "Kayanza", Category: "Province"},
+	"BI-MA":  {Code: "BI-MA", Country: iso3166.BI, Name: "Makamba", Category: "Province"},
+	"BI-MU":  {Code: "BI-MU", Country: iso3166.BI, Name: "Muramvya", Category: "Province"},
+	"BI-MW":  {Code: "BI-MW", Country: iso3166.BI, Name: "Mwaro", Category: "Province"},
+	"BI-MY":  {Code: "BI-MY", Country: iso3166.BI, Name: "Muyinga", Category: "Province"},
+	"BI-NG":  {Code: "BI-NG", Country: iso3166.BI, Name: "Ngozi", Category: "Province"},
+	"BI-RM":  {Code: "BI-RM", Country: iso3166.BI, Name: "Rumonge", Category: "Province"},
+	"BI-RT":  {Code: "BI-RT", Country: iso3166.BI, Name: "Rutana", Category: "Province"},
+	"BI-RY":  {Code: "BI-RY", Country: iso3166.BI, Name: "Ruyigi", Category: "Province"},
+	"BJ-AK":  {Code: "BJ-AK", Country: iso3166.BJ, Name: "Atacora", Category: "Department"},
+	"BJ-AL":  {Code: "BJ-AL", Country: iso3166.BJ, Name: "Alibori", Category: "Department"},
+	"BJ-AQ":  {Code: "BJ-AQ", Country: iso3166.BJ, Name: "Atlantique", Category: "Department"},
+	"BJ-BO":  {Code: "BJ-BO", Country: iso3166.BJ, Name: "Borgou", Category: "Department"},
+	"BJ-CO":  {Code: "BJ-CO", Country: iso3166.BJ, Name: "Collines", Category: "Department"},
+	"BJ-DO":  {Code: "BJ-DO", Country: iso3166.BJ, Name: "Donga", Category: "Department"},
+	"BJ-KO":  {Code: "BJ-KO", Country: iso3166.BJ, Name: "Couffo", Category: "Department"},
+	"BJ-LI":  {Code: "BJ-LI", Country: iso3166.BJ, Name: "Littoral", Category: "Department"},
+	"BJ-MO":  {Code: "BJ-MO", Country: iso3166.BJ, Name: "Mono", Category: "Department"},
+	"BJ-OU":  {Code: "BJ-OU", Country: iso3166.BJ, Name: "Ouémé", Category: "Department"},
+	"BJ-PL":  {Code: "BJ-PL", Country: iso3166.BJ, Name: "Plateau", Category: "Department"},
+	"BJ-ZO":  {Code: "BJ-ZO", Country: iso3166.BJ, Name: "Zou", Category: "Department"},
+	"BN-BE":  {Code: "BN-BE", Country: iso3166.BN, Name: "Belait", Category: "District"},
+	"BN-BM":  {Code: "BN-BM", Country: iso3166.BN, Name: "Brunei-Muara", Category: "District"},
+	"BN-TE":  {Code: "BN-TE", Country: iso3166.BN, Name: "Temburong", Category: "District"},
+	"BN-TU":  {Code: "BN-TU", Country: iso3166.BN, Name: "Tutong", Category: "District"},
+	"BO-B":   {Code: "BO-B", Country: iso3166.BO, Name: "El Beni", Category: "Department"},
+	"BO-C":   {Code: "BO-C", Country: iso3166.BO, Name: "Cochabamba", Category: "Department"},
+	"BO-H":   {Code: "BO-H", Country: iso3166.BO, Name: "Chuquisaca", Category: "Department"},
+	"BO-L":   {Code: "BO-L", Country: iso3166.BO, Name: "La Paz", Category: "Department"},
+	"BO-N":   {Code: "BO-N", Country: iso3166.BO, Name: "Pando", Category: "Department"},
+	"BO-O":   {Code: "BO-O", Country: iso3166.BO, Name: "Oruro", Category: "Department"},
+	"BO-P":   {Code: "BO-P", Country: iso3166.BO, Name: "Potosí", Category: "Department"},
+	"BO-S":   {Code: "BO-S", Country: iso3166.BO, Name: "Santa Cruz", Category: "Department"},
+	"BO-T":   {Code: "BO-T", Country: iso3166.BO, Name: "Tarija", Category: "Department"},
+	"BQ-BO":  {Code: "BQ-BO", Country: iso3166.BQ, Name: "Bonaire", Category: "Special municipality"},
+	"BQ-SA":  {Code: "BQ-SA", Country: iso3166.BQ, Name: "Saba", Category: "Special municipality"},
+	"BQ-SE":  {Code: "BQ-SE", Country: iso3166.BQ, Name: "Sint Eustatius", Category: "Special municipality"},
+	"BR-AC":  {Code: "BR-AC", Country: iso3166.BR, Name: "Acre", Category: "State"},
+	"BR-AL":  {Code: "BR-AL", Country: iso3166.BR, Name: "Alagoas", Category: "State"},
+	"BR-AM":  {Code: "BR-AM", Country: iso3166.BR, Name: "Amazonas", Category: "State"},
+	"BR-AP":  {Code: "BR-AP", Country: iso3166.BR, Name: "Amapá", Category: "State"},
+	"BR-BA":  {Code: "BR-BA", Country: iso3166.BR, Name: "Bahia", Category: "State"},
+	"BR-CE":  {Code: "BR-CE", Country: iso3166.BR, Name: "Ceará", Category: "State"},
+	"BR-DF":  {Code: "BR-DF", Country: iso3166.BR, Name: "Distrito Federal", Category: "Federal district"},
+	"BR-ES":  {Code: "BR-ES", Country: iso3166.BR, Name: "Espírito Santo", Category: "State"},
+	"BR-GO":  {Code: "BR-GO", Country: iso3166.BR, Name: "Goiás", Category: "State"},
+	"BR-MA":  {Code: "BR-MA", Country: iso3166.BR, Name: "Maranhão", Category: "State"},
+	"BR-MG":  {Code: "BR-MG", Country: iso3166.BR, Name: "Minas Gerais", Category: "State"},
+	"BR-MS":  {Code: "BR-MS", Country: iso3166.BR, Name: "Mato Grosso do Sul", Category: "State"},
+	"BR-MT":  {Code: "BR-MT", Country: iso3166.BR, Name: "Mato Grosso", Category: "State"},
+	"BR-PA":  {Code: "BR-PA", Country: iso3166.BR, Name: "Pará", Category: "State"},
+	"BR-PB":  {Code: "BR-PB", Country: iso3166.BR, Name: "Paraíba", Category: "State"},
+	"BR-PE":  {Code: "BR-PE", Country: iso3166.BR, Name: "Pernambuco", Category: "State"},
+	"BR-PI":  {Code: "BR-PI", Country: iso3166.BR, Name: "Piauí", Category: "State"},
+	"BR-PR":  {Code: "BR-PR", Country: iso3166.BR, Name: "Paraná", Category: "State"},
+	"BR-RJ":  {Code: "BR-RJ", Country: iso3166.BR, Name: "Rio de Janeiro", Category: "State"},
+	"BR-RN":  {Code: "BR-RN", Country: iso3166.BR, Name: "Rio Grande do Norte", Category: "State"},
+	"BR-RO":  {Code: "BR-RO", Country: iso3166.BR, Name: "Rondônia", Category: "State"},
+	"BR-RR":  {Code: "BR-RR", Country: iso3166.BR, Name: "Roraima", Category: "State"},
+	"BR-RS":  {Code: "BR-RS", Country: iso3166.BR, Name: "Rio Grande do Sul", Category: "State"},
+	"BR-SC":  {Code: "BR-SC", Country: iso3166.BR, Name: "Santa Catarina", Category: "State"},
+	"BR-SE":  {Code: "BR-SE", Country: iso3166.BR, Name: "Sergipe", Category: "State"},
+	"BR-SP":  {Code: "BR-SP", Country: iso3166.BR, Name: "São Paulo", Category: "State"},
+	"BR-TO":  {Code: "BR-TO", Country: iso3166.BR, Name: "Tocantins", Category: "State"},
+	"BS-AK":  {Code: "BS-AK", Country: iso3166.BS, Name: "Acklins", Category: "District"},
+	"BS-BI":  {Code: "BS-BI", Country: iso3166.BS, Name: "Bimini", Category: "District"},
+	"BS-BP":  {Code: "BS-BP", Country: iso3166.BS, Name: "Black Point", Category: "District"},
+	"BS-BY":  {Code: "BS-BY", Country: iso3166.BS, Name: "Berry Islands", Category: "District"},
+	"BS-CE":  {Code: "BS-CE", Country: iso3166.BS, Name: "Central Eleuthera", Category: "District"},
+	"BS-CI":  {Code: "BS-CI", Country: iso3166.BS, Name: "Cat Island", Category: "District"},
+	"BS-CK":  {Code: "BS-CK", Country: iso3166.BS, Name: "Crooked Island and Long Cay", Category: "District"},
+	"BS-CO":  {Code: "BS-CO", Country: iso3166.BS, Name: "Central Abaco", Category: "District"},
+	"BS-CS":  {Code: "BS-CS", Country: iso3166.BS, Name: "Central Andros", Category: "District"},
+	"BS-EG":  {Code: "BS-EG", Country: iso3166.BS, Name: "East Grand Bahama", Category: "District"},
+	"BS-EX":  {Code: "BS-EX", Country: iso3166.BS, Name: "Exuma", Category: "District"},
+	"BS-FP":  {Code: "BS-FP", Country: iso3166.BS, Name: "City of Freeport", Category: "District"},
+	"BS-GC":  {Code: "BS-GC", Country: iso3166.BS, Name: "Grand Cay", Category: "District"},
+	"BS-HI":  {Code: "BS-HI", Country: iso3166.BS, Name: "Harbour Island", Category: "District"},
+	"BS-HT":  {Code: "BS-HT", Country: iso3166.BS, Name: "Hope Town", Category: "District"},
+	"BS-IN":  {Code: "BS-IN", Country: iso3166.BS, Name: "Inagua", Category: "District"},
+	"BS-LI":  {Code: "BS-LI", Country: iso3166.BS, Name: "Long Island", Category: "District"},
+	"BS-MC":  {Code: "BS-MC", Country: iso3166.BS, Name: "Mangrove Cay", Category: "District"},
+	"BS-MG":  {Code: "BS-MG", Country: iso3166.BS, Name: "Mayaguana", Category: "District"},
+	"BS-MI":  {Code: "BS-MI", Country: iso3166.BS, Name: "Moore's Island", Category: "District"},
+	"BS-NE":  {Code: "BS-NE", Country: iso3166.BS, Name: "North Eleuthera", Category: "District"},
+	"BS-NO":  {Code: "BS-NO", Country: iso3166.BS, Name: "North Abaco", Category: "District"},
+	"BS-NP":  {Code: "BS-NP", Country: iso3166.BS, Name: "New Providence", Category: "Island"},
+	"BS-NS":  {Code: "BS-NS", Country: iso3166.BS, Name: "North Andros", Category: "District"},
+	"BS-RC":  {Code: "BS-RC", Country: iso3166.BS, Name: "Rum Cay", Category: "District"},
+	"BS-RI":  {Code: "BS-RI", Country: iso3166.BS, Name: "Ragged Island", Category: "District"},
+	"BS-SA":  {Code: "BS-SA", Country: iso3166.BS, Name: "South Andros", Category: "District"},
+	"BS-SE":  {Code: "BS-SE", Country: iso3166.BS, Name: "South Eleuthera", Category: "District"},
+	"BS-SO":  {Code: "BS-SO", Country: iso3166.BS, Name: "South Abaco", Category: "District"},
+	"BS-SS":  {Code: "BS-SS", Country: iso3166.BS, Name: "San Salvador", Category: "District"},
+	"BS-SW":  {Code: "BS-SW", Country: iso3166.BS, Name: "Spanish Wells", Category: "District"},
+	"BS-WG":  {Code: "BS-WG", Country: iso3166.BS, Name: "West Grand Bahama", Category: "District"},
+	"BT-11":  {Code: "BT-11", Country: iso3166.BT, Name: "Paro", Category: "District"},
+	"BT-12":  {Code: "BT-12", Country: iso3166.BT, Name: "Chhukha", Category: "District"},
+	"BT-13":  {Code: "BT-13", Country: iso3166.BT, Name: "Haa", Category: "District"},
+	"BT-14":  {Code: "BT-14", Country: iso3166.BT, Name: "Samtse", Category: "District"},
+	"BT-15":  {Code: "BT-15", Country: iso3166.BT, Name: "Thimphu", Category: "District"},
+	"BT-21":  {Code: "BT-21", Country: iso3166.BT, Name: "Tsirang", Category: "District"},
+	"BT-22":  {Code: "BT-22", Country: iso3166.BT, Name: "Dagana", Category: "District"},
+	"BT-23":  {Code: "BT-23", Country: iso3166.BT, Name: "Punakha", Category: "District"},
+	"BT-24":  {Code: "BT-24", Country: iso3166.BT, Name: "Wangdue Phodrang", Category: "District"},
+	"BT-31":  {Code: "BT-31", Country: iso3166.BT, Name: "Sarpang", Category: "District"},
+	"BT-32":  {Code: "BT-32", Country: iso3166.BT, Name: "Trongsa", Category: "District"},
+	"BT-33":  {Code: "BT-33", Country: iso3166.BT, Name: "Bumthang", Category: "District"},
+	"BT-34":  {Code: "BT-34", Country: iso3166.BT, Name: "Zhemgang", Category: "District"},
+	"BT-41":  {Code: "BT-41", Country: iso3166.BT, Name: "Trashigang", Category: "District"},
+	"BT-42":  {Code: "BT-42", Country: iso3166.BT, Name: "Monggar", Category: "District"},
+	"BT-43":  {Code: "BT-43", Country: iso3166.BT, Name: "Pema Gatshel", Category: "District"},
+	"BT-44":  {Code: "BT-44", Country: iso3166.BT, Name: "Lhuentse", Category: "District"},
+	"BT-45":  {Code: "BT-45", Country: iso3166.BT, Name: "Samdrup Jongkhar", Category: "District"},
+	"BT-GA":  {Code: "BT-GA", Country: iso3166.BT, Name: "Gasa", Category: "District"},
+	"BT-TY":  {Code: "BT-TY", Country: iso3166.BT, Name: "Trashi Yangtse", Category: "District"},
+	"BW-CE":  {Code: "BW-CE", Country: iso3166.BW, Name: "Central", Category: "District"},
+	"BW-CH":  {Code: "BW-CH", Country: iso3166.BW, Name: "Chobe", Category: "District"},
+	"BW-FR":  {Code: "BW-FR", Country: iso3166.BW, Name: "Francistown", Category: "City"},
+	"BW-GA":  {Code: "BW-GA", Country: iso3166.BW, Name: "Gaborone", Category: "City"},
+	"BW-GH":  {Code: "BW-GH", Country: iso3166.BW, Name: "Ghanzi", Category: "District"},
+	"BW-JW":  {Code: "BW-JW", Country: iso3166.BW, Name: "Jwaneng", Category: "Town"},
+	"BW-KG":  {Code: "BW-KG", Country: iso3166.BW, Name: "Kgalagadi", Category: "District"},
+	"BW-KL":  {Code: "BW-KL", Country: iso3166.BW, Name: "Kgatleng", Category: "District"},
+	"BW-KW":  {Code: "BW-KW", Country: iso3166.BW, Name: "Kweneng", Category: "District"},
+	"BW-LO":  {Code: "BW-LO", Country: iso3166.BW, Name: "Lobatse", Category: "Town"},
+	"BW-NE":  {Code: "BW-NE", Country: iso3166.BW, Name: "North East", Category: "District"},
+	"BW-NW":  {Code: "BW-NW", Country: iso3166.BW, Name: "North West", Category: "District"},
+	"BW-SE":  {Code: "BW-SE", Country: iso3166.BW, Name: "South East", Category: "District"},
+	"BW-SO":  {Code: "BW-SO", Country: iso3166.BW, Name: "Southern", Category: "District"},
+	"BW-SP":  {Code: "BW-SP", Country: iso3166.BW, Name: "Selibe Phikwe", Category: "Town"},
+	"BW-ST":  {Code: "BW-ST", Country: iso3166.BW, Name: "Sowa Town", Category: "Town"},
+	"BY-BR":  {Code: "BY-BR", Country: iso3166.BY, Name: "Bresckaja voblasć", Category: "Oblast"},
+	"BY-HM":  {Code: "BY-HM", Country: iso3166.BY, Name: "Gorod Minsk", Category: "City"},
+	"BY-HO":  {Code: "BY-HO", Country: iso3166.BY, Name: "Gomel'skaja oblast'", Category: "Oblast"},
+	"BY-HR":  {Code: "BY-HR", Country: iso3166.BY, Name: "Grodnenskaja oblast'", Category: "Oblast"},
+	"BY-MA":  {Code: "BY-MA", Country: iso3166.BY, Name: "Mahilioŭskaja voblasć", Category: "Oblast"},
+	"BY-MI":  {Code: "BY-MI", Country: iso3166.BY, Name: "Minskaja oblast'", Category: "Oblast"},
+	"BY-VI":  {Code: "BY-VI", Country: iso3166.BY, Name: "Viciebskaja voblasć", Category: "Oblast"},
+	"BZ-BZ":  {Code: "BZ-BZ", Country: iso3166.BZ, Name: "Belize", Category: "District"},
+	"BZ-CY":  {Code: "BZ-CY", Country: iso3166.BZ, Name: "Cayo", Category: "District"},
+	"BZ-CZL": {Code: "BZ-CZL", Country: iso3166.BZ, Name: "Corozal", Category: "District"},
+	"BZ-OW":  {Code: "BZ-OW", Country: iso3166.BZ, Name: "Orange Walk", Category: "District"},
+	"BZ-SC":  {Code: "BZ-SC", Country: iso3166.BZ, Name: "Stann Creek", Category: "District"},
+	"BZ-TOL": {Code: "BZ-TOL", Country: iso3166.BZ, Name: "Toledo", Category: "District"},
+	"CA-AB":  {Code: "CA-AB", Country: iso3166.CA, Name: "Alberta", Category: "Province"},
+	"CA-BC":  {Code: "CA-BC", Country: iso3166.CA, Name: "British Columbia", Category: "Province"},
+	"CA-MB":  {Code: "CA-MB", Country: iso3166.CA, Name: "Manitoba", Category: "Province"},
+	"CA-NB":  {Code: "CA-NB", Country: iso3166.CA, Name: "New Brunswick", Category: "Province"},
+	"CA-NL":  {Code: "CA-NL", Country: iso3166.CA, Name: "Newfoundland and Labrador", Category: "Province"},
+	"CA-NS":  {Code: "CA-NS", Country: iso3166.CA, Name: "Nova Scotia", Category: "Province"},
+	"CA-NT":  {Code: "CA-NT", Country: iso3166.CA, Name: "Northwest Territories", Category: "Territory"},
+	"CA-NU":  {Code: "CA-NU", Country: iso3166.CA, Name: "Nunavut", Category: "Territory"},
+	"CA-ON":  {Code: "CA-ON", Country: iso3166.CA, Name: "Ontario", Category: "Province"},
+	"CA-PE":  {Code: "CA-PE", Country: iso3166.CA, Name: "Prince Edward Island", Category: "Province"},
+	"CA-QC":  {Code: "CA-QC", Country: iso3166.CA, Name: "Quebec", Category: "Province"},
+	"CA-SK":  {Code: "CA-SK", Country: iso3166.CA, Name: "Saskatchewan", Category: "Province"},
+	"CA-YT":  {Code: "CA-YT", Country: iso3166.CA, Name: "Yukon", Category: "Territory"},
+	"CD-BC":  {Code: "CD-BC", Country: iso3166.CD, Name: "Kongo Central", Category: "Province"},
+	"CD-BU":  {Code: "CD-BU", Country: iso3166.CD, Name: "Bas-Uélé", Category: "Province"},
+	"CD-EQ":  {Code: "CD-EQ", Country: iso3166.CD, Name: "Équateur", Category: "Province"},
+	"CD-HK":  {Code: "CD-HK", Country: iso3166.CD, Name: "Haut-Katanga", Category: "Province"},
+	"CD-HL":  {Code: "CD-HL", Country: iso3166.CD, Name: "Haut-Lomami", Category: "Province"},
+	"CD-HU":  {Code: "CD-HU", Country: iso3166.CD, Name: "Haut-Uélé", Category: "Province"},
+	"CD-IT":  {Code: "CD-IT", Country: iso3166.CD, Name: "Ituri", Category: "Province"},
+	"CD-KC":  {Code: "CD-KC", Country: iso3166.CD, Name: "Kasaï Central", Category: "Province"},
+	"CD-KE":  {Code: "CD-KE", Country: iso3166.CD, Name: "Kasaï Oriental", Category: "Province"},
+	"CD-KG":  {Code: "CD-KG", Country: iso3166.CD, Name: "Kwango", Category: "Province"},
+	"CD-KL":  {Code: "CD-KL", Country: iso3166.CD, Name: "Kwilu", Category: "Province"},
+	"CD-KN":  {Code: "CD-KN", Country: iso3166.CD, Name: "Kinshasa", Category: "City"},
+	"CD-KS":  {Code: "CD-KS", Country: iso3166.CD, Name: "Kasaï", Category: "Province"},
+	"CD-LO":  {Code: "CD-LO", Country: iso3166.CD, Name: "Lomami", Category: "Province"},
+	"CD-LU":  {Code: "CD-LU", Country: iso3166.CD, Name: "Lualaba", Category: "Province"},
+	"CD-MA":  {Code: "CD-MA", Country: iso3166.CD, Name: "Maniema", Category: "Province"},
+	"CD-MN":  {Code: "CD-MN", Country: iso3166.CD, Name: "Mai-Ndombe", Category: "Province"},
+	"CD-MO":  {Code: "CD-MO", Country: iso3166.CD, Name: "Mongala", Category: "Province"},
+	"CD-NK":  {Code: "CD-NK", Country: iso3166.CD, Name: "Nord-Kivu", Category: "Province"},
+	"CD-NU":  {Code: "CD-NU", Country: iso3166.CD, Name: "Nord-Ubangi", Category: "Province"},
+	"CD-SA":  {Code: "CD-SA", Country: iso3166.CD, Name: "Sankuru", Category: "Province"},
+	"CD-SK":  {Code: "CD-SK", Country: iso3166.CD, Name: "Sud-Kivu", Category: "Province"},
+	"CD-SU":  {Code: "CD-SU", Country: iso3166.CD, Name: "Sud-Ubangi", Category: "Province"},
+	"CD-TA":  {Code: "CD-TA", Country: iso3166.CD, Name: "Tanganyika", Category: "Province"},
+	"CD-TO":  {Code: "CD-TO", Country: iso3166.CD, Name: "Tshopo", Category: "Province"},
+	"CD-TU":  {Code: "CD-TU", Country: iso3166.CD, Name: "Tshuapa", Category: "Province"},
+	"CF-AC":  {Code: "CF-AC", Country: iso3166.CF, Name: "Ouham", Category: "Prefecture"},
+	"CF-BB":  {Code: "CF-BB", Country: iso3166.CF, Name: "Bamingui-Bangoran", Category: "Prefecture"},
+	"CF-BGF": {Code: "CF-BGF", Country: iso3166.CF, Name: "Bangui", Category: "Commune"},
+	"CF-BK":  {Code: "CF-BK", Country: iso3166.CF, Name: "Basse-Kotto", Category: "Prefecture"},
+	"CF-HK":  {Code: "CF-HK", Country: iso3166.CF, Name: "Haute-Kotto", Category: "Prefecture"},
+	"CF-HM":  {Code: "CF-HM", Country: iso3166.CF, Name: "Haut-Mbomou", Category: "Prefecture"},
+	"CF-HS":  {Code: "CF-HS", Country: iso3166.CF, Name: "Haute-Sangha / Mambéré-Kadéï", Category: "Prefecture"},
+	"CF-KB":  {Code: "CF-KB", Country: iso3166.CF, Name: "Gribingui", Category: "Economic prefecture"},
+	"CF-KG":  {Code: "CF-KG", Country: iso3166.CF, Name: "Kemö-Gïrïbïngï", Category: "Prefecture"},
+	"CF-LB":  {Code: "CF-LB", Country: iso3166.CF, Name: "Lobaye", Category: "Prefecture"},
+	"CF-MB":  {Code: "CF-MB", Country: iso3166.CF, Name: "Mbomou", Category: "Prefecture"},
+	"CF-MP":  {Code: "CF-MP", Country: iso3166.CF, Name: "Ombella-Mpoko", Category: "Prefecture"},
+	"CF-NM":  {Code: "CF-NM", Country: iso3166.CF, Name: "Nana-Mambéré", Category: "Prefecture"},
+	"CF-OP":  {Code: "CF-OP", Country: iso3166.CF, Name: "Ouham-Pendé", Category: "Prefecture"},
+	"CF-SE":  {Code: "CF-SE", Country: iso3166.CF, Name: "Sangha", Category: "Economic prefecture"},
+	"CF-UK":  {Code: "CF-UK", Country: iso3166.CF, Name: "Ouaka", Category: "Prefecture"},
+	"CF-VK":  {Code: "CF-VK", Country: iso3166.CF, Name: "Vakaga", Category: "Prefecture"},
+	"CG-11":  {Code: "CG-11", Country: iso3166.CG, Name: "Bouenza", Category: "Department"},
+	"CG-12":  {Code: "CG-12", Country: iso3166.CG, Name: "Pool", Category: "Department"},
+	"CG-13":  {Code: "CG-13", Country: iso3166.CG, Name: "Sangha", Category: "Department"},
+	"CG-14":  {Code: "CG-14", Country: iso3166.CG, Name: "Plateaux", Category: "Department"},
+	"CG-15":  {Code: "CG-15", Country: iso3166.CG, Name: "Cuvette-Ouest", Category: "Department"},
+	"CG-16":  {Code: "CG-16", Country: iso3166.CG, Name: "Pointe-Noire", Category: "Department"},
+	"CG-2":   {Code: "CG-2", Country: iso3166.CG, Name: "Lékoumou", Category: "Department"},
+	"CG-5":   {Code: "CG-5", Country: iso3166.CG, Name: "Kouilou", Category: "Department"},
+	"CG-7":   {Code: "CG-7", Country: iso3166.CG, Name: "Likouala", Category: "Department"},
+	"CG-8":   {Code: "CG-8", Country: iso3166.CG, Name: "Cuvette", Category: "Department"},
+	"CG-9":   {Code: "CG-9", Country: iso3166.CG, Name: "Niari", Category: "Department"},
+	"CG-BZV": {Code: "CG-BZV", Country: iso3166.CG, Name: "Brazzaville", Category: "Department"},
+	"CH-AG":  {Code: "CH-AG", Country: iso3166.CH, Name: "Aargau", Category: "Canton"},
+	"CH-AI":  {Code: "CH-AI", Country: iso3166.CH, Name: "Appenzell Innerrhoden", Category: "Canton"},
+	"CH-AR":  {Code: "CH-AR", Country: iso3166.CH, Name: "Appenzell Ausserrhoden", Category: "Canton"},
+	"CH-BE":  {Code: "CH-BE", Country: iso3166.CH, Name: "Bern", Category: "Canton"},
+	"CH-BL":  {Code: "CH-BL", Country: iso3166.CH, Name: "Basel-Landschaft", Category: "Canton"},
+	"CH-BS":  {Code: "CH-BS", Country: iso3166.CH, Name: "Basel-Stadt", Category: "Canton"},
+	"CH-FR":  {Code: "CH-FR", Country: iso3166.CH, Name: "Freiburg", Category: "Canton"},
+	"CH-GE":  {Code: "CH-GE", Country: iso3166.CH, Name: "Genève", Category: "Canton"},
+	"CH-GL":  {Code: "CH-GL", Country: iso3166.CH, Name: "Glarus", Category: "Canton"},
+	"CH-GR":  {Code: "CH-GR", Country: iso3166.CH, Name: "Graubünden", Category: "Canton"},
+	"CH-JU":  {Code: "CH-JU", Country: iso3166.CH, Name: "Jura", Category: "Canton"},
+	"CH-LU":  {Code: "CH-LU", Country: iso3166.CH, Name: "Luzern", Category: "Canton"},
+	"CH-NE":  {Code: "CH-NE", Country: iso3166.CH, Name: "Neuchâtel", Category: "Canton"},
+	"CH-NW":  {Code: "CH-NW", Country: iso3166.CH, Name: "Nidwalden", Category: "Canton"},
+	"CH-OW":  {Code: "CH-OW", Country: iso3166.CH, Name: "Obwalden", Category: "Canton"},
+	"CH-SG":  {Code: "CH-SG", Country: iso3166.CH, Name: "Sankt Gallen", Category: "Canton"},
+	"CH-SH":  {Code: "CH-SH", Country: iso3166.CH, Name: "Schaffhausen", Category: "Canton"},
+	"CH-SO":  {Code: "CH-SO", Country: iso3166.CH, Name: "Solothurn", Category: "Canton"},
+	"CH-SZ":  {Code: "CH-SZ", Country: iso3166.CH, Name: "Schwyz", Category: "Canton"},
+	"CH-TG":  {Code: "CH-TG", Country: iso3166.CH, Name: "Thurgau", Category: "Canton"},
+	"CH-TI":  {Code: "CH-TI", Country: iso3166.CH, Name: "Ticino", Category: "Canton"},
+	"CH-UR":  {Code: "CH-UR", Country: iso3166.CH, Name: "Uri", Category: "Canton"},
+	"CH-VD":  {Code: "CH-VD", Country: iso3166.CH, Name: "Vaud", Category: "Canton"},
+	"CH-VS":  {Code: "CH-VS", Country: iso3166.CH, Name: "Valais", Category: "Canton"},
+	"CH-ZG":  {Code: "CH-ZG", Country: iso3166.CH, Name: "Zug", Category: "Canton"},
+	"CH-ZH":  {Code: "CH-ZH", Country: iso3166.CH, Name: "Zürich", Category: "Canton"},
+	"CI-AB":  {Code: "CI-AB", Country: iso3166.CI, Name: "Abidjan", Category: "Autonomous district"},
+	"CI-BS":  {Code: "CI-BS", Country: iso3166.CI, Name: "Bas-Sassandra", Category: "District"},
+	"CI-CM":  {Code: "CI-CM", Country: iso3166.CI, Name: "Comoé", Category: "District"},
+	"CI-DN":  {Code: "CI-DN", Country: iso3166.CI, Name: "Denguélé", Category: "District"},
+	"CI-GD":  {Code: "CI-GD", Country: iso3166.CI, Name: "Gôh-Djiboua", Category: "District"},
+	"CI-LC":  {Code: "CI-LC", Country: iso3166.CI, Name: "Lacs", Category: "District"},
+	"CI-LG":  {Code: "CI-LG", Country: iso3166.CI, Name: "Lagunes", Category: "District"},
+	"CI-MG":  {Code: "CI-MG", Country: iso3166.CI, Name: "Montagnes", Category: "District"},
+	"CI-SM":  {Code: "CI-SM", Country: iso3166.CI, Name: "Sassandra-Marahoué", Category: "District"},
+	"CI-SV":  {Code: "CI-SV", Country: iso3166.CI, Name: "Savanes", Category: "District"},
+	"CI-VB":  {Code: "CI-VB", Country: iso3166.CI, Name: "Vallée du Bandama", Category: "District"},
+	"CI-WR":  {Code: "CI-WR", Country: iso3166.CI, Name: "Woroba", Category: "District"},
+	"CI-YM":  {Code: "CI-YM", Country: iso3166.CI, Name: "Yamoussoukro", Category: "Autonomous district"},
+	"CI-ZZ":  {Code: "CI-ZZ", Country: iso3166.CI, Name: "Zanzan", Category: "District"},
+	"CL-AI":  {Code: "CL-AI", Country: iso3166.CL, Name: "Aisén del General Carlos Ibañez del Campo", Category: "Region"},
+	"CL-AN":  {Code: "CL-AN", Country: iso3166.CL, Name: "Antofagasta", Category: "Region"},
+	"CL-AP":  {Code: "CL-AP", Country: iso3166.CL, Name: "Arica y Parinacota", Category: "Region"},
+	"CL-AR":  {Code: "CL-AR", Country: iso3166.CL, Name: "La Araucanía", Category: "Region"},
+	"CL-AT":  {Code: "CL-AT", Country: iso3166.CL, Name: "Atacama", Category: "Region"},
+	"CL-BI":  {Code: "CL-BI", Country: iso3166.CL, Name: "Biobío", Category: "Region"},
+	"CL-CO":  {Code: "CL-CO", Country: iso3166.CL, Name: "Coquimbo", Category: "Region"},
+	"CL-LI":  {Code: "CL-LI", Country: iso3166.CL, Name: "Libertador General Bernardo O'Higgins", Category: "Region"},
+	"CL-LL":  {Code: "CL-LL", Country: iso3166.CL, Name: "Los Lagos", Category: "Region"},
+	"CL-LR":  {Code: "CL-LR", Country: iso3166.CL, Name: "Los Ríos", Category: "Region"},
+	"CL-MA":  {Code: "CL-MA", Country: iso3166.CL, Name: "Magallanes", Category: "Region"},
+	"CL-ML":  {Code: "CL-ML", Country: iso3166.CL, Name: "Maule", Category: "Region"},
+	"CL-NB":  {Code: "CL-NB", Country: iso3166.CL, Name: "Ñuble", Category: "Region"},
+	"CL-RM":  {Code: "CL-RM", Country: iso3166.CL, Name: "Región Metropolitana de Santiago", Category: "Region"},
+	"CL-TA":  {Code: "CL-TA", Country: iso3166.CL, Name: "Tarapacá", Category: "Region"},
+	"CL-VS":  {Code: "CL-VS", Country: iso3166.CL, Name: "Valparaíso", Category: "Region"},
+	"CM-AD":  {Code: "CM-AD", Country: iso3166.CM, Name: "Adamaoua", Category: "Region"},
+	"CM-CE":  {Code: "CM-CE", Country: iso3166.CM, Name: "Centre", Category: "Region"},
+	"CM-EN":  {Code: "CM-EN", Country: iso3166.CM, Name: "Far North", Category: "Region"},
+	"CM-ES":  {Code: "CM-ES", Country: iso3166.CM, Name: "East", Category: "Region"},
+	"CM-LT":  {Code: "CM-LT", Country: iso3166.CM, Name: "Littoral", Category: "Region"},
+	"CM-NO":  {Code: "CM-NO", Country: iso3166.CM, Name: "North", Category: "Region"},
+	"CM-NW":  {Code: "CM-NW", Country: iso3166.CM, Name: "North-West", Category: "Region"},
+	"CM-OU":  {Code: "CM-OU", Country: iso3166.CM, Name: "West", Category: "Region"},
+	"CM-SU":  {Code: "CM-SU", Country: iso3166.CM, Name: "South", Category: "Region"},
+	"CM-SW":  {Code: "CM-SW", Country: iso3166.CM, Name: "South-West", Category: "Region"},
+	"CN-AH":  {Code: "CN-AH", Country: iso3166.CN, Name: "Anhui Sheng", Category: "Province"},
+	"CN-BJ":  {Code: "CN-BJ", Country: iso3166.CN, Name: "Beijing Shi", Category: "Municipality"},
+	"CN-CQ":  {Code: "CN-CQ", Country: iso3166.CN, Name: "Chongqing Shi", Category: "Municipality"},
+	"CN-FJ":  {Code: "CN-FJ", Country: iso3166.CN, Name: "Fujian Sheng", Category: "Province"},
+	"CN-GD":  {Code: "CN-GD", Country: iso3166.CN, Name: "Guangdong Sheng", Category: "Province"},
+	"CN-GS":  {Code: "CN-GS", Country: iso3166.CN, Name: "Gansu Sheng", Category: "Province"},
+	"CN-GX":  {Code: "CN-GX", Country: iso3166.CN, Name: "Guangxi Zhuangzu Zizhiqu", Category: "Autonomous region"},
+	"CN-GZ":  {Code: "CN-GZ", Country: iso3166.CN, Name: "Guizhou Sheng", Category: "Province"},
+	"CN-HA":  {Code: "CN-HA", Country: iso3166.CN, Name: "Henan Sheng", Category: "Province"},
+	"CN-HB":  {Code: "CN-HB", Country: iso3166.CN, Name: "Hubei Sheng", Category: "Province"},
+	"CN-HE":  {Code: "CN-HE", Country: iso3166.CN, Name: "Hebei Sheng", Category: "Province"},
+	"CN-HI":  {Code: "CN-HI", Country: iso3166.CN, Name: "Hainan Sheng", Category: "Province"},
+	"CN-HK":  {Code: "CN-HK", Country: iso3166.CN, Name: "Hong Kong SAR", Category: "Special administrative region"},
+	"CN-HL":  {Code: "CN-HL", Country: iso3166.CN, Name: "Heilongjiang Sheng", Category: "Province"},
+	"CN-HN":  {Code: "CN-HN", Country: iso3166.CN, Name: "Hunan Sheng", Category: "Province"},
+	"CN-JL":  {Code: "CN-JL", Country: iso3166.CN, Name: "Jilin Sheng", Category: "Province"},
+	"CN-JS":  {Code: "CN-JS", Country: iso3166.CN, Name: "Jiangsu Sheng", Category: "Province"},
+	"CN-JX":  {Code: "CN-JX", Country: iso3166.CN, Name: "Jiangxi Sheng", Category: "Province"},
+	"CN-LN":  {Code: "CN-LN", Country: iso3166.CN, Name: "Liaoning Sheng", Category: "Province"},
+	"CN-MO":  {Code: "CN-MO", Country: iso3166.CN, Name: "Macao SAR", Category: "Special administrative region"},
+	"CN-NM":  {Code: "CN-NM", Country: iso3166.CN, Name: "Nei Mongol Zizhiqu", Category: "Autonomous region"},
+	"CN-NX":  {Code: "CN-NX", Country: iso3166.CN, Name: "Ningxia Huizi Zizhiqu", Category: "Autonomous region"},
+	"CN-QH":  {Code: "CN-QH", Country: iso3166.CN, Name: "Qinghai Sheng", Category: "Province"},
+	"CN-SC":  {Code: "CN-SC", Country: iso3166.CN, Name: "Sichuan Sheng", Category: "Province"},
+	"CN-SD":  {Code: "CN-SD", Country: iso3166.CN, Name: "Shandong Sheng", Category: "Province"},
+	"CN-SH":  {Code: "CN-SH", Country: iso3166.CN, Name: "Shanghai Shi", Category: "Municipality"},
+	"CN-SN":  {Code: "CN-SN", Country: iso3166.CN, Name: "Shaanxi Sheng", Category: "Province"},
+	"CN-SX":  {Code: "CN-SX", Country: iso3166.CN, Name: "Shanxi Sheng", Category: "Province"},
+	"CN-TJ":  {Code: "CN-TJ", Country: iso3166.CN, Name: "Tianjin Shi", Category: "Municipality"},
+	"CN-TW":  {Code: "CN-TW", Country: iso3166.CN, Name: "Taiwan Sheng", Category: "Province"},
+	"CN-XJ":  {Code: "CN-XJ", Country: iso3166.CN, Name: "Xinjiang Uygur Zizhiqu", Category: "Autonomous region"},
+	"CN-XZ":  {Code: "CN-XZ", Country: iso3166.CN, Name: "Xizang Zizhiqu", Category: "Autonomous region"},
+	"CN-YN":  {Code: "CN-YN", Country: iso3166.CN, Name: "Yunnan Sheng", Category: "Province"},
+	"CN-ZJ":  {Code: "CN-ZJ", Country: iso3166.CN, Name: "Zhejiang Sheng", Category: "Province"},
+	"CO-AMA": {Code: "CO-AMA", Country: iso3166.CO, Name: "Amazonas", Category: "Department"},
+	"CO-ANT": {Code: "CO-ANT", Country: iso3166.CO, Name: "Antioquia", Category: "Department"},
+	"CO-ARA": {Code: "CO-ARA", Country: iso3166.CO, Name: "Arauca", Category: "Department"},
+	"CO-ATL": {Code: "CO-ATL", Country: iso3166.CO, Name: "Atlántico", Category: "Department"},
+	"CO-BOL": {Code: "CO-BOL", Country: iso3166.CO, Name: "Bolívar", Category: "Department"},
+	"CO-BOY": {Code: "CO-BOY", Country: iso3166.CO, Name: "Boyacá", Category: "Department"},
+	"CO-CAL": {Code: "CO-CAL", Country: iso3166.CO, Name: "Caldas", Category: "Department"},
+	"CO-CAQ": {Code: "CO-CAQ", Country: iso3166.CO, Name: "Caquetá", Category: "Department"},
+	"CO-CAS": {Code: "CO-CAS", Country: iso3166.CO, Name: "Casanare", Category: "Department"},
+	"CO-CAU": {Code: "CO-CAU", Country: iso3166.CO, Name: "Cauca", Category: "Department"},
+	"CO-CES": {Code: "CO-CES", Country: iso3166.CO, Name: "Cesar", Category: "Department"},
+	"CO-CHO": {Code: "CO-CHO", Country: iso3166.CO, Name: "Chocó", Category: "Department"},
+	"CO-COR": {Code: "CO-COR", Country: iso3166.CO, Name: "Córdoba", Category: "Department"},
+	"CO-CUN": {Code: "CO-CUN", Country: iso3166.CO, Name: "Cundinamarca", Category: "Department"},
+	"CO-DC":  {Code: "CO-DC", Country: iso3166.CO, Name: "Distrito Capital de Bogotá", Category: "Capital district"},
+	"CO-GUA": {Code: "CO-GUA", Country: iso3166.CO, Name: "Guainía", Category: "Department"},
+	"CO-GUV": {Code: "CO-GUV", Country: iso3166.CO, Name: "Guaviare", Category: "Department"},
+	"CO-HUI": {Code: "CO-HUI", Country: iso3166.CO, Name: "Huila", Category: "Department"},
+	"CO-LAG": {Code: "CO-LAG", Country: iso3166.CO, Name: "La Guajira", Category: "Department"},
+	"CO-MAG": {Code: "CO-MAG", Country: iso3166.CO, Name: "Magdalena", Category: "Department"},
+	"CO-MET": {Code: "CO-MET", Country: iso3166.CO, Name: "Meta", Category: "Department"},
+	"CO-NAR": {Code: "CO-NAR", Country: iso3166.CO, Name: "Nariño", Category: "Department"},
+	"CO-NSA": {Code: "CO-NSA", Country: iso3166.CO, Name: "Norte de Santander", Category: "Department"},
+	"CO-PUT": {Code: "CO-PUT", Country: iso3166.CO, Name: "Putumayo", Category: "Department"},
+	"CO-QUI": {Code: "CO-QUI", Country: iso3166.CO, Name: "Quindío", Category: "Department"},
+	"CO-RIS": {Code: "CO-RIS", Country: iso3166.CO, Name: "Risaralda", Category: "Department"},
+	"CO-SAN": {Code: "CO-SAN", Country: iso3166.CO, Name: "Santander", Category: "Department"},
+	"CO-SAP": {Code: "CO-SAP", Country: iso3166.CO, Name: "San Andrés, Providencia y Santa Catalina", Category: "Department"},
+	"CO-SUC": {Code: "CO-SUC", Country: iso3166.CO, Name: "Sucre", Category: "Department"},
+	"CO-TOL": {Code: "CO-TOL", Country: iso3166.CO, Name: "Tolima", Category: "Department"},
+	"CO-VAC": {Code: "CO-VAC", Country: iso3166.CO, Name: "Valle del Cauca", Category: "Department"},
+	"CO-VAU": {Code: "CO-VAU", Country: iso3166.CO, Name: "Vaupés", Category: "Department"},
+	"CO-VID": {Code: "CO-VID", Country: iso3166.CO, Name: "Vichada", Category: "Department"},
+	"CR-A":   {Code: "CR-A", Country: iso3166.CR, Name: "Alajuela", Category: "Province"},
+	"CR-C":   {Code: "CR-C", Country: iso3166.CR, Name: "Cartago", Category: "Province"},
+	"CR-G":   {Code: "CR-G", Country: iso3166.CR, Name: "Guanacaste", Category: "Province"},
+	"CR-H":   {Code: "CR-H", Country: iso3166.CR, Name: "Heredia", Category: "Province"},
+	"CR-L":   {Code: "CR-L", Country: iso3166.CR, Name: "Limón", Category: "Province"},
+	"CR-P":   {Code: "CR-P", Country: iso3166.CR, Name: "Puntarenas", Category: "Province"},
+	"CR-SJ":  {Code: "CR-SJ", Country: iso3166.CR, Name: "San José", Category: "Province"},
+	"CU-01":  {Code: "CU-01", Country: iso3166.CU, Name: "Pinar del Río", Category: "Province"},
+	"CU-03":  {Code: "CU-03", Country: iso3166.CU, Name: "La Habana", Category: "Province"},
+	"CU-04":  {Code: "CU-04", Country: iso3166.CU, Name: "Matanzas", Category: "Province"},
+	"CU-05":  {Code: "CU-05", Country: iso3166.CU, Name: "Villa Clara", Category: "Province"},
+	"CU-06":  {Code: "CU-06", Country: iso3166.CU, Name: "Cienfuegos", Category: "Province"},
+	"CU-07":  {Code: "CU-07", Country: iso3166.CU, Name: "Sancti Spíritus", Category: "Province"},
+	"CU-08":  {Code: "CU-08", Country: iso3166.CU, Name: "Ciego de Ávila", Category: "Province"},
+	"CU-09":  {Code: "CU-09", Country: iso3166.CU, Name: "Camagüey", Category: "Province"},
+	"CU-10":  {Code: "CU-10", Country: iso3166.CU, Name: "Las Tunas", Category: "Province"},
+	"CU-11":  {Code: "CU-11", Country: iso3166.CU, Name: "Holguín", Category: "Province"},
+	"CU-12":  {Code: "CU-12", Country: iso3166.CU, Name: "Granma", Category: "Province"},
+	"CU-13":  {Code: "CU-13", Country: iso3166.CU, Name: "Santiago de Cuba", Category: "Province"},
+	"CU-14":  {Code: "CU-14", Country: iso3166.CU, Name: "Guantánamo", Category: "Province"},
+	"CU-15":  {Code: "CU-15", Country: iso3166.CU, Name: "Artemisa", Category: "Province"},
+	"CU-16":  {Code: "CU-16", Country: iso3166.CU, Name: "Mayabeque", Category: "Province"},
+	"CU-99":  {Code: "CU-99", Country: iso3166.CU, Name: "Isla de la Juventud", Category: "Special municipality"},
+	"CV-B":   {Code: "CV-B", Country: iso3166.CV, Name: "Ilhas de Barlavento", Category: "Geographical region"},
+	"CV-BR":  {Code: "CV-BR", Country: iso3166.CV, Name: "Brava", Category: "Municipality", ParentCode: "CV-S"},
+	"CV-BV":  {Code: "CV-BV", Country: iso3166.CV, Name: "Boa Vista", Category: "Municipality", ParentCode: "CV-B"},
+	"CV-CA":  {Code: "CV-CA", Country: iso3166.CV, Name: "Santa Catarina", Category: "Municipality", ParentCode: "CV-S"},
+	"CV-CF":  {Code: "CV-CF", Country: iso3166.CV, Name: "Santa Catarina do Fogo", Category: "Municipality", ParentCode: "CV-S"},
+	"CV-CR":  {Code: "CV-CR", Country: iso3166.CV, Name: "Santa Cruz", Category: "Municipality", ParentCode: "CV-S"},
+	"CV-MA":  {Code: "CV-MA", Country: iso3166.CV, Name: "Maio", Category: "Municipality", ParentCode: "CV-S"},
+	"CV-MO":  {Code: "CV-MO", Country: iso3166.CV, Name: "Mosteiros", Category: "Municipality", ParentCode: "CV-S"},
+	"CV-PA":  {Code: "CV-PA", Country: iso3166.CV, Name: "Paul", Category: "Municipality", ParentCode: "CV-B"},
+	"CV-PN":  {Code: "CV-PN", Country: iso3166.CV, Name: "Porto Novo", Category: "Municipality", ParentCode: "CV-B"},
+	"CV-PR":  {Code: "CV-PR", Country: iso3166.CV, Name: "Praia", Category: "Municipality", ParentCode: "CV-S"},
+	"CV-RB":  {Code: "CV-RB", Country: iso3166.CV, Name: "Ribeira Brava", Category: "Municipality", ParentCode: "CV-B"},
+	"CV-RG":  {Code: "CV-RG", Country: iso3166.CV, Name: "Ribeira Grande", Category: "Municipality", ParentCode: "CV-B"},
+	"CV-RS":  {Code: "CV-RS", Country: iso3166.CV, Name: "Ribeira Grande de Santiago", Category: "Municipality", ParentCode: "CV-S"},
+	"CV-S":   {Code: "CV-S", Country: iso3166.CV, Name: "Ilhas de Sotavento", Category: "Geographical region"},
+	"CV-SD":  {Code: "CV-SD", Country: iso3166.CV, Name: "São Domingos", Category: "Municipality", ParentCode: "CV-S"},
+	"CV-SF":  {Code: "CV-SF", Country: iso3166.CV, Name: "São Filipe", Category: "Municipality", ParentCode: "CV-S"},
+	"CV-SL":  {Code: "CV-SL", Country: iso3166.CV, Name: "Sal", Category: "Municipality", ParentCode: "CV-B"},
+	"CV-SM":  {Code: "CV-SM", Country: iso3166.CV, Name: "São Miguel", Category: "Municipality", ParentCode: "CV-S"},
+	"CV-SO":  {Code: "CV-SO", Country: iso3166.CV, Name: "São Lourenço dos Órgãos", Category: "Municipality", ParentCode: "CV-S"},
+	"CV-SS":  {Code: "CV-SS", Country: iso3166.CV, Name: "São Salvador do Mundo", Category: "Municipality", ParentCode: "CV-S"},
+	"CV-SV":  {Code: "CV-SV", Country: iso3166.CV, Name: "São Vicente", Category: "Municipality", ParentCode: "CV-B"},
+	"CV-TA":  {Code: "CV-TA", Country: iso3166.CV, Name: "Tarrafal", Category: "Municipality", ParentCode: "CV-S"},
+	"CV-TS":  {Code: "CV-TS", Country: iso3166.CV, Name: "Tarrafal de São Nicolau", Category: "Municipality", ParentCode: "CV-B"},
+	"CY-01":  {Code: "CY-01", Country: iso3166.CY, Name: "Lefkosia", Category: "District"},
+	"CY-02":  {Code: "CY-02", Country: iso3166.CY, Name: "Lemesos", Category: "District"},
+	"CY-03":  {Code: "CY-03", Country: iso3166.CY, Name: "Larnaka", Category: "District"},
+	"CY-04":  {Code: "CY-04", Country: iso3166.CY, Name: "Ammochostos", Category: "District"},
+	"CY-05":  {Code: "CY-05", Country: iso3166.CY, Name: "Baf", Category: "District"},
+	"CY-06":  {Code: "CY-06", Country: iso3166.CY, Name: "Girne", Category: "District"},
+	"CZ-10":  {Code: "CZ-10", Country: iso3166.CZ, Name: "Praha, Hlavní město", Category: "Capital city"},
+	"CZ-20":  {Code: "CZ-20", Country: iso3166.CZ, Name: "Středočeský kraj", Category: "Region"},
+	"CZ-201": {Code: "CZ-201", Country: iso3166.CZ, Name: "Benešov", Category: "District", ParentCode: "CZ-20"},
+	"CZ-202": {Code: "CZ-202", Country: iso3166.CZ, Name: "Beroun", Category: "District", ParentCode: "CZ-20"},
+	"CZ-203": {Code: "CZ-203", Country: iso3166.CZ, Name: "Kladno", Category: "District", ParentCode: "CZ-20"},
+	"CZ-204": {Code: "CZ-204", Country: iso3166.CZ, Name: "Kolín", Category: "District", ParentCode: "CZ-20"},
+	"CZ-205": {Code: "CZ-205", Country: iso3166.CZ, Name: "Kutná Hora", Category: "District", ParentCode: "CZ-20"},
+	"CZ-206": {Code: "CZ-206", Country: iso3166.CZ, Name: "Mělník", Category: "District", ParentCode: "CZ-20"},
+	"CZ-207": {Code: "CZ-207", Country: iso3166.CZ, Name: "Mladá Boleslav", Category: "District", ParentCode: "CZ-20"},
+	"CZ-208": {Code: "CZ-208", Country: iso3166.CZ, Name: "Nymburk", Category: "District", ParentCode: "CZ-20"},
+	"CZ-209": {Code: "CZ-209", Country: iso3166.CZ, Name: "Praha-východ", Category: "District", ParentCode: "CZ-20"},
+	"CZ-20A": {Code: "CZ-20A", Country: iso3166.CZ, Name: "Praha-západ", Category: "District", ParentCode: "CZ-20"},
+	"CZ-20B": {Code: "CZ-20B", Country: iso3166.CZ, Name: "Příbram", Category: "District", ParentCode: "CZ-20"},
+	"CZ-20C": {Code: "CZ-20C", Country: iso3166.CZ, Name: "Rakovník", Category: "District", ParentCode: "CZ-20"},
+	"CZ-31":  {Code: "CZ-31", Country: iso3166.CZ, Name: "Jihočeský kraj", Category: "Region"},
+	"CZ-311": {Code: "CZ-311", Country: iso3166.CZ, Name: "České Budějovice", Category: "District", ParentCode: "CZ-31"},
+	"CZ-312": {Code: "CZ-312", Country: iso3166.CZ, Name: "Český Krumlov", Category: "District", ParentCode: "CZ-31"},
+	"CZ-313": {Code: "CZ-313", Country: iso3166.CZ, Name: "Jindřichův Hradec", Category: "District", ParentCode: "CZ-31"},
+	"CZ-314": {Code: "CZ-314", Country: iso3166.CZ, Name: "Písek", Category: "District", ParentCode: "CZ-31"},
+	"CZ-315": {Code: "CZ-315", Country: iso3166.CZ, Name: "Prachatice", Category: "District", ParentCode: "CZ-31"},
+	"CZ-316": {Code: "CZ-316", Country: iso3166.CZ, Name: "Strakonice", Category: "District", ParentCode: "CZ-31"},
+	"CZ-317": {Code: "CZ-317", Country: iso3166.CZ, Name: "Tábor", Category: "District", ParentCode: "CZ-31"},
+	"CZ-32":  {Code: "CZ-32", Country: iso3166.CZ, Name: "Plzeňský kraj", Category: "Region"},
+	"CZ-321": {Code: "CZ-321", Country: iso3166.CZ, Name: "Domažlice", Category: "District", ParentCode: "CZ-32"},
+	"CZ-322": {Code: "CZ-322", Country: iso3166.CZ, Name: "Klatovy", Category: "District", ParentCode: "CZ-32"},
+	"CZ-323": {Code: "CZ-323", Country: iso3166.CZ, Name: "Plzeň-město", Category: "District", ParentCode: "CZ-32"},
+	"CZ-324": {Code: "CZ-324", Country: iso3166.CZ, Name: "Plzeň-jih", Category: "District", ParentCode: "CZ-32"},
+	"CZ-325": {Code: "CZ-325", Country: iso3166.CZ, Name: "Plzeň-sever", Category: "District", ParentCode: "CZ-32"},
+	"CZ-326": {Code: "CZ-326", Country: iso3166.CZ, Name: "Rokycany", Category: "District", ParentCode: "CZ-32"},
+	"CZ-327": {Code: "CZ-327", Country: iso3166.CZ, Name: "Tachov", Category: "District", ParentCode: "CZ-32"},
+	"CZ-41":  {Code: "CZ-41", Country: iso3166.CZ, Name: "Karlovarský kraj", Category: "Region"},
+	"CZ-411": {Code: "CZ-411", Country: iso3166.CZ, Name: "Cheb", Category: "District", ParentCode: "CZ-41"},
+	"CZ-412": {Code: "CZ-412", Country: iso3166.CZ, Name: "Karlovy Vary", Category: "District", ParentCode: "CZ-41"},
+	"CZ-413": {Code: "CZ-413", Country: iso3166.CZ, Name: "Sokolov", Category: "District", ParentCode: "CZ-41"},
+	"CZ-42":  {Code: "CZ-42", Country: iso3166.CZ, Name: "Ústecký kraj", Category: "Region"},
+	"CZ-421": {Code: "CZ-421", Country: iso3166.CZ, Name: "Děčín", Category: "District", ParentCode: "CZ-42"},
+	"CZ-422": {Code: "CZ-422", Country: iso3166.CZ, Name: "Chomutov", Category: "District", ParentCode: "CZ-42"},
+	"CZ-423": {Code: "CZ-423", Country: iso3166.CZ, Name: "Litoměřice", Category: "District", ParentCode: "CZ-42"},
+	"CZ-424": {Code: "CZ-424", Country: iso3166.CZ, Name: "Louny", Category: "District", ParentCode: "CZ-42"},
+	"CZ-425": {Code: "CZ-425", Country: iso3166.CZ, Name: "Most", Category: "District", ParentCode: "CZ-42"},
+	"CZ-426": {Code: "CZ-426", Country: iso3166.CZ, Name: "Teplice", Category: "District", ParentCode: "CZ-42"},
+	"CZ-427": {Code: "CZ-427", Country: iso3166.CZ, Name: "Ústí nad Labem", Category: "District", ParentCode: "CZ-42"},
+	"CZ-51":  {Code: "CZ-51", Country: iso3166.CZ, Name: "Liberecký kraj", Category: "Region"},
+	"CZ-511": {Code: "CZ-511", Country: iso3166.CZ, Name: "Česká Lípa", Category: "District", ParentCode: "CZ-51"},
+	"CZ-512": {Code: "CZ-512", Country: iso3166.CZ, Name: "Jablonec nad Nisou", Category: "District", ParentCode: "CZ-51"},
+	"CZ-513": {Code: "CZ-513", Country: iso3166.CZ, Name: "Liberec", Category: "District", ParentCode: "CZ-51"},
+	"CZ-514": {Code: "CZ-514", Country: iso3166.CZ, Name: "Semily", Category: "District", ParentCode: "CZ-51"},
+	"CZ-52":  {Code: "CZ-52", Country: iso3166.CZ, Name: "Královéhradecký kraj", Category: "Region"},
+	"CZ-521": {Code: "CZ-521", Country: iso3166.CZ, Name: "Hradec Králové", Category: "District", ParentCode: "CZ-52"},
+	"CZ-522": {Code: "CZ-522", Country: iso3166.CZ, Name: "Jičín", Category: "District", ParentCode: "CZ-52"},
+	"CZ-523": {Code: "CZ-523", Country: iso3166.CZ, Name: "Náchod", Category: "District", ParentCode: "CZ-52"},
+	"CZ-524": {Code: "CZ-524", Country: iso3166.CZ, Name: "Rychnov nad Kněžnou", Category: "District", ParentCode: "CZ-52"},
+	"CZ-525": {Code: "CZ-525", Country: iso3166.CZ, Name: "Trutnov", Category: "District", ParentCode: "CZ-52"},
+	"CZ-53":  {Code: "CZ-53", Country: iso3166.CZ, Name: "Pardubický kraj", Category: "Region"},
+	"CZ-531": {Code: "CZ-531", Country: iso3166.CZ, Name: "Chrudim", Category: "District", ParentCode: "CZ-53"},
+	"CZ-532": {Code: "CZ-532", Country: iso3166.CZ, Name: "Pardubice", Category: "District", ParentCode: "CZ-53"},
+	"CZ-533": {Code: "CZ-533", Country: iso3166.CZ, Name: "Svitavy", Category: "District", ParentCode: "CZ-53"},
+	"CZ-534": {Code: "CZ-534", Country: iso3166.CZ, Name: "Ústí nad Orlicí", Category: "District", ParentCode: "CZ-53"},
+	"CZ-63":  {Code: "CZ-63", Country: iso3166.CZ, Name: "Kraj Vysočina", Category: "Region"},
+	"CZ-631": {Code: "CZ-631", Country: iso3166.CZ, Name: "Havlíčkův Brod", Category: "District", ParentCode: "CZ-63"},
+	"CZ-632": {Code: "CZ-632", Country: iso3166.CZ, Name: "Jihlava", Category: "District", ParentCode: "CZ-63"},
+	"CZ-633": {Code: "CZ-633", Country: iso3166.CZ, Name: "Pelhřimov", Category: "District", ParentCode: "CZ-63"},
+	"CZ-634": {Code: "CZ-634", Country: iso3166.CZ, Name: "Třebíč", Category: "District", ParentCode: "CZ-63"},
+	"CZ-635": {Code: "CZ-635", Country: iso3166.CZ, Name: "Žďár nad Sázavou", Category: "District", ParentCode: "CZ-63"},
+	"CZ-64":  {Code: "CZ-64", Country: iso3166.CZ, Name: "Jihomoravský kraj", Category: "Region"},
+	"CZ-641": {Code: "CZ-641", Country: iso3166.CZ, Name: "Blansko", Category: "District", ParentCode: "CZ-64"},
+	"CZ-642": {Code: "CZ-642", Country: iso3166.CZ, Name: "Brno-město", Category: "District", ParentCode: "CZ-64"},
+	"CZ-643": {Code: "CZ-643", Country: iso3166.CZ, Name: "Brno-venkov", Category: "District", ParentCode: "CZ-64"},
+	"CZ-644": {Code: "CZ-644", Country: iso3166.CZ, Name: "Břeclav", Category: "District", ParentCode: "CZ-64"},
+	"CZ-645": {Code: "CZ-645", Country: iso3166.CZ, Name: "Hodonín", Category: "District", ParentCode: "CZ-64"},
+	"CZ-646": {Code: "CZ-646", Country: iso3166.CZ, Name: "Vyškov", Category: "District", ParentCode: "CZ-64"},
+	"CZ-647": {Code: "CZ-647", Country: iso3166.CZ, Name: "Znojmo", Category: "District", ParentCode: "CZ-64"},
+	"CZ-71":  {Code: "CZ-71", Country: iso3166.CZ, Name: "Olomoucký kraj", Category: "Region"},
+	"CZ-711": {Code: "CZ-711", Country: iso3166.CZ, Name: "Jeseník", Category: "District", ParentCode: "CZ-71"},
+	"CZ-712": {Code: "CZ-712", Country: iso3166.CZ, Name: "Olomouc", Category: "District", ParentCode: "CZ-71"},
+	"CZ-713": {Code: "CZ-713", Country: iso3166.CZ, Name: "Prostějov", Category: "District", ParentCode: "CZ-71"},
+	"CZ-714": {Code: "CZ-714", Country: iso3166.CZ, Name: "Přerov", Category: "District", ParentCode: "CZ-71"},
+	"CZ-715": {Code: "CZ-715", Country: iso3166.CZ, Name: "Šumperk", Category: "District", ParentCode: "CZ-71"},
+	"CZ-72":  {Code: "CZ-72", Country: iso3166.CZ, Name: "Zlínský kraj", Category: "Region"},
+	"CZ-721": {Code: "CZ-721", Country: iso3166.CZ, Name: "Kroměříž", Category: "District", ParentCode: "CZ-72"},
+	"CZ-722": {Code: "CZ-722", Country: iso3166.CZ, Name: "Uherské Hradiště", Category: "District", ParentCode: "CZ-72"},
+	"CZ-723": {Code: "CZ-723", Country: iso3166.CZ, Name: "Vsetín", Category: "District", ParentCode: "CZ-72"},
+	"CZ-724": {Code: "CZ-724", Country: iso3166.CZ, Name: "Zlín", Category: "District", ParentCode: "CZ-72"},
+	"CZ-80":  {Code: "CZ-80", Country: iso3166.CZ, Name: "Moravskoslezský kraj", Category: "Region"},
+	"CZ-801": {Code: "CZ-801", Country: iso3166.CZ, Name: "Bruntál", Category: "District", ParentCode: "CZ-80"},
+	"CZ-802": {Code: "CZ-802", Country: iso3166.CZ, Name: "Frýdek-Místek", Category: "District", ParentCode: "CZ-80"},
+	"CZ-803": {Code: "CZ-803", Country: iso3166.CZ, Name: "Karviná", Category: "District", ParentCode: "CZ-80"},
+	"CZ-804": {Code: "CZ-804", Country: iso3166.CZ, Name: "Nový Jičín", Category: "District", ParentCode: "CZ-80"},
+	"CZ-805": {Code: "CZ-805", Country: iso3166.CZ, Name: "Opava", Category: "District", ParentCode: "CZ-80"},
+	"CZ-806": {Code: "CZ-806", Country: iso3166.CZ, Name: "Ostrava-město", Category: "District", ParentCode: "CZ-80"},
+	"DE-BB":  {Code: "DE-BB", Country: iso3166.DE, Name: "Brandenburg", Category: "Land"},
+	"DE-BE":  {Code: "DE-BE", Country: iso3166.DE, Name: "Berlin", Category: "Land"},
+	"DE-BW":  {Code: "DE-BW", Country: iso3166.DE, Name: "Baden-Württemberg", Category: "Land"},
+	"DE-BY":  {Code: "DE-BY", Country: iso3166.DE, Name: "Bayern", Category: "Land"},
+	"DE-HB":  {Code: "DE-HB", Country: iso3166.DE, Name: "Bremen", Category: "Land"},
+	"DE-HE":  {Code: "DE-HE", Country: iso3166.DE, Name: "Hessen", Category: "Land"},
+	"DE-HH":  {Code: "DE-HH", Country: iso3166.DE, Name: "Hamburg", Category: "Land"},
+	"DE-MV":  {Code: "DE-MV", Country: iso3166.DE, Name: "Mecklenburg-Vorpommern", Category: "Land"},
+	"DE-NI":  {Code: "DE-NI", Country: iso3166.DE, Name: "Niedersachsen", Category: "Land"},
+	"DE-NW":  {Code: "DE-NW", Country: iso3166.DE, Name: "Nordrhein-Westfalen", Category: "Land"},
+	"DE-RP":  {Code: "DE-RP", Country: iso3166.DE, Name: "Rheinland-Pfalz", Category: "Land"},
+	"DE-SH":  {Code: "DE-SH", Country: iso3166.DE, Name: "Schleswig-Holstein", Category: "Land"},
+	"DE-SL":  {Code: "DE-SL", Country: iso3166.DE, Name: "Saarland", Category: "Land"},
+	"DE-SN":  {Code: "DE-SN", Country: iso3166.DE, Name: "Sachsen", Category: "Land"},
+	"DE-ST":  {Code: "DE-ST", Country: iso3166.DE, Name: "Sachsen-Anhalt", Category: "Land"},
+	"DE-TH":  {Code: "DE-TH", Country: iso3166.DE, Name: "Thüringen", Category: "Land"},
+	"DJ-AR":  {Code: "DJ-AR", Country: iso3166.DJ, Name: "Arta", Category: "Region"},
+	"DJ-AS":  {Code: "DJ-AS", Country: iso3166.DJ, Name: "Ali Sabieh", Category: "Region"},
+	"DJ-DI":  {Code: "DJ-DI", Country: iso3166.DJ, Name: "Dikhil", Category: "Region"},
+	"DJ-DJ":  {Code: "DJ-DJ", Country: iso3166.DJ, Name: "Djibouti", Category: "City"},
+	"DJ-OB":  {Code: "DJ-OB", Country: iso3166.DJ, Name: "Awbūk", Category: "Region"},
+	"DJ-TA":  {Code: "DJ-TA", Country: iso3166.DJ, Name: "Tadjourah", Category: "Region"},
+	"DK-81":  {Code: "DK-81", Country: iso3166.DK, Name: "Nordjylland", Category: "Region"},
+	"DK-82":  {Code: "DK-82", Country: iso3166.DK, Name: "Midtjylland", Category: "Region"},
+	"DK-83":  {Code: "DK-83", Country: iso3166.DK, Name: "Syddanmark", Category: "Region"},
+	"DK-84":  {Code: "DK-84", Country: iso3166.DK, Name: "Hovedstaden", Category: "Region"},
+	"DK-85":  {Code: "DK-85", Country: iso3166.DK, Name: "Sjælland", Category: "Region"},
+	"DM-02":  {Code: "DM-02", Country: iso3166.DM, Name: "Saint Andrew", Category: "Parish"},
+	"DM-03":  {Code: "DM-03", Country: iso3166.DM, Name: "Saint David", Category: "Parish"},
+	"DM-04":  {Code: "DM-04", Country: iso3166.DM, Name: "Saint George", Category: "Parish"},
+	"DM-05":  {Code: "DM-05", Country: iso3166.DM, Name: "Saint John", Category: "Parish"},
+	"DM-06":  {Code: "DM-06", Country: iso3166.DM, Name: "Saint Joseph", Category: "Parish"},
+	"DM-07":  {Code: "DM-07", Country: iso3166.DM, Name: "Saint Luke", Category: "Parish"},
+	"DM-08":  {Code: "DM-08", Country: iso3166.DM, Name: "Saint Mark", Category: "Parish"},
+	"DM-09":  {Code: "DM-09", Country: iso3166.DM, Name: "Saint Patrick", Category: "Parish"},
+	"DM-10":  {Code: "DM-10", Country: iso3166.DM, Name: "Saint Paul", Category: "Parish"},
+	"DM-11":  {Code: "DM-11", Country: iso3166.DM, Name: "Saint Peter", Category: "Parish"},
+	"DO-01":  {Code: "DO-01", Country: iso3166.DO, Name: "Distrito Nacional (Santo Domingo)", Category: "District", ParentCode: "DO-40"},
+	"DO-02":  {Code: "DO-02", Country: iso3166.DO, Name: "Azua", Category: "Province", ParentCode: "DO-41"},
+	"DO-03":  {Code: "DO-03", Country: iso3166.DO, Name: "Baoruco", Category: "Province", ParentCode: "DO-38"},
+	"DO-04":  {Code: "DO-04", Country: iso3166.DO, Name: "Barahona", Category: "Province", ParentCode: "DO-38"},
+	"DO-05":  {Code: "DO-05", Country: iso3166.DO, Name: "Dajabón", Category: "Province", ParentCode: "DO-34"},
+	"DO-06":  {Code: "DO-06", Country: iso3166.DO, Name: "Duarte", Category: "Province", ParentCode: "DO-33"},
+	"DO-07":  {Code: "DO-07", Country: iso3166.DO, Name: "Elías Piña", Category: "Province", ParentCode: "DO-37"},
+	"DO-08":  {Code: "DO-08", Country: iso3166.DO, Name: "El Seibo", Category: "Province", ParentCode: "DO-42"},
+	"DO-09":  {Code: "DO-09", Country: iso3166.DO, Name: "Espaillat", Category: "Province", ParentCode: "DO-35"},
+	"DO-10":  {Code: "DO-10", Country: iso3166.DO, Name: "Independencia", Category: "Province", ParentCode: "DO-38"},
+	"DO-11":  {Code: "DO-11", Country: iso3166.DO, Name: "La Altagracia", Category: "Province", ParentCode: "DO-42"},
+	"DO-12":  {Code: "DO-12", Country: iso3166.DO, Name: "La Romana", Category: "Province", ParentCode: "DO-42"},
+	"DO-13":  {Code: "DO-13", Country: iso3166.DO, Name: "La Vega", Category: "Province", ParentCode: "DO-36"},
+	"DO-14":  {Code: "DO-14", Country: iso3166.DO, Name: "María Trinidad Sánchez", Category: "Province", ParentCode: "DO-33"},
+	"DO-15":  {Code: "DO-15", Country: iso3166.DO, Name: "Monte Cristi", Category: "Province", ParentCode: "DO-34"},
+	"DO-16":  {Code: "DO-16", Country: iso3166.DO, Name: "Pedernales", Category: "Province", ParentCode: "DO-38"},
+	"DO-17":  {Code: "DO-17", Country: iso3166.DO, Name: "Peravia", Category: "Province", ParentCode: "DO-41"},
+	"DO-18":  {Code: "DO-18", Country: iso3166.DO, Name: "Puerto Plata", Category: "Province", ParentCode: "DO-35"},
+	"DO-19":  {Code: "DO-19", Country: iso3166.DO, Name: "Hermanas Mirabal", Category: "Province", ParentCode: "DO-33"},
+	"DO-20":  {Code: "DO-20", Country: iso3166.DO, Name: "Samaná", Category: "Province", ParentCode: "DO-33"},
+	"DO-21":  {Code: "DO-21", Country: iso3166.DO, Name: "San Cristóbal", Category: "Province", ParentCode: "DO-41"},
+	"DO-22":  {Code: "DO-22", Country: iso3166.DO, Name: "San Juan", Category: "Province", ParentCode: "DO-37"},
+	"DO-23":  {Code: "DO-23", Country: iso3166.DO, Name: "San Pedro de Macorís", Category: "Province", ParentCode: "DO-39"},
+	"DO-24":  {Code: "DO-24", Country: iso3166.DO, Name: "Sánchez Ramírez", Category: "Province", ParentCode: "DO-36"},
+	"DO-25":  {Code: "DO-25", Country: iso3166.DO, Name: "Santiago", Category: "Province", ParentCode: "DO-35"},
+	"DO-26":  {Code: "DO-26", Country: iso3166.DO, Name: "Santiago Rodríguez", Category: "Province", ParentCode: "DO-34"},
+	"DO-27":  {Code: "DO-27", Country: iso3166.DO, Name: "Valverde", Category: "Province", ParentCode: "DO-34"},
+	"DO-28":  {Code: "DO-28", Country: iso3166.DO, Name: "Monseñor Nouel", Category: "Province", ParentCode: "DO-36"},
+	"DO-29":  {Code: "DO-29", Country: iso3166.DO, Name: "Monte Plata", Category: "Province", ParentCode: "DO-39"},
+	"DO-30":  {Code: "DO-30", Country: iso3166.DO, Name: "Hato Mayor", Category: "Province", ParentCode: "DO-39"},
+	"DO-31":  {Code: "DO-31", Country: iso3166.DO, Name: "San José de Ocoa", Category: "Province", ParentCode: "DO-41"},
+	"DO-32":  {Code: "DO-32", Country: iso3166.DO, Name: "Santo Domingo", Category: "Province", ParentCode: "DO-40"},
+	"DO-33":  {Code: "DO-33", Country: iso3166.DO, Name: "Cibao Nordeste", Category: "Region"},
+	"DO-34":  {Code: "DO-34", Country: iso3166.DO, Name: "Cibao Noroeste", Category: "Region"},
+	"DO-35":  {Code: "DO-35", Country: iso3166.DO, Name: "Cibao Norte", Category: "Region"},
+	"DO-36":  {Code: "DO-36", Country: iso3166.DO, Name: "Cibao Sur", Category: "Region"},
+	"DO-37":  {Code: "DO-37", Country: iso3166.DO, Name: "El Valle", Category: "Region"},
+	"DO-38":  {Code: "DO-38", Country: iso3166.DO, Name: "Enriquillo", Category: "Region"},
+	"DO-39":  {Code: "DO-39", Country: iso3166.DO, Name: "Higuamo", Category: "Region"},
+	"DO-40":  {Code: "DO-40", Country: iso3166.DO, Name: "Ozama", Category: "Region"},
+	"DO-41":  {Code: "DO-41", Country: iso3166.DO, Name: "Valdesia", Category: "Region"},
+	"DO-42":  {Code: "DO-42", Country: iso3166.DO, Name: "Yuma", Category: "Region"},
+	"DZ-01":  {Code: "DZ-01", Country: iso3166.DZ, Name: "Adrar", Category: "Province"},
+	"DZ-02":  {Code: "DZ-02", Country: iso3166.DZ, Name: "Chlef", Category: "Province"},
+	"DZ-03":  {Code: "DZ-03", Country: iso3166.DZ, Name: "Laghouat", Category: "Province"},
+	"DZ-04":  {Code: "DZ-04", Country: iso3166.DZ, Name: "Oum el Bouaghi", Category: "Province"},
+	"DZ-05":  {Code: "DZ-05", Country: iso3166.DZ, Name: "Batna", Category: "Province"},
+	"DZ-06":  {Code: "DZ-06", Country: iso3166.DZ, Name: "Béjaïa", Category: "Province"},
+	"DZ-07":  {Code: "DZ-07", Country: iso3166.DZ, Name: "Biskra", Category: "Province"},
+	"DZ-08":  {Code: "DZ-08", Country: iso3166.DZ, Name: "Béchar", Category: "Province"},
+	"DZ-09":  {Code: "DZ-09", Country: iso3166.DZ, Name: "Blida", Category: "Province"},
+	"DZ-10":  {Code: "DZ-10", Country: iso3166.DZ, Name: "Bouira", Category: "Province"},
+	"DZ-11":  {Code: "DZ-11", Country: iso3166.DZ, Name: "Tamanrasset", Category: "Province"},
+	"DZ-12":  {Code: "DZ-12", Country: iso3166.DZ, Name: "Tébessa", Category: "Province"},
+	"DZ-13":  {Code: "DZ-13", Country: iso3166.DZ, Name: "Tlemcen", Category: "Province"},
+	"DZ-14":  {Code: "DZ-14", Country: iso3166.DZ, Name: "Tiaret", Category: "Province"},
+	"DZ-15":  {Code: "DZ-15", Country: iso3166.DZ, Name: "Tizi Ouzou", Category: "Province"},
+	"DZ-16":  {Code: "DZ-16", Country: iso3166.DZ, Name: "Alger", Category: "Province"},
+	"DZ-17":  {Code: "DZ-17", Country: iso3166.DZ, Name: "Djelfa", Category: "Province"},
+	"DZ-18":  {Code: "DZ-18", Country: iso3166.DZ, Name: "Jijel", Category: "Province"},
+	"DZ-19":  {Code: "DZ-19", Country: iso3166.DZ, Name: "Sétif", Category: "Province"},
+	"DZ-20":  {Code: "DZ-20", Country: iso3166.DZ, Name: "Saïda", Category: "Province"},
+	"DZ-21":  {Code: "DZ-21", Country: iso3166.DZ, Name: "Skikda", Category: "Province"},
+	"DZ-22":  {Code: "DZ-22", Country: iso3166.DZ, Name: "Sidi Bel Abbès", Category: "Province"},
+	"DZ-23":  {Code: "DZ-23", Country: iso3166.DZ, Name: "Annaba", Category: "Province"},
+	"DZ-24":  {Code: "DZ-24", Country: iso3166.DZ, Name: "Guelma", Category: "Province"},
+	"DZ-25":  {Code: "DZ-25", Country: iso3166.DZ, Name: "Constantine", Category: "Province"},
+	"DZ-26":  {Code: "DZ-26", Country: iso3166.DZ, Name: "Médéa", Category: "Province"},
+	"DZ-27":  {Code: "DZ-27", Country: iso3166.DZ, Name: "Mostaganem", Category: "Province"},
+	"DZ-28":  {Code: "DZ-28", Country: iso3166.DZ, Name: "M'sila", Category: "Province"},
+	"DZ-29":  {Code: "DZ-29", Country: iso3166.DZ, Name: "Mascara", Category: "Province"},
+	"DZ-30":  {Code: "DZ-30", Country: iso3166.DZ, Name: "Ouargla", Category: "Province"},
+	"DZ-31":  {Code: "DZ-31", Country: iso3166.DZ, Name: "Oran", Category: "Province"},
+	"DZ-32":  {Code: "DZ-32", Country: iso3166.DZ, Name: "El Bayadh", Category: "Province"},
+	"DZ-33":  {Code: "DZ-33", Country: iso3166.DZ, Name: "Illizi", Category: "Province"},
+	"DZ-34":  {Code: "DZ-34", Country: iso3166.DZ, Name: "Bordj Bou Arréridj", Category: "Province"},
+	"DZ-35":  {Code: "DZ-35", Country: iso3166.DZ, Name: "Boumerdès", Category: "Province"},
+	"DZ-36":  {Code: "DZ-36", Country: iso3166.DZ, Name: "El Tarf", Category: "Province"},
+	"DZ-37":  {Code: "DZ-37", Country: iso3166.DZ, Name: "Tindouf", Category: "Province"},
+	"DZ-38":  {Code: "DZ-38", Country: iso3166.DZ, Name: "Tissemsilt", Category: "Province"},
+	"DZ-39":  {Code: "DZ-39", Country: iso3166.DZ, Name: "El Oued", Category: "Province"},
+	"DZ-40":  {Code: "DZ-40", Country: iso3166.DZ, Name: "Khenchela", Category: "Province"},
+	"DZ-41":  {Code: "DZ-41", Country: iso3166.DZ, Name: "Souk Ahras", Category: "Province"},
+	"DZ-42":  {Code: "DZ-42", Country: iso3166.DZ, Name: "Tipaza", Category: "Province"},
+	"DZ-43":  {Code: "DZ-43", Country: iso3166.DZ, Name: "Mila", Category: "Province"},
+	"DZ-44":  {Code: "DZ-44", Country: iso3166.DZ, Name: "Aïn Defla", Category: "Province"},
+	"DZ-45":  {Code: "DZ-45", Country: iso3166.DZ, Name: "Naama", Category: "Province"},
+	"DZ-46":  {Code: "DZ-46", Country: iso3166.DZ, Name: "Aïn Témouchent", Category: "Province"},
+	"DZ-47":  {Code: "DZ-47", Country: iso3166.DZ, Name: "Ghardaïa", Category: "Province"},
+	"DZ-48":  {Code: "DZ-48", Country: iso3166.DZ, Name: "Relizane", Category: "Province"},
+	"EC-A":   {Code: "EC-A", Country: iso3166.EC, Name: "Azuay", Category: "Province"},
+	"EC-B":   {Code: "EC-B", Country: iso3166.EC, Name: "Bolívar", Category: "Province"},
+	"EC-C":   {Code: "EC-C", Country: iso3166.EC, Name: "Carchi", Category: "Province"},
+	"EC-D":   {Code: "EC-D", Country: iso3166.EC, Name: "Orellana", Category: "Province"},
+	"EC-E":   {Code: "EC-E", Country: iso3166.EC, Name: "Esmeraldas", Category: "Province"},
+	"EC-F":   {Code: "EC-F", Country: iso3166.EC, Name: "Cañar", Category: "Province"},
+	"EC-G":   {Code: "EC-G", Country: iso3166.EC, Name: "Guayas", Category: "Province"},
+	"EC-H":   {Code: "EC-H", Country: iso3166.EC, Name: "Chimborazo", Category: "Province"},
+	"EC-I":   {Code: "EC-I", Country: iso3166.EC, Name: "Imbabura", Category: "Province"},
+	"EC-L":   {Code: "EC-L", Country: iso3166.EC, Name: "Loja", Category: "Province"},
+	"EC-M":   {Code: "EC-M", Country: iso3166.EC, Name: "Manabí", Category: "Province"},
+	"EC-N":   {Code: "EC-N", Country: iso3166.EC, Name: "Napo", Category: "Province"},
+	"EC-O":   {Code: "EC-O", Country: iso3166.EC, Name: "El Oro", Category: "Province"},
+	"EC-P":   {Code: "EC-P", Country: iso3166.EC, Name: "Pichincha", Category: "Province"},
+	"EC-R":   {Code: "EC-R", Country: iso3166.EC, Name: "Los Ríos", Category: "Province"},
+	"EC-S":   {Code: "EC-S", Country: iso3166.EC, Name: "Morona Santiago", Category: "Province"},
+	"EC-SD":  {Code: "EC-SD", Country: iso3166.EC, Name: "Santo Domingo de los Tsáchilas", Category: "Province"},
+	"EC-SE":  {Code: "EC-SE", Country: iso3166.EC, Name: "Santa Elena", Category: "Province"},
+	"EC-T":   {Code: "EC-T", Country: iso3166.EC, Name: "Tungurahua", Category: "Province"},
+	"EC-U":   {Code: "EC-U", Country: iso3166.EC, Name: "Sucumbíos", Category: "Province"},
+	"EC-W":   {Code: "EC-W", Country: iso3166.EC, Name: "Galápagos", Category: "Province"},
+	"EC-X":   {Code: "EC-X", Country: iso3166.EC, Name: "Cotopaxi", Category: "Province"},
+	"EC-Y":   {Code: "EC-Y", Country: iso3166.EC, Name: "Pastaza", Category: "Province"},
+	"EC-Z":   {Code: "EC-Z", Country: iso3166.EC, Name: "Zamora Chinchipe", Category: "Province"},
+	"EE-130": {Code: "EE-130", Country: iso3166.EE, Name: "Alutaguse", Category: "Rural municipality", ParentCode: "EE-45"},
+	"EE-141": {Code: "EE-141", Country: iso3166.EE, Name: "Anija", Category: "Rural municipality", ParentCode: "EE-37"},
+	"EE-142": {Code: "EE-142", Country: iso3166.EE, Name: "Antsla", Category: "Rural municipality", ParentCode: "EE-87"},
+	"EE-171": {Code: "EE-171", Country: iso3166.EE, Name: "Elva", Category: "Rural municipality", ParentCode: "EE-79"},
+	"EE-184": {Code: "EE-184", Country: iso3166.EE, Name: "Haapsalu", Category: "Urban municipality", ParentCode: "EE-56"},
+	"EE-191": {Code: "EE-191", Country: iso3166.EE, Name: "Haljala", Category: "Rural municipality", ParentCode: "EE-60"},
+	"EE-198": {Code: "EE-198", Country: iso3166.EE, Name: "Harku", Category: "Rural municipality", ParentCode: "EE-37"},
+	"EE-205": {Code: "EE-205", Country: iso3166.EE, Name: "Hiiumaa", Category: "Rural municipality", ParentCode: "EE-39"},
+	"EE-214": {Code: "EE-214", Country: iso3166.EE, Name: "Häädemeeste", Category: "Rural municipality", ParentCode: "EE-68"},
+	"EE-245": {Code: "EE-245", Country: iso3166.EE, Name: "Jõelähtme", Category: "Rural municipality", ParentCode: "EE-37"},
+	"EE-247": {Code: "EE-247", Country: iso3166.EE, Name: "Jõgeva", Category: "Rural municipality", ParentCode: "EE-50"},
+	"EE-251": {Code: "EE-251", Country: iso3166.EE, Name: "Jõhvi", Category: "Rural municipality", ParentCode: "EE-45"},
+	"EE-255": {Code: "EE-255", Country: iso3166.EE, Name: "Järva", Category: "Rural municipality", ParentCode: "EE-52"},
+	"EE-272": {Code: "EE-272", Country: iso3166.EE, Name: "Kadrina", Category: "Rural municipality", ParentCode: "EE-60"},
+	"EE-283": {Code: "EE-283", Country: iso3166.EE, Name: "Kambja", Category: "Rural municipality", ParentCode: "EE-79"},
+	"EE-284": {Code: "EE-284", Country: iso3166.EE, Name: "Kanepi", Category: "Rural municipality", ParentCode: "EE-64"},
+	"EE-291": {Code: "EE-291", Country: iso3166.EE, Name: "Kastre", Category: "Rural municipality", ParentCode: "EE-79"},
+	"EE-293": {Code: "EE-293", Country: iso3166.EE, Name: "Kehtna", Category: "Rural municipality", ParentCode: "EE-71"},
+	"EE-296": {Code: "EE-296", Country: iso3166.EE, Name: "Keila", Category: "Urban municipality", ParentCode: "EE-37"},
+	"EE-303": {Code: "EE-303", Country: iso3166.EE, Name: "Kihnu", Category: "Rural municipality", ParentCode: "EE-68"},
+	"EE-305": {Code: "EE-305", Country: iso3166.EE, Name: "Kiili", Category: "Rural municipality", ParentCode: "EE-37"},
+	"EE-317": {Code: "EE-317", Country: iso3166.EE, Name: "Kohila", Category: "Rural municipality", ParentCode: "EE-71"},
+	"EE-321": {Code: "EE-321", Country: iso3166.EE, Name: "Kohtla-Järve", Category: "Urban municipality", ParentCode: "EE-45"},
+	"EE-338": {Code: "EE-338", Country: iso3166.EE, Name: "Kose", Category: "Rural municipality", ParentCode: "EE-37"},
+	"EE-353": {Code: "EE-353", Country: iso3166.EE, Name: "Kuusalu", Category: "Rural municipality", ParentCode: "EE-37"},
+	"EE-37":  {Code: "EE-37", Country: iso3166.EE, Name: "Harjumaa", Category: "County"},
+	"EE-39":  {Code: "EE-39", Country: iso3166.EE, Name: "Hiiumaa", Category: "County"},
+	"EE-424": {Code: "EE-424", Country: iso3166.EE, Name: "Loksa", Category: "Urban municipality", ParentCode: "EE-37"},
+	"EE-430": {Code: "EE-430", Country: iso3166.EE, Name: "Lääneranna", Category: "Rural municipality", ParentCode: "EE-68"},
+	"EE-431": {Code: "EE-431", Country: iso3166.EE, Name: "Lääne-Harju", Category: "Rural municipality", ParentCode: "EE-37"},
+	"EE-432": {Code: "EE-432", Country: iso3166.EE, Name: "Luunja", Category: "Rural municipality", ParentCode: "EE-79"},
+	"EE-441": {Code: "EE-441", Country: iso3166.EE, Name: "Lääne-Nigula", Category: "Rural municipality", ParentCode: "EE-56"},
+	"EE-442": {Code: "EE-442", Country: iso3166.EE, Name: "Lüganuse", Category: "Rural municipality", ParentCode: "EE-45"},
+	"EE-446": {Code: "EE-446", Country: iso3166.EE, Name: "Maardu", Category: "Urban municipality", ParentCode: "EE-37"},
+	"EE-45":  {Code: "EE-45", Country: iso3166.EE, Name: "Ida-Virumaa", Category: "County"},
+	"EE-478": {Code: "EE-478", Country: iso3166.EE, Name: "Muhu", Category: "Rural municipality", ParentCode: "EE-74"},
+	"EE-480": {Code: "EE-480", Country: iso3166.EE, Name: "Mulgi", Category: "Rural municipality", ParentCode: "EE-84"},
+	"EE-486": {Code: "EE-486", Country: iso3166.EE, Name: "Mustvee", Category: "Rural municipality", ParentCode: "EE-50"},
+	"EE-50":  {Code: "EE-50", Country: iso3166.EE, Name: "Jõgevamaa", Category: "County"},
+	"EE-503": {Code: "EE-503", Country: iso3166.EE, Name: "Märjamaa", Category: "Rural municipality", ParentCode: "EE-71"},
+	"EE-511": {Code: "EE-511", Country: iso3166.EE, Name: "Narva", Category: "Urban municipality", ParentCode: "EE-45"},
+	"EE-514": {Code: "EE-514", Country: iso3166.EE, Name: "Narva-Jõesuu", Category: "Urban municipality", ParentCode: "EE-45"},
+	"EE-52":  {Code: "EE-52", Country: iso3166.EE, Name: "Järvamaa", Category: "County"},
+	"EE-528": {Code: "EE-528", Country: iso3166.EE, Name: "Nõo", Category: "Rural municipality", ParentCode: "EE-79"},
+	"EE-557": {Code: "EE-557", Country: iso3166.EE, Name: "Otepää", Category: "Rural municipality", ParentCode: "EE-81"},
+	"EE-56":  {Code: "EE-56", Country: iso3166.EE, Name: "Läänemaa", Category: "County"},
+	"EE-567": {Code: "EE-567", Country: iso3166.EE, Name: "Paide", Category: "Urban municipality", ParentCode: "EE-52"},
+	"EE-586": {Code: "EE-586", Country: iso3166.EE, Name: "Peipsiääre", Category: "Rural municipality", ParentCode: "EE-79"},
+	"EE-60":  {Code: "EE-60", Country: iso3166.EE, Name: "Lääne-Virumaa", Category: "County"},
+	"EE-615": {Code: "EE-615", Country: iso3166.EE, Name: "Põhja-Sakala", Category: "Rural municipality", ParentCode: "EE-84"},
+	"EE-618": {Code: "EE-618", Country: iso3166.EE, Name: "Põltsamaa", Category: "Rural municipality", ParentCode: "EE-50"},
+	"EE-622": {Code: "EE-622", Country: iso3166.EE, Name: "Põlva", Category: "Rural municipality", ParentCode: "EE-64"},
+	"EE-624": {Code: "EE-624", Country: iso3166.EE, Name: "Pärnu", Category: "Urban municipality", ParentCode: "EE-68"},
+	"EE-638": {Code: "EE-638", Country: iso3166.EE, Name: "Põhja-Pärnumaa", Category: "Rural municipality", ParentCode: "EE-68"},
+	"EE-64":  {Code: "EE-64", Country: iso3166.EE, Name: "Põlvamaa", Category: "County"},
+	"EE-651": {Code: "EE-651", Country: iso3166.EE, Name: "Raasiku", Category: "Rural municipality", ParentCode: "EE-37"},
+	"EE-653": {Code: "EE-653", Country: iso3166.EE, Name: "Rae", Category: "Rural municipality", ParentCode: "EE-37"},
+	"EE-661": {Code: "EE-661", Country: iso3166.EE, Name: "Rakvere", Category: "Rural municipality", ParentCode: "EE-60"},
+	"EE-663": {Code: "EE-663", Country: iso3166.EE, Name: "Rakvere", Category: "Urban municipality", ParentCode: "EE-60"},
+	"EE-668": {Code: "EE-668", Country: iso3166.EE, Name: "Rapla", Category: "Rural municipality", ParentCode: "EE-71"},
+	"EE-68":  {Code: "EE-68", Country: iso3166.EE, Name: "Pärnumaa", Category: "County"},
+	"EE-689": {Code: "EE-689", Country: iso3166.EE, Name: "Ruhnu", Category: "Rural municipality", ParentCode: "EE-74"},
+	"EE-698": {Code: "EE-698", Country: iso3166.EE, Name: "Rõuge", Category: "Rural municipality", ParentCode: "EE-87"},
+	"EE-708": {Code: "EE-708", Country: iso3166.EE, Name: "Räpina", Category: "Rural municipality", ParentCode: "EE-64"},
+	"EE-71":  {Code: "EE-71", Country: iso3166.EE, Name: "Raplamaa", Category: "County"},
+	"EE-712": {Code: "EE-712", Country: iso3166.EE, Name: "Saarde", Category: "Rural municipality", ParentCode: "EE-68"},
+	"EE-714": {Code: "EE-714", Country: iso3166.EE, Name: "Saaremaa", Category: "Rural municipality", ParentCode: "EE-74"},
+	"EE-719": {Code: "EE-719", Country: iso3166.EE, Name: "Saku", Category: "Rural municipality", ParentCode: "EE-37"},
+	"EE-726": {Code: "EE-726", Country: iso3166.EE, Name: "Saue", Category: "Rural municipality", ParentCode: "EE-37"},
+	"EE-732": {Code: "EE-732", Country: iso3166.EE, Name: "Setomaa", Category: "Rural municipality", ParentCode: "EE-87"},
+	"EE-735": {Code: "EE-735", Country: iso3166.EE, Name: "Sillamäe", Category: "Urban municipality", ParentCode: "EE-45"},
+	"EE-74":  {Code: "EE-74", Country: iso3166.EE, Name: "Saaremaa", Category: "County"},
+	"EE-784": {Code: "EE-784", Country: iso3166.EE, Name: "Tallinn", Category: "Urban municipality", ParentCode: "EE-37"},
+	"EE-79":  {Code: "EE-79", Country: iso3166.EE, Name: "Tartumaa", Category: "County"},
+	"EE-792": {Code: "EE-792", Country: iso3166.EE, Name: "Tapa", Category: "Rural municipality", ParentCode: "EE-60"},
+	"EE-793": {Code: "EE-793", Country: iso3166.EE, Name: "Tartu", Category: "Urban municipality", ParentCode: "EE-79"},
+	"EE-796": {Code: "EE-796", Country: iso3166.EE, Name: "Tartu", Category: "Rural municipality", ParentCode: "EE-79"},
+	"EE-803": {Code: "EE-803", Country: iso3166.EE, Name: "Toila", Category: "Rural municipality", ParentCode: "EE-45"},
+	"EE-809": {Code: "EE-809", Country: iso3166.EE, Name: "Tori", Category: "Rural municipality", ParentCode: "EE-68"},
+	"EE-81":  {Code: "EE-81", Country: iso3166.EE, Name: "Valgamaa", Category: "County"},
+	"EE-824": {Code: "EE-824", Country: iso3166.EE, Name: "Tõrva", Category: "Rural municipality", ParentCode: "EE-81"},
+	"EE-834": {Code: "EE-834", Country: iso3166.EE, Name: "Türi", Category: "Rural municipality", ParentCode: "EE-52"},
+	"EE-84":  {Code: "EE-84", Country: iso3166.EE, Name: "Viljandimaa", Category: "County"},
+	"EE-855": {Code: "EE-855", Country: iso3166.EE, Name: "Valga", Category: "Rural municipality", ParentCode: "EE-81"},
+	"EE-87":  {Code: "EE-87", Country: iso3166.EE, Name: "Võrumaa", Category: "County"},
+	"EE-890": {Code: "EE-890", Country: iso3166.EE, Name: "Viimsi", Category: "Rural municipality", ParentCode: "EE-37"},
+	"EE-897": {Code: "EE-897", Country: iso3166.EE, Name: "Viljandi", Category: "Urban municipality", ParentCode: "EE-84"},
+	"EE-899": {Code: "EE-899", Country: iso3166.EE, Name: "Viljandi", Category: "Rural municipality", ParentCode: "EE-84"},
+	"EE-901": {Code: "EE-901", Country: iso3166.EE, Name: "Vinni", Category: "Rural municipality", ParentCode: "EE-60"},
+	"EE-903": {Code: "EE-903", Country: iso3166.EE, Name: "Viru-Nigula", Category: "Rural municipality", ParentCode: "EE-60"},
+	"EE-907": {Code: "EE-907", Country: iso3166.EE, Name: "Vormsi", Category: "Rural municipality", ParentCode: "EE-56"},
+	"EE-917": {Code: "EE-917", Country: iso3166.EE, Name: "Võru", Category: "Rural municipality", ParentCode: "EE-87"},
+	"EE-919": {Code: "EE-919", Country: iso3166.EE, Name: "Võru", Category: "Urban municipality", ParentCode: "EE-87"},
+	"EE-928": {Code: "EE-928", Country: iso3166.EE, Name: "Väike-Maarja", Category: "Rural municipality", ParentCode: "EE-60"},
+	"EG-ALX": {Code: "EG-ALX", Country: iso3166.EG, Name: "Al Iskandarīyah", Category: "Governorate"},
+	"EG-ASN": {Code: "EG-ASN", Country: iso3166.EG, Name: "Aswān", Category: "Governorate"},
+	"EG-AST": {Code: "EG-AST", Country: iso3166.EG, Name: "Asyūţ", Category: "Governorate"},
+	"EG-BA":  {Code: "EG-BA", Country: iso3166.EG, Name: "Al Baḩr al Aḩmar", Category: "Governorate"},
+	"EG-BH":  {Code: "EG-BH", Country: iso3166.EG, Name: "Al Buḩayrah", Category: "Governorate"},
+	"EG-BNS": {Code: "EG-BNS", Country: iso3166.EG, Name: "Banī Suwayf", Category: "Governorate"},
+	"EG-C":   {Code: "EG-C", Country: iso3166.EG, Name: "Al Qāhirah", Category: "Governorate"},
+	"EG-DK":  {Code: "EG-DK", Country: iso3166.EG, Name: "Ad Daqahlīyah", Category: "Governorate"},
+	"EG-DT":  {Code: "EG-DT", Country: iso3166.EG, Name: "Dumyāţ", Category: "Governorate"},
+	"EG-FYM": {Code: "EG-FYM", Country: iso3166.EG, Name: "Al Fayyūm", Category: "Governorate"},
+	"EG-GH":  {Code: "EG-GH", Country: iso3166.EG, Name: "Al Gharbīyah", Category: "Governorate"},
+	"EG-GZ":  {Code: "EG-GZ", Country: iso3166.EG, Name: "Al Jīzah", Category: "Governorate"},
+	"EG-IS":  {Code: "EG-IS", Country: iso3166.EG, Name: "Al Ismā'īlīyah", Category: "Governorate"},
+	"EG-JS":  {Code: "EG-JS", Country: iso3166.EG, Name: "Janūb Sīnā'", Category: "Governorate"},
+	"EG-KB":  {Code: "EG-KB", Country: iso3166.EG, Name: "Al Qalyūbīyah", Category: "Governorate"},
+	"EG-KFS": {Code: "EG-KFS", Country: iso3166.EG, Name: "Kafr ash Shaykh", Category: "Governorate"},
+	"EG-KN":  {Code: "EG-KN", Country: iso3166.EG, Name: "Qinā", Category: "Governorate"},
+	"EG-LX":  {Code: "EG-LX", Country: iso3166.EG, Name: "Al Uqşur", Category: "Governorate"},
+	"EG-MN":  {Code: "EG-MN", Country: iso3166.EG, Name: "Al Minyā", Category: "Governorate"},
+	"EG-MNF": {Code: "EG-MNF", Country: iso3166.EG, Name: "Al Minūfīyah", Category: "Governorate"},
+	"EG-MT":  {Code: "EG-MT", Country: iso3166.EG, Name: "Maţrūḩ", Category: "Governorate"},
+	"EG-PTS": {Code: "EG-PTS", Country: iso3166.EG, Name: "Būr Sa‘īd", Category: "Governorate"},
+	"EG-SHG": {Code: "EG-SHG", Country: iso3166.EG, Name: "Sūhāj", Category: "Governorate"},
+	"EG-SHR": {Code: "EG-SHR", Country: iso3166.EG, Name: "Ash Sharqīyah", Category: "Governorate"},
+	"EG-SIN": {Code: "EG-SIN", Country: iso3166.EG, Name: "Shamāl Sīnā'", Category: "Governorate"},
+	"EG-SUZ": {Code: "EG-SUZ", Country: iso3166.EG, Name: "As Suways", Category: "Governorate"},
+	"EG-WAD": {Code: "EG-WAD", Country: iso3166.EG, Name: "Al Wādī al Jadīd", Category: "Governorate"},
+	"ER-AN":  {Code: "ER-AN", Country: iso3166.ER, Name: "Ansabā", Category: "Region"},
+	"ER-DK":  {Code: "ER-DK", Country: iso3166.ER, Name: "Debubawi K’eyyĭḥ Baḥri", Category: "Region"},
+	"ER-DU":  {Code: "ER-DU", Country: iso3166.ER, Name: "Al Janūbī", Category: "Region"},
+	"ER-GB":  {Code: "ER-GB", Country: iso3166.ER, Name: "Gash-Barka", Category: "Region"},
+	"ER-MA":  {Code: "ER-MA", Country: iso3166.ER, Name: "Al Awsaţ", Category: "Region"},
+	"ER-SK":  {Code: "ER-SK", Country: iso3166.ER, Name: "Semienawi K’eyyĭḥ Baḥri", Category: "Region"},
+	"ES-A":   {Code: "ES-A", Country: iso3166.ES, Name: "Alacant*", Category: "Province", ParentCode: "ES-VC"},
+	"ES-AB":  {Code: "ES-AB", Country: iso3166.ES, Name: "Albacete", Category: "Province", ParentCode: "ES-CM"},
+	"ES-AL":  {Code: "ES-AL", Country: iso3166.ES, Name: "Almería", Category: "Province", ParentCode: "ES-AN"},
+	"ES-AN":  {Code: "ES-AN", Country: iso3166.ES, Name: "Andalucía", Category: "Autonomous community"},
+	"ES-AR":  {Code: "ES-AR", Country: iso3166.ES, Name: "Aragón", Category: "Autonomous community"},
+	"ES-AS":  {Code: "ES-AS", Country: iso3166.ES, Name: "Asturias, Principado de", Category: "Autonomous community"},
+	"ES-AV":  {Code: "ES-AV", Country: iso3166.ES, Name: "Ávila", Category: "Province", ParentCode: "ES-CL"},
+	"ES-B":   {Code: "ES-B", Country: iso3166.ES, Name: "Barcelona [Barcelona]", Category: "Province", ParentCode: "ES-CT"},
+	"ES-BA":  {Code: "ES-BA", Country: iso3166.ES, Name: "Badajoz", Category: "Province", ParentCode: "ES-EX"},
+	"ES-BI":  {Code: "ES-BI", Country: iso3166.ES, Name: "Bizkaia", Category: "Province", ParentCode: "ES-PV"},
+	"ES-BU":  {Code: "ES-BU", Country: iso3166.ES, Name: "Burgos", Category: "Province", ParentCode: "ES-CL"},
+	"ES-C":   {Code: "ES-C", Country: iso3166.ES, Name: "A Coruña [La Coruña]", Category: "Province", ParentCode: "ES-GA"},
+	"ES-CA":  {Code: "ES-CA", Country: iso3166.ES, Name: "Cádiz", Category: "Province", ParentCode: "ES-AN"},
+	"ES-CB":  {Code: "ES-CB", Country: iso3166.ES, Name: "Cantabria", Category: "Autonomous community"},
+	"ES-CC":  {Code: "ES-CC", Country: iso3166.ES, Name: "Cáceres", Category: "Province", ParentCode: "ES-EX"},
+	"ES-CE":  {Code: "ES-CE", Country: iso3166.ES, Name: "Ceuta", Category: "Autonomous city in north africa"},
+	"ES-CL":  {Code: "ES-CL", Country: iso3166.ES, Name: "Castilla y León", Category: "Autonomous community"},
+	"ES-CM":  {Code: "ES-CM", Country: iso3166.ES, Name: "Castilla-La Mancha", Category: "Autonomous community"},
+	"ES-CN":  {Code: "ES-CN", Country: iso3166.ES, Name: "Canarias", Category: "Autonomous community"},
+	"ES-CO":  {Code: "ES-CO", Country: iso3166.ES, Name: "Córdoba", Category: "Province", ParentCode: "ES-AN"},
+	"ES-CR":  {Code: "ES-CR", Country: iso3166.ES, Name: "Ciudad Real", Category: "Province", ParentCode: "ES-CM"},
+	"ES-CS":  {Code: "ES-CS", Country: iso3166.ES, Name: "Castelló*", Category: "Province", ParentCode: "ES-VC"},
+	"ES-CT":  {Code: "ES-CT", Country: iso3166.ES, Name: "Catalunya [Cataluña]", Category: "Autonomous community"},
+	"ES-CU":  {Code: "ES-CU", Country: iso3166.ES, Name: "Cuenca", Category: "Province", ParentCode: "ES-CM"},
+	"ES-EX":  {Code: "ES-EX", Country: iso3166.ES, Name: "Extremadura", Category: "Autonomous community"},
+	"ES-GA":  {Code: "ES-GA", Country: iso3166.ES, Name: "Galicia [Galicia]", Category: "Autonomous community"},
+	"ES-GC":  {Code: "ES-GC", Country: iso3166.ES, Name: "Las Palmas", Category: "Province", ParentCode: "ES-CN"},
+	"ES-GI":  {Code: "ES-GI", Country: iso3166.ES, Name: "Girona [Gerona]", Category: "Province", ParentCode: "ES-CT"},
+	"ES-GR":  {Code: "ES-GR", Country: iso3166.ES, Name: "Granada", Category: "Province", ParentCode: "ES-AN"},
+	"ES-GU":  {Code: "ES-GU", Country: iso3166.ES, Name: "Guadalajara", Category: "Province", ParentCode: "ES-CM"},
+	"ES-H":   {Code: "ES-H", Country: iso3166.ES, Name: "Huelva", Category: "Province", ParentCode: "ES-AN"},
+	"ES-HU":  {Code: "ES-HU", Country: iso3166.ES, Name: "Huesca", Category: "Province", ParentCode: "ES-AR"},
+	"ES-IB":  {Code: "ES-IB", Country: iso3166.ES, Name: "Illes Balears [Islas Baleares]", Category: "Autonomous community"},
+	"ES-J":   {Code: "ES-J", Country: iso3166.ES, Name: "Jaén", Category: "Province", ParentCode: "ES-AN"},
+	"ES-L":   {Code: "ES-L", Country: iso3166.ES, Name: "Lleida [Lérida]", Category: "Province", ParentCode: "ES-CT"},
+	"ES-LE":  {Code: "ES-LE", Country: iso3166.ES, Name: "León", Category: "Province", ParentCode: "ES-CL"},
+	"ES-LO":  {Code: "ES-LO", Country: iso3166.ES, Name: "La Rioja", Category: "Province", ParentCode: "ES-RI"},
+	"ES-LU":  {Code: "ES-LU", Country: iso3166.ES, Name: "Lugo [Lugo]", Category: "Province", ParentCode: "ES-GA"},
+	"ES-M":   {Code: "ES-M", Country: iso3166.ES, Name: "Madrid", Category: "Province", ParentCode: "ES-MD"},
+	"ES-MA":  {Code: "ES-MA", Country: iso3166.ES, Name: "Málaga", Category: "Province", ParentCode: "ES-AN"},
+	"ES-MC":  {Code: "ES-MC", Country: iso3166.ES, Name: "Murcia, Región de", Category: "Autonomous community"},
+	"ES-MD":  {Code: "ES-MD", Country: iso3166.ES, Name: "Madrid, Comunidad de", Category: "Autonomous community"},
+	"ES-ML":  {Code: "ES-ML", Country: iso3166.ES, Name: "Melilla", Category: "Autonomous city in north africa"},
+	"ES-MU":  {Code: "ES-MU", Country: iso3166.ES, Name: "Murcia", Category: "Province", ParentCode: "ES-MC"},
+	"ES-NA":  {Code: "ES-NA", Country: iso3166.ES, Name: "Nafarroa*", Category: "Province", ParentCode: "ES-NC"},
+	"ES-NC":  {Code: "ES-NC", Country: iso3166.ES, Name: "Nafarroako Foru Komunitatea*", Category: "Autonomous community"},
+	"ES-O":   {Code: "ES-O", Country: iso3166.ES, Name: "Asturias", Category: "Province", ParentCode: "ES-AS"},
+	"ES-OR":  {Code: "ES-OR", Country: iso3166.ES, Name: "Ourense [Orense]", Category: "Province", ParentCode: "ES-GA"},
+	"ES-P":   {Code: "ES-P", Country: iso3166.ES, Name: "Palencia", Category: "Province", ParentCode: "ES-CL"},
+	"ES-PM":  {Code: "ES-PM", Country: iso3166.ES, Name: "Illes Balears [Islas Baleares]", Category: "Province", ParentCode: "ES-IB"},
+	"ES-PO":  {Code: "ES-PO", Country: iso3166.ES, Name: "Pontevedra [Pontevedra]", Category: "Province", ParentCode: "ES-GA"},
+	"ES-PV":  {Code: "ES-PV", Country: iso3166.ES, Name: "Euskal Herria", Category: "Autonomous community"},
+	"ES-RI":  {Code: "ES-RI", Country: iso3166.ES, Name: "La Rioja", Category: "Autonomous community"},
+	"ES-S":   {Code: "ES-S", Country: iso3166.ES, Name: "Cantabria", Category: "Province", ParentCode: "ES-CB"},
+	"ES-SA":  {Code: "ES-SA", Country: iso3166.ES, Name: "Salamanca", Category: "Province", ParentCode: "ES-CL"},
+	"ES-SE":  {Code: "ES-SE", Country: iso3166.ES, Name: "Sevilla", Category: "Province", ParentCode: "ES-AN"},
+	"ES-SG":  {Code: "ES-SG", Country: iso3166.ES, Name: "Segovia", Category: "Province", ParentCode: "ES-CL"},
+	"ES-SO":  {Code: "ES-SO", Country: iso3166.ES, Name: "Soria", Category: "Province", ParentCode: "ES-CL"},
+	"ES-SS":  {Code: "ES-SS", Country: iso3166.ES, Name: "Gipuzkoa", Category: "Province", ParentCode: "ES-PV"},
+	"ES-T":   {Code: "ES-T", Country: iso3166.ES, Name: "Tarragona [Tarragona]", Category: "Province", ParentCode: "ES-CT"},
+	"ES-TE":  {Code: "ES-TE", Country: iso3166.ES, Name: "Teruel", Category: "Province", ParentCode: "ES-AR"},
+	"ES-TF":  {Code: "ES-TF", Country: iso3166.ES, Name: "Santa Cruz de Tenerife", Category: "Province", ParentCode: "ES-CN"},
+	"ES-TO":  {Code: "ES-TO", Country: iso3166.ES, Name: "Toledo", Category: "Province", ParentCode: "ES-CM"},
+	"ES-V":   {Code: "ES-V", Country: iso3166.ES, Name: "Valencia", Category: "Province", ParentCode: "ES-VC"},
+	"ES-VA":  {Code: "ES-VA", Country: iso3166.ES, Name: "Valladolid", Category: "Province", ParentCode: "ES-CL"},
+	"ES-VC":  {Code: "ES-VC", Country: iso3166.ES, Name: "Valenciana, Comunidad", Category: "Autonomous community"},
+	"ES-VI":  {Code: "ES-VI", Country: iso3166.ES, Name: "Araba*", Category: "Province", ParentCode: "ES-PV"},
+	"ES-Z":   {Code: "ES-Z", Country: iso3166.ES, Name: "Zaragoza", Category: "Province", ParentCode: "ES-AR"},
+	"ES-ZA":  {Code: "ES-ZA", Country: iso3166.ES, Name: "Zamora", Category: "Province", ParentCode: "ES-CL"},
+	"ET-AA":  {Code: "ET-AA", Country: iso3166.ET, Name: "Addis Ababa", Category: "Administration"},
+	"ET-AF":  {Code: "ET-AF", Country: iso3166.ET, Name: "Afar", Category: "Regional state"},
+	"ET-AM":  {Code: "ET-AM", Country: iso3166.ET, Name: "Amara", Category: "Regional state"},
+	"ET-BE":  {Code: "ET-BE", Country: iso3166.ET, Name: "Benshangul-Gumaz", Category: "Regional state"},
+	"ET-DD":  {Code: "ET-DD", Country: iso3166.ET, Name: "Dire Dawa", Category: "Administration"},
+	"ET-GA":  {Code: "ET-GA", Country: iso3166.ET, Name: "Gambela Peoples", Category: "Regional state"},
+	"ET-HA":  {Code: "ET-HA", Country: iso3166.ET, Name: "Harari People", Category: "Regional state"},
+	"ET-OR":  {Code: "ET-OR", Country: iso3166.ET, Name: "Oromia", Category: "Regional state"},
+	"ET-SN":  {Code: "ET-SN", Country: iso3166.ET, Name: "Southern Nations, Nationalities and Peoples", Category: "Regional state"},
+	"ET-SO":  {Code: "ET-SO", Country: iso3166.ET, Name: "Somali", Category: "Regional state"},
+	"ET-TI":  {Code: "ET-TI", Country: iso3166.ET, Name: "Tigrai", Category: "Regional state"},
+	"FI-01":  {Code: "FI-01", Country: iso3166.FI, Name: "Åland", Category: "Region"},
+	"FI-02":  {Code: "FI-02", Country: iso3166.FI, Name: "Etelä-Karjala", Category: "Region"},
+	"FI-03":  {Code: "FI-03", Country: iso3166.FI, Name: "Etelä-Pohjanmaa", Category: "Region"},
+	"FI-04":  {Code: "FI-04", Country: iso3166.FI, Name: "Etelä-Savo", Category: "Region"},
+	"FI-05":  {Code: "FI-05", Country: iso3166.FI, Name: "Kainuu", Category: "Region"},
+	"FI-06":  {Code: "FI-06", Country: iso3166.FI, Name: "Kanta-Häme", Category: "Region"},
+	"FI-07":  {Code: "FI-07", Country: iso3166.FI, Name: "Keski-Pohjanmaa", Category: "Region"},
+	"FI-08":  {Code: "FI-08", Country: iso3166.FI, Name: "Keski-Suomi", Category: "Region"},
+	"FI-09":  {Code: "FI-09", Country: iso3166.FI, Name: "Kymenlaakso", Category: "Region"},
+	"FI-10":  {Code: "FI-10", Country: iso3166.FI, Name: "Lappi", Category: "Region"},
+	"FI-11":  {Code: "FI-11", Country: iso3166.FI, Name: "Pirkanmaa", Category: "Region"},
+	"FI-12":  {Code: "FI-12", Country: iso3166.FI, Name: "Pohjanmaa", Category: "Region"},
+	"FI-13":  {Code: "FI-13", Country: iso3166.FI, Name: "Pohjois-Karjala", Category: "Region"},
+	"FI-14":  {Code: "FI-14", Country: iso3166.FI, Name: "Pohjois-Pohjanmaa", Category: "Region"},
+	"FI-15":  {Code: "FI-15", Country: iso3166.FI, Name: "Pohjois-Savo", Category: "Region"},
+	"FI-16":  {Code: "FI-16", Country: iso3166.FI, Name: "Päijät-Häme", Category: "Region"},
+	"FI-17":  {Code: "FI-17", Country: iso3166.FI, Name: "Satakunta", Category: "Region"},
+	"FI-18":  {Code: "FI-18", Country: iso3166.FI, Name: "Uusimaa", Category: "Region"},
+	"FI-19":  {Code: "FI-19", Country: iso3166.FI, Name: "Varsinais-Suomi", Category: "Region"},
+	"FJ-01":  {Code: "FJ-01", Country: iso3166.FJ, Name: "Ba", Category: "Province", ParentCode: "FJ-W"},
+	"FJ-02":  {Code: "FJ-02", Country: iso3166.FJ, Name: "Bua", Category: "Province", ParentCode: "FJ-N"},
+	"FJ-03":  {Code: "FJ-03", Country: iso3166.FJ, Name: "Cakaudrove", Category: "Province", ParentCode: "FJ-N"},
+	"FJ-04":  {Code: "FJ-04", Country: iso3166.FJ, Name: "Kadavu", Category: "Province", ParentCode: "FJ-E"},
+	"FJ-05":  {Code: "FJ-05", Country: iso3166.FJ, Name: "Lau", Category: "Province", ParentCode: "FJ-E"},
+	"FJ-06":  {Code: "FJ-06", Country: iso3166.FJ, Name: "Lomaiviti", Category: "Province", ParentCode: "FJ-E"},
+	"FJ-07":  {Code: "FJ-07", Country: iso3166.FJ, Name: "Macuata", Category: "Province", ParentCode: "FJ-N"},
+	"FJ-08":  {Code: "FJ-08", Country: iso3166.FJ, Name: "Nadroga and Navosa", Category: "Province", ParentCode: "FJ-W"},
+	"FJ-09":  {Code: "FJ-09", Country: iso3166.FJ, Name: "Naitasiri", Category: "Province", ParentCode: "FJ-C"},
+	"FJ-10":  {Code: "FJ-10", Country: iso3166.FJ, Name: "Namosi", Category: "Province", ParentCode: "FJ-C"},
+	"FJ-11":  {Code: "FJ-11", Country: iso3166.FJ, Name: "Ra", Category: "Province", ParentCode: "FJ-W"},
+	"FJ-12":  {Code: "FJ-12", Country: iso3166.FJ, Name: "Rewa", Category: "Province", ParentCode: "FJ-C"},
+	"FJ-13":  {Code: "FJ-13", Country: iso3166.FJ, Name: "Serua", Category: "Province", ParentCode: "FJ-C"},
+	"FJ-14":  {Code: "FJ-14", Country: iso3166.FJ, Name: "Tailevu", Category: "Province", ParentCode: "FJ-C"},
+	"FJ-C":   {Code: "FJ-C", Country: iso3166.FJ, Name: "Central", Category: "Division"},
+	"FJ-E":   {Code: "FJ-E", Country: iso3166.FJ, Name: "Eastern", Category: "Division"},
+	"FJ-N":   {Code: "FJ-N", Country: iso3166.FJ, Name: "Northern", Category: "Division"},
+	"FJ-R":   {Code: "FJ-R", Country: iso3166.FJ, Name: "Rotuma", Category: "Dependency"},
+	"FJ-W":   {Code: "FJ-W", Country: iso3166.FJ, Name: "Western", Category: "Division"},
+	"FM-KSA": {Code: "FM-KSA", Country: iso3166.FM, Name: "Kosrae", Category: "State"},
+	"FM-PNI": {Code: "FM-PNI", Country: iso3166.FM, Name: "Pohnpei", Category: "State"},
+	"FM-TRK": {Code: "FM-TRK", Country: iso3166.FM, Name: "Chuuk", Category: "State"},
+	"FM-YAP": {Code: "FM-YAP", Country: iso3166.FM, Name: "Yap", Category: "State"},
+	"FR-01":  {Code: "FR-01", Country: iso3166.FR, Name: "Ain", Category: "Metropolitan department", ParentCode: "FR-ARA"},
+	"FR-02":  {Code: "FR-02", Country: iso3166.FR, Name: "Aisne", Category: "Metropolitan department", ParentCode: "FR-HDF"},
+	"FR-03":  {Code: "FR-03", Country: iso3166.FR, Name: "Allier", Category: "Metropolitan department", ParentCode: "FR-ARA"},
+	"FR-04":  {Code: "FR-04", Country: iso3166.FR, Name: "Alpes-de-Haute-Provence", Category: "Metropolitan department", ParentCode: "FR-PAC"},
+	"FR-05":  {Code: "FR-05", Country: iso3166.FR, Name: "Hautes-Alpes", Category: "Metropolitan department", ParentCode: "FR-PAC"},
+	"FR-06":  {Code: "FR-06", Country: iso3166.FR, Name: "Alpes-Maritimes", Category: "Metropolitan department", ParentCode: "FR-PAC"},
+	"FR-07":  {Code: "FR-07", Country: iso3166.FR, Name: "Ardèche", Category: "Metropolitan department", ParentCode: "FR-ARA"},
+	"FR-08":  {Code: "FR-08", Country: iso3166.FR, Name: "Ardennes", Category: "Metropolitan department", ParentCode: "FR-GES"},
+	"FR-09":  {Code: "FR-09", Country: iso3166.FR, Name: "Ariège", Category: "Metropolitan department", ParentCode: "FR-OCC"},
+	"FR-10":  {Code: "FR-10", Country: iso3166.FR, Name: "Aube", Category: "Metropolitan department", ParentCode: "FR-GES"},
+	"FR-11":  {Code: "FR-11", Country: iso3166.FR, Name: "Aude", Category: "Metropolitan department", ParentCode: "FR-OCC"},
+	"FR-12":  {Code: "FR-12", Country: iso3166.FR, Name: "Aveyron", Category: "Metropolitan department", ParentCode: "FR-OCC"},
+	"FR-13":  {Code: "FR-13", Country: iso3166.FR, Name: "Bouches-du-Rhône", Category: "Metropolitan department", ParentCode: "FR-PAC"},
+	"FR-14":  {Code: "FR-14", Country: iso3166.FR, Name: "Calvados", Category: "Metropolitan department", ParentCode: "FR-NOR"},
+	"FR-15":  {Code: "FR-15", Country: iso3166.FR, Name: "Cantal", Category: "Metropolitan department", ParentCode: "FR-ARA"},
+	"FR-16":  {Code: "FR-16", Country: iso3166.FR, Name: "Charente", Category: "Metropolitan department", ParentCode: "FR-NAQ"},
+	"FR-17":  {Code: "FR-17", Country: iso3166.FR, Name: "Charente-Maritime", Category: "Metropolitan department", ParentCode: "FR-NAQ"},
+	"FR-18":  {Code: "FR-18", Country: iso3166.FR, Name: "Cher", Category: "Metropolitan department", ParentCode: "FR-CVL"},
+	"FR-19":  {Code: "FR-19", Country: iso3166.FR, Name: "Corrèze", Category: "Metropolitan department", ParentCode: "FR-NAQ"},
+	"FR-20R": {Code: "FR-20R", Country: iso3166.FR, Name: "Corse", Category: "Metropolitan collectivity with special status"},
+	"FR-21":  {Code: "FR-21", Country: iso3166.FR, Name: "Côte-d'Or", Category: "Metropolitan department", ParentCode: "FR-BFC"},
+	"FR-22":  {Code: "FR-22", Country: iso3166.FR, Name: "Côtes-d'Armor", Category: "Metropolitan department", ParentCode: "FR-BRE"},
+	"FR-23":  {Code: "FR-23", Country: iso3166.FR, Name: "Creuse", Category: "Metropolitan department", ParentCode: "FR-NAQ"},
+	"FR-24":  {Code: "FR-24", Country: iso3166.FR, Name: "Dordogne", Category: "Metropolitan department", ParentCode: "FR-NAQ"},
+	"FR-25":  {Code: "FR-25", Country: iso3166.FR, Name: "Doubs", Category: "Metropolitan department", ParentCode: "FR-BFC"},
+	"FR-26":  {Code: "FR-26", Country: iso3166.FR, Name: "Drôme", Category: "Metropolitan department", ParentCode: "FR-ARA"},
+	"FR-27":  {Code: "FR-27", Country: iso3166.FR, Name: "Eure", Category: "Metropolitan department", ParentCode: "FR-NOR"},
+	"FR-28":  {Code: "FR-28", Country: iso3166.FR, Name: "Eure-et-Loir", Category: "Metropolitan department", ParentCode: "FR-CVL"},
+	"FR-29":  {Code: "FR-29", Country: iso3166.FR, Name: "Finistère", Category: "Metropolitan department", ParentCode: "FR-BRE"},
+	"FR-2A":  {Code: "FR-2A", Country: iso3166.FR, Name: "Corse-du-Sud", Category: "Metropolitan department", ParentCode: "FR-20R"},
+	"FR-2B":  {Code: "FR-2B", Country: iso3166.FR, Name: "Haute-Corse", Category: "Metropolitan department", ParentCode: "FR-20R"},
+	"FR-30":  {Code: "FR-30", Country: iso3166.FR, Name: "Gard", Category: "Metropolitan department", ParentCode: "FR-OCC"},
+	"FR-31":  {Code: "FR-31", Country: iso3166.FR, Name: "Haute-Garonne", Category: "Metropolitan department", ParentCode: "FR-OCC"},
+	"FR-32":  {Code: "FR-32", Country: iso3166.FR, Name: "Gers", Category: "Metropolitan department", ParentCode: "FR-OCC"},
+	"FR-33":  {Code: "FR-33", Country: iso3166.FR, Name: "Gironde", Category: "Metropolitan department", ParentCode: "FR-NAQ"},
+	"FR-34":  {Code: "FR-34", Country: iso3166.FR, Name: "Hérault", Category: "Metropolitan department", ParentCode: "FR-OCC"},
+	"FR-35":  {Code: "FR-35", Country: iso3166.FR, Name: "Ille-et-Vilaine", Category: "Metropolitan department", ParentCode: "FR-BRE"},
+	"FR-36":  {Code: "FR-36", Country: iso3166.FR, Name: "Indre", Category: "Metropolitan department", ParentCode: "FR-CVL"},
+	"FR-37":  {Code: "FR-37", Country: iso3166.FR, Name: "Indre-et-Loire", Category: "Metropolitan department", ParentCode: "FR-CVL"},
+	"FR-38":  {Code: "FR-38", Country: iso3166.FR, Name: "Isère", Category: "Metropolitan department", ParentCode: "FR-ARA"},
+	"FR-39":  {Code: "FR-39", Country: iso3166.FR, Name: "Jura", Category: "Metropolitan department", ParentCode: "FR-BFC"},
+	"FR-40":  {Code: "FR-40", Country: iso3166.FR, Name: "Landes", Category: "Metropolitan department", ParentCode: "FR-NAQ"},
+	"FR-41":  {Code: "FR-41", Country: iso3166.FR, Name: "Loir-et-Cher", Category: "Metropolitan department", ParentCode: "FR-CVL"},
+	"FR-42":  {Code: "FR-42", Country: iso3166.FR, Name: "Loire", Category: "Metropolitan department", ParentCode: "FR-ARA"},
+	"FR-43":  {Code: "FR-43", Country: iso3166.FR, Name: "Haute-Loire", Category: "Metropolitan department", ParentCode: "FR-ARA"},
+	"FR-44":  {Code: "FR-44", Country: iso3166.FR, Name: "Loire-Atlantique", Category: "Metropolitan department", ParentCode: "FR-PDL"},
+	"FR-45":  {Code: "FR-45", Country: iso3166.FR, Name: "Loiret", Category: "Metropolitan department", ParentCode: "FR-CVL"},
+	"FR-46":  {Code: "FR-46", Country: iso3166.FR, Name: "Lot", Category: "Metropolitan department", ParentCode: "FR-OCC"},
+	"FR-47":  {Code: "FR-47", Country: iso3166.FR, Name: "Lot-et-Garonne", Category: "Metropolitan department", ParentCode: "FR-NAQ"},
+	"FR-48":  {Code: "FR-48", Country: iso3166.FR, Name: "Lozère", Category: "Metropolitan department", ParentCode: "FR-OCC"},
+	"FR-49":  {Code: "FR-49", Country: iso3166.FR, Name: "Maine-et-Loire", Category: "Metropolitan department", ParentCode: "FR-PDL"},
+	"FR-50":  {Code: "FR-50", Country: iso3166.FR, Name: "Manche", Category: "Metropolitan department", ParentCode: "FR-NOR"},
+	"FR-51":  {Code: "FR-51", Country: iso3166.FR, Name: "Marne", Category: "Metropolitan department", ParentCode: "FR-GES"},
+	"FR-52":  {Code: "FR-52", Country: iso3166.FR, Name: "Haute-Marne", Category: "Metropolitan department", ParentCode: "FR-GES"},
+	"FR-53":  {Code: "FR-53", Country: iso3166.FR, Name: "Mayenne", Category: "Metropolitan department", ParentCode: "FR-PDL"},
+	"FR-54":  {Code: "FR-54", Country: iso3166.FR, Name: "Meurthe-et-Moselle", Category: "Metropolitan department", ParentCode: "FR-GES"},
+	"FR-55":  {Code: "FR-55", Country: iso3166.FR, Name: "Meuse", Category: "Metropolitan department", ParentCode: "FR-GES"},
+	"FR-56":  {Code: "FR-56", Country: iso3166.FR, Name: "Morbihan", Category: "Metropolitan department", ParentCode: "FR-BRE"},
+	"FR-57":  {Code: "FR-57", Country: iso3166.FR, Name: "Moselle", Category: "Metropolitan department", ParentCode: "FR-GES"},
+	"FR-58":  {Code: "FR-58", Country: iso3166.FR, Name: "Nièvre", Category: "Metropolitan department", ParentCode: "FR-BFC"},
+	"FR-59":  {Code: "FR-59", Country: iso3166.FR, Name: "Nord", Category: "Metropolitan department", ParentCode: "FR-HDF"},
+	"FR-60":  {Code: "FR-60", Country: iso3166.FR, Name: "Oise", Category: "Metropolitan department", ParentCode: "FR-HDF"},
+	"FR-61":  {Code: "FR-61", Country: iso3166.FR, Name: "Orne", Category: "Metropolitan department", ParentCode: "FR-NOR"},
+	"FR-62":  {Code: "FR-62", Country: iso3166.FR, Name: "Pas-de-Calais", Category: "Metropolitan department", ParentCode: "FR-HDF"},
+	"FR-63":  {Code: "FR-63", Country: iso3166.FR, Name: "Puy-de-Dôme", Category: "Metropolitan department", ParentCode: "FR-ARA"},
+	"FR-64":  {Code: "FR-64", Country: iso3166.FR, Name: "Pyrénées-Atlantiques", Category: "Metropolitan department", ParentCode: "FR-NAQ"},
+	"FR-65":  {Code: "FR-65", Country: iso3166.FR, Name: "Hautes-Pyrénées", Category: "Metropolitan department", ParentCode: "FR-OCC"},
+	"FR-66":  {Code: "FR-66", Country: iso3166.FR, Name: "Pyrénées-Orientales", Category: "Metropolitan department", ParentCode: "FR-OCC"},
+	"FR-67":  {Code: "FR-67", Country: iso3166.FR, Name: "Bas-Rhin", Category: "Metropolitan department", ParentCode: "FR-GES"},
+	"FR-68":  {Code: "FR-68", Country: iso3166.FR, Name: "Haut-Rhin", Category: "Metropolitan department", ParentCode: "FR-GES"},
+	"FR-69":  {Code: "FR-69", Country: iso3166.FR, Name: "Rhône", Category: "Metropolitan department", ParentCode: "FR-ARA"},
+	"FR-69M": {Code: "FR-69M", Country: iso3166.FR, Name: "Métropole de Lyon", Category: "Metropolitan collectivity with special status", ParentCode: "FR-ARA"},
+	"FR-6AE": {Code: "FR-6AE", Country: iso3166.FR, Name: "Alsace", Category: "European collectivity", ParentCode: "FR-GES"},
+	"FR-70":  {Code: "FR-70", Country: iso3166.FR, Name: "Haute-Saône", Category: "Metropolitan department", ParentCode: "FR-BFC"},
+	"FR-71":  {Code: "FR-71", Country: iso3166.FR, Name: "Saône-et-Loire", Category: "Metropolitan department", ParentCode: "FR-BFC"},
+	"FR-72":  {Code: "FR-72", Country: iso3166.FR, Name: "Sarthe", Category: "Metropolitan department", ParentCode: "FR-PDL"},
+	"FR-73":  {Code: "FR-73", Country: iso3166.FR, Name: "Savoie", Category: "Metropolitan department", ParentCode: "FR-ARA"},
+	"FR-74":  {Code: "FR-74", Country: iso3166.FR, Name: "Haute-Savoie", Category: "Metropolitan department", ParentCode: "FR-ARA"},
+	"FR-75C": {Code: "FR-75C", Country: iso3166.FR, Name: "Paris", Category: "Metropolitan collectivity with special status", ParentCode: "FR-IDF"},
+	"FR-76":  {Code: "FR-76", Country: iso3166.FR, Name: "Seine-Maritime", Category: "Metropolitan department", ParentCode: "FR-NOR"},
+	"FR-77":  {Code: "FR-77", Country: iso3166.FR, Name: "Seine-et-Marne", Category: "Metropolitan department", ParentCode: "FR-IDF"},
+	"FR-78":  {Code: "FR-78", Country: iso3166.FR, Name: "Yvelines", Category: "Metropolitan department", ParentCode: "FR-IDF"},
+	"FR-79":  {Code: "FR-79", Country: iso3166.FR, Name: "Deux-Sèvres", Category: "Metropolitan department", ParentCode: "FR-NAQ"},
+	"FR-80":  {Code: "FR-80", Country: iso3166.FR, Name: "Somme", Category: "Metropolitan department", ParentCode: "FR-HDF"},
+	"FR-81":  {Code: "FR-81", Country: iso3166.FR, Name: "Tarn", Category: "Metropolitan department", ParentCode: "FR-OCC"},
+	"FR-82":  {Code: "FR-82", Country: iso3166.FR, Name: "Tarn-et-Garonne", Category: "Metropolitan department", ParentCode: "FR-OCC"},
+	"FR-83":  {Code: "FR-83", Country: iso3166.FR, Name: "Var", Category: "Metropolitan department", ParentCode: "FR-PAC"},
+	"FR-84":  {Code: "FR-84", Country: iso3166.FR, Name: "Vaucluse", Category: "Metropolitan department", ParentCode: "FR-PAC"},
+	"FR-85":  {Code: "FR-85", Country: iso3166.FR, Name: "Vendée", Category: "Metropolitan department", ParentCode: "FR-PDL"},
+	"FR-86":  {Code: "FR-86", Country: iso3166.FR, Name: "Vienne", Category: "Metropolitan department", ParentCode: "FR-NAQ"},
+	"FR-87":  {Code: "FR-87", Country: iso3166.FR, Name: "Haute-Vienne", Category: "Metropolitan department", ParentCode: "FR-NAQ"},
+	"FR-88":  {Code: "FR-88", Country: iso3166.FR, Name: "Vosges", Category: "Metropolitan department", ParentCode: "FR-GES"},
+	"FR-89":  {Code: "FR-89", Country: iso3166.FR, Name: "Yonne", Category: "Metropolitan department", ParentCode: "FR-BFC"},
+	"FR-90":  {Code: "FR-90", Country: iso3166.FR, Name: "Territoire de Belfort", Category: "Metropolitan department", ParentCode: "FR-BFC"},
+	"FR-91":  {Code: "FR-91", Country: iso3166.FR, Name: "Essonne", Category: "Metropolitan department", ParentCode: "FR-IDF"},
+	"FR-92":  {Code: "FR-92", Country: iso3166.FR, Name: "Hauts-de-Seine", Category: "Metropolitan department", ParentCode: "FR-IDF"},
+	"FR-93":  {Code: "FR-93", Country: iso3166.FR, Name: "Seine-Saint-Denis", Category: "Metropolitan department", ParentCode: "FR-IDF"},
+	"FR-94":  {Code: "FR-94", Country: iso3166.FR, Name: "Val-de-Marne", Category: "Metropolitan department", ParentCode: "FR-IDF"},
+	"FR-95":  {Code: "FR-95", Country: iso3166.FR, Name: "Val-d'Oise", Category: "Metropolitan department", ParentCode: "FR-IDF"},
+	"FR-971": {Code: "FR-971", Country: iso3166.FR, Name: "Guadeloupe", Category: "Overseas department", ParentCode: "FR-GP"},
+	"FR-972": {Code: "FR-972", Country: iso3166.FR, Name: "Martinique", Category: "Overseas department", ParentCode: "FR-MQ"},
+	"FR-973": {Code: "FR-973", Country: iso3166.FR, Name: "Guyane (française)", Category: "Overseas department", ParentCode: "FR-GF"},
+	"FR-974": {Code: "FR-974", Country: iso3166.FR, Name: "La Réunion", Category: "Overseas department", ParentCode: "FR-RE"},
+	"FR-976": {Code: "FR-976", Country: iso3166.FR, Name: "Mayotte", Category: "Overseas department", ParentCode: "FR-YT"},
+	"FR-ARA": {Code: "FR-ARA", Country: iso3166.FR, Name: "Auvergne-Rhône-Alpes", Category: "Metropolitan region"},
+	"FR-BFC": {Code: "FR-BFC", Country: iso3166.FR, Name: "Bourgogne-Franche-Comté", Category: "Metropolitan region"},
+	"FR-BL":  {Code: "FR-BL", Country: iso3166.FR, Name: "Saint-Barthélemy", Category: "Overseas collectivity"},
+	"FR-BRE": {Code: "FR-BRE", Country: iso3166.FR, Name: "Bretagne", Category: "Metropolitan region"},
+	"FR-CP":  {Code: "FR-CP", Country: iso3166.FR, Name: "Clipperton", Category: "Dependency"},
+	"FR-CVL": {Code: "FR-CVL", Country: iso3166.FR, Name: "Centre-Val de Loire", Category: "Metropolitan region"},
+	"FR-GES": {Code: "FR-GES", Country: iso3166.FR, Name: "Grand-Est", Category: "Metropolitan region"},
+	"FR-GF":  {Code: "FR-GF", Country: iso3166.FR, Name: "Guyane (française)", Category: "Overseas region"},
+	"FR-GP":  {Code: "FR-GP", Country: iso3166.FR, Name: "Guadeloupe", Category: "Overseas region"},
+	"FR-HDF": {Code: "FR-HDF", Country: iso3166.FR, Name: "Hauts-de-France", Category: "Metropolitan region"},
+	"FR-IDF": {Code: "FR-IDF", Country: iso3166.FR, Name: "Île-de-France", Category: "Metropolitan region"},
+	"FR-MF":  {Code: "FR-MF", Country: iso3166.FR, Name: "Saint-Martin", Category: "Overseas collectivity"},
+	"FR-MQ":  {Code: "FR-MQ", Country: iso3166.FR, Name: "Martinique", Category: "Overseas region"},
+	"FR-NAQ": {Code: "FR-NAQ", Country: iso3166.FR, Name: "Nouvelle-Aquitaine", Category: "Metropolitan region"},
+	"FR-NC":  {Code: "FR-NC", Country: iso3166.FR, Name: "Nouvelle-Calédonie", Category: "Overseas collectivity with special status"},
+	"FR-NOR": {Code: "FR-NOR", Country: iso3166.FR, Name: "Normandie", Category: "Metropolitan region"},
+	"FR-OCC": {Code: "FR-OCC", Country: iso3166.FR, Name: "Occitanie", Category: "Metropolitan region"},
+	"FR-PAC": {Code: "FR-PAC", Country: iso3166.FR, Name: "Provence-Alpes-Côte-d’Azur", Category: "Metropolitan region"},
+	"FR-PDL": {Code: "FR-PDL", Country: iso3166.FR, Name: "Pays-de-la-Loire", Category: "Metropolitan region"},
+	"FR-PF":  {Code: "FR-PF", Country: iso3166.FR, Name: "Polynésie française", Category: "Overseas collectivity"},
+	"FR-PM":  {Code: "FR-PM", Country: iso3166.FR, Name: "Saint-Pierre-et-Miquelon", Category: "Overseas collectivity"},
+	"FR-RE":  {Code: "FR-RE", Country: iso3166.FR, Name: "La Réunion", Category: "Overseas region"},
+	"FR-TF":  {Code: "FR-TF", Country: iso3166.FR, Name: "Terres australes françaises", Category: "Overseas territory"},
+	"FR-WF":  {Code: "FR-WF", Country: iso3166.FR, Name: "Wallis-et-Futuna", Category: "Overseas collectivity"},
+	"FR-YT":  {Code: "FR-YT", Country: iso3166.FR, Name: "Mayotte", Category: "Overseas region"},
+	"GA-1":   {Code: "GA-1", Country: iso3166.GA, Name: "Estuaire", Category: "Province"},
+	"GA-2":   {Code: "GA-2", Country: iso3166.GA, Name: "Haut-Ogooué", Category: "Province"},
+	"GA-3":   {Code: "GA-3", Country: iso3166.GA, Name: "Moyen-Ogooué", Category: "Province"},
+	"GA-4":   {Code: "GA-4", Country: iso3166.GA, Name: "Ngounié", Category: "Province"},
+	"GA-5":   {Code: "GA-5", Country: iso3166.GA, Name: "Nyanga", Category: "Province"},
+	"GA-6":   {Code: "GA-6", Country: iso3166.GA, Name: "Ogooué-Ivindo", Category: "Province"},
+	"GA-7":   {Code: "GA-7", Country: iso3166.GA, Name: "Ogooué-Lolo", Category: "Province"},
+	"GA-8":   {Code: "GA-8", Country: iso3166.GA, Name: "Ogooué-Maritime", Category: "Province"},
+	"GA-9":   {Code: "GA-9", Country: iso3166.GA, Name: "Woleu-Ntem", Category: "Province"},
+	"GB-ABC": {Code: "GB-ABC", Country: iso3166.GB, Name: "Armagh City, Banbridge and Craigavon", Category: "District", ParentCode: "GB-NIR"},
+	"GB-ABD": {Code: "GB-ABD", Country: iso3166.GB, Name: "Aberdeenshire", Category: "Council area", ParentCode: "GB-SCT"},
+	"GB-ABE": {Code: "GB-ABE", Country: iso3166.GB, Name: "Aberdeen City", Category: "Council area", ParentCode: "GB-SCT"},
+	"GB-AGB": {Code: "GB-AGB", Country: iso3166.GB, Name: "Argyll and Bute", Category: "Council area", ParentCode: "GB-SCT"},
+	"GB-AGY": {Code: "GB-AGY", Country: iso3166.GB, Name: "Isle of Anglesey [Sir Ynys Môn GB-YNM]", Category: "Unitary authority", ParentCode: "GB-WLS"},
+	"GB-AND": {Code: "GB-AND", Country: iso3166.GB, Name: "Ards and North Down", Category: "District", ParentCode: "GB-NIR"},
+	"GB-ANN": {Code: "GB-ANN", Country: iso3166.GB, Name: "Antrim and Newtownabbey", Category: "District", ParentCode: "GB-NIR"},
+	"GB-ANS": {Code: "GB-ANS", Country: iso3166.GB, Name: "Angus", Category: "Council area", ParentCode: "GB-SCT"},
+	"GB-BAS": {Code: "GB-BAS", Country: iso3166.GB, Name: "Bath and North East Somerset", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-BBD": {Code: "GB-BBD", Country: iso3166.GB, Name: "Blackburn with Darwen", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-BCP": {Code: "GB-BCP", Country: iso3166.GB, Name: "Bournemouth, Christchurch and Poole", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-BDF": {Code: "GB-BDF", Country: iso3166.GB, Name: "Bedford", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-BDG": {Code: "GB-BDG", Country: iso3166.GB, Name: "Barking and Dagenham", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-BEN": {Code: "GB-BEN", Country: iso3166.GB, Name: "Brent", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-BEX": {Code: "GB-BEX", Country: iso3166.GB, Name: "Bexley", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-BFS": {Code: "GB-BFS", Country: iso3166.GB, Name: "Belfast City", Category: "District", ParentCode: "GB-NIR"},
+	"GB-BGE": {Code: "GB-BGE", Country: iso3166.GB, Name: "Bridgend [Pen-y-bont ar Ogwr GB-POG]", Category: "Unitary authority", ParentCode: "GB-WLS"},
+	"GB-BGW": {Code: "GB-BGW", Country: iso3166.GB, Name: "Blaenau Gwent", Category: "Unitary authority", ParentCode: "GB-WLS"},
+	"GB-BIR": {Code: "GB-BIR", Country: iso3166.GB, Name: "Birmingham", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-BKM": {Code: "GB-BKM", Country: iso3166.GB, Name: "Buckinghamshire", Category: "Two-tier county", ParentCode: "GB-ENG"},
+	"GB-BNE": {Code: "GB-BNE", Country: iso3166.GB, Name: "Barnet", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-BNH": {Code: "GB-BNH", Country: iso3166.GB, Name: "Brighton and Hove", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-BNS": {Code: "GB-BNS", Country: iso3166.GB, Name: "Barnsley", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-BOL": {Code: "GB-BOL", Country: iso3166.GB, Name: "Bolton", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-BPL": {Code: "GB-BPL", Country: iso3166.GB, Name: "Blackpool", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-BRC": {Code: "GB-BRC", Country: iso3166.GB, Name: "Bracknell Forest", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-BRD": {Code: "GB-BRD", Country: iso3166.GB, Name: "Bradford", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-BRY": {Code: "GB-BRY", Country: iso3166.GB, Name: "Bromley", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-BST": {Code: "GB-BST", Country: iso3166.GB, Name: "Bristol, City of", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-BUR": {Code: "GB-BUR", Country: iso3166.GB, Name: "Bury", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-CAM": {Code: "GB-CAM", Country: iso3166.GB, Name: "Cambridgeshire", Category: "Two-tier county", ParentCode: "GB-ENG"},
+	"GB-CAY": {Code: "GB-CAY", Country: iso3166.GB, Name: "Caerphilly [Caerffili GB-CAF]", Category: "Unitary authority", ParentCode: "GB-WLS"},
+	"GB-CBF": {Code: "GB-CBF", Country: iso3166.GB, Name: "Central Bedfordshire", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-CCG": {Code: "GB-CCG", Country: iso3166.GB, Name: "Causeway Coast and Glens", Category: "District", ParentCode: "GB-NIR"},
+	"GB-CGN": {Code: "GB-CGN", Country: iso3166.GB, Name: "Ceredigion [Sir Ceredigion]", Category: "Unitary authority", ParentCode: "GB-WLS"},
+	"GB-CHE": {Code: "GB-CHE", Country: iso3166.GB, Name: "Cheshire East", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-CHW": {Code: "GB-CHW", Country: iso3166.GB, Name: "Cheshire West and Chester", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-CLD": {Code: "GB-CLD", Country: iso3166.GB, Name: "Calderdale", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-CLK": {Code: "GB-CLK", Country: iso3166.GB, Name: "Clackmannanshire", Category: "Council area", ParentCode: "GB-SCT"},
+	"GB-CMA": {Code: "GB-CMA", Country: iso3166.GB, Name: "Cumbria", Category: "Two-tier county", ParentCode: "GB-ENG"},
+	"GB-CMD": {Code: "GB-CMD", Country: iso3166.GB, Name: "Camden", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-CMN": {Code: "GB-CMN", Country: iso3166.GB, Name: "Carmarthenshire [Sir Gaerfyrddin GB-GFY]", Category: "Unitary authority", ParentCode: "GB-WLS"},
+	"GB-CON": {Code: "GB-CON", Country: iso3166.GB, Name: "Cornwall", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-COV": {Code: "GB-COV", Country: iso3166.GB, Name: "Coventry", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-CRF": {Code: "GB-CRF", Country: iso3166.GB, Name: "Cardiff [Caerdydd GB-CRD]", Category: "Unitary authority", ParentCode: "GB-WLS"},
+	"GB-CRY": {Code: "GB-CRY", Country: iso3166.GB, Name: "Croydon", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-CWY": {Code: "GB-CWY", Country: iso3166.GB, Name: "Conwy", Category: "Unitary authority", ParentCode: "GB-WLS"},
+	"GB-DAL": {Code: "GB-DAL", Country: iso3166.GB, Name: "Darlington", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-DBY": {Code: "GB-DBY", Country: iso3166.GB, Name: "Derbyshire", Category: "Two-tier county", ParentCode: "GB-ENG"},
+	"GB-DEN": {Code: "GB-DEN", Country: iso3166.GB, Name: "Denbighshire [Sir Ddinbych GB-DDB]", Category: "Unitary authority", ParentCode: "GB-WLS"},
+	"GB-DER": {Code: "GB-DER", Country: iso3166.GB, Name: "Derby", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-DEV": {Code: "GB-DEV", Country: iso3166.GB, Name: "Devon", Category: "Two-tier county", ParentCode: "GB-ENG"},
+	"GB-DGY": {Code: "GB-DGY", Country: iso3166.GB, Name: "Dumfries and Galloway", Category: "Council area", ParentCode: "GB-SCT"},
+	"GB-DNC": {Code: "GB-DNC", Country: iso3166.GB, Name: "Doncaster", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-DND": {Code: "GB-DND", Country: iso3166.GB, Name: "Dundee City", Category: "Council area", ParentCode: "GB-SCT"},
+	"GB-DOR": {Code: "GB-DOR", Country: iso3166.GB, Name: "Dorset", Category: "Two-tier county", ParentCode: "GB-ENG"},
+	"GB-DRS": {Code: "GB-DRS", Country: iso3166.GB, Name: "Derry and Strabane", Category: "District", ParentCode: "GB-NIR"},
+	"GB-DUD": {Code: "GB-DUD", Country: iso3166.GB, Name: "Dudley", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-DUR": {Code: "GB-DUR", Country: iso3166.GB, Name: "Durham, County", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-EAL": {Code: "GB-EAL", Country: iso3166.GB, Name: "Ealing", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-EAY": {Code: "GB-EAY", Country: iso3166.GB, Name: "East Ayrshire", Category: "Council area", ParentCode: "GB-SCT"},
+	"GB-EDH": {Code: "GB-EDH", Country: iso3166.GB, Name: "Edinburgh, City of", Category: "Council area", ParentCode: "GB-SCT"},
+	"GB-EDU": {Code: "GB-EDU", Country: iso3166.GB, Name: "East Dunbartonshire", Category: "Council area", ParentCode: "GB-SCT"},
+	"GB-ELN": {Code: "GB-ELN", Country: iso3166.GB, Name: "East Lothian", Category: "Council area", ParentCode: "GB-SCT"},
+	"GB-ELS": {Code: "GB-ELS", Country: iso3166.GB, Name: "Eilean Siar", Category: "Council area", ParentCode: "GB-SCT"},
+	"GB-ENF": {Code: "GB-ENF", Country: iso3166.GB, Name: "Enfield", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-ENG": {Code: "GB-ENG", Country: iso3166.GB, Name: "England", Category: "Country"},
+	"GB-ERW": {Code: "GB-ERW", Country: iso3166.GB, Name: "East Renfrewshire", Category: "Council area", ParentCode: "GB-SCT"},
+	"GB-ERY": {Code: "GB-ERY", Country: iso3166.GB, Name: "East Riding of Yorkshire", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-ESS": {Code: "GB-ESS", Country: iso3166.GB, Name: "Essex", Category: "Two-tier county", ParentCode: "GB-ENG"},
+	"GB-ESX": {Code: "GB-ESX", Country: iso3166.GB, Name: "East Sussex", Category: "Two-tier county", ParentCode: "GB-ENG"},
+	"GB-FAL": {Code: "GB-FAL", Country: iso3166.GB, Name: "Falkirk", Category: "Council area", ParentCode: "GB-SCT"},
+	"GB-FIF": {Code: "GB-FIF", Country: iso3166.GB, Name: "Fife", Category: "Council area", ParentCode: "GB-SCT"},
+	"GB-FLN": {Code: "GB-FLN", Country: iso3166.GB, Name: "Flintshire [Sir y Fflint GB-FFL]", Category: "Unitary authority", ParentCode: "GB-WLS"},
+	"GB-FMO": {Code: "GB-FMO", Country: iso3166.GB, Name: "Fermanagh and Omagh", Category: "District", ParentCode: "GB-NIR"},
+	"GB-GAT": {Code: "GB-GAT", Country: iso3166.GB, Name: "Gateshead", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-GLG": {Code: "GB-GLG", Country: iso3166.GB, Name: "Glasgow City", Category: "Council area", ParentCode: "GB-SCT"},
+	"GB-GLS": {Code: "GB-GLS", Country: iso3166.GB, Name: "Gloucestershire", Category: "Two-tier county", ParentCode: "GB-ENG"},
+	"GB-GRE": {Code: "GB-GRE", Country: iso3166.GB, Name: "Greenwich", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-GWN": {Code: "GB-GWN", Country: iso3166.GB, Name: "Gwynedd", Category: "Unitary authority", ParentCode: "GB-WLS"},
+	"GB-HAL": {Code: "GB-HAL", Country: iso3166.GB, Name: "Halton", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-HAM": {Code: "GB-HAM", Country: iso3166.GB, Name: "Hampshire", Category: "Two-tier county", ParentCode: "GB-ENG"},
+	"GB-HAV": {Code: "GB-HAV", Country: iso3166.GB, Name: "Havering", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-HCK": {Code: "GB-HCK", Country: iso3166.GB, Name: "Hackney", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-HEF": {Code: "GB-HEF", Country: iso3166.GB, Name: "Herefordshire", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-HIL": {Code: "GB-HIL", Country: iso3166.GB, Name: "Hillingdon", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-HLD": {Code: "GB-HLD", Country: iso3166.GB, Name: "Highland", Category: "Council area", ParentCode: "GB-SCT"},
+	"GB-HMF": {Code: "GB-HMF", Country: iso3166.GB, Name: "Hammersmith and Fulham", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-HNS": {Code: "GB-HNS", Country: iso3166.GB, Name: "Hounslow", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-HPL": {Code: "GB-HPL", Country: iso3166.GB, Name: "Hartlepool", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-HRT": {Code: "GB-HRT", Country: iso3166.GB, Name: "Hertfordshire", Category: "Two-tier county", ParentCode: "GB-ENG"},
+	"GB-HRW": {Code: "GB-HRW", Country: iso3166.GB, Name: "Harrow", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-HRY": {Code: "GB-HRY", Country: iso3166.GB, Name: "Haringey", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-IOS": {Code: "GB-IOS", Country: iso3166.GB, Name: "Isles of Scilly", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-IOW": {Code: "GB-IOW", Country: iso3166.GB, Name: "Isle of Wight", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-ISL": {Code: "GB-ISL", Country: iso3166.GB, Name: "Islington", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-IVC": {Code: "GB-IVC", Country: iso3166.GB, Name: "Inverclyde", Category: "Council area", ParentCode: "GB-SCT"},
+	"GB-KEC": {Code: "GB-KEC", Country: iso3166.GB, Name: "Kensington and Chelsea", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-KEN": {Code: "GB-KEN", Country: iso3166.GB, Name: "Kent", Category: "Two-tier county", ParentCode: "GB-ENG"},
+	"GB-KHL": {Code: "GB-KHL", Country: iso3166.GB, Name: "Kingston upon Hull", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-KIR": {Code: "GB-KIR", Country: iso3166.GB, Name: "Kirklees", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-KTT": {Code: "GB-KTT", Country: iso3166.GB, Name: "Kingston upon Thames", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-KWL": {Code: "GB-KWL", Country: iso3166.GB, Name: "Knowsley", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-LAN": {Code: "GB-LAN", Country: iso3166.GB, Name: "Lancashire", Category: "Two-tier county", ParentCode: "GB-ENG"},
+	"GB-LBC": {Code: "GB-LBC", Country: iso3166.GB, Name: "Lisburn and Castlereagh", Category: "District", ParentCode: "GB-NIR"},
+	"GB-LBH": {Code: "GB-LBH", Country: iso3166.GB, Name: "Lambeth", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-LCE": {Code: "GB-LCE", Country: iso3166.GB, Name: "Leicester", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-LDS": {Code: "GB-LDS", Country: iso3166.GB, Name: "Leeds", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-LEC": {Code: "GB-LEC", Country: iso3166.GB, Name: "Leicestershire", Category: "Two-tier county", ParentCode: "GB-ENG"},
+	"GB-LEW": {Code: "GB-LEW", Country: iso3166.GB, Name: "Lewisham", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-LIN": {Code: "GB-LIN", Country: iso3166.GB, Name: "Lincolnshire", Category: "Two-tier county", ParentCode: "GB-ENG"},
+	"GB-LIV": {Code: "GB-LIV", Country: iso3166.GB, Name: "Liverpool", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-LND": {Code: "GB-LND", Country: iso3166.GB, Name: "London, City of", Category: "City corporation", ParentCode: "GB-ENG"},
+	"GB-LUT": {Code: "GB-LUT", Country: iso3166.GB, Name: "Luton", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-MAN": {Code: "GB-MAN", Country: iso3166.GB, Name: "Manchester", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-MDB": {Code: "GB-MDB", Country: iso3166.GB, Name: "Middlesbrough", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-MDW": {Code: "GB-MDW", Country: iso3166.GB, Name: "Medway", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-MEA": {Code: "GB-MEA", Country: iso3166.GB, Name: "Mid and East Antrim", Category: "District", ParentCode: "GB-NIR"},
+	"GB-MIK": {Code: "GB-MIK", Country: iso3166.GB, Name: "Milton Keynes", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-MLN": {Code: "GB-MLN", Country: iso3166.GB, Name: "Midlothian", Category: "Council area", ParentCode: "GB-SCT"},
+	"GB-MON": {Code: "GB-MON", Country: iso3166.GB, Name: "Monmouthshire [Sir Fynwy GB-FYN]", Category: "Unitary authority", ParentCode: "GB-WLS"},
+	"GB-MRT": {Code: "GB-MRT", Country: iso3166.GB, Name: "Merton", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-MRY": {Code: "GB-MRY", Country: iso3166.GB, Name: "Moray", Category: "Council area", ParentCode: "GB-SCT"},
+	"GB-MTY": {Code: "GB-MTY", Country: iso3166.GB, Name: "Merthyr Tydfil [Merthyr Tudful GB-MTU]", Category: "Unitary authority", ParentCode: "GB-WLS"},
+	"GB-MUL": {Code: "GB-MUL", Country: iso3166.GB, Name: "Mid-Ulster", Category: "District", ParentCode: "GB-NIR"},
+	"GB-NAY": {Code: "GB-NAY", Country: iso3166.GB, Name: "North Ayrshire", Category: "Council area", ParentCode: "GB-SCT"},
+	"GB-NBL": {Code: "GB-NBL", Country: iso3166.GB, Name: "Northumberland", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-NEL": {Code: "GB-NEL", Country: iso3166.GB, Name: "North East Lincolnshire", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-NET": {Code: "GB-NET", Country: iso3166.GB, Name: "Newcastle upon Tyne", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-NFK": {Code: "GB-NFK", Country: iso3166.GB, Name: "Norfolk", Category: "Two-tier county", ParentCode: "GB-ENG"},
+	"GB-NGM": {Code: "GB-NGM", Country: iso3166.GB, Name: "Nottingham", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-NIR": {Code: "GB-NIR", Country: iso3166.GB, Name: "Northern Ireland", Category: "Province"},
+	"GB-NLK": {Code: "GB-NLK", Country: iso3166.GB, Name: "North Lanarkshire", Category: "Council area", ParentCode: "GB-SCT"},
+	"GB-NLN": {Code: "GB-NLN", Country: iso3166.GB, Name: "North Lincolnshire", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-NMD": {Code: "GB-NMD", Country: iso3166.GB, Name: "Newry, Mourne and Down", Category: "District", ParentCode: "GB-NIR"},
+	"GB-NSM": {Code: "GB-NSM", Country: iso3166.GB, Name: "North Somerset", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-NTH": {Code: "GB-NTH", Country: iso3166.GB, Name: "Northamptonshire", Category: "Two-tier county", ParentCode: "GB-ENG"},
+	"GB-NTL": {Code: "GB-NTL", Country: iso3166.GB, Name: "Neath Port Talbot [Castell-nedd Port Talbot GB-CTL]", Category: "Unitary authority", ParentCode: "GB-WLS"},
+	"GB-NTT": {Code: "GB-NTT", Country: iso3166.GB, Name: "Nottinghamshire", Category: "Two-tier county", ParentCode: "GB-ENG"},
+	"GB-NTY": {Code: "GB-NTY", Country: iso3166.GB, Name: "North Tyneside", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-NWM": {Code: "GB-NWM", Country: iso3166.GB, Name: "Newham", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-NWP": {Code: "GB-NWP", Country: iso3166.GB, Name: "Newport [Casnewydd GB-CNW]", Category: "Unitary authority", ParentCode: "GB-WLS"},
+	"GB-NYK": {Code: "GB-NYK", Country: iso3166.GB, Name: "North Yorkshire", Category: "Two-tier county", ParentCode: "GB-ENG"},
+	"GB-OLD": {Code: "GB-OLD", Country: iso3166.GB, Name: "Oldham", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-ORK": {Code: "GB-ORK", Country: iso3166.GB, Name: "Orkney Islands", Category: "Council area", ParentCode: "GB-SCT"},
+	"GB-OXF": {Code: "GB-OXF", Country: iso3166.GB, Name: "Oxfordshire", Category: "Two-tier county", ParentCode: "GB-ENG"},
+	"GB-PEM": {Code: "GB-PEM", Country: iso3166.GB, Name: "Pembrokeshire [Sir Benfro GB-BNF]", Category: "Unitary authority", ParentCode: "GB-WLS"},
+	"GB-PKN": {Code: "GB-PKN", Country: iso3166.GB, Name: "Perth and Kinross", Category: "Council area", ParentCode: "GB-SCT"},
+	"GB-PLY": {Code: "GB-PLY", Country: iso3166.GB, Name: "Plymouth", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-POR": {Code: "GB-POR", Country: iso3166.GB, Name: "Portsmouth", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-POW": {Code: "GB-POW", Country: iso3166.GB, Name: "Powys", Category: "Unitary authority", ParentCode: "GB-WLS"},
+	"GB-PTE": {Code: "GB-PTE", Country: iso3166.GB, Name: "Peterborough", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-RCC": {Code: "GB-RCC", Country: iso3166.GB, Name: "Redcar and Cleveland", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-RCH": {Code: "GB-RCH", Country: iso3166.GB, Name: "Rochdale", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-RCT": {Code: "GB-RCT", Country: iso3166.GB, Name: "Rhondda Cynon Taff [Rhondda CynonTaf]", Category: "Unitary authority", ParentCode: "GB-WLS"},
+	"GB-RDB": {Code: "GB-RDB", Country: iso3166.GB, Name: "Redbridge", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-RDG": {Code: "GB-RDG", Country: iso3166.GB, Name: "Reading", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-RFW": {Code: "GB-RFW", Country: iso3166.GB, Name: "Renfrewshire", Category: "Council area", ParentCode: "GB-SCT"},
+	"GB-RIC": {Code: "GB-RIC", Country: iso3166.GB, Name: "Richmond upon Thames", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-ROT": {Code: "GB-ROT", Country: iso3166.GB, Name: "Rotherham", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-RUT": {Code: "GB-RUT", Country: iso3166.GB, Name: "Rutland", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-SAW": {Code: "GB-SAW", Country: iso3166.GB, Name: "Sandwell", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-SAY": {Code: "GB-SAY", Country: iso3166.GB, Name: "South Ayrshire", Category: "Council area", ParentCode: "GB-SCT"},
+	"GB-SCB": {Code: "GB-SCB", Country: iso3166.GB, Name: "Scottish Borders", Category: "Council area", ParentCode: "GB-SCT"},
+	"GB-SCT": {Code: "GB-SCT", Country: iso3166.GB, Name: "Scotland", Category: "Country"},
+	"GB-SFK": {Code: "GB-SFK", Country: iso3166.GB, Name: "Suffolk", Category: "Two-tier county", ParentCode: "GB-ENG"},
+	"GB-SFT": {Code: "GB-SFT", Country: iso3166.GB, Name: "Sefton", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-SGC": {Code: "GB-SGC", Country: iso3166.GB, Name: "South Gloucestershire", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-SHF": {Code: "GB-SHF", Country: iso3166.GB, Name: "Sheffield", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-SHN": {Code: "GB-SHN", Country: iso3166.GB, Name: "St. Helens", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-SHR": {Code: "GB-SHR", Country: iso3166.GB, Name: "Shropshire", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-SKP": {Code: "GB-SKP", Country: iso3166.GB, Name: "Stockport", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-SLF": {Code: "GB-SLF", Country: iso3166.GB, Name: "Salford", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-SLG": {Code: "GB-SLG", Country: iso3166.GB, Name: "Slough", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-SLK": {Code: "GB-SLK", Country: iso3166.GB, Name: "South Lanarkshire", Category: "Council area", ParentCode: "GB-SCT"},
+	"GB-SND": {Code: "GB-SND", Country: iso3166.GB, Name: "Sunderland", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-SOL": {Code: "GB-SOL", Country: iso3166.GB, Name: "Solihull", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-SOM": {Code: "GB-SOM", Country: iso3166.GB, Name: "Somerset", Category: "Two-tier county", ParentCode: "GB-ENG"},
+	"GB-SOS": {Code: "GB-SOS", Country: iso3166.GB, Name: "Southend-on-Sea", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-SRY": {Code: "GB-SRY", Country: iso3166.GB, Name: "Surrey", Category: "Two-tier county", ParentCode: "GB-ENG"},
+	"GB-STE": {Code: "GB-STE", Country: iso3166.GB, Name: "Stoke-on-Trent", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-STG": {Code: "GB-STG", Country: iso3166.GB, Name: "Stirling", Category: "Council area", ParentCode: "GB-SCT"},
+	"GB-STH": {Code: "GB-STH", Country: iso3166.GB, Name: "Southampton", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-STN": {Code: "GB-STN", Country: iso3166.GB, Name: "Sutton", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-STS": {Code: "GB-STS", Country: iso3166.GB, Name: "Staffordshire", Category: "Two-tier county", ParentCode: "GB-ENG"},
+	"GB-STT": {Code: "GB-STT", Country: iso3166.GB, Name: "Stockton-on-Tees", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-STY": {Code: "GB-STY", Country: iso3166.GB, Name: "South Tyneside", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-SWA": {Code: "GB-SWA", Country: iso3166.GB, Name: "Swansea [Abertawe GB-ATA]", Category: "Unitary authority", ParentCode: "GB-WLS"},
+	"GB-SWD": {Code: "GB-SWD", Country: iso3166.GB, Name: "Swindon", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-SWK": {Code: "GB-SWK", Country: iso3166.GB, Name: "Southwark", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-TAM": {Code: "GB-TAM", Country: iso3166.GB, Name: "Tameside", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-TFW": {Code: "GB-TFW", Country: iso3166.GB, Name: "Telford and Wrekin", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-THR": {Code: "GB-THR", Country: iso3166.GB, Name: "Thurrock", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-TOB": {Code: "GB-TOB", Country: iso3166.GB, Name: "Torbay", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-TOF": {Code: "GB-TOF", Country: iso3166.GB, Name: "Torfaen [Tor-faen]", Category: "Unitary authority", ParentCode: "GB-WLS"},
+	"GB-TRF": {Code: "GB-TRF", Country: iso3166.GB, Name: "Trafford", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-TWH": {Code: "GB-TWH", Country: iso3166.GB, Name: "Tower Hamlets", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-VGL": {Code: "GB-VGL", Country: iso3166.GB, Name: "Vale of Glamorgan, The [Bro Morgannwg GB-BMG]", Category: "Unitary authority", ParentCode: "GB-WLS"},
+	"GB-WAR": {Code: "GB-WAR", Country: iso3166.GB, Name: "Warwickshire", Category: "Two-tier county", ParentCode: "GB-ENG"},
+	"GB-WBK": {Code: "GB-WBK", Country: iso3166.GB, Name: "West Berkshire", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-WDU": {Code: "GB-WDU", Country: iso3166.GB, Name: "West Dunbartonshire", Category: "Council area", ParentCode: "GB-SCT"},
+	"GB-WFT": {Code: "GB-WFT", Country: iso3166.GB, Name: "Waltham Forest", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-WGN": {Code: "GB-WGN", Country: iso3166.GB, Name: "Wigan", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-WIL": {Code: "GB-WIL", Country: iso3166.GB, Name: "Wiltshire", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-WKF": {Code: "GB-WKF", Country: iso3166.GB, Name: "Wakefield", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-WLL": {Code: "GB-WLL", Country: iso3166.GB, Name: "Walsall", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-WLN": {Code: "GB-WLN", Country: iso3166.GB, Name: "West Lothian", Category: "Council area", ParentCode: "GB-SCT"},
+	"GB-WLS": {Code: "GB-WLS", Country: iso3166.GB, Name: "Wales [Cymru GB-CYM]", Category: "Country"},
+	"GB-WLV": {Code: "GB-WLV", Country: iso3166.GB, Name: "Wolverhampton", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-WND": {Code: "GB-WND", Country: iso3166.GB, Name: "Wandsworth", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-WNM": {Code: "GB-WNM", Country: iso3166.GB, Name: "Windsor and Maidenhead", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-WOK": {Code: "GB-WOK", Country: iso3166.GB, Name: "Wokingham", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-WOR": {Code: "GB-WOR", Country: iso3166.GB, Name: "Worcestershire", Category: "Two-tier county", ParentCode: "GB-ENG"},
+	"GB-WRL": {Code: "GB-WRL", Country: iso3166.GB, Name: "Wirral", Category: "Metropolitan district", ParentCode: "GB-ENG"},
+	"GB-WRT": {Code: "GB-WRT", Country: iso3166.GB, Name: "Warrington", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-WRX": {Code: "GB-WRX", Country: iso3166.GB, Name: "Wrexham [Wrecsam GB-WRC]", Category: "Unitary authority", ParentCode: "GB-WLS"},
+	"GB-WSM": {Code: "GB-WSM", Country: iso3166.GB, Name: "Westminster", Category: "London borough", ParentCode: "GB-ENG"},
+	"GB-WSX": {Code: "GB-WSX", Country: iso3166.GB, Name: "West Sussex", Category: "Two-tier county", ParentCode: "GB-ENG"},
+	"GB-YOR": {Code: "GB-YOR", Country: iso3166.GB, Name: "York", Category: "Unitary authority", ParentCode: "GB-ENG"},
+	"GB-ZET": {Code: "GB-ZET", Country: iso3166.GB, Name: "Shetland Islands", Category: "Council area", ParentCode: "GB-SCT"},
+	"GD-01":  {Code: "GD-01", Country: iso3166.GD, Name: "Saint Andrew", Category: "Parish"},
+	"GD-02":  {Code: "GD-02", Country: iso3166.GD, Name: "Saint David", Category: "Parish"},
+	"GD-03":  {Code: "GD-03", Country: iso3166.GD, Name: "Saint George", Category: "Parish"},
+	"GD-04":  {Code: "GD-04", Country: iso3166.GD, Name: "Saint John", Category: "Parish"},
+	"GD-05":  {Code: "GD-05", Country: iso3166.GD, Name: "Saint Mark", Category: "Parish"},
+	"GD-06":  {Code: "GD-06", Country: iso3166.GD, Name: "Saint Patrick", Category: "Parish"},
+	"GD-10":  {Code: "GD-10", Country: iso3166.GD, Name: "Southern Grenadine Islands", Category: "Dependency"},
+	"GE-AB":  {Code: "GE-AB", Country: iso3166.GE, Name: "Abkhazia", Category: "Autonomous republic"},
+	"GE-AJ":  {Code: "GE-AJ", Country: iso3166.GE, Name: "Ajaria", Category: "Autonomous republic"},
+	"GE-GU":  {Code: "GE-GU", Country: iso3166.GE, Name: "Guria", Category: "Region"},
+	"GE-IM":  {Code: "GE-IM", Country: iso3166.GE, Name: "Imereti", Category: "Region"},
+	"GE-KA":  {Code: "GE-KA", Country: iso3166.GE, Name: "K'akheti", Category: "Region"},
+	"GE-KK":  {Code: "GE-KK", Country: iso3166.GE, Name: "Kvemo Kartli", Category: "Region"},
+	"GE-MM":  {Code: "GE-MM", Country: iso3166.GE, Name: "Mtskheta-Mtianeti", Category: "Region"},
+	"GE-RL":  {Code: "GE-RL", Country: iso3166.GE, Name: "Rach'a-Lechkhumi-Kvemo Svaneti", Category: "Region"},
+	"GE-SJ":  {Code: "GE-SJ", Country: iso3166.GE, Name: "Samtskhe-Javakheti", Category: "Region"},
+	"GE-SK":  {Code: "GE-SK", Country: iso3166.GE, Name: "Shida Kartli", Category: "Region"},
+	"GE-SZ":  {Code: "GE-SZ", Country: iso3166.GE, Name: "Samegrelo-Zemo Svaneti", Category: "Region"},
+	"GE-TB":  {Code: "GE-TB", Country: iso3166.GE, Name: "Tbilisi", Category: "City"},
+	"GH-AA":  {Code: "GH-AA", Country: iso3166.GH, Name: "Greater Accra", Category: "Region"},
+	"GH-AF":  {Code: "GH-AF", Country: iso3166.GH, Name: "Ahafo", Category: "Region"},
+	"GH-AH":  {Code: "GH-AH", Country: iso3166.GH, Name: "Ashanti", Category: "Region"},
+	"GH-BE":  {Code: "GH-BE", Country: iso3166.GH, Name: "Bono East", Category: "Region"},
+	"GH-BO":  {Code: "GH-BO", Country: iso3166.GH, Name: "Bono", Category: "Region"},
+	"GH-CP":  {Code: "GH-CP", Country: iso3166.GH, Name: "Central", Category: "Region"},
+	"GH-EP":  {Code: "GH-EP", Country: iso3166.GH, Name: "Eastern", Category: "Region"},
+	"GH-NE":  {Code: "GH-NE", Country: iso3166.GH, Name: "North East", Category: "Region"},
+	"GH-NP":  {Code: "GH-NP", Country: iso3166.GH, Name: "Northern", Category: "Region"},
+	"GH-OT":  {Code: "GH-OT", Country: iso3166.GH, Name: "Oti", Category: "Region"},
+	"GH-SV":  {Code: "GH-SV", Country: iso3166.GH, Name: "Savannah", Category: "Region"},
+	"GH-TV":  {Code: "GH-TV", Country: iso3166.GH, Name: "Volta", Category: "Region"},
+	"GH-UE":  {Code: "GH-UE", Country: iso3166.GH, Name: "Upper East", Category: "Region"},
+	"GH-UW":  {Code: "GH-UW", Country: iso3166.GH, Name: "Upper West", Category: "Region"},
+	"GH-WN":  {Code: "GH-WN", Country: iso3166.GH, Name: "Western North", Category: "Region"},
+	"GH-WP":  {Code: "GH-WP", Country: iso3166.GH, Name: "Western", Category: "Region"},
+	"GL-AV":  {Code: "GL-AV", Country: iso3166.GL, Name: "Avannaata Kommunia", Category: "Municipality"},
+	"GL-KU":  {Code: "GL-KU", Country: iso3166.GL, Name: "Kommune Kujalleq", Category: "Municipality"},
+	"GL-QE":  {Code: "GL-QE", Country: iso3166.GL, Name: "Qeqqata Kommunia", Category: "Municipality"},
+	"GL-QT":  {Code: "GL-QT", Country: iso3166.GL, Name: "Kommune Qeqertalik", Category: "Municipality"},
+	"GL-SM":  {Code: "GL-SM", Country: iso3166.GL, Name: "Kommuneqarfik Sermersooq", Category: "Municipality"},
+	"GM-B":   {Code: "GM-B", Country: iso3166.GM, Name: "Banjul", Category: "City"},
+	"GM-L":   {Code: "GM-L", Country: iso3166.GM, Name: "Lower River", Category: "Division"},
+	"GM-M":   {Code: "GM-M", Country: iso3166.GM, Name: "Central River", Category: "Division"},
+	"GM-N":   {Code: "GM-N", Country: iso3166.GM, Name: "North Bank", Category: "Division"},
+	"GM-U":   {Code: "GM-U", Country: iso3166.GM, Name: "Upper River", Category: "Division"},
+	"GM-W":   {Code: "GM-W", Country: iso3166.GM, Name: "Western", Category: "Division"},
+	"GN-B":   {Code: "GN-B", Country: iso3166.GN, Name: "Boké", Category: "Administrative region"},
+	"GN-BE":  {Code: "GN-BE", Country: iso3166.GN, Name: "Beyla", Category: "Prefecture", ParentCode: "GN-N"},
+	"GN-BF":  {Code: "GN-BF", Country: iso3166.GN, Name: "Boffa", Category: "Prefecture", ParentCode: "GN-B"},
+	"GN-BK":  {Code: "GN-BK", Country: iso3166.GN, Name: "Boké", Category: "Prefecture", ParentCode: "GN-B"},
+	"GN-C":   {Code: "GN-C", Country: iso3166.GN, Name: "Conakry", Category: "Governorate"},
+	"GN-CO":  {Code: "GN-CO", Country: iso3166.GN, Name: "Coyah", Category: "Prefecture", ParentCode: "GN-D"},
+	"GN-D":   {Code: "GN-D", Country: iso3166.GN, Name: "Kindia", Category: "Administrative region"},
+	"GN-DB":  {Code: "GN-DB", Country: iso3166.GN, Name: "Dabola", Category: "Prefecture", ParentCode: "GN-F"},
+	"GN-DI":  {Code: "GN-DI", Country: iso3166.GN, Name: "Dinguiraye", Category: "Prefecture", ParentCode: "GN-F"},
+	"GN-DL":  {Code: "GN-DL", Country: iso3166.GN, Name: "Dalaba", Category: "Prefecture", ParentCode: "GN-M"},
+	"GN-DU":  {Code: "GN-DU", Country: iso3166.GN, Name: "Dubréka", Category: "Prefecture", ParentCode: "GN-D"},
+	"GN-F":   {Code: "GN-F", Country: iso3166.GN, Name: "Faranah", Category: "Administrative region"},
+	"GN-FA":  {Code: "GN-FA", Country: iso3166.GN, Name: "Faranah", Category: "Prefecture", ParentCode: "GN-F"},
+	"GN-FO":  {Code: "GN-FO", Country: iso3166.GN, Name: "Forécariah", Category: "Prefecture", ParentCode: "GN-D"},
+	"GN-FR":  {Code: "GN-FR", Country: iso3166.GN, Name: "Fria", Category: "Prefecture", ParentCode: "GN-B"},
+	"GN-GA":  {Code: "GN-GA", Country: iso3166.GN, Name: "Gaoual", Category: "Prefecture", ParentCode: "GN-B"},
+	"GN-GU":  {Code: "GN-GU", Country: iso3166.GN, Name: "Guékédou", Category: "Prefecture", ParentCode: "GN-N"},
+	"GN-K":   {Code: "GN-K", Country: iso3166.GN, Name: "Kankan", Category: "Administrative region"},
+	"GN-KA":  {Code: "GN-KA", Country: iso3166.GN, Name: "Kankan", Category: "Prefecture", ParentCode: "GN-K"},
+	"GN-KB":  {Code: "GN-KB", Country: iso3166.GN, Name: "Koubia", Category: "Prefecture", ParentCode: "GN-L"},
+	"GN-KD":  {Code: "GN-KD", Country: iso3166.GN, Name: "Kindia", Category: "Prefecture", ParentCode: "GN-D"},
+	"GN-KE":  {Code: "GN-KE", Country: iso3166.GN, Name: "Kérouané", Category: "Prefecture", ParentCode: "GN-K"},
+	"GN-KN":  {Code: "GN-KN", Country: iso3166.GN, Name: "Koundara", Category: "Prefecture", ParentCode: "GN-B"},
+	"GN-KO":  {Code: "GN-KO", Country: iso3166.GN, Name: "Kouroussa", Category: "Prefecture", ParentCode: "GN-K"},
+	"GN-KS":  {Code: "GN-KS", Country: iso3166.GN, Name: "Kissidougou", Category: "Prefecture", ParentCode: "GN-F"},
+	"GN-L":   {Code: "GN-L", Country: iso3166.GN, Name: "Labé", Category: "Administrative region"},
+	"GN-LA":  {Code: "GN-LA", Country: iso3166.GN, Name: "Labé", Category: "Prefecture", ParentCode: "GN-L"},
+	"GN-LE":  {Code: "GN-LE", Country: iso3166.GN, Name: "Lélouma", Category: "Prefecture", ParentCode: "GN-L"},
+	"GN-LO":  {Code: "GN-LO", Country: iso3166.GN, Name: "Lola", Category: "Prefecture", ParentCode: "GN-N"},
+	"GN-M":   {Code: "GN-M", Country: iso3166.GN, Name: "Mamou", Category: "Administrative region"},
+	"GN-MC":  {Code: "GN-MC", Country: iso3166.GN, Name: "Macenta", Category: "Prefecture", ParentCode: "GN-N"},
+	"GN-MD":  {Code: "GN-MD", Country: iso3166.GN, Name: "Mandiana", Category: "Prefecture", ParentCode: "GN-K"},
+	"GN-ML":  {Code: "GN-ML", Country: iso3166.GN, Name: "Mali", Category: "Prefecture", ParentCode: "GN-L"},
+	"GN-MM":  {Code: "GN-MM", Country: iso3166.GN, Name: "Mamou", Category: "Prefecture", ParentCode: "GN-M"},
+	"GN-N":   {Code: "GN-N", Country: iso3166.GN, Name: "Nzérékoré", Category: "Administrative region"},
+	"GN-NZ":  {Code: "GN-NZ", Country: iso3166.GN, Name: "Nzérékoré", Category: "Prefecture", ParentCode: "GN-N"},
+	"GN-PI":  {Code: "GN-PI", Country: iso3166.GN, Name: "Pita", Category: "Prefecture", ParentCode: "GN-M"},
+	"GN-SI":  {Code: "GN-SI", Country: iso3166.GN, Name: "Siguiri", Category: "Prefecture", ParentCode: "GN-K"},
+	"GN-TE":  {Code: "GN-TE", Country: iso3166.GN, Name: "Télimélé", Category: "Prefecture", ParentCode: "GN-D"},
+	"GN-TO":  {Code: "GN-TO", Country: iso3166.GN, Name: "Tougué", Category: "Prefecture", ParentCode: "GN-L"},
+	"GN-YO":  {Code: "GN-YO", Country: iso3166.GN, Name: "Yomou", Category: "Prefecture", ParentCode: "GN-N"},
+	"GQ-AN":  {Code: "GQ-AN", Country: iso3166.GQ, Name: "Annobon", Category: "Province", ParentCode: "GQ-I"},
+	"GQ-BN":  {Code: "GQ-BN", Country: iso3166.GQ, Name: "Bioko Nord", Category: "Province", ParentCode: "GQ-I"},
+	"GQ-BS":  {Code: "GQ-BS", Country: iso3166.GQ, Name: "Bioko Sud", Category: "Province", ParentCode: "GQ-I"},
+	"GQ-C":   {Code: "GQ-C", Country: iso3166.GQ, Name: "Região Continental", Category: "Region"},
+	"GQ-CS":  {Code: "GQ-CS", Country: iso3166.GQ, Name: "Centro Sud", Category: "Province", ParentCode: "GQ-C"},
+	"GQ-DJ":  {Code: "GQ-DJ", Country: iso3166.GQ, Name: "Djibloho", Category: "Province", ParentCode: "GQ-C"},
+	"GQ-I":   {Code: "GQ-I", Country: iso3166.GQ, Name: "Região Insular", Category: "Region"},
+	"GQ-KN":  {Code: "GQ-KN", Country: iso3166.GQ, Name: "Kié-Ntem", Category: "Province", ParentCode: "GQ-C"},
+	"GQ-LI":  {Code: "GQ-LI", Country: iso3166.GQ, Name: "Litoral", Category: "Province", ParentCode: "GQ-C"},
+	"GQ-WN":  {Code: "GQ-WN", Country: iso3166.GQ, Name: "Wele-Nzas", Category: "Province", ParentCode: "GQ-C"},
+	"GR-69":  {Code: "GR-69", Country: iso3166.GR, Name: "Ágion Óros", Category: "Self-governed part"},
+	"GR-A":   {Code: "GR-A", Country: iso3166.GR, Name: "Anatolikí Makedonía kai Thráki", Category: "Administrative region"},
+	"GR-B":   {Code: "GR-B", Country: iso3166.GR, Name: "Kentrikí Makedonía", Category: "Administrative region"},
+	"GR-C":   {Code: "GR-C", Country: iso3166.GR, Name: "Dytikí Makedonía", Category: "Administrative region"},
+	"GR-D":   {Code: "GR-D", Country: iso3166.GR, Name: "Ípeiros", Category: "Administrative region"},
+	"GR-E":   {Code: "GR-E", Country: iso3166.GR, Name: "Thessalía", Category: "Administrative region"},
+	"GR-F":   {Code: "GR-F", Country: iso3166.GR, Name: "Ionía Nísia", Category: "Administrative region"},
+	"GR-G":   {Code: "GR-G", Country: iso3166.GR, Name: "Dytikí Elláda", Category: "Administrative region"},
+	"GR-H":   {Code: "GR-H", Country: iso3166.GR, Name: "Stereá Elláda", Category: "Administrative region"},
+	"GR-I":   {Code: "GR-I", Country: iso3166.GR, Name: "Attikí", Category: "Administrative region"},
+	"GR-J":   {Code: "GR-J", Country: iso3166.GR, Name: "Pelopónnisos", Category: "Administrative region"},
+	"GR-K":   {Code: "GR-K", Country: iso3166.GR, Name: "Vóreio Aigaío", Category: "Administrative region"},
+	"GR-L":   {Code: "GR-L", Country: iso3166.GR, Name: "Nótio Aigaío", Category: "Administrative region"},
+	"GR-M":   {Code: "GR-M", Country: iso3166.GR, Name: "Kríti", Category: "Administrative region"},
+	"GT-AV":  {Code: "GT-AV", Country: iso3166.GT, Name: "Alta Verapaz", Category: "Department"},
+	"GT-BV":  {Code: "GT-BV", Country: iso3166.GT, Name: "Baja Verapaz", Category: "Department"},
+	"GT-CM":  {Code: "GT-CM", Country: iso3166.GT, Name: "Chimaltenango", Category: "Department"},
+	"GT-CQ":  {Code: "GT-CQ", Country: iso3166.GT, Name: "Chiquimula", Category: "Department"},
+	"GT-ES":  {Code: "GT-ES", Country: iso3166.GT, Name: "Escuintla", Category: "Department"},
+	"GT-GU":  {Code: "GT-GU", Country: iso3166.GT, Name: "Guatemala", Category: "Department"},
+	"GT-HU":  {Code: "GT-HU", Country: iso3166.GT, Name: "Huehuetenango", Category: "Department"},
+	"GT-IZ":  {Code: "GT-IZ", Country: iso3166.GT, Name: "Izabal", Category: "Department"},
+	"GT-JA":  {Code: "GT-JA", Country: iso3166.GT, Name: "Jalapa", Category: "Department"},
+	"GT-JU":  {Code: "GT-JU", Country: iso3166.GT, Name: "Jutiapa", Category: "Department"},
+	"GT-PE":  {Code: "GT-PE", Country: iso3166.GT, Name: "Petén", Category: "Department"},
+	"GT-PR":  {Code: "GT-PR", Country: iso3166.GT, Name: "El Progreso", Category: "Department"},
+	"GT-QC":  {Code: "GT-QC", Country: iso3166.GT, Name: "Quiché", Category: "Department"},
+	"GT-QZ":  {Code: "GT-QZ", Country: iso3166.GT, Name: "Quetzaltenango", Category: "Department"},
+	"GT-RE":  {Code: "GT-RE", Country: iso3166.GT, Name: "Retalhuleu", Category: "Department"},
+	"GT-SA":  {Code: "GT-SA", Country: iso3166.GT, Name: "Sacatepéquez", Category: "Department"},
+	"GT-SM":  {Code: "GT-SM", Country: iso3166.GT, Name: "San Marcos", Category: "Department"},
+	"GT-SO":  {Code: "GT-SO", Country: iso3166.GT, Name: "Sololá", Category: "Department"},
+	"GT-SR":  {Code: "GT-SR", Country: iso3166.GT, Name: "Santa Rosa", Category: "Department"},
+	"GT-SU":  {Code: "GT-SU", Country: iso3166.GT, Name: "Suchitepéquez", Category: "Department"},
+	"GT-TO":  {Code: "GT-TO", Country: iso3166.GT, Name: "Totonicapán", Category: "Department"},
+	"GT-ZA":  {Code: "GT-ZA", Country: iso3166.GT, Name: "Zacapa", Category: "Department"},
+	"GW-BA":  {Code: "GW-BA", Country: iso3166.GW, Name: "Bafatá", Category: "Region", ParentCode: "GW-L"},
+	"GW-BL":  {Code: "GW-BL", Country: iso3166.GW, Name: "Bolama / Bijagós", Category: "Region", ParentCode: "GW-S"},
+	"GW-BM":  {Code: "GW-BM", Country: iso3166.GW, Name: "Biombo", Category: "Region", ParentCode: "GW-N"},
+	"GW-BS":  {Code: "GW-BS", Country: iso3166.GW, Name: "Bissau", Category: "Autonomous sector"},
+	"GW-CA":  {Code: "GW-CA", Country: iso3166.GW, Name: "Cacheu", Category: "Region", ParentCode: "GW-N"},
+	"GW-GA":  {Code: "GW-GA", Country: iso3166.GW, Name: "Gabú", Category: "Region", ParentCode: "GW-L"},
+	"GW-L":   {Code: "GW-L", Country: iso3166.GW, Name: "Leste", Category: "Province"},
+	"GW-N":   {Code: "GW-N", Country: iso3166.GW, Name: "Norte", Category: "Province"},
+	"GW-OI":  {Code: "GW-OI", Country: iso3166.GW, Name: "Oio", Category: "Region", ParentCode: "GW-N"},
+	"GW-QU":  {Code: "GW-QU", Country: iso3166.GW, Name: "Quinara", Category: "Region", ParentCode: "GW-S"},
+	"GW-S":   {Code: "GW-S", Country: iso3166.GW, Name: "Sul", Category: "Province"},
+	"GW-TO":  {Code: "GW-TO", Country: iso3166.GW, Name: "Tombali", Category: "Region", ParentCode: "GW-S"},
+	"GY-BA":  {Code: "GY-BA", Country: iso3166.GY, Name: "Barima-Waini", Category: "Region"},
+	"GY-CU":  {Code: "GY-CU", Country: iso3166.GY, Name: "Cuyuni-Mazaruni", Category: "Region"},
+	"GY-DE":  {Code: "GY-DE", Country: iso3166.GY, Name: "Demerara-Mahaica", Category: "Region"},
+	"GY-EB":  {Code: "GY-EB", Country: iso3166.GY, Name: "East Berbice-Corentyne", Category: "Region"},
+	"GY-ES":  {Code: "GY-ES", Country: iso3166.GY, Name: "Essequibo Islands-West Demerara", Category: "Region"},
+	"GY-MA":  {Code: "GY-MA", Country: iso3166.GY, Name: "Mahaica-Berbice", Category: "Region"},
+	"GY-PM":  {Code: "GY-PM", Country: iso3166.GY, Name: "Pomeroon-Supenaam", Category: "Region"},
+	"GY-PT":  {Code: "GY-PT", Country: iso3166.GY, Name: "Potaro-Siparuni", Category: "Region"},
+	"GY-UD":  {Code: "GY-UD", Country: iso3166.GY, Name: "Upper Demerara-Berbice", Category: "Region"},
+	"GY-UT":  {Code: "GY-UT", Country: iso3166.GY, Name: "Upper Takutu-Upper Essequibo", Category: "Region"},
+	"HN-AT":  {Code: "HN-AT", Country: iso3166.HN, Name: "Atlántida", Category: "Department"},
+	"HN-CH":  {Code: "HN-CH", Country: iso3166.HN, Name: "Choluteca", Category: "Department"},
+	"HN-CL":  {Code: "HN-CL", Country: iso3166.HN, Name: "Colón", Category: "Department"},
+	"HN-CM":  {Code: "HN-CM", Country: iso3166.HN, Name: "Comayagua", Category: "Department"},
+	"HN-CP":  {Code: "HN-CP", Country: iso3166.HN, Name: "Copán", Category: "Department"},
+	"HN-CR":  {Code: "HN-CR", Country: iso3166.HN, Name: "Cortés", Category: "Department"},
+	"HN-EP":  {Code: "HN-EP", Country: iso3166.HN, Name: "El Paraíso", Category: "Department"},
+	"HN-FM":  {Code: "HN-FM", Country: iso3166.HN, Name: "Francisco Morazán", Category: "Department"},
+	"HN-GD":  {Code: "HN-GD", Country: iso3166.HN, Name: "Gracias a Dios", Category: "Department"},
+	"HN-IB":  {Code: "HN-IB", Country: iso3166.HN, Name: "Islas de la Bahía", Category: "Department"},
+	"HN-IN":  {Code: "HN-IN", Country: iso3166.HN, Name: "Intibucá", Category: "Department"},
+	"HN-LE":  {Code: "HN-LE", Country: iso3166.HN, Name: "Lempira", Category: "Department"},
+	"HN-LP":  {Code: "HN-LP", Country: iso3166.HN, Name: "La Paz", Category: "Department"},
+	"HN-OC":  {Code: "HN-OC", Country: iso3166.HN, Name: "Ocotepeque", Category: "Department"},
+	"HN-OL":  {Code: "HN-OL", Country: iso3166.HN, Name: "Olancho", Category: "Department"},
+	"HN-SB":  {Code: "HN-SB", Country: iso3166.HN, Name: "Santa Bárbara", Category: "Department"},
+	"HN-VA":  {Code: "HN-VA", Country: iso3166.HN, Name: "Valle", Category: "Department"},
+	"HN-YO":  {Code: "HN-YO", Country: iso3166.HN, Name: "Yoro", Category: "Department"},
+	"HR-01":  {Code: "HR-01", Country: iso3166.HR, Name: "Zagrebačka županija", Category: "County"},
+	"HR-02":  {Code: "HR-02", Country: iso3166.HR, Name: "Krapinsko-zagorska županija", Category: "County"},
+	"HR-03":  {Code: "HR-03", Country: iso3166.HR, Name: "Sisačko-moslavačka županija", Category: "County"},
+	"HR-04":  {Code: "HR-04", Country: iso3166.HR, Name: "Karlovačka županija", Category: "County"},
+	"HR-05":  {Code: "HR-05", Country: iso3166.HR, Name: "Varaždinska županija", Category: "County"},
+	"HR-06":  {Code: "HR-06", Country: iso3166.HR, Name: "Koprivničko-križevačka županija", Category: "County"},
+	"HR-07":  {Code: "HR-07", Country: iso3166.HR, Name: "Bjelovarsko-bilogorska županija", Category: "County"},
+	"HR-08":  {Code: "HR-08", Country: iso3166.HR, Name: "Primorsko-goranska županija", Category: "County"},
+	"HR-09":  {Code: "HR-09", Country: iso3166.HR, Name: "Ličko-senjska županija", Category: "County"},
+	"HR-10":  {Code: "HR-10", Country: iso3166.HR, Name: "Virovitičko-podravska županija", Category: "County"},
+	"HR-11":  {Code: "HR-11", Country: iso3166.HR, Name: "Požeško-slavonska županija", Category: "County"},
+	"HR-12":  {Code: "HR-12", Country: iso3166.HR, Name: "Brodsko-posavska županija", Category: "County"},
+	"HR-13":  {Code: "HR-13", Country: iso3166.HR, Name: "Zadarska županija", Category: "County"},
+	"HR-14":  {Code: "HR-14", Country: iso3166.HR, Name: "Osječko-baranjska županija", Category: "County"},
+	"HR-15":  {Code: "HR-15", Country: iso3166.HR, Name: "Šibensko-kninska županija", Category: "County"},
+	"HR-16":  {Code: "HR-16", Country: iso3166.HR, Name: "Vukovarsko-srijemska županija", Category: "County"},
+	"HR-17":  {Code: "HR-17", Country: iso3166.HR, Name: "Splitsko-dalmatinska županija", Category: "County"},
+	"HR-18":  {Code: "HR-18", Country: iso3166.HR, Name: "Istarska županija", Category: "County"},
+	"HR-19":  {Code: "HR-19", Country: iso3166.HR, Name: "Dubrovačko-neretvanska županija", Category: "County"},
+	"HR-20":  {Code: "HR-20", Country: iso3166.HR, Name: "Međimurska županija", Category: "County"},
+	"HR-21":  {Code: "HR-21", Country: iso3166.HR, Name: "Grad Zagreb", Category: "City"},
+	"HT-AR":  {Code: "HT-AR", Country: iso3166.HT, Name: "Artibonite", Category: "Department"},
+	"HT-CE":  {Code: "HT-CE", Country: iso3166.HT, Name: "Centre", Category: "Department"},
+	"HT-GA":  {Code: "HT-GA", Country: iso3166.HT, Name: "Grandans", Category: "Department"},
+	"HT-ND":  {Code: "HT-ND", Country: iso3166.HT, Name: "Nord", Category: "Department"},
+	"HT-NE":  {Code: "HT-NE", Country: iso3166.HT, Name: "Nord-Est", Category: "Department"},
+	"HT-NI":  {Code: "HT-NI", Country: iso3166.HT, Name: "Nip", Category: "Department"},
+	"HT-NO":  {Code: "HT-NO", Country: iso3166.HT, Name: "Nord-Ouest", Category: "Department"},
+	"HT-OU":  {Code: "HT-OU", Country: iso3166.HT, Name: "Lwès", Category: "Department"},
+	"HT-SD":  {Code: "HT-SD", Country: iso3166.HT, Name: "Sid", Category: "Department"},
+	"HT-SE":  {Code: "HT-SE", Country: iso3166.HT, Name: "Sidès", Category: "Department"},
+	"HU-BA":  {Code: "HU-BA", Country: iso3166.HU, Name: "Baranya", Category: "County"},
+	"HU-BC":  {Code: "HU-BC", Country: iso3166.HU, Name: "Békéscsaba", Category: "City with county rights"},
+	"HU-BE":  {Code: "HU-BE", Country: iso3166.HU, Name: "Békés", Category: "County"},
+	"HU-BK":  {Code: "HU-BK", Country: iso3166.HU, Name: "Bács-Kiskun", Category: "County"},
+	"HU-BU":  {Code: "HU-BU", Country: iso3166.HU, Name: "Budapest", Category: "Capital city"},
+	"HU-BZ":  {Code: "HU-BZ", Country: iso3166.HU, Name: "Borsod-Abaúj-Zemplén", Category: "County"},
+	"HU-CS":  {Code: "HU-CS", Country: iso3166.HU, Name: "Csongrád", Category: "County"},
+	"HU-DE":  {Code: "HU-DE", Country: iso3166.HU, Name: "Debrecen", Category: "City with county rights"},
+	"HU-DU":  {Code: "HU-DU", Country: iso3166.HU, Name: "Dunaújváros", Category: "City with county rights"},
+	"HU-EG":  {Code: "HU-EG", Country: iso3166.HU, Name: "Eger", Category: "City with county rights"},
+	"HU-ER":  {Code: "HU-ER", Country: iso3166.HU, Name: "Érd", Category: "City with county rights"},
+	"HU-FE":  {Code: "HU-FE", Country: iso3166.HU, Name: "Fejér", Category: "County"},
+	"HU-GS":  {Code: "HU-GS", Country: iso3166.HU, Name: "Győr-Moson-Sopron", Category: "County"},
+	"HU-GY":  {Code: "HU-GY", Country: iso3166.HU, Name: "Győr", Category: "City with county rights"},
+	"HU-HB":  {Code: "HU-HB", Country: iso3166.HU, Name: "Hajdú-Bihar", Category: "County"},
+	"HU-HE":  {Code: "HU-HE", Country: iso3166.HU, Name: "Heves", Category: "County"},
+	"HU-HV":  {Code: "HU-HV", Country: iso3166.HU, Name: "Hódmezővásárhely", Category: "City with county rights"},
+	"HU-JN":  {Code: "HU-JN", Country: iso3166.HU, Name: "Jász-Nagykun-Szolnok", Category: "County"},
+	"HU-KE":  {Code: "HU-KE", Country: iso3166.HU, Name: "Komárom-Esztergom", Category: "County"},
+	"HU-KM":  {Code: "HU-KM", Country: iso3166.HU, Name: "Kecskemét", Category: "City with county rights"},
+	"HU-KV":  {Code: "HU-KV", Country: iso3166.HU, Name: "Kaposvár", Category: "City with county rights"},
+	"HU-MI":  {Code: "HU-MI", Country: iso3166.HU, Name: "Miskolc", Category: "City with county rights"},
+	"HU-NK":  {Code: "HU-NK", Country: iso3166.HU, Name: "Nagykanizsa", Category: "City with county rights"},
+	"HU-NO":  {Code: "HU-NO", Country: iso3166.HU, Name: "Nógrád", Category: "County"},
+	"HU-NY":  {Code: "HU-NY", Country: iso3166.HU, Name: "Nyíregyháza", Category: "City with county rights"},
+	"HU-PE":  {Code: "HU-PE", Country: iso3166.HU, Name: "Pest", Category: "County"},
+	"HU-PS":  {Code: "HU-PS", Country: iso3166.HU, Name: "Pécs", Category: "City with county rights"},
+	"HU-SD":  {Code: "HU-SD", Country: iso3166.HU, Name: "Szeged", Category: "City with county rights"},
+	"HU-SF":  {Code: "HU-SF", Country: iso3166.HU, Name: "Székesfehérvár", Category: "City with county rights"},
+	"HU-SH":  {Code: "HU-SH", Country: iso3166.HU, Name: "Szombathely", Category: "City with county rights"},
+	"HU-SK":  {Code: "HU-SK", Country: iso3166.HU, Name: "Szolnok", Category: "City with county rights"},
+	"HU-SN":  {Code: "HU-SN", Country: iso3166.HU, Name: "Sopron", Category: "City with county rights"},
+	"HU-SO":  {Code: "HU-SO", Country: iso3166.HU, Name: "Somogy", Category: "County"},
+	"HU-SS":  {Code: "HU-SS", Country: iso3166.HU, Name: "Szekszárd", Category: "City with county rights"},
+	"HU-ST":  {Code: "HU-ST", Country: iso3166.HU, Name: "Salgótarján", Category: "City with county rights"},
+	"HU-SZ":  {Code: "HU-SZ", Country: iso3166.HU, Name: "Szabolcs-Szatmár-Bereg", Category: "County"},
+	"HU-TB":  {Code: "HU-TB", Country: iso3166.HU, Name: "Tatabánya", Category: "City with county rights"},
+	"HU-TO":  {Code: "HU-TO", Country: iso3166.HU, Name: "Tolna", Category: "County"},
+	"HU-VA":  {Code: "HU-VA", Country: iso3166.HU, Name: "Vas", Category: "County"},
+	"HU-VE":  {Code: "HU-VE", Country: iso3166.HU, Name: "Veszprém", Category: "County"},
+	"HU-VM":  {Code: "HU-VM", Country: iso3166.HU, Name: "Veszprém", Category: "City with county rights"},
+	"HU-ZA":  {Code: "HU-ZA", Country: iso3166.HU, Name: "Zala", Category: "County"},
+	"HU-ZE":  {Code: "HU-ZE", Country: iso3166.HU, Name: "Zalaegerszeg", Category: "City with county rights"},
+	"ID-AC":  {Code: "ID-AC", Country: iso3166.ID, Name: "Aceh", Category: "Province", ParentCode: "ID-SM"},
+	"ID-BA":  {Code: "ID-BA", Country: iso3166.ID, Name: "Bali", Category: "Province", ParentCode: "ID-NU"},
+	"ID-BB":  {Code: "ID-BB", Country: iso3166.ID, Name: "Kepulauan Bangka Belitung", Category: "Province", ParentCode: "ID-SM"},
+	"ID-BE":  {Code: "ID-BE", Country: iso3166.ID, Name: "Bengkulu", Category: "Province", ParentCode: "ID-SM"},
+	"ID-BT":  {Code: "ID-BT", Country: iso3166.ID, Name: "Banten", Category: "Province", ParentCode: "ID-JW"},
+	"ID-GO":  {Code: "ID-GO", Country: iso3166.ID, Name: "Gorontalo", Category: "Province", ParentCode: "ID-SL"},
+	"ID-JA":  {Code: "ID-JA", Country: iso3166.ID, Name: "Jambi", Category: "Province", ParentCode: "ID-SM"},
+	"ID-JB":  {Code: "ID-JB", Country: iso3166.ID, Name: "Jawa Barat", Category: "Province", ParentCode: "ID-JW"},
+	"ID-JI":  {Code: "ID-JI", Country: iso3166.ID, Name: "Jawa Timur", Category: "Province", ParentCode: "ID-JW"},
+	"ID-JK":  {Code: "ID-JK", Country: iso3166.ID, Name: "Jakarta Raya", Category: "Capital district", ParentCode: "ID-JW"},
+	"ID-JT":  {Code: "ID-JT", Country: iso3166.ID, Name: "Jawa Tengah", Category: "Province", ParentCode: "ID-JW"},
+	"ID-JW":  {Code: "ID-JW", Country: iso3166.ID, Name: "Jawa", Category: "Geographical unit"},
+	"ID-KA":  {Code: "ID-KA", Country: iso3166.ID, Name: "Kalimantan", Category: "Geographical unit"},
+	"ID-KB":  {Code: "ID-KB", Country: iso3166.ID, Name: "Kalimantan Barat", Category: "Province", ParentCode: "ID-KA"},
+	"ID-KI":  {Code: "ID-KI", Country: iso3166.ID, Name: "Kalimantan Timur", Category: "Province", ParentCode: "ID-KA"},
+	"ID-KR":  {Code: "ID-KR", Country: iso3166.ID, Name: "Kepulauan Riau", Category: "Province", ParentCode: "ID-SM"},
+	"ID-KS":  {Code: "ID-KS", Country: iso3166.ID, Name: "Kalimantan Selatan", Category: "Province", ParentCode: "ID-KA"},
+	"ID-KT":  {Code: "ID-KT", Country: iso3166.ID, Name: "Kalimantan Tengah", Category: "Province", ParentCode: "ID-KA"},
+	"ID-KU":  {Code: "ID-KU", Country: iso3166.ID, Name: "Kalimantan Utara", Category: "Province", ParentCode: "ID-KA"},
+	"ID-LA":  {Code: "ID-LA", Country: iso3166.ID, Name: "Lampung", Category: "Province", ParentCode: "ID-SM"},
+	"ID-MA":  {Code: "ID-MA", Country: iso3166.ID, Name: "Maluku", Category: "Province", ParentCode: "ID-ML"},
+	"ID-ML":  {Code: "ID-ML", Country: iso3166.ID, Name: "Maluku", Category: "Geographical unit"},
+	"ID-MU":  {Code: "ID-MU", Country: iso3166.ID, Name: "Maluku Utara", Category: "Province", ParentCode: "ID-ML"},
+	"ID-NB":  {Code: "ID-NB", Country: iso3166.ID, Name: "Nusa Tenggara Barat", Category: "Province", ParentCode: "ID-NU"},
+	"ID-NT":  {Code: "ID-NT", Country: iso3166.ID, Name: "Nusa Tenggara Timur", Category: "Province", ParentCode: "ID-NU"},
+	"ID-NU":  {Code: "ID-NU", Country: iso3166.ID, Name: "Nusa Tenggara", Category: "Geographical unit"},
+	"ID-PA":  {Code: "ID-PA", Country: iso3166.ID, Name: "Papua", Category: "Province", ParentCode: "ID-PP"},
+	"ID-PB":  {Code: "ID-PB", Country: iso3166.ID, Name: "Papua Barat", Category: "Province", ParentCode: "ID-PP"},
+	"ID-PP":  {Code: "ID-PP", Country: iso3166.ID, Name: "Papua", Category: "Geographical unit"},
+	"ID-RI":  {Code: "ID-RI", Country: iso3166.ID, Name: "Riau", Category: "Province", ParentCode: "ID-SM"},
+	"ID-SA":  {Code: "ID-SA", Country: iso3166.ID, Name: "Sulawesi Utara", Category: "Province", ParentCode: "ID-SL"},
+	"ID-SB":  {Code: "ID-SB", Country: iso3166.ID, Name: "Sumatera Barat", Category: "Province", ParentCode: "ID-SM"},
+	"ID-SG":  {Code: "ID-SG", Country: iso3166.ID, Name: "Sulawesi Tenggara", Category: "Province", ParentCode: "ID-SL"},
+	"ID-SL":  {Code: "ID-SL", Country: iso3166.ID, Name: "Sulawesi", Category: "Geographical unit"},
+	"ID-SM":  {Code: "ID-SM", Country: iso3166.ID, Name: "Sumatera", Category: "Geographical unit"},
+	"ID-SN":  {Code: "ID-SN", Country: iso3166.ID, Name: "Sulawesi Selatan", Category: "Province", ParentCode: "ID-SL"},
+	"ID-SR":  {Code: "ID-SR", Country: iso3166.ID, Name: "Sulawesi Barat", Category: "Province", ParentCode: "ID-SL"},
+	"ID-SS":  {Code: "ID-SS", Country: iso3166.ID, Name: "Sumatera Selatan", Category: "Province", ParentCode: "ID-SM"},
+	"ID-ST":  {Code: "ID-ST", Country: iso3166.ID, Name: "Sulawesi Tengah", Category: "Province", ParentCode: "ID-SL"},
+	"ID-SU":  {Code: "ID-SU", Country: iso3166.ID, Name: "Sumatera Utara", Category: "Province", ParentCode: "ID-SM"},
+	"ID-YO":  {Code: "ID-YO", Country: iso3166.ID, Name: "Yogyakarta", Category: "Special region", ParentCode: "ID-JW"},
+	"IE-C":   {Code: "IE-C", Country: iso3166.IE, Name: "Connaught", Category: "Province"},
+	"IE-CE":  {Code: "IE-CE", Country: iso3166.IE, Name: "Clare", Category: "County", ParentCode: "IE-M"},
+	"IE-CN":  {Code: "IE-CN", Country: iso3166.IE, Name: "Cavan", Category: "County", ParentCode: "IE-U"},
+	"IE-CO":  {Code: "IE-CO", Country: iso3166.IE, Name: "Cork", Category: "County", ParentCode: "IE-M"},
+	"IE-CW":  {Code: "IE-CW", Country: iso3166.IE, Name: "Carlow", Category: "County", ParentCode: "IE-L"},
+	"IE-D":   {Code: "IE-D", Country: iso3166.IE, Name: "Dublin", Category: "County", ParentCode: "IE-L"},
+	"IE-DL":  {Code: "IE-DL", Country: iso3166.IE, Name: "Donegal", Category: "County", ParentCode: "IE-U"},
+	"IE-G":   {Code: "IE-G", Country: iso3166.IE, Name: "Galway", Category: "County", ParentCode: "IE-C"},
+	"IE-KE":  {Code: "IE-KE", Country: iso3166.IE, Name: "Kildare", Category: "County", ParentCode: "IE-L"},
+	"IE-KK":  {Code: "IE-KK", Country: iso3166.IE, Name: "Kilkenny", Category: "County", ParentCode: "IE-L"},
+	"IE-KY":  {Code: "IE-KY", Country: iso3166.IE, Name: "Kerry", Category: "County", ParentCode: "IE-M"},
+	"IE-L":   {Code: "IE-L", Country: iso3166.IE, Name: "Leinster", Category: "Province"},
+	"IE-LD":  {Code: "IE-LD", Country: iso3166.IE, Name: "Longford", Category: "County", ParentCode: "IE-L"},
+	"IE-LH":  {Code: "IE-LH", Country: iso3166.IE, Name: "Louth", Category: "County", ParentCode: "IE-L"},
+	"IE-LK":  {Code: "IE-LK", Country: iso3166.IE, Name: "Limerick", Category: "County", ParentCode: "IE-M"},
+	"IE-LM":  {Code: "IE-LM", Country: iso3166.IE, Name: "Leitrim", Category: "County", ParentCode: "IE-C"},
+	"IE-LS":  {Code: "IE-LS", Country: iso3166.IE, Name: "Laois", Category: "County", ParentCode: "IE-L"},
+	"IE-M":   {Code: "IE-M", Country: iso3166.IE, Name: "Munster", Category: "Province"},
+	"IE-MH":  {Code: "IE-MH", Country: iso3166.IE, Name: "Meath", Category: "County", ParentCode: "IE-L"},
+	"IE-MN":  {Code: "IE-MN", Country: iso3166.IE, Name: "Monaghan", Category: "County", ParentCode: "IE-U"},
+	"IE-MO":  {Code: "IE-MO", Country: iso3166.IE, Name: "Mayo", Category: "County", ParentCode: "IE-C"},
+	"IE-OY":  {Code: "IE-OY", Country: iso3166.IE, Name: "Offaly", Category: "County", ParentCode: "IE-L"},
+	"IE-RN":  {Code: "IE-RN", Country: iso3166.IE, Name: "Roscommon", Category: "County", ParentCode: "IE-C"},
+	"IE-SO":  {Code: "IE-SO", Country: iso3166.IE, Name: "Sligo", Category: "County", ParentCode: "IE-C"},
+	"IE-TA":  {Code: "IE-TA", Country: iso3166.IE, Name: "Tipperary", Category: "County", ParentCode: "IE-M"},
+	"IE-U":   {Code: "IE-U", Country: iso3166.IE, Name: "Ulster", Category: "Province"},
+	"IE-WD":  {Code: "IE-WD", Country: iso3166.IE, Name: "Waterford", Category: "County", ParentCode: "IE-M"},
+	"IE-WH":  {Code: "IE-WH", Country: iso3166.IE, Name: "Westmeath", Category: "County", ParentCode: "IE-L"},
+	"IE-WW":  {Code: "IE-WW", Country: iso3166.IE, Name: "Wicklow", Category: "County", ParentCode: "IE-L"},
+	"IE-WX":  {Code: "IE-WX", Country: iso3166.IE, Name: "Wexford", Category: "County", ParentCode: "IE-L"},
+	"IL-D":   {Code: "IL-D", Country: iso3166.IL, Name: "Al Janūbī", Category: "District"},
+	"IL-HA":  {Code: "IL-HA", Country: iso3166.IL, Name: "H̱efa", Category: "District"},
+	"IL-JM":  {Code: "IL-JM", Country: iso3166.IL, Name: "Al Quds", Category: "District"},
+	"IL-M":   {Code: "IL-M", Country: iso3166.IL, Name: "Al Awsaţ", Category: "District"},
+	"IL-TA":  {Code: "IL-TA", Country: iso3166.IL, Name: "Tall Abīb", Category: "District"},
+	"IL-Z":   {Code: "IL-Z", Country: iso3166.IL, Name: "Ash Shamālī", Category: "District"},
+	"IN-AN":  {Code: "IN-AN", Country: iso3166.IN, Name: "Andaman and Nicobar Islands", Category: "Union territory"},
+	"IN-AP":  {Code: "IN-AP", Country: iso3166.IN, Name: "Andhra Pradesh", Category: "State"},
+	"IN-AR":  {Code: "IN-AR", Country: iso3166.IN, Name: "Arunāchal Pradesh", Category: "State"},
+	"IN-AS":  {Code: "IN-AS", Country: iso3166.IN, Name: "Assam", Category: "State"},
+	"IN-BR":  {Code: "IN-BR", Country: iso3166.IN, Name: "Bihār", Category: "State"},
+	"IN-CH":  {Code: "IN-CH", Country: iso3166.IN, Name: "Chandīgarh", Category: "Union territory"},
+	"IN-CT":  {Code: "IN-CT", Country: iso3166.IN, Name: "Chhattīsgarh", Category: "State"},
+	"IN-DH":  {Code: "IN-DH", Country: iso3166.IN, Name: "Dādra and Nagar Haveli and Damān and Diu", Category: "Union territory"},
+	"IN-DL":  {Code: "IN-DL", Country: iso3166.IN, Name: "Delhi", Category: "Union territory"},
+	"IN-GA":  {Code: "IN-GA", Country: iso3166.IN, Name: "Goa", Category: "State"},
+	"IN-GJ":  {Code: "IN-GJ", Country: iso3166.IN, Name: "Gujarāt", Category: "State"},
+	"IN-HP":  {Code: "IN-HP", Country: iso3166.IN, Name: "Himāchal Pradesh", Category: "State"},
+	"IN-HR":  {Code: "IN-HR", Country: iso3166.IN, Name: "Haryāna", Category: "State"},
+	"IN-JH":  {Code: "IN-JH", Country: iso3166.IN, Name: "Jhārkhand", Category: "State"},
+	"IN-JK":  {Code: "IN-JK", Country: iso3166.IN, Name: "Jammu and Kashmīr", Category: "Union territory"},
+	"IN-KA":  {Code: "IN-KA", Country: iso3166.IN, Name: "Karnātaka", Category: "State"},
+	"IN-KL":  {Code: "IN-KL", Country: iso3166.IN, Name: "Kerala", Category: "State"},
+	"IN-LA":  {Code: "IN-LA", Country: iso3166.IN, Name: "Ladākh", Category: "Union territory"},
+	"IN-LD":  {Code: "IN-LD", Country: iso3166.IN, Name: "Lakshadweep", Category: "Union territory"},
+	"IN-MH":  {Code: "IN-MH", Country: iso3166.IN, Name: "Mahārāshtra", Category: "State"},
+	"IN-ML":  {Code: "IN-ML", Country: iso3166.IN, Name: "Meghālaya", Category: "State"},
+	"IN-MN":  {Code: "IN-MN", Country: iso3166.IN, Name: "Manipur", Category: "State"},
+	"IN-MP":  {Code: "IN-MP", Country: iso3166.IN, Name: "Madhya Pradesh", Category: "State"},
+	"IN-MZ":  {Code: "IN-MZ", Country: iso3166.IN, Name: "Mizoram", Category: "State"},
+	"IN-NL":  {Code: "IN-NL", Country: iso3166.IN, Name: "Nāgāland", Category: "State"},
+	"IN-OR":  {Code: "IN-OR", Country: iso3166.IN, Name: "Odisha", Category: "State"},
+	"IN-PB":  {Code: "IN-PB", Country: iso3166.IN, Name: "Punjab", Category: "State"},
+	"IN-PY":  {Code: "IN-PY", Country: iso3166.IN, Name: "Puducherry", Category: "Union territory"},
+	"IN-RJ":  {Code: "IN-RJ", Country: iso3166.IN, Name: "Rājasthān", Category: "State"},
+	"IN-SK":  {Code: "IN-SK", Country: iso3166.IN, Name: "Sikkim", Category: "State"},
+	"IN-TG":  {Code: "IN-TG", Country: iso3166.IN, Name: "Telangāna", Category: "State"},
+	"IN-TN":  {Code: "IN-TN", Country: iso3166.IN, Name: "Tamil Nādu", Category: "State"},
+	"IN-TR":  {Code: "IN-TR", Country: iso3166.IN, Name: "Tripura", Category: "State"},
+	"IN-UP":  {Code: "IN-UP", Country: iso3166.IN, Name: "Uttar Pradesh", Category: "State"},
+	"IN-UT":  {Code: "IN-UT", Country: iso3166.IN, Name: "Uttarākhand", Category: "State"},
+	"IN-WB":  {Code: "IN-WB", Country: iso3166.IN, Name: "West Bengal", Category: "State"},
+	"IQ-AN":  {Code: "IQ-AN", Country: iso3166.IQ, Name: "Al Anbār", Category: "Governorate"},
+	"IQ-AR":  {Code: "IQ-AR", Country: iso3166.IQ, Name: "Arbīl", Category: "Governorate"},
+	"IQ-BA":  {Code: "IQ-BA", Country: iso3166.IQ, Name: "Al Başrah", Category: "Governorate"},
+	"IQ-BB":  {Code: "IQ-BB", Country: iso3166.IQ, Name: "Bābil", Category: "Governorate"},
+	"IQ-BG":  {Code: "IQ-BG", Country: iso3166.IQ, Name: "Baghdād", Category: "Governorate"},
+	"IQ-DA":  {Code: "IQ-DA", Country: iso3166.IQ, Name: "Dahūk", Category: "Governorate"},
+	"IQ-DI":  {Code: "IQ-DI", Country: iso3166.IQ, Name: "Diyālá", Category: "Governorate"},
+	"IQ-DQ":  {Code: "IQ-DQ", Country: iso3166.IQ, Name: "Dhī Qār", Category: "Governorate"},
+	"IQ-KA":  {Code: "IQ-KA", Country: iso3166.IQ, Name: "Karbalā’", Category: "Governorate"},
+	"IQ-KI":  {Code: "IQ-KI", Country: iso3166.IQ, Name: "Kirkūk", Category: "Governorate"},
+	"IQ-MA":  {Code: "IQ-MA", Country: iso3166.IQ, Name: "Maysān", Category: "Governorate"},
+	"IQ-MU":  {Code: "IQ-MU", Country: iso3166.IQ, Name: "Al Muthanná", Category: "Governorate"},
+	"IQ-NA":  {Code: "IQ-NA", Country: iso3166.IQ, Name: "An Najaf", Category: "Governorate"},
+	"IQ-NI":  {Code: "IQ-NI", Country: iso3166.IQ, Name: "Nīnawá", Category: "Governorate"},
+	"IQ-QA":  {Code: "IQ-QA", Country: iso3166.IQ, Name: "Al Qādisīyah", Category: "Governorate"},
+	"IQ-SD":  {Code: "IQ-SD", Country: iso3166.IQ, Name: "Şalāḩ ad Dīn", Category: "Governorate"},
+	"IQ-SU":  {Code: "IQ-SU", Country: iso3166.IQ, Name: "As Sulaymānīyah", Category: "Governorate"},
+	"IQ-WA":  {Code: "IQ-WA", Country: iso3166.IQ, Name: "Wāsiţ", Category: "Governorate"},
+	"IR-00":  {Code: "IR-00", Country: iso3166.IR, Name: "Markazī", Category: "Province"},
+	"IR-01":  {Code: "IR-01", Country: iso3166.IR, Name: "Gīlān", Category: "Province"},
+	"IR-02":  {Code: "IR-02", Country: iso3166.IR, Name: "Māzandarān", Category: "Province"},
+	"IR-03":  {Code: "IR-03", Country: iso3166.IR, Name: "Āz̄ārbāyjān-e Shārqī", Category: "Province"},
+	"IR-04":  {Code: "IR-04", Country: iso3166.IR, Name: "Āz̄ārbāyjān-e Ghārbī", Category: "Province"},
+	"IR-05":  {Code: "IR-05", Country: iso3166.IR, Name: "Kermānshāh", Category: "Province"},
+	"IR-06":  {Code: "IR-06", Country: iso3166.IR, Name: "Khūzestān", Category: "Province"},
+	"IR-07":  {Code: "IR-07", Country: iso3166.IR, Name: "Fārs", Category: "Province"},
+	"IR-08":  {Code: "IR-08", Country: iso3166.IR, Name: "Kermān", Category: "Province"},
+	"IR-09":  {Code: "IR-09", Country: iso3166.IR, Name: "Khorāsān-e Raẕavī", Category: "Province"},
+	"IR-10":  {Code: "IR-10", Country: iso3166.IR, Name: "Eşfahān", Category: "Province"},
+	"IR-11":  {Code: "IR-11", Country: iso3166.IR, Name: "Sīstān va Balūchestān", Category: "Province"},
+	"IR-12":  {Code: "IR-12", Country: iso3166.IR, Name: "Kordestān", Category: "Province"},
+	"IR-13":  {Code: "IR-13", Country: iso3166.IR, Name: "Hamadān", Category: "Province"},
+	"IR-14":  {Code: "IR-14", Country: iso3166.IR, Name: "Chahār Maḩāl va Bakhtīārī", Category: "Province"},
+	"IR-15":  {Code: "IR-15", Country: iso3166.IR, Name: "Lorestān", Category: "Province"},
+	"IR-16":  {Code: "IR-16", Country: iso3166.IR, Name: "Īlām", Category: "Province"},
+	"IR-17":  {Code: "IR-17", Country: iso3166.IR, Name: "Kohgīlūyeh va Bowyer Aḩmad", Category: "Province"},
+	"IR-18":  {Code: "IR-18", Country: iso3166.IR, Name: "Būshehr", Category: "Province"},
+	"IR-19":  {Code: "IR-19", Country: iso3166.IR, Name: "Zanjān", Category: "Province"},
+	"IR-20":  {Code: "IR-20", Country: iso3166.IR, Name: "Semnān", Category: "Province"},
+	"IR-21":  {Code: "IR-21", Country: iso3166.IR, Name: "Yazd", Category: "Province"},
+	"IR-22":  {Code: "IR-22", Country: iso3166.IR, Name: "Hormozgān", Category: "Province"},
+	"IR-23":  {Code: "IR-23", Country: iso3166.IR, Name: "Tehrān", Category: "Province"},
+	"IR-24":  {Code: "IR-24", Country: iso3166.IR, Name: "Ardabīl", Category: "Province"},
+	"IR-25":  {Code: "IR-25", Country: iso3166.IR, Name: "Qom", Category: "Province"},
+	"IR-26":  {Code: "IR-26", Country: iso3166.IR, Name: "Qazvīn", Category: "Province"},
+	"IR-27":  {Code: "IR-27", Country: iso3166.IR, Name: "Golestān", Category: "Province"},
+	"IR-28":  {Code: "IR-28", Country: iso3166.IR, Name: "Khorāsān-e Shomālī", Category: "Province"},
+	"IR-29":  {Code: "IR-29", Country: iso3166.IR, Name: "Khorāsān-e Jonūbī", Category: "Province"},
+	"IR-30":  {Code: "IR-30", Country: iso3166.IR, Name: "Alborz", Category: "Province"},
+	"IS-1":   {Code: "IS-1", Country: iso3166.IS, Name: "Höfuðborgarsvæði", Category: "Region"},
+	"IS-2":   {Code: "IS-2", Country: iso3166.IS, Name: "Suðurnes", Category: "Region"},
+	"IS-3":   {Code: "IS-3", Country: iso3166.IS, Name: "Vesturland", Category: "Region"},
+	"IS-4":   {Code: "IS-4", Country: iso3166.IS, Name: "Vestfirðir", Category: "Region"},
+	"IS-5":   {Code: "IS-5", Country: iso3166.IS, Name: "Norðurland vestra", Category: "Region"},
+	"IS-6":   {Code: "IS-6", Country: iso3166.IS, Name: "Norðurland eystra", Category: "Region"},
+	"IS-7":   {Code: "IS-7", Country: iso3166.IS, Name: "Austurland", Category: "Region"},
+	"IS-8":   {Code: "IS-8", Country: iso3166.IS, Name: "Suðurland", Category: "Region"},
+	"IS-AKH": {Code: "IS-AKH", Country: iso3166.IS, Name: "Akrahreppur", Category: "Municipality", ParentCode: "IS-5"},
+	"IS-AKN": {Code: "IS-AKN", Country: iso3166.IS, Name: "Akraneskaupstaður", Category: "Municipality", ParentCode: "IS-3"},
+	"IS-AKU": {Code: "IS-AKU", Country: iso3166.IS, Name: "Akureyrarbær", Category: "Municipality", ParentCode: "IS-6"},
+	"IS-ARN": {Code: "IS-ARN", Country: iso3166.IS, Name: "Árneshreppur", Category: "Municipality", ParentCode: "IS-4"},
+	"IS-ASA": {Code: "IS-ASA", Country: iso3166.IS, Name: "Ásahreppur", Category: "Municipality", ParentCode: "IS-8"},
+	"IS-BFJ": {Code: "IS-BFJ", Country: iso3166.IS, Name: "Borgarfjarðarhreppur", Category: "Municipality", ParentCode: "IS-7"},
+	"IS-BLA": {Code: "IS-BLA", Country: iso3166.IS, Name: "Bláskógabyggð", Category: "Municipality", ParentCode: "IS-8"},
+	"IS-BLO": {Code: "IS-BLO", Country: iso3166.IS, Name: "Blönduósbær", Category: "Municipality", ParentCode: "IS-5"},
+	"IS-BOG": {Code: "IS-BOG", Country: iso3166.IS, Name: "Borgarbyggð", Category: "Municipality", ParentCode: "IS-3"},
+	"IS-BOL": {Code: "IS-BOL", Country: iso3166.IS, Name: "Bolungarvíkurkaupstaður", Category: "Municipality", ParentCode: "IS-4"},
+	"IS-DAB": {Code: "IS-DAB", Country: iso3166.IS, Name: "Dalabyggð", Category: "Municipality", ParentCode: "IS-3"},
+	"IS-DAV": {Code: "IS-DAV", Country: iso3166.IS, Name: "Dalvíkurbyggð", Category: "Municipality", ParentCode: "IS-6"},
+	"IS-DJU": {Code: "IS-DJU", Country: iso3166.IS, Name: "Djúpavogshreppur", Category: "Municipality", ParentCode: "IS-7"},
+	"IS-EOM": {Code: "IS-EOM", Country: iso3166.IS, Name: "Eyja- og Miklaholtshreppur", Category: "Municipality", ParentCode: "IS-3"},
+	"IS-EYF": {Code: "IS-EYF", Country: iso3166.IS, Name: "Eyjafjarðarsveit", Category: "Municipality", ParentCode: "IS-6"},
+	"IS-FJD": {Code: "IS-FJD", Country: iso3166.IS, Name: "Fjarðabyggð", Category: "Municipality", ParentCode: "IS-7"},
+	"IS-FJL": {Code: "IS-FJL", Country: iso3166.IS, Name: "Fjallabyggð", Category: "Municipality", ParentCode: "IS-6"},
+	"IS-FLA": {Code: "IS-FLA", Country: iso3166.IS, Name: "Flóahreppur", Category: "Municipality", ParentCode: "IS-8"},
+	"IS-FLD": {Code: "IS-FLD", Country: iso3166.IS, Name: "Fljótsdalshérað", Category: "Municipality", ParentCode: "IS-7"},
+	"IS-FLR": {Code: "IS-FLR", Country: iso3166.IS, Name: "Fljótsdalshreppur", Category: "Municipality", ParentCode: "IS-7"},
+	"IS-GAR": {Code: "IS-GAR", Country: iso3166.IS, Name: "Garðabær", Category: "Municipality", ParentCode: "IS-1"},
+	"IS-GOG": {Code: "IS-GOG", Country: iso3166.IS, Name: "Grímsnes- og Grafningshreppur", Category: "Municipality", ParentCode: "IS-8"},
+	"IS-GRN": {Code: "IS-GRN", Country: iso3166.IS, Name: "Grindavíkurbær", Category: "Municipality", ParentCode: "IS-2"},
+	"IS-GRU": {Code: "IS-GRU", Country: iso3166.IS, Name: "Grundarfjarðarbær", Category: "Municipality", ParentCode: "IS-3"},
+	"IS-GRY": {Code: "IS-GRY", Country: iso3166.IS, Name: "Grýtubakkahreppur", Category: "Municipality", ParentCode: "IS-6"},
+	"IS-HAF": {Code: "IS-HAF", Country: iso3166.IS, Name: "Hafnarfjarðarkaupstaður", Category: "Municipality", ParentCode: "IS-1"},
+	"IS-HEL": {Code: "IS-HEL", Country: iso3166.IS, Name: "Helgafellssveit", Category: "Municipality", ParentCode: "IS-3"},
+	"IS-HRG": {Code: "IS-HRG", Country: iso3166.IS, Name: "Hörgársveit", Category: "Municipality", ParentCode: "IS-6"},
+	"IS-HRU": {Code: "IS-HRU", Country: iso3166.IS, Name: "Hrunamannahreppur", Category: "Municipality", ParentCode: "IS-8"},
+	"IS-HUT": {Code: "IS-HUT", Country: iso3166.IS, Name: "Húnavatnshreppur", Category: "Municipality", ParentCode: "IS-5"},
+	"IS-HUV": {Code: "IS-HUV", Country: iso3166.IS, Name: "Húnaþing vestra", Category: "Municipality", ParentCode: "IS-5"},
+	"IS-HVA": {Code: "IS-HVA", Country: iso3166.IS, Name: "Hvalfjarðarsveit", Category: "Municipality", ParentCode: "IS-3"},
+	"IS-HVE": {Code: "IS-HVE", Country: iso3166.IS, Name: "Hveragerðisbær", Category: "Municipality", ParentCode: "IS-8"},
+	"IS-ISA": {Code: "IS-ISA", Country: iso3166.IS, Name: "Ísafjarðarbær", Category: "Municipality", ParentCode: "IS-4"},
+	"IS-KAL": {Code: "IS-KAL", Country: iso3166.IS, Name: "Kaldrananeshreppur", Category: "Municipality", ParentCode: "IS-4"},
+	"IS-KJO": {Code: "IS-KJO", Country: iso3166.IS, Name: "Kjósarhreppur", Category: "Municipality", ParentCode: "IS-1"},
+	"IS-KOP": {Code: "IS-KOP", Country: iso3166.IS, Name: "Kópavogsbær", Category: "Municipality", ParentCode: "IS-1"},
+	"IS-LAN": {Code: "IS-LAN", Country: iso3166.IS, Name: "Langanesbyggð", Category: "Municipality", ParentCode: "IS-6"},
+	"IS-MOS": {Code: "IS-MOS", Country: iso3166.IS, Name: "Mosfellsbær", Category: "Municipality", ParentCode: "IS-1"},
+	"IS-MYR": {Code: "IS-MYR", Country: iso3166.IS, Name: "Mýrdalshreppur", Category: "Municipality", ParentCode: "IS-8"},
+	"IS-NOR": {Code: "IS-NOR", Country: iso3166.IS, Name: "Norðurþing", Category: "Municipality", ParentCode: "IS-6"},
+	"IS-RGE": {Code: "IS-RGE", Country: iso3166.IS, Name: "Rangárþing eystra", Category: "Municipality", ParentCode: "IS-8"},
+	"IS-RGY": {Code: "IS-RGY", Country: iso3166.IS, Name: "Rangárþing ytra", Category: "Municipality", ParentCode: "IS-8"},
+	"IS-RHH": {Code: "IS-RHH", Country: iso3166.IS, Name: "Reykhólahreppur", Category: "Municipality", ParentCode: "IS-4"},
+	"IS-RKN": {Code: "IS-RKN", Country: iso3166.IS, Name: "Reykjanesbær", Category: "Municipality", ParentCode: "IS-2"},
+	"IS-RKV": {Code: "IS-RKV", Country: iso3166.IS, Name: "Reykjavíkurborg", Category: "Municipality", ParentCode: "IS-1"},
+	"IS-SBH": {Code: "IS-SBH", Country: iso3166.IS, Name: "Svalbarðshreppur", Category: "Municipality", ParentCode: "IS-6"},
+	"IS-SBT": {Code: "IS-SBT", Country: iso3166.IS, Name: "Svalbarðsstrandarhreppur", Category: "Municipality", ParentCode: "IS-6"},
+	"IS-SDN": {Code: "IS-SDN", Country: iso3166.IS, Name: "Suðurnesjabær", Category: "Municipality", ParentCode: "IS-2"},
+	"IS-SDV": {Code: "IS-SDV", Country: iso3166.IS, Name: "Súðavíkurhreppur", Category: "Municipality", ParentCode: "IS-4"},
+	"IS-SEL": {Code: "IS-SEL", Country: iso3166.IS, Name: "Seltjarnarnesbær", Category: "Municipality", ParentCode: "IS-1"},
+	"IS-SEY": {Code: "IS-SEY", Country: iso3166.IS, Name: "Seyðisfjarðarkaupstaður", Category: "Municipality", ParentCode: "IS-7"},
+	"IS-SFA": {Code: "IS-SFA", Country: iso3166.IS, Name: "Sveitarfélagið Árborg", Category: "Municipality", ParentCode: "IS-8"},
+	"IS-SHF": {Code: "IS-SHF", Country: iso3166.IS, Name: "Sveitarfélagið Hornafjörður", Category: "Municipality", ParentCode: "IS-7"},
+	"IS-SKF": {Code: "IS-SKF", Country: iso3166.IS, Name: "Skaftárhreppur", Category: "Municipality", ParentCode: "IS-8"},
+	"IS-SKG": {Code: "IS-SKG", Country: iso3166.IS, Name: "Skagabyggð", Category: "Municipality", ParentCode: "IS-5"},
+	"IS-SKO": {Code: "IS-SKO", Country: iso3166.IS, Name: "Skorradalshreppur", Category: "Municipality", ParentCode: "IS-3"},
+	"IS-SKU": {Code: "IS-SKU", Country: iso3166.IS, Name: "Skútustaðahreppur", Category: "Municipality", ParentCode: "IS-6"},
+	"IS-SNF": {Code: "IS-SNF", Country: iso3166.IS, Name: "Snæfellsbær", Category: "Municipality", ParentCode: "IS-3"},
+	"IS-SOG": {Code: "IS-SOG", Country: iso3166.IS, Name: "Skeiða- og Gnúpverjahreppur", Category: "Municipality", ParentCode: "IS-8"},
+	"IS-SOL": {Code: "IS-SOL", Country: iso3166.IS, Name: "Sveitarfélagið Ölfus", Category: "Municipality", ParentCode: "IS-8"},
+	"IS-SSF": {Code: "IS-SSF", Country: iso3166.IS, Name: "Sveitarfélagið Skagafjörður", Category: "Municipality", ParentCode: "IS-5"},
+	"IS-SSS": {Code: "IS-SSS", Country: iso3166.IS, Name: "Sveitarfélagið Skagaströnd", Category: "Municipality", ParentCode: "IS-5"},
+	"IS-STR": {Code: "IS-STR", Country: iso3166.IS, Name: "Strandabyggð", Category: "Municipality", ParentCode: "IS-4"},
+	"IS-STY": {Code: "IS-STY", Country: iso3166.IS, Name: "Stykkishólmsbær", Category: "Municipality", ParentCode: "IS-3"},
+	"IS-SVG": {Code: "IS-SVG", Country: iso3166.IS, Name: "Sveitarfélagið Vogar", Category: "Municipality", ParentCode: "IS-2"},
+	"IS-TAL": {Code: "IS-TAL", Country: iso3166.IS, Name: "Tálknafjarðarhreppur", Category: "Municipality", ParentCode: "IS-4"},
+	"IS-THG": {Code: "IS-THG", Country: iso3166.IS, Name: "Þingeyjarsveit", Category: "Municipality", ParentCode: "IS-6"},
+	"IS-TJO": {Code: "IS-TJO", Country: iso3166.IS, Name: "Tjörneshreppur", Category: "Municipality", ParentCode: "IS-6"},
+	"IS-VEM": {Code: "IS-VEM", Country: iso3166.IS, Name: "Vestmannaeyjabær", Category: "Municipality", ParentCode: "IS-8"},
+	"IS-VER": {Code: "IS-VER", Country: iso3166.IS, Name: "Vesturbyggð", Category: "Municipality", ParentCode: "IS-4"},
+	"IS-VOP": {Code: "IS-VOP", Country: iso3166.IS, Name: "Vopnafjarðarhreppur", Category: "Municipality", ParentCode: "IS-7"},
+	"IT-21":  {Code: "IT-21", Country: iso3166.IT, Name: "Piemonte", Category: "Region"},
+	"IT-23":  {Code: "IT-23", Country: iso3166.IT, Name: "Val d'Aoste", Category: "Autonomous region"},
+	"IT-25":  {Code: "IT-25", Country: iso3166.IT, Name: "Lombardia", Category: "Region"},
+	"IT-32":  {Code: "IT-32", Country: iso3166.IT, Name: "Trentino-Alto Adige", Category: "Autonomous region"},
+	"IT-34":  {Code: "IT-34", Country: iso3166.IT, Name: "Veneto", Category: "Region"},
+	"IT-36":  {Code: "IT-36", Country: iso3166.IT, Name: "Friuli Venezia Giulia", Category: "Autonomous region"},
+	"IT-42":  {Code: "IT-42", Country: iso3166.IT, Name: "Liguria", Category: "Region"},
+	"IT-45":  {Code: "IT-45", Country: iso3166.IT, Name: "Emilia-Romagna", Category: "Region"},
+	"IT-52":  {Code: "IT-52", Country: iso3166.IT, Name: "Toscana", Category: "Region"},
+	"IT-55":  {Code: "IT-55", Country: iso3166.IT, Name: "Umbria", Category: "Region"},
+	"IT-57":  {Code: "IT-57", Country: iso3166.IT, Name: "Marche", Category: "Region"},
+	"IT-62":  {Code: "IT-62", Country: iso3166.IT, Name: "Lazio", Category: "Region"},
+	"IT-65":  {Code: "IT-65", Country: iso3166.IT, Name: "Abruzzo", Category: "Region"},
+	"IT-67":  {Code: "IT-67", Country: iso3166.IT, Name: "Molise", Category: "Region"},
+	"IT-72":  {Code: "IT-72", Country: iso3166.IT, Name: "Campania", Category: "Region"},
+	"IT-75":  {Code: "IT-75", Country: iso3166.IT, Name: "Puglia", Category: "Region"},
+	"IT-77":  {Code: "IT-77", Country: iso3166.IT, Name: "Basilicata", Category: "Region"},
+	"IT-78":  {Code: "IT-78", Country: iso3166.IT, Name: "Calabria", Category: "Region"},
+	"IT-82":  {Code: "IT-82", Country: iso3166.IT, Name: "Sicilia", Category: "Autonomous region"},
+	"IT-88":  {Code: "IT-88", Country: iso3166.IT, Name: "Sardegna", Category: "Autonomous region"},
+	"IT-AG":  {Code: "IT-AG", Country: iso3166.IT, Name: "Agrigento", Category: "Free municipal consortium", ParentCode: "IT-82"},
+	"IT-AL":  {Code: "IT-AL", Country: iso3166.IT, Name: "Alessandria", Category: "Province", ParentCode: "IT-21"},
+	"IT-AN":  {Code: "IT-AN", Country: iso3166.IT, Name: "Ancona", Category: "Province", ParentCode: "IT-57"},
+	"IT-AP":  {Code: "IT-AP", Country: iso3166.IT, Name: "Ascoli Piceno", Category: "Province", ParentCode: "IT-57"},
+	"IT-AQ":  {Code: "IT-AQ", Country: iso3166.IT, Name: "L'Aquila", Category: "Province", ParentCode: "IT-65"},
+	"IT-AR":  {Code: "IT-AR", Country: iso3166.IT, Name: "Arezzo", Category: "Province", ParentCode: "IT-52"},
+	"IT-AT":  {Code: "IT-AT", Country: iso3166.IT, Name: "Asti", Category: "Province", ParentCode: "IT-21"},
+	"IT-AV":  {Code: "IT-AV", Country: iso3166.IT, Name: "Avellino", Category: "Province", ParentCode: "IT-72"},
+	"IT-BA":  {Code: "IT-BA", Country: iso3166.IT, Name: "Bari", Category: "Metropolitan city", ParentCode: "IT-75"},
+	"IT-BG":  {Code: "IT-BG", Country: iso3166.IT, Name: "Bergamo", Category: "Province", ParentCode: "IT-25"},
+	"IT-BI":  {Code: "IT-BI", Country: iso3166.IT, Name: "Biella", Category: "Province", ParentCode: "IT-21"},
+	"IT-BL":  {Code: "IT-BL", Country: iso3166.IT, Name: "Belluno", Category: "Province", ParentCode: "IT-34"},
+	"IT-BN":  {Code: "IT-BN", Country: iso3166.IT, Name: "Benevento", Category: "Province", ParentCode: "IT-72"},
+	"IT-BO":  {Code: "IT-BO", Country: iso3166.IT, Name: "Bologna", Category: "Metropolitan city", ParentCode: "IT-45"},
+	"IT-BR":  {Code: "IT-BR", Country: iso3166.IT, Name: "Brindisi", Category: "Province", ParentCode: "IT-75"},
+	"IT-BS":  {Code: "IT-BS", Country: iso3166.IT, Name: "Brescia", Category: "Province", ParentCode: "IT-25"},
+	"IT-BT":  {Code: "IT-BT", Country: iso3166.IT, Name: "Barletta-Andria-Trani", Category: "Province", ParentCode: "IT-75"},
+	"IT-BZ":  {Code: "IT-BZ", Country: iso3166.IT, Name: "Bolzano", Category: "Autonomous province", ParentCode: "IT-32"},
+	"IT-CA":  {Code: "IT-CA", Country: iso3166.IT, Name: "Cagliari", Category: "Metropolitan city", ParentCode: "IT-88"},
+	"IT-CB":  {Code: "IT-CB", Country: iso3166.IT, Name: "Campobasso", Category: "Province", ParentCode: "IT-67"},
+	"IT-CE":  {Code: "IT-CE", Country: iso3166.IT, Name: "Caserta", Category: "Province", ParentCode: "IT-72"},
+	"IT-CH":  {Code: "IT-CH", Country: iso3166.IT, Name: "Chieti", Category: "Province", ParentCode: "IT-65"},
+	"IT-CL":  {Code: "IT-CL", Country: iso3166.IT, Name: "Caltanissetta", Category: "Free municipal consortium", ParentCode: "IT-82"},
+	"IT-CN":  {Code: "IT-CN", Country: iso3166.IT, Name: "Cuneo", Category: "Province", ParentCode: "IT-21"},
+	"IT-CO":  {Code: "IT-CO", Country: iso3166.IT, Name: "Como", Category: "Province", ParentCode: "IT-25"},
+	"IT-CR":  {Code: "IT-CR", Country: iso3166.IT, Name: "Cremona", Category: "Province", ParentCode: "IT-25"},
+	"IT-CS":  {Code: "IT-CS", Country: iso3166.IT, Name: "Cosenza", Category: "Province", ParentCode: "IT-78"},
+	"IT-CT":  {Code: "IT-CT", Country: iso3166.IT, Name: "Catania", Category: "Metropolitan city", ParentCode: "IT-82"},
+	"IT-CZ":  {Code: "IT-CZ", Country: iso3166.IT, Name: "Catanzaro", Category: "Province", ParentCode: "IT-78"},
+	"IT-EN":  {Code: "IT-EN", Country: iso3166.IT, Name: "Enna", Category: "Free municipal consortium", ParentCode: "IT-82"},
+	"IT-FC":  {Code: "IT-FC", Country: iso3166.IT, Name: "Forlì-Cesena", Category: "Province", ParentCode: "IT-45"},
+	"IT-FE":  {Code: "IT-FE", Country: iso3166.IT, Name: "Ferrara", Category: "Province", ParentCode: "IT-45"},
+	"IT-FG":  {Code: "IT-FG", Country: iso3166.IT, Name: "Foggia", Category: "Province", ParentCode: "IT-75"},
+	"IT-FI":  {Code: "IT-FI", Country: iso3166.IT, Name: "Firenze", Category: "Metropolitan city", ParentCode: "IT-52"},
+	"IT-FM":  {Code: "IT-FM", Country: iso3166.IT, Name: "Fermo", Category: "Province", ParentCode: "IT-57"},
+	"IT-FR":  {Code: "IT-FR", Country: iso3166.IT, Name: "Frosinone", Category: "Province", ParentCode: "IT-62"},
+	"IT-GE":  {Code: "IT-GE", Country: iso3166.IT, Name: "Genova", Category: "Metropolitan city", ParentCode: "IT-42"},
+	"IT-GO":  {Code: "IT-GO", Country: iso3166.IT, Name: "Gorizia", Category: "Decentralized regional entity", ParentCode: "IT-36"},
+	"IT-GR":  {Code: "IT-GR", Country: iso3166.IT, Name: "Grosseto", Category: "Province", ParentCode: "IT-52"},
+	"IT-IM":  {Code: "IT-IM", Country: iso3166.IT, Name: "Imperia", Category: "Province", ParentCode: "IT-42"},
+	"IT-IS":  {Code: "IT-IS", Country: iso3166.IT, Name: "Isernia", Category: "Province", ParentCode: "IT-67"},
+	"IT-KR":  {Code: "IT-KR", Country: iso3166.IT, Name: "Crotone", Category: "Province", ParentCode: "IT-78"},
+	"IT-LC":  {Code: "IT-LC", Country: iso3166.IT, Name: "Lecco", Category: "Province", ParentCode: "IT-25"},
+	"IT-LE":  {Code: "IT-LE", Country: iso3166.IT, Name: "Lecce", Category: "Province", ParentCode: "IT-75"},
+	"IT-LI":  {Code: "IT-LI", Country: iso3166.IT, Name: "Livorno", Category: "Province", ParentCode: "IT-52"},
+	"IT-LO":  {Code: "IT-LO", Country: iso3166.IT, Name: "Lodi", Category: "Province", ParentCode: "IT-25"},
+	"IT-LT":  {Code: "IT-LT", Country: iso3166.IT, Name: "Latina", Category: "Province", ParentCode: "IT-62"},
+	"IT-LU":  {Code: "IT-LU", Country: iso3166.IT, Name: "Lucca", Category: "Province", ParentCode: "IT-52"},
+	"IT-MB":  {Code: "IT-MB", Country: iso3166.IT, Name: "Monza e Brianza", Category: "Province", ParentCode: "IT-25"},
+	"IT-MC":  {Code: "IT-MC", Country: iso3166.IT, Name: "Macerata", Category: "Province", ParentCode: "IT-57"},
+	"IT-ME":  {Code: "IT-ME", Country: iso3166.IT, Name: "Messina", Category: "Metropolitan city", ParentCode: "IT-82"},
+	"IT-MI":  {Code: "IT-MI", Country: iso3166.IT, Name: "Milano", Category: "Metropolitan city", ParentCode: "IT-25"},
+	"IT-MN":  {Code: "IT-MN", Country: iso3166.IT, Name: "Mantova", Category: "Province", ParentCode: "IT-25"},
+	"IT-MO":  {Code: "IT-MO", Country: iso3166.IT, Name: "Modena", Category: "Province", ParentCode: "IT-45"},
+	"IT-MS":  {Code: "IT-MS", Country: iso3166.IT, Name: "Massa-Carrara", Category: "Province", ParentCode: "IT-52"},
+	"IT-MT":  {Code: "IT-MT", Country: iso3166.IT, Name: "Matera", Category: "Province", ParentCode: "IT-77"},
+	"IT-NA":  {Code: "IT-NA", Country: iso3166.IT, Name: "Napoli", Category: "Metropolitan city", ParentCode: "IT-72"},
+	"IT-NO":  {Code: "IT-NO", Country: iso3166.IT, Name: "Novara", Category: "Province", ParentCode: "IT-21"},
+	"IT-NU":  {Code: "IT-NU", Country: iso3166.IT, Name: "Nuoro", Category: "Province", ParentCode: "IT-88"},
+	"IT-OR":  {Code: "IT-OR", Country: iso3166.IT, Name: "Oristano", Category: "Province", ParentCode: "IT-88"},
+	"IT-PA":  {Code: "IT-PA", Country: iso3166.IT, Name: "Palermo", Category: "Metropolitan city", ParentCode: "IT-82"},
+	"IT-PC":  {Code: "IT-PC", Country: iso3166.IT, Name: "Piacenza", Category: "Province", ParentCode: "IT-45"},
+	"IT-PD":  {Code: "IT-PD", Country: iso3166.IT, Name: "Padova", Category: "Province", ParentCode: "IT-34"},
+	"IT-PE":  {Code: "IT-PE", Country: iso3166.IT, Name: "Pescara", Category: "Province", ParentCode: "IT-65"},
+	"IT-PG":  {Code: "IT-PG", Country: iso3166.IT, Name: "Perugia", Category: "Province", ParentCode: "IT-55"},
+	"IT-PI":  {Code: "IT-PI", Country: iso3166.IT, Name: "Pisa", Category: "Province", ParentCode: "IT-52"},
+	"IT-PN":  {Code: "IT-PN", Country: iso3166.IT, Name: "Pordenone", Category: "Decentralized regional entity", ParentCode: "IT-36"},
+	"IT-PO":  {Code: "IT-PO", Country: iso3166.IT, Name: "Prato", Category: "Province", ParentCode: "IT-52"},
+	"IT-PR":  {Code: "IT-PR", Country: iso3166.IT, Name: "Parma", Category: "Province", ParentCode: "IT-45"},
+	"IT-PT":  {Code: "IT-PT", Country: iso3166.IT, Name: "Pistoia", Category: "Province", ParentCode: "IT-52"},
+	"IT-PU":  {Code: "IT-PU", Country: iso3166.IT, Name: "Pesaro e Urbino", Category: "Province", ParentCode: "IT-57"},
+	"IT-PV":  {Code: "IT-PV", Country: iso3166.IT, Name: "Pavia", Category: "Province", ParentCode: "IT-25"},
+	"IT-PZ":  {Code: "IT-PZ", Country: iso3166.IT, Name: "Potenza", Category: "Province", ParentCode: "IT-77"},
+	"IT-RA":  {Code: "IT-RA", Country: iso3166.IT, Name: "Ravenna", Category: "Province", ParentCode: "IT-45"},
+	"IT-RC":  {Code: "IT-RC", Country: iso3166.IT, Name: "Reggio Calabria", Category: "Metropolitan city", ParentCode: "IT-78"},
+	"IT-RE":  {Code: "IT-RE", Country: iso3166.IT, Name: "Reggio Emilia", Category: "Province", ParentCode: "IT-45"},
+	"IT-RG":  {Code: "IT-RG", Country: iso3166.IT, Name: "Ragusa", Category: "Free municipal consortium", ParentCode: "IT-82"},
+	"IT-RI":  {Code: "IT-RI", Country: iso3166.IT, Name: "Rieti", Category: "Province", ParentCode: "IT-62"},
+	"IT-RM":  {Code: "IT-RM", Country: iso3166.IT, Name: "Roma", Category: "Metropolitan city", ParentCode: "IT-62"},
+	"IT-RN":  {Code: "IT-RN", Country: iso3166.IT, Name: "Rimini", Category: "Province", ParentCode: "IT-45"},
+	"IT-RO":  {Code: "IT-RO", Country: iso3166.IT, Name: "Rovigo", Category: "Province", ParentCode: "IT-34"},
+	"IT-SA":  {Code: "IT-SA", Country: iso3166.IT, Name: "Salerno", Category: "Province", ParentCode: "IT-72"},
+	"IT-SI":  {Code: "IT-SI", Country: iso3166.IT, Name: "Siena", Category: "Province", ParentCode: "IT-52"},
+	"IT-SO":  {Code: "IT-SO", Country: iso3166.IT, Name: "Sondrio", Category: "Province", ParentCode: "IT-25"},
+	"IT-SP":  {Code: "IT-SP", Country: iso3166.IT, Name: "La Spezia", Category: "Province", ParentCode: "IT-42"},
+	"IT-SR":  {Code: "IT-SR", Country: iso3166.IT, Name: "Siracusa", Category: "Free municipal consortium", ParentCode: "IT-82"},
+	"IT-SS":  {Code: "IT-SS", Country: iso3166.IT, Name: "Sassari", Category: "Province", ParentCode: "IT-88"},
+	"IT-SU":  {Code: "IT-SU", Country: iso3166.IT, Name: "Sud Sardegna", Category: "Province", ParentCode: "IT-88"},
+	"IT-SV":  {Code: "IT-SV", Country: iso3166.IT, Name: "Savona", Category: "Province", ParentCode: "IT-42"},
+	"IT-TA":  {Code: "IT-TA", Country: iso3166.IT, Name: "Taranto", Category: "Province", ParentCode: "IT-75"},
+	"IT-TE":  {Code: "IT-TE", Country: iso3166.IT, Name: "Teramo", Category: "Province", ParentCode: "IT-65"},
+	"IT-TN":  {Code: "IT-TN", Country: iso3166.IT, Name: "Trento", Category: "Autonomous province", ParentCode: "IT-32"},
+	"IT-TO":  {Code: "IT-TO", Country: iso3166.IT, Name: "Torino", Category: "Metropolitan city", ParentCode: "IT-21"},
+	"IT-TP":  {Code: "IT-TP", Country: iso3166.IT, Name: "Trapani", Category: "Free municipal consortium", ParentCode: "IT-82"},
+	"IT-TR":  {Code: "IT-TR", Country: iso3166.IT, Name: "Terni", Category: "Province", ParentCode: "IT-55"},
+	"IT-TS":  {Code: "IT-TS", Country: iso3166.IT, Name: "Trieste", Category: "Decentralized regional entity", ParentCode: "IT-36"},
+	"IT-TV":  {Code: "IT-TV", Country: iso3166.IT, Name: "Treviso", Category: "Province", ParentCode: "IT-34"},
+	"IT-UD":  {Code: "IT-UD", Country: iso3166.IT, Name: "Udine", Category: "Decentralized regional entity", ParentCode: "IT-36"},
+	"IT-VA":  {Code: "IT-VA", Country: iso3166.IT, Name: "Varese", Category: "Province", ParentCode: "IT-25"},
+	"IT-VB":  {Code: "IT-VB", Country: iso3166.IT, Name: "Verbano-Cusio-Ossola", Category: "Province", ParentCode: "IT-21"},
+	"IT-VC":  {Code: "IT-VC", Country: iso3166.IT, Name: "Vercelli", Category: "Province", ParentCode: "IT-21"},
+	"IT-VE":  {Code: "IT-VE", Country: iso3166.IT, Name: "Venezia", Category: "Metropolitan city", ParentCode: "IT-34"},
+	"IT-VI":  {Code: "IT-VI", Country: iso3166.IT, Name: "Vicenza", Category: "Province", ParentCode: "IT-34"},
+	"IT-VR":  {Code: "IT-VR", Country: iso3166.IT, Name: "Verona", Category: "Province", ParentCode: "IT-34"},
+	"IT-VT":  {Code: "IT-VT", Country: iso3166.IT, Name: "Viterbo", Category: "Province", ParentCode: "IT-62"},
+	"IT-VV":  {Code: "IT-VV", Country: iso3166.IT, Name: "Vibo Valentia", Category: "Province", ParentCode: "IT-78"},
+	"JM-01":  {Code: "JM-01", Country: iso3166.JM, Name: "Kingston", Category: "Parish"},
+	"JM-02":  {Code: "JM-02", Country: iso3166.JM, Name: "Saint Andrew", Category: "Parish"},
+	"JM-03":  {Code: "JM-03", Country: iso3166.JM, Name: "Saint Thomas", Category: "Parish"},
+	"JM-04":  {Code: "JM-04", Country: iso3166.JM, Name: "Portland", Category: "Parish"},
+	"JM-05":  {Code: "JM-05", Country: iso3166.JM, Name: "Saint Mary", Category: "Parish"},
+	"JM-06":  {Code: "JM-06", Country: iso3166.JM, Name: "Saint Ann", Category: "Parish"},
+	"JM-07":  {Code: "JM-07", Country: iso3166.JM, Name: "Trelawny", Category: "Parish"},
+	"JM-08":  {Code: "JM-08", Country: iso3166.JM, Name: "Saint James", Category: "Parish"},
+	"JM-09":  {Code: "JM-09", Country: iso3166.JM, Name: "Hanover", Category: "Parish"},
+	"JM-10":  {Code: "JM-10", Country: iso3166.JM, Name: "Westmoreland", Category: "Parish"},
+	"JM-11":  {Code: "JM-11", Country: iso3166.JM, Name: "Saint Elizabeth", Category: "Parish"},
+	"JM-12":  {Code: "JM-12", Country: iso3166.JM, Name: "Manchester", Category: "Parish"},
+	"JM-13":  {Code: "JM-13", Country: iso3166.JM, Name: "Clarendon", Category: "Parish"},
+	"JM-14":  {Code: "JM-14", Country: iso3166.JM, Name: "Saint Catherine", Category: "Parish"},
+	"JO-AJ":  {Code: "JO-AJ", Country: iso3166.JO, Name: "‘Ajlūn", Category: "Governorate"},
+	"JO-AM":  {Code: "JO-AM", Country: iso3166.JO, Name: "Al ‘A̅şimah", Category: "Governorate"},
+	"JO-AQ":  {Code: "JO-AQ", Country: iso3166.JO, Name: "Al ‘Aqabah", Category: "Governorate"},
+	"JO-AT":  {Code: "JO-AT", Country: iso3166.JO, Name: "Aţ Ţafīlah", Category: "Governorate"},
+	"JO-AZ":  {Code: "JO-AZ", Country: iso3166.JO, Name: "Az Zarqā’", Category: "Governorate"},
+	"JO-BA":  {Code: "JO-BA", Country: iso3166.JO, Name: "Al Balqā’", Category: "Governorate"},
+	"JO-IR":  {Code: "JO-IR", Country: iso3166.JO, Name: "Irbid", Category: "Governorate"},
+	"JO-JA":  {Code: "JO-JA", Country: iso3166.JO, Name: "Jarash", Category: "Governorate"},
+	"JO-KA":  {Code: "JO-KA", Country: iso3166.JO, Name: "Al Karak", Category: "Governorate"},
+	"JO-MA":  {Code: "JO-MA", Country: iso3166.JO, Name: "Al Mafraq", Category: "Governorate"},
+	"JO-MD":  {Code: "JO-MD", Country: iso3166.JO, Name: "Mādabā", Category: "Governorate"},
+	"JO-MN":  {Code: "JO-MN", Country: iso3166.JO, Name: "Ma‘ān", Category: "Governorate"},
+	"JP-01":  {Code: "JP-01", Country: iso3166.JP, Name: "Hokkaido", Category: "Prefecture"},
+	"JP-02":  {Code: "JP-02", Country: iso3166.JP, Name: "Aomori", Category: "Prefecture"},
+	"JP-03":  {Code: "JP-03", Country: iso3166.JP, Name: "Iwate", Category: "Prefecture"},
+	"JP-04":  {Code: "JP-04", Country: iso3166.JP, Name: "Miyagi", Category: "Prefecture"},
+	"JP-05":  {Code: "JP-05", Country: iso3166.JP, Name: "Akita", Category: "Prefecture"},
+	"JP-06":  {Code: "JP-06", Country: iso3166.JP, Name: "Yamagata", Category: "Prefecture"},
+	"JP-07":  {Code: "JP-07", Country: iso3166.JP, Name: "Fukushima", Category: "Prefecture"},
+	"JP-08":  {Code: "JP-08", Country: iso3166.JP, Name: "Ibaraki", Category: "Prefecture"},
+	"JP-09":  {Code: "JP-09", Country: iso3166.JP, Name: "Tochigi", Category: "Prefecture"},
+	"JP-10":  {Code: "JP-10", Country: iso3166.JP, Name: "Gunma", Category: "Prefecture"},
+	"JP-11":  {Code: "JP-11", Country: iso3166.JP, Name: "Saitama", Category: "Prefecture"},
+	"JP-12":  {Code: "JP-12", Country: iso3166.JP, Name: "Chiba", Category: "Prefecture"},
+	"JP-13":  {Code: "JP-13", Country: iso3166.JP, Name: "Tokyo", Category: "Prefecture"},
+	"JP-14":  {Code: "JP-14", Country: iso3166.JP, Name: "Kanagawa", Category: "Prefecture"},
+	"JP-15":  {Code: "JP-15", Country: iso3166.JP, Name: "Niigata", Category: "Prefecture"},
+	"JP-16":  {Code: "JP-16", Country: iso3166.JP, Name: "Toyama", Category: "Prefecture"},
+	"JP-17":  {Code: "JP-17", Country: iso3166.JP, Name: "Ishikawa", Category: "Prefecture"},
+	"JP-18":  {Code: "JP-18", Country: iso3166.JP, Name: "Fukui", Category: "Prefecture"},
+	"JP-19":  {Code: "JP-19", Country: iso3166.JP, Name: "Yamanashi", Category: "Prefecture"},
+	"JP-20":  {Code: "JP-20", Country: iso3166.JP, Name: "Nagano", Category: "Prefecture"},
+	"JP-21":  {Code: "JP-21", Country: iso3166.JP, Name: "Gifu", Category: "Prefecture"},
+	"JP-22":  {Code: "JP-22", Country: iso3166.JP, Name: "Shizuoka", Category: "Prefecture"},
+	"JP-23":  {Code: "JP-23", Country: iso3166.JP, Name: "Aichi", Category: "Prefecture"},
+	"JP-24":  {Code: "JP-24", Country: iso3166.JP, Name: "Mie", Category: "Prefecture"},
+	"JP-25":  {Code: "JP-25", Country: iso3166.JP, Name: "Shiga", Category: "Prefecture"},
+	"JP-26":  {Code: "JP-26", Country: iso3166.JP, Name: "Kyoto", Category: "Prefecture"},
+	"JP-27":  {Code: "JP-27", Country: iso3166.JP, Name: "Osaka", Category: "Prefecture"},
+	"JP-28":  {Code: "JP-28", Country: iso3166.JP, Name: "Hyogo", Category: "Prefecture"},
+	"JP-29":  {Code: "JP-29", Country: iso3166.JP, Name: "Nara", Category: "Prefecture"},
+	"JP-30":  {Code: "JP-30", Country: iso3166.JP, Name: "Wakayama", Category: "Prefecture"},
+	"JP-31":  {Code: "JP-31", Country: iso3166.JP, Name: "Tottori", Category: "Prefecture"},
+	"JP-32":  {Code: "JP-32", Country: iso3166.JP, Name: "Shimane", Category: "Prefecture"},
+	"JP-33":  {Code: "JP-33", Country: iso3166.JP, Name: "Okayama", Category: "Prefecture"},
+	"JP-34":  {Code: "JP-34", Country: iso3166.JP, Name: "Hiroshima", Category: "Prefecture"},
+	"JP-35":  {Code: "JP-35", Country: iso3166.JP, Name: "Yamaguchi", Category: "Prefecture"},
+	"JP-36":  {Code: "JP-36", Country: iso3166.JP, Name: "Tokushima", Category: "Prefecture"},
+	"JP-37":  {Code: "JP-37", Country: iso3166.JP, Name: "Kagawa", Category: "Prefecture"},
+	"JP-38":  {Code: "JP-38", Country: iso3166.JP, Name: "Ehime", Category: "Prefecture"},
+	"JP-39":  {Code: "JP-39", Country: iso3166.JP, Name: "Kochi", Category: "Prefecture"},
+	"JP-40":  {Code: "JP-40", Country: iso3166.JP, Name: "Fukuoka", Category: "Prefecture"},
+	"JP-41":  {Code: "JP-41", Country: iso3166.JP, Name: "Saga", Category: "Prefecture"},
+	"JP-42":  {Code: "JP-42", Country: iso3166.JP, Name: "Nagasaki", Category: "Prefecture"},
+	"JP-43":  {Code: "JP-43", Country: iso3166.JP, Name: "Kumamoto", Category: "Prefecture"},
+	"JP-44":  {Code: "JP-44", Country: iso3166.JP, Name: "Oita", Category: "Prefecture"},
+	"JP-45":  {Code: "JP-45", Country: iso3166.JP, Name: "Miyazaki", Category: "Prefecture"},
+	"JP-46":  {Code: "JP-46", Country: iso3166.JP, Name: "Kagoshima", Category: "Prefecture"},
+	"JP-47":  {Code: "JP-47", Country: iso3166.JP, Name: "Okinawa", Category: "Prefecture"},
+	"KE-01":  {Code: "KE-01", Country: iso3166.KE, Name: "Baringo", Category: "County"},
+	"KE-02":  {Code: "KE-02", Country: iso3166.KE, Name: "Bomet", Category: "County"},
+	"KE-03":  {Code: "KE-03", Country: iso3166.KE, Name: "Bungoma", Category: "County"},
+	"KE-04":  {Code: "KE-04", Country: iso3166.KE, Name: "Busia", Category: "County"},
+	"KE-05":  {Code: "KE-05", Country: iso3166.KE, Name: "Elgeyo/Marakwet", Category: "County"},
+	"KE-06":  {Code: "KE-06", Country: iso3166.KE, Name: "Embu", Category: "County"},
+	"KE-07":  {Code: "KE-07", Country: iso3166.KE, Name: "Garissa", Category: "County"},
+	"KE-08":  {Code: "KE-08", Country: iso3166.KE, Name: "Homa Bay", Category: "County"},
+	"KE-09":  {Code: "KE-09", Country: iso3166.KE, Name: "Isiolo", Category: "County"},
+	"KE-10":  {Code: "KE-10", Country: iso3166.KE, Name: "Kajiado", Category: "County"},
+	"KE-11":  {Code: "KE-11", Country: iso3166.KE, Name: "Kakamega", Category: "County"},
+	"KE-12":  {Code: "KE-12", Country: iso3166.KE, Name: "Kericho", Category: "County"},
+	"KE-13":  {Code: "KE-13", Country: iso3166.KE, Name: "Kiambu", Category: "County"},
+	"KE-14":  {Code: "KE-14", Country: iso3166.KE, Name: "Kilifi", Category: "County"},
+	"KE-15":  {Code: "KE-15", Country: iso3166.KE, Name: "Kirinyaga", Category: "County"},
+	"KE-16":  {Code: "KE-16", Country: iso3166.KE, Name: "Kisii", Category: "County"},
+	"KE-17":  {Code: "KE-17", Country: iso3166.KE, Name: "Kisumu", Category: "County"},
+	"KE-18":  {Code: "KE-18", Country: iso3166.KE, Name: "Kitui", Category: "County"},
+	"KE-19":  {Code: "KE-19", Country: iso3166.KE, Name: "Kwale", Category: "County"},
+	"KE-20":  {Code: "KE-20", Country: iso3166.KE, Name: "Laikipia", Category: "County"},
+	"KE-21":  {Code: "KE-21", Country: iso3166.KE, Name: "Lamu", Category: "County"},
+	"KE-22":  {Code: "KE-22", Country: iso3166.KE, Name: "Machakos", Category: "County"},
+	"KE-23":  {Code: "KE-23", Country: iso3166.KE, Name: "Makueni", Category: "County"},
+	"KE-24":  {Code: "KE-24", Country: iso3166.KE, Name: "Mandera", Category: "County"},
+	"KE-25":  {Code: "KE-25", Country: iso3166.KE, Name: "Marsabit", Category: "County"},
+	"KE-26":  {Code: "KE-26", Country: iso3166.KE, Name: "Meru", Category: "County"},
+	"KE-27":  {Code: "KE-27", Country: iso3166.KE, Name: "Migori", Category: "County"},
+	"KE-28":  {Code: "KE-28", Country: iso3166.KE, Name: "Mombasa", Category: "County"},
+	"KE-29":  {Code: "KE-29", Country: iso3166.KE, Name: "Murang'a", Category: "County"},
+	"KE-30":  {Code: "KE-30", Country: iso3166.KE, Name: "Nairobi City", Category: "County"},
+	"KE-31":  {Code: "KE-31", Country: iso3166.KE, Name: "Nakuru", Category: "County"},
+	"KE-32":  {Code: "KE-32", Country: iso3166.KE, Name: "Nandi", Category: "County"},
+	"KE-33":  {Code: "KE-33", Country: iso3166.KE, Name: "Narok", Category: "County"},
+	"KE-34":  {Code: "KE-34", Country: iso3166.KE, Name: "Nyamira", Category: "County"},
+	"KE-35":  {Code: "KE-35", Country: iso3166.KE, Name: "Nyandarua", Category: "County"},
+	"KE-36":  {Code: "KE-36", Country: iso3166.KE, Name: "Nyeri", Category: "County"},
+	"KE-37":  {Code: "KE-37", Country: iso3166.KE, Name: "Samburu", Category: "County"},
+	"KE-38":  {Code: "KE-38", Country: iso3166.KE, Name: "Siaya", Category: "County"},
+	"KE-39":  {Code: "KE-39", Country: iso3166.KE, Name: "Taita/Taveta", Category: "County"},
+	"KE-40":  {Code: "KE-40", Country: iso3166.KE, Name: "Tana River", Category: "County"},
+	"KE-41":  {Code: "KE-41", Country: iso3166.KE, Name: "Tharaka-Nithi", Category: "County"},
+	"KE-42":  {Code: "KE-42", Country: iso3166.KE, Name: "Trans Nzoia", Category: "County"},
+	"KE-43":  {Code: "KE-43", Country: iso3166.KE, Name: "Turkana", Category: "County"},
+	"KE-44":  {Code: "KE-44", Country: iso3166.KE, Name: "Uasin Gishu", Category: "County"},
+	"KE-45":  {Code: "KE-45", Country: iso3166.KE, Name: "Vihiga", Category: "County"},
+	"KE-46":  {Code: "KE-46", Country: iso3166.KE, Name: "Wajir", Category: "County"},
+	"KE-47":  {Code: "KE-47", Country: iso3166.KE, Name: "West Pokot", Category: "County"},
+	"KG-B":   {Code: "KG-B", Country: iso3166.KG, Name: "Batken", Category: "Region"},
+	"KG-C":   {Code: "KG-C", Country: iso3166.KG, Name: "Chuyskaya oblast'", Category: "Region"},
+	"KG-GB":  {Code: "KG-GB", Country: iso3166.KG, Name: "Bishkek Shaary", Category: "City"},
+	"KG-GO":  {Code: "KG-GO", Country: iso3166.KG, Name: "Gorod Osh", Category: "City"},
+	"KG-J":   {Code: "KG-J", Country: iso3166.KG, Name: "Dzhalal-Abadskaya oblast'", Category: "Region"},
+	"KG-N":   {Code: "KG-N", Country: iso3166.KG, Name: "Naryn", Category: "Region"},
+	"KG-O":   {Code: "KG-O", Country: iso3166.KG, Name: "Osh", Category: "Region"},
+	"KG-T":   {Code: "KG-T", Country: iso3166.KG, Name: "Talas", Category: "Region"},
+	"KG-Y":   {Code: "KG-Y", Country: iso3166.KG, Name: "Issyk-Kul'skaja oblast'", Category: "Region"},
+	"KH-1":   {Code: "KH-1", Country: iso3166.KH, Name: "Banteay Mean Choăy", Category: "Province"},
+	"KH-10":  {Code: "KH-10", Country: iso3166.KH, Name: "Kracheh", Category: "Province"},
+	"KH-11":  {Code: "KH-11", Country: iso3166.KH, Name: "Mondol Kiri", Category: "Province"},
+	"KH-12":  {Code: "KH-12", Country: iso3166.KH, Name: "Phnom Penh", Category: "Autonomous municipality"},
+	"KH-13":  {Code: "KH-13", Country: iso3166.KH, Name: "Preah Vihear", Category: "Province"},
+	"KH-14":  {Code: "KH-14", Country: iso3166.KH, Name: "Prey Veaeng", Category: "Province"},
+	"KH-15":  {Code: "KH-15", Country: iso3166.KH, Name: "Pousaat", Category: "Province"},
+	"KH-16":  {Code: "KH-16", Country: iso3166.KH, Name: "Rotanak Kiri", Category: "Province"},
+	"KH-17":  {Code: "KH-17", Country: iso3166.KH, Name: "Siem Reab", Category: "Province"},
+	"KH-18":  {Code: "KH-18", Country: iso3166.KH, Name: "Preah Sihanouk", Category: "Province"},
+	"KH-19":  {Code: "KH-19", Country: iso3166.KH, Name: "Stoĕng Trêng", Category: "Province"},
+	"KH-2":   {Code: "KH-2", Country: iso3166.KH, Name: "Baat Dambang", Category: "Province"},
+	"KH-20":  {Code: "KH-20", Country: iso3166.KH, Name: "Svaay Rieng", Category: "Province"},
+	"KH-21":  {Code: "KH-21", Country: iso3166.KH, Name: "Taakaev", Category: "Province"},
+	"KH-22":  {Code: "KH-22", Country: iso3166.KH, Name: "Otdar Mean Chey", Category: "Province"},
+	"KH-23":  {Code: "KH-23", Country: iso3166.KH, Name: "Kaeb", Category: "Province"},
+	"KH-24":  {Code: "KH-24", Country: iso3166.KH, Name: "Pailin", Category: "Province"},
+	"KH-25":  {Code: "KH-25", Country: iso3166.KH, Name: "Tbong Khmum", Category: "Province"},
+	"KH-3":   {Code: "KH-3", Country: iso3166.KH, Name: "Kampong Chaam", Category: "Province"},
+	"KH-4":   {Code: "KH-4", Country: iso3166.KH, Name: "Kampong Chhnang", Category: "Province"},
+	"KH-5":   {Code: "KH-5", Country: iso3166.KH, Name: "Kampong Spueu", Category: "Province"},
+	"KH-6":   {Code: "KH-6", Country: iso3166.KH, Name: "Kampong Thum", Category: "Province"},
+	"KH-7":   {Code: "KH-7", Country: iso3166.KH, Name: "Kampot", Category: "Province"},
+	"KH-8":   {Code: "KH-8", Country: iso3166.KH, Name: "Kandaal", Category: "Province"},
+	"KH-9":   {Code: "KH-9", Country: iso3166.KH, Name: "Kaoh Kong", Category: "Province"},
+	"KI-G":   {Code: "KI-G", Country: iso3166.KI, Name: "Gilbert Islands", Category: "Group of islands (20 inhabited islands)"},
+	"KI-L":   {Code: "KI-L", Country: iso3166.KI, Name: "Line Islands", Category: "Group of islands (20 inhabited islands)"},
+	"KI-P":   {Code: "KI-P", Country: iso3166.KI, Name: "Phoenix Islands", Category: "Group of islands (20 inhabited islands)"},
+	"KM-A":   {Code: "KM-A", Country: iso3166.KM, Name: "Andjouân", Category: "Island"},
+	"KM-G":   {Code: "KM-G", Country: iso3166.KM, Name: "Andjazîdja", Category: "Island"},
+	"KM-M":   {Code: "KM-M", Country: iso3166.KM, Name: "Mohéli", Category: "Island"},
+	"KN-01":  {Code: "KN-01", Country: iso3166.KN, Name: "Christ Church Nichola Town", Category: "Parish", ParentCode: "KN-K"},
+	"KN-02":  {Code: "KN-02", Country: iso3166.KN, Name: "Saint Anne Sandy Point", Category: "Parish", ParentCode: "KN-K"},
+	"KN-03":  {Code: "KN-03", Country: iso3166.KN, Name: "Saint George Basseterre", Category: "Parish", ParentCode: "KN-K"},
+	"KN-04":  {Code: "KN-04", Country: iso3166.KN, Name: "Saint George Gingerland", Category: "Parish", ParentCode: "KN-N"},
+	"KN-05":  {Code: "KN-05", Country: iso3166.KN, Name: "Saint James Windward", Category: "Parish", ParentCode: "KN-N"},
+	"KN-06":  {Code: "KN-06", Country: iso3166.KN, Name: "Saint John Capisterre", Category: "Parish", ParentCode: "KN-K"},
+	"KN-07":  {Code: "KN-07", Country: iso3166.KN, Name: "Saint John Figtree", Category: "Parish", ParentCode: "KN-N"},
+	"KN-08":  {Code: "KN-08", Country: iso3166.KN, Name: "Saint Mary Cayon", Category: "Parish", ParentCode: "KN-K"},
+	"KN-09":  {Code: "KN-09", Country: iso3166.KN, Name: "Saint Paul Capisterre", Category: "Parish", ParentCode: "KN-K"},
+	"KN-10":  {Code: "KN-10", Country: iso3166.KN, Name: "Saint Paul Charlestown", Category: "Parish", ParentCode: "KN-N"},
+	"KN-11":  {Code: "KN-11", Country: iso3166.KN, Name: "Saint Peter Basseterre", Category: "Parish", ParentCode: "KN-K"},
+	"KN-12":  {Code: "KN-12", Country: iso3166.KN, Name: "Saint Thomas Lowland", Category: "Parish", ParentCode: "KN-N"},
+	"KN-13":  {Code: "KN-13", Country: iso3166.KN, Name: "Saint Thomas Middle Island", Category: "Parish", ParentCode: "KN-K"},
+	"KN-15":  {Code: "KN-15", Country: iso3166.KN, Name: "Trinity Palmetto Point", Category: "Parish", ParentCode: "KN-K"},
+	"KN-K":   {Code: "KN-K", Country: iso3166.KN, Name: "Saint Kitts", Category: "State"},
+	"KN-N":   {Code: "KN-N", Country: iso3166.KN, Name: "Nevis", Category: "State"},
+	"KP-01":  {Code: "KP-01", Country: iso3166.KP, Name: "P'yǒngyang", Category: "Capital city"},
+	"KP-02":  {Code: "KP-02", Country: iso3166.KP, Name: "P'yǒngan-namdo", Category: "Province"},
+	"KP-03":  {Code: "KP-03", Country: iso3166.KP, Name: "P'yǒngan-bukto", Category: "Province"},
+	"KP-04":  {Code: "KP-04", Country: iso3166.KP, Name: "Chagang-do", Category: "Province"},
+	"KP-05":  {Code: "KP-05", Country: iso3166.KP, Name: "Hwanghae-namdo", Category: "Province"},
+	"KP-06":  {Code: "KP-06", Country: iso3166.KP, Name: "Hwanghae-bukto", Category: "Province"},
+	"KP-07":  {Code: "KP-07", Country: iso3166.KP, Name: "Kangweonto", Category: "Province"},
+	"KP-08":  {Code: "KP-08", Country: iso3166.KP, Name: "Hamgyǒng-namdo", Category: "Province"},
+	"KP-09":  {Code: "KP-09", Country: iso3166.KP, Name: "Hamgyǒng-bukto", Category: "Province"},
+	"KP-10":  {Code: "KP-10", Country: iso3166.KP, Name: "Ryanggang-do", Category: "Province"},
+	"KP-13":  {Code: "KP-13", Country: iso3166.KP, Name: "Raseon", Category: "Special city"},
+	"KP-14":  {Code: "KP-14", Country: iso3166.KP, Name: "Nampho", Category: "Metropolitan city"},
+	"KR-11":  {Code: "KR-11", Country: iso3166.KR, Name: "Seoul-teukbyeolsi", Category: "Special city"},
+	"KR-26":  {Code: "KR-26", Country: iso3166.KR, Name: "Busan-gwangyeoksi", Category: "Metropolitan city"},
+	"KR-27":  {Code: "KR-27", Country: iso3166.KR, Name: "Daegu-gwangyeoksi", Category: "Metropolitan city"},
+	"KR-28":  {Code: "KR-28", Country: iso3166.KR, Name: "Incheon-gwangyeoksi", Category: "Metropolitan city"},
+	"KR-29":  {Code: "KR-29", Country: iso3166.KR, Name: "Gwangju-gwangyeoksi", Category: "Metropolitan city"},
+	"KR-30":  {Code: "KR-30", Country: iso3166.KR, Name: "Daejeon-gwangyeoksi", Category: "Metropolitan city"},
+	"KR-31":  {Code: "KR-31", Country: iso3166.KR, Name: "Ulsan-gwangyeoksi", Category: "Metropolitan city"},
+	"KR-41":  {Code: "KR-41", Country: iso3166.KR, Name: "Gyeonggi-do", Category: "Province"},
+	"KR-42":  {Code: "KR-42", Country: iso3166.KR, Name: "Gangwon-do", Category: "Province"},
+	"KR-43":  {Code: "KR-43", Country: iso3166.KR, Name: "Chungcheongbuk-do", Category: "Province"},
+	"KR-44":  {Code: "KR-44", Country: iso3166.KR, Name: "Chungcheongnam-do", Category: "Province"},
+	"KR-45":  {Code: "KR-45", Country: iso3166.KR, Name: "Jeollabuk-do", Category: "Province"},
+	"KR-46":  {Code: "KR-46", Country: iso3166.KR, Name: "Jeollanam-do", Category: "Province"},
+	"KR-47":  {Code: "KR-47", Country: iso3166.KR, Name: "Gyeongsangbuk-do", Category: "Province"},
+	"KR-48":  {Code: "KR-48", Country: iso3166.KR, Name: "Gyeongsangnam-do", Category: "Province"},
+	"KR-49":  {Code: "KR-49", Country: iso3166.KR, Name: "Jeju-teukbyeoljachido", Category: "Special self-governing province"},
+	"KR-50":  {Code: "KR-50", Country: iso3166.KR, Name: "Sejong", Category: "Special self-governing city"},
+	"KW-AH":  {Code: "KW-AH", Country: iso3166.KW, Name: "Al Aḩmadī", Category: "Governorate"},
+	"KW-FA":  {Code: "KW-FA", Country: iso3166.KW, Name: "Al Farwānīyah", Category: "Governorate"},
+	"KW-HA":  {Code: "KW-HA", Country: iso3166.KW, Name: "Ḩawallī", Category: "Governorate"},
+	"KW-JA":  {Code: "KW-JA", Country: iso3166.KW, Name: "Al Jahrā’", Category: "Governorate"},
+	"KW-KU":  {Code: "KW-KU", Country: iso3166.KW, Name: "Al ‘Āşimah", Category: "Governorate"},
+	"KW-MU":  {Code: "KW-MU", Country: iso3166.KW, Name: "Mubārak al Kabīr", Category: "Governorate"},
+	"KZ-AKM": {Code: "KZ-AKM", Country: iso3166.KZ, Name: "Akmolinskaja oblast'", Category: "Region"},
+	"KZ-AKT": {Code: "KZ-AKT", Country: iso3166.KZ, Name: "Aktjubinskaja oblast'", Category: "Region"},
+	"KZ-ALA": {Code: "KZ-ALA", Country: iso3166.KZ, Name: "Almaty", Category: "City"},
+	"KZ-ALM": {Code: "KZ-ALM", Country: iso3166.KZ, Name: "Almatinskaja oblast'", Category: "Region"},
+	"KZ-AST": {Code: "KZ-AST", Country: iso3166.KZ, Name: "Nur-Sultan", Category: "City"},
+	"KZ-ATY": {Code: "KZ-ATY", Country: iso3166.KZ, Name: "Atyrauskaja oblast'", Category: "Region"},
+	"KZ-KAR": {Code: "KZ-KAR", Country: iso3166.KZ, Name: "Karagandinskaja oblast'", Category: "Region"},
+	"KZ-KUS": {Code: "KZ-KUS", Country: iso3166.KZ, Name: "Kostanajskaja oblast'", Category: "Region"},
+	"KZ-KZY": {Code: "KZ-KZY", Country: iso3166.KZ, Name: "Kyzylordinskaja oblast'", Category: "Region"},
+	"KZ-MAN": {Code: "KZ-MAN", Country: iso3166.KZ, Name: "Mangghystaū oblysy", Category: "Region"},
+	"KZ-PAV": {Code: "KZ-PAV", Country: iso3166.KZ, Name: "Pavlodar oblysy", Category: "Region"},
+	"KZ-SEV": {Code: "KZ-SEV", Country: iso3166.KZ, Name: "Severo-Kazahstanskaja oblast'", Category: "Region"},
+	"KZ-SHY": {Code: "KZ-SHY", Country: iso3166.KZ, Name: "Shymkent", Category: "City"},
+	"KZ-VOS": {Code: "KZ-VOS", Country: iso3166.KZ, Name: "Shyghys Qazaqstan oblysy", Category: "Region"},
+	"KZ-YUZ": {Code: "KZ-YUZ", Country: iso3166.KZ, Name: "Turkestankaya oblast'", Category: "Region"},
+	"KZ-ZAP": {Code: "KZ-ZAP", Country: iso3166.KZ, Name: "Batys Qazaqstan oblysy", Category: "Region"},
+	"KZ-ZHA": {Code: "KZ-ZHA", Country: iso3166.KZ, Name: "Zhambyl oblysy", Category: "Region"},
+	"LA-AT":  {Code: "LA-AT", Country: iso3166.LA, Name: "Attapu", Category: "Province"},
+	"LA-BK":  {Code: "LA-BK", Country: iso3166.LA, Name: "Bokèo", Category: "Province"},
+	"LA-BL":  {Code: "LA-BL", Country: iso3166.LA, Name: "Bolikhamxai", Category: "Province"},
+	"LA-CH":  {Code: "LA-CH", Country: iso3166.LA, Name: "Champasak", Category: "Province"},
+	"LA-HO":  {Code: "LA-HO", Country: iso3166.LA, Name: "Houaphan", Category: "Province"},
+	"LA-KH":  {Code: "LA-KH", Country: iso3166.LA, Name: "Khammouan", Category: "Province"},
+	"LA-LM":  {Code: "LA-LM", Country: iso3166.LA, Name: "Louang Namtha", Category: "Province"},
+	"LA-LP":  {Code: "LA-LP", Country: iso3166.LA, Name: "Louangphabang", Category: "Province"},
+	"LA-OU":  {Code: "LA-OU", Country: iso3166.LA, Name: "Oudômxai", Category: "Province"},
+	"LA-PH":  {Code: "LA-PH", Country: iso3166.LA, Name: "Phôngsali", Category: "Province"},
+	"LA-SL":  {Code: "LA-SL", Country: iso3166.LA, Name: "Salavan", Category: "Province"},
+	"LA-SV":  {Code: "LA-SV", Country: iso3166.LA, Name: "Savannakhét", Category: "Province"},
+	"LA-VI":  {Code: "LA-VI", Country: iso3166.LA, Name: "Viangchan", Category: "Province"},
+	"LA-VT":  {Code: "LA-VT", Country: iso3166.LA, Name: "Viangchan", Category: "Prefecture"},
+	"LA-XA":  {Code: "LA-XA", Country: iso3166.LA, Name: "Xaignabouli", Category: "Province"},
+	"LA-XE":  {Code: "LA-XE", Country: iso3166.LA, Name: "Xékong", Category: "Province"},
+	"LA-XI":  {Code: "LA-XI", Country: iso3166.LA, Name: "Xiangkhouang", Category: "Province"},
+	"LA-XS":  {Code: "LA-XS", Country: iso3166.LA, Name: "Xaisômboun", Category: "Province"},
+	"LB-AK":  {Code: "LB-AK", Country: iso3166.LB, Name: "Aakkâr", Category: "Governorate"},
+	"LB-AS":  {Code: "LB-AS", Country: iso3166.LB, Name: "Ash Shimāl", Category: "Governorate"},
+	"LB-BA":  {Code: "LB-BA", Country: iso3166.LB, Name: "Bayrūt", Category: "Governorate"},
+	"LB-BH":  {Code: "LB-BH", Country: iso3166.LB, Name: "Baalbek-Hermel", Category: "Governorate"},
+	"LB-BI":  {Code: "LB-BI", Country: iso3166.LB, Name: "Al Biqā‘", Category: "Governorate"},
+	"LB-JA":  {Code: "LB-JA", Country: iso3166.LB, Name: "Al Janūb", Category: "Governorate"},
+	"LB-JL":  {Code: "LB-JL", Country: iso3166.LB, Name: "Jabal Lubnān", Category: "Governorate"},
+	"LB-NA":  {Code: "LB-NA", Country: iso3166.LB, Name: "An Nabaţīyah", Category: "Governorate"},
+	"LC-01":  {Code: "LC-01", Country: iso3166.LC, Name: "Anse la Raye", Category: "District"},
+	"LC-02":  {Code: "LC-02", Country: iso3166.LC, Name: "Castries", Category: "District"},
+	"LC-03":  {Code: "LC-03", Country: iso3166.LC, Name: "Choiseul", Category: "District"},
+	"LC-05":  {Code: "LC-05", Country: iso3166.LC, Name: "Dennery", Category: "District"},
+	"LC-06":  {Code: "LC-06", Country: iso3166.LC, Name: "Gros Islet", Category: "District"},
+	"LC-07":  {Code: "LC-07", Country: iso3166.LC, Name: "Laborie", Category: "District"},
+	"LC-08":  {Code: "LC-08", Country: iso3166.LC, Name: "Micoud", Category: "District"},
+	"LC-10":  {Code: "LC-10", Country: iso3166.LC, Name: "Soufrière", Category: "District"},
+	"LC-11":  {Code: "LC-11", Country: iso3166.LC, Name: "Vieux Fort", Category: "District"},
+	"LC-12":  {Code: "LC-12", Country: iso3166.LC, Name: "Canaries", Category: "District"},
+	"LI-01":  {Code: "LI-01", Country: iso3166.LI, Name: "Balzers", Category: "Commune"},
+	"LI-02":  {Code: "LI-02", Country: iso3166.LI, Name: "Eschen", Category: "Commune"},
+	"LI-03":  {Code: "LI-03", Country: iso3166.LI, Name: "Gamprin", Category: "Commune"},
+	"LI-04":  {Code: "LI-04", Country: iso3166.LI, Name: "Mauren", Category: "Commune"},
+	"LI-05":  {Code: "LI-05", Country: iso3166.LI, Name: "Planken", Category: "Commune"},
+	"LI-06":  {Code: "LI-06", Country: iso3166.LI, Name: "Ruggell", Category: "Commune"},
+	"LI-07":  {Code: "LI-07", Country: iso3166.LI, Name: "Schaan", Category: "Commune"},
+	"LI-08":  {Code: "LI-08", Country: iso3166.LI, Name: "Schellenberg", Category: "Commune"},
+	"LI-09":  {Code: "LI-09", Country: iso3166.LI, Name: "Triesen", Category: "Commune"},
+	"LI-10":  {Code: "LI-10", Country: iso3166.LI, Name: "Triesenberg", Category: "Commune"},
+	"LI-11":  {Code: "LI-11", Country: iso3166.LI, Name: "Vaduz", Category: "Commune"},
+	"LK-1":   {Code: "LK-1", Country: iso3166.LK, Name: "Western Province", Category: "Province"},
+	"LK-11":  {Code: "LK-11", Country: iso3166.LK, Name: "Colombo", Category: "District", ParentCode: "LK-1"},
+	"LK-12":  {Code: "LK-12", Country: iso3166.LK, Name: "Gampaha", Category: "District", ParentCode: "LK-1"},
+	"LK-13":  {Code: "LK-13", Country: iso3166.LK, Name: "Kalutara", Category: "District", ParentCode: "LK-1"},
+	"LK-2":   {Code: "LK-2", Country: iso3166.LK, Name: "Central Province", Category: "Province"},
+	"LK-21":  {Code: "LK-21", Country: iso3166.LK, Name: "Kandy", Category: "District", ParentCode: "LK-2"},
+	"LK-22":  {Code: "LK-22", Country: iso3166.LK, Name: "Matale", Category: "District", ParentCode: "LK-2"},
+	"LK-23":  {Code: "LK-23", Country: iso3166.LK, Name: "Nuwara Eliya", Category: "District", ParentCode: "LK-2"},
+	"LK-3":   {Code: "LK-3", Country: iso3166.LK, Name: "Southern Province", Category: "Province"},
+	"LK-31":  {Code: "LK-31", Country: iso3166.LK, Name: "Galle", Category: "District", ParentCode: "LK-3"},
+	"LK-32":  {Code: "LK-32", Country: iso3166.LK, Name: "Matara", Category: "District", ParentCode: "LK-3"},
+	"LK-33":  {Code: "LK-33", Country: iso3166.LK, Name: "Hambantota", Category: "District", ParentCode: "LK-3"},
+	"LK-4":   {Code: "LK-4", Country: iso3166.LK, Name: "Northern Province", Category: "Province"},
+	"LK-41":  {Code: "LK-41", Country: iso3166.LK, Name: "Jaffna", Category: "District", ParentCode: "LK-4"},
+	"LK-42":  {Code: "LK-42", Country: iso3166.LK, Name: "Kilinochchi", Category: "District", ParentCode: "LK-4"},
+	"LK-43":  {Code: "LK-43", Country: iso3166.LK, Name: "Mannar", Category: "District", ParentCode: "LK-4"},
+	"LK-44":  {Code: "LK-44", Country: iso3166.LK, Name: "Vavuniya", Category: "District", ParentCode: "LK-4"},
+	"LK-45":  {Code: "LK-45", Country: iso3166.LK, Name: "Mullaittivu", Category: "District", ParentCode: "LK-4"},
+	"LK-5":   {Code: "LK-5", Country: iso3166.LK, Name: "Eastern Province", Category: "Province"},
+	"LK-51":  {Code: "LK-51", Country: iso3166.LK, Name: "Batticaloa", Category: "District", ParentCode: "LK-5"},
+	"LK-52":  {Code: "LK-52", Country: iso3166.LK, Name: "Ampara", Category: "District", ParentCode: "LK-5"},
+	"LK-53":  {Code: "LK-53", Country: iso3166.LK, Name: "Trincomalee", Category: "District", ParentCode: "LK-5"},
+	"LK-6":   {Code: "LK-6", Country: iso3166.LK, Name: "North Western Province", Category: "Province"},
+	"LK-61":  {Code: "LK-61", Country: iso3166.LK, Name: "Kurunegala", Category: "District", ParentCode: "LK-6"},
+	"LK-62":  {Code: "LK-62", Country: iso3166.LK, Name: "Puttalam", Category: "District", ParentCode: "LK-6"},
+	"LK-7":   {Code: "LK-7", Country: iso3166.LK, Name: "North Central Province", Category: "Province"},
+	"LK-71":  {Code: "LK-71", Country: iso3166.LK, Name: "Anuradhapura", Category: "District", ParentCode: "LK-7"},
+	"LK-72":  {Code: "LK-72", Country: iso3166.LK, Name: "Polonnaruwa", Category: "District", ParentCode: "LK-7"},
+	"LK-8":   {Code: "LK-8", Country: iso3166.LK, Name: "Uva Province", Category: "Province"},
+	"LK-81":  {Code: "LK-81", Country: iso3166.LK, Name: "Badulla", Category: "District", ParentCode: "LK-8"},
+	"LK-82":  {Code: "LK-82", Country: iso3166.LK, Name: "Monaragala", Category: "District", ParentCode: "LK-8"},
+	"LK-9":   {Code: "LK-9", Country: iso3166.LK, Name: "Sabaragamuwa Province", Category: "Province"},
+	"LK-91":  {Code: "LK-91", Country: iso3166.LK, Name: "Ratnapura", Category: "District", ParentCode: "LK-9"},
+	"LK-92":  {Code: "LK-92", Country: iso3166.LK, Name: "Kegalla", Category: "District", ParentCode: "LK-9"},
+	"LR-BG":  {Code: "LR-BG", Country: iso3166.LR, Name: "Bong", Category: "County"},
+	"LR-BM":  {Code: "LR-BM", Country: iso3166.LR, Name: "Bomi", Category: "County"},
+	"LR-CM":  {Code: "LR-CM", Country: iso3166.LR, Name: "Grand Cape Mount", Category: "County"},
+	"LR-GB":  {Code: "LR-GB", Country: iso3166.LR, Name: "Grand Bassa", Category: "County"},
+	"LR-GG":  {Code: "LR-GG", Country: iso3166.LR, Name: "Grand Gedeh", Category: "County"},
+	"LR-GK":  {Code: "LR-GK", Country: iso3166.LR, Name: "Grand Kru", Category: "County"},
+	"LR-GP":  {Code: "LR-GP", Country: iso3166.LR, Name: "Gbarpolu", Category: "County"},
+	"LR-LO":  {Code: "LR-LO", Country: iso3166.LR, Name: "Lofa", Category: "County"},
+	"LR-MG":  {Code: "LR-MG", Country: iso3166.LR, Name: "Margibi", Category: "County"},
+	"LR-MO":  {Code: "LR-MO", Country: iso3166.LR, Name: "Montserrado", Category: "County"},
+	"LR-MY":  {Code: "LR-MY", Country: iso3166.LR, Name: "Maryland", Category: "County"},
+	"LR-NI":  {Code: "LR-NI", Country: iso3166.LR, Name: "Nimba", Category: "County"},
+	"LR-RG":  {Code: "LR-RG", Country: iso3166.LR, Name: "River Gee", Category: "County"},
+	"LR-RI":  {Code: "LR-RI", Country: iso3166.LR, Name: "River Cess", Category: "County"},
+	"LR-SI":  {Code: "LR-SI", Country: iso3166.LR, Name: "Sinoe", Category: "County"},
+	"LS-A":   {Code: "LS-A", Country: iso3166.LS, Name: "Maseru", Category: "District"},
+	"LS-B":   {Code: "LS-B", Country: iso3166.LS, Name: "Botha-Bothe", Category: "District"},
+	"LS-C":   {Code: "LS-C", Country: iso3166.LS, Name: "Leribe", Category: "District"},
+	"LS-D":   {Code: "LS-D", Country: iso3166.LS, Name: "Berea", Category: "District"},
+	"LS-E":   {Code: "LS-E", Country: iso3166.LS, Name: "Mafeteng", Category: "District"},
+	"LS-F":   {Code: "LS-F", Country: iso3166.LS, Name: "Mohale's Hoek", Category: "District"},
+	"LS-G":   {Code: "LS-G", Country: iso3166.LS, Name: "Quthing", Category: "District"},
+	"LS-H":   {Code: "LS-H", Country: iso3166.LS, Name: "Qacha's Nek", Category: "District"},
+	"LS-J":   {Code: "LS-J", Country: iso3166.LS, Name: "Mokhotlong", Category: "District"},
+	"LS-K":   {Code: "LS-K", Country: iso3166.LS, Name: "Thaba-Tseka", Category: "District"},
+	"LT-01":  {Code: "LT-01", Country: iso3166.LT, Name: "Akmenė", Category: "District municipality"},
+	"LT-02":  {Code: "LT-02", Country: iso3166.LT, Name: "Alytaus miestas", Category: "City municipality"},
+	"LT-03":  {Code: "LT-03", Country: iso3166.LT, Name: "Alytus", Category: "District municipality"},
+	"LT-04":  {Code: "LT-04", Country: iso3166.LT, Name: "Anykščiai", Category: "District municipality"},
+	"LT-05":  {Code: "LT-05", Country: iso3166.LT, Name: "Birštono", Category: "Municipality"},
+	"LT-06":  {Code: "LT-06", Country: iso3166.LT, Name: "Biržai", Category: "District municipality"},
+	"LT-07":  {Code: "LT-07", Country: iso3166.LT, Name: "Druskininkai", Category: "Municipality"},
+	"LT-08":  {Code: "LT-08", Country: iso3166.LT, Name: "Elektrėnai", Category: "Municipality"},
+	"LT-09":  {Code: "LT-09", Country: iso3166.LT, Name: "Ignalina", Category: "District municipality"},
+	"LT-10":  {Code: "LT-10", Country: iso3166.LT, Name: "Jonava", Category: "District municipality"},
+	"LT-11":  {Code: "LT-11", Country: iso3166.LT, Name: "Joniškis", Category: "District municipality"},
+	"LT-12":  {Code: "LT-12", Country: iso3166.LT, Name: "Jurbarkas", Category: "District municipality"},
+	"LT-13":  {Code: "LT-13", Country: iso3166.LT, Name: "Kaišiadorys", Category: "District municipality"},
+	"LT-14":  {Code: "LT-14", Country: iso3166.LT, Name: "Kalvarijos", Category: "Municipality"},
+	"LT-15":  {Code: "LT-15", Country: iso3166.LT, Name: "Kauno miestas", Category: "City municipality"},
+	"LT-16":  {Code: "LT-16", Country: iso3166.LT, Name: "Kaunas", Category: "District municipality"},
+	"LT-17":  {Code: "LT-17", Country: iso3166.LT, Name: "Kazlų Rūdos", Category: "Municipality"},
+	"LT-18":  {Code: "LT-18", Country: iso3166.LT, Name: "Kėdainiai", Category: "District municipality"},
+	"LT-19":  {Code: "LT-19", Country: iso3166.LT, Name: "Kelmė", Category: "District municipality"},
+	"LT-20":  {Code: "LT-20", Country: iso3166.LT, Name: "Klaipėdos miestas", Category: "City municipality"},
+	"LT-21":  {Code: "LT-21", Country: iso3166.LT, Name: "Klaipėda", Category: "District municipality"},
+	"LT-22":  {Code: "LT-22", Country: iso3166.LT, Name: "Kretinga", Category: "District municipality"},
+	"LT-23":  {Code: "LT-23", Country: iso3166.LT, Name: "Kupiškis", Category: "District municipality"},
+	"LT-24":  {Code: "LT-24", Country: iso3166.LT, Name: "Lazdijai", Category: "District municipality"},
+	"LT-25":  {Code: "LT-25", Country: iso3166.LT, Name: "Marijampolė", Category: "District municipality"},
+	"LT-26":  {Code: "LT-26", Country: iso3166.LT, Name: "Mažeikiai", Category: "District municipality"},
+	"LT-27":  {Code: "LT-27", Country: iso3166.LT, Name: "Molėtai", Category: "District municipality"},
+	"LT-28":  {Code: "LT-28", Country: iso3166.LT, Name: "Neringa", Category: "Municipality"},
+	"LT-29":  {Code: "LT-29", Country: iso3166.LT, Name: "Pagėgiai", Category: "Municipality"},
+	"LT-30":  {Code: "LT-30", Country: iso3166.LT, Name: "Pakruojis", Category: "District municipality"},
+	"LT-31":  {Code: "LT-31", Country: iso3166.LT, Name: "Palangos miestas", Category: "City municipality"},
+	"LT-32":  {Code: "LT-32", Country: iso3166.LT, Name: "Panevėžio miestas", Category: "City municipality"},
+	"LT-33":  {Code: "LT-33", Country: iso3166.LT, Name: "Panevėžys", Category: "District municipality"},
+	"LT-34":  {Code: "LT-34", Country: iso3166.LT, Name: "Pasvalys", Category: "District municipality"},
+	"LT-35":  {Code: "LT-35", Country: iso3166.LT, Name: "Plungė", Category: "District municipality"},
+	"LT-36":  {Code: "LT-36", Country: iso3166.LT, Name: "Prienai", Category: "District municipality"},
+	"LT-37":  {Code: "LT-37", Country: iso3166.LT, Name: "Radviliškis", Category: "District municipality"},
+	"LT-38":  {Code: "LT-38", Country: iso3166.LT, Name: "Raseiniai", Category: "District municipality"},
+	"LT-39":  {Code: "LT-39", Country: iso3166.LT, Name: "Rietavo", Category: "Municipality"},
+	"LT-40":  {Code: "LT-40", Country: iso3166.LT, Name: "Rokiškis", Category: "District municipality"},
+	"LT-41":  {Code: "LT-41", Country: iso3166.LT, Name: "Šakiai", Category: "District municipality"},
+	"LT-42":  {Code: "LT-42", Country: iso3166.LT, Name: "Šalčininkai", Category: "District municipality"},
+	"LT-43":  {Code: "LT-43", Country: iso3166.LT, Name: "Šiaulių miestas", Category: "City municipality"},
+	"LT-44":  {Code: "LT-44", Country: iso3166.LT, Name: "Šiauliai", Category: "District municipality"},
+	"LT-45":  {Code: "LT-45", Country: iso3166.LT, Name: "Šilalė", Category: "District municipality"},
+	"LT-46":  {Code: "LT-46", Country: iso3166.LT, Name: "Šilutė", Category: "District municipality"},
+	"LT-47":  {Code: "LT-47", Country: iso3166.LT, Name: "Širvintos", Category: "District municipality"},
+	"LT-48":  {Code: "LT-48", Country: iso3166.LT, Name: "Skuodas", Category: "District municipality"},
+	"LT-49":  {Code: "LT-49", Country: iso3166.LT, Name: "Švenčionys", Category: "District municipality"},
+	"LT-50":  {Code: "LT-50", Country: iso3166.LT, Name: "Tauragė", Category: "District municipality"},
+	"LT-51":  {Code: "LT-51", Country: iso3166.LT, Name: "Telšiai", Category: "District municipality"},
+	"LT-52":  {Code: "LT-52", Country: iso3166.LT, Name: "Trakai", Category: "District municipality"},
+	"LT-53":  {Code: "LT-53", Country: iso3166.LT, Name: "Ukmergė", Category: "District municipality"},
+	"LT-54":  {Code: "LT-54", Country: iso3166.LT, Name: "Utena", Category: "District municipality"},
+	"LT-55":  {Code: "LT-55", Country: iso3166.LT, Name: "Varėna", Category: "District municipality"},
+	"LT-56":  {Code: "LT-56", Country: iso3166.LT, Name: "Vilkaviškis", Category: "District municipality"},
+	"LT-57":  {Code: "LT-57", Country: iso3166.LT, Name: "Vilniaus miestas", Category: "City municipality"},
+	"LT-58":  {Code: "LT-58", Country: iso3166.LT, Name: "Vilnius", Category: "District municipality"},
+	"LT-59":  {Code: "LT-59", Country: iso3166.LT, Name: "Visaginas", Category: "Municipality"},
+	"LT-60":  {Code: "LT-60", Country: iso3166.LT, Name: "Zarasai", Category: "District municipality"},
+	"LT-AL":  {Code: "LT-AL", Country: iso3166.LT, Name: "Alytaus apskritis", Category: "County"},
+	"LT-KL":  {Code: "LT-KL", Country: iso3166.LT, Name: "Klaipėdos apskritis", Category: "County"},
+	"LT-KU":  {Code: "LT-KU", Country: iso3166.LT, Name: "Kauno apskritis", Category: "County"},
+	"LT-MR":  {Code: "LT-MR", Country: iso3166.LT, Name: "Marijampolės apskritis", Category: "County"},
+	"LT-PN":  {Code: "LT-PN", Country: iso3166.LT, Name: "Panevėžio apskritis", Category: "County"},
+	"LT-SA":  {Code: "LT-SA", Country: iso3166.LT, Name: "Šiaulių apskritis", Category: "County"},
+	"LT-TA":  {Code: "LT-TA", Country: iso3166.LT, Name: "Tauragės apskritis", Category: "County"},
+	"LT-TE":  {Code: "LT-TE", Country: iso3166.LT, Name: "Telšių apskritis", Category: "County"},
+	"LT-UT":  {Code: "LT-UT", Country: iso3166.LT, Name: "Utenos apskritis", Category: "County"},
+	"LT-VL":  {Code: "LT-VL", Country: iso3166.LT, Name: "Vilniaus apskritis", Category: "County"},
+	"LU-CA":  {Code: "LU-CA", Country: iso3166.LU, Name: "Capellen", Category: "Canton"},
+	"LU-CL":  {Code: "LU-CL", Country: iso3166.LU, Name: "Clerf", Category: "Canton"},
+	"LU-DI":  {Code: "LU-DI", Country: iso3166.LU, Name: "Diekirch", Category: "Canton"},
+	"LU-EC":  {Code: "LU-EC", Country: iso3166.LU, Name: "Echternach", Category: "Canton"},
+	"LU-ES":  {Code: "LU-ES", Country: iso3166.LU, Name: "Esch an der Alzette", Category: "Canton"},
+	"LU-GR":  {Code: "LU-GR", Country: iso3166.LU, Name: "Grevenmacher", Category: "Canton"},
+	"LU-LU":  {Code: "LU-LU", Country: iso3166.LU, Name: "Luxembourg", Category: "Canton"},
+	"LU-ME":  {Code: "LU-ME", Country: iso3166.LU, Name: "Mersch", Category: "Canton"},
+	"LU-RD":  {Code: "LU-RD", Country: iso3166.LU, Name: "Redange", Category: "Canton"},
+	"LU-RM":  {Code: "LU-RM", Country: iso3166.LU, Name: "Remich", Category: "Canton"},
+	"LU-VD":  {Code: "LU-VD", Country: iso3166.LU, Name: "Veianen", Category: "Canton"},
+	"LU-WI":  {Code: "LU-WI", Country: iso3166.LU, Name: "Wiltz", Category: "Canton"},
+	"LV-001": {Code: "LV-001", Country: iso3166.LV, Name: "Aglonas novads", Category: "Municipality"},
+	"LV-002": {Code: "LV-002", Country: iso3166.LV, Name: "Aizkraukles novads", Category: "Municipality"},
+	"LV-003": {Code: "LV-003", Country: iso3166.LV, Name: "Aizputes novads", Category: "Municipality"},
+	"LV-004": {Code: "LV-004", Country: iso3166.LV, Name: "Aknīstes novads", Category: "Municipality"},
+	"LV-005": {Code: "LV-005", Country: iso3166.LV, Name: "Alojas novads", Category: "Municipality"},
+	"LV-006": {Code: "LV-006", Country: iso3166.LV, Name: "Alsungas novads", Category: "Municipality"},
+	"LV-007": {Code: "LV-007", Country: iso3166.LV, Name: "Alūksnes novads", Category: "Municipality"},
+	"LV-008": {Code: "LV-008", Country: iso3166.LV, Name: "Amatas novads", Category: "Municipality"},
+	"LV-009": {Code: "LV-009", Country: iso3166.LV, Name: "Apes novads", Category: "Municipality"},
+	"LV-010": {Code: "LV-010", Country: iso3166.LV, Name: "Auces novads", Category: "Municipality"},
+	"LV-011": {Code: "LV-011", Country: iso3166.LV, Name: "Ādažu novads", Category: "Municipality"},
+	"LV-012": {Code: "LV-012", Country: iso3166.LV, Name: "Babītes novads", Category: "Municipality"},
+	"LV-013": {Code: "LV-013", Country: iso3166.LV, Name: "Baldones novads", Category: "Municipality"},
+	"LV-014": {Code: "LV-014", Country: iso3166.LV, Name: "Baltinavas novads", Category: "Municipality"},
+	"LV-015": {Code: "LV-015", Country: iso3166.LV, Name: "Balvu novads", Category: "Municipality"},
+	"LV-016": {Code: "LV-016", Country: iso3166.LV, Name: "Bauskas novads", Category: "Municipality"},
+	"LV-017": {Code: "LV-017", Country: iso3166.LV, Name: "Beverīnas novads", Category: "Municipality"},
+	"LV-018": {Code: "LV-018", Country: iso3166.LV, Name: "Brocēnu novads", Category: "Municipality"},
+	"LV-019": {Code: "LV-019", Country: iso3166.LV, Name: "Burtnieku novads", Category: "Municipality"},
+	"LV-020": {Code: "LV-020", Country: iso3166.LV, Name: "Carnikavas novads", Category: "Municipality"},
+	"LV-021": {Code: "LV-021", Country: iso3166.LV, Name: "Cesvaines novads", Category: "Municipality"},
+	"LV-022": {Code: "LV-022", Country: iso3166.LV, Name: "Cēsu novads", Category: "Municipality"},
+	"LV-023": {Code: "LV-023", Country: iso3166.LV, Name: "Ciblas novads", Category: "Municipality"},
+	"LV-024": {Code: "LV-024", Country: iso3166.LV, Name: "Dagdas novads", Category: "Municipality"},
+	"LV-025": {Code: "LV-025", Country: iso3166.LV, Name: "Daugavpils novads", Category: "Municipality"},
+	"LV-026": {Code: "LV-026", Country: iso3166.LV, Name: "Dobeles novads", Category: "Municipality"},
+	"LV-027": {Code: "LV-027", Country: iso3166.LV, Name: "Dundagas novads", Category: "Municipality"},
+	"LV-028": {Code: "LV-028", Country: iso3166.LV, Name: "Durbes novads", Category: "Municipality"},
+	"LV-029": {Code: "LV-029", Country: iso3166.LV, Name: "Engures novads", Category: "Municipality"},
+	"LV-030": {Code: "LV-030", Country: iso3166.LV, Name: "Ērgļu novads", Category: "Municipality"},
+	"LV-031": {Code: "LV-031", Country: iso3166.LV, Name: "Garkalnes novads", Category: "Municipality"},
+	"LV-032": {Code: "LV-032", Country: iso3166.LV, Name: "Grobiņas novads", Category: "Municipality"},
+	"LV-033": {Code: "LV-033", Country: iso3166.LV, Name: "Gulbenes novads", Category: "Municipality"},
+	"LV-034": {Code: "LV-034", Country: iso3166.LV, Name: "Iecavas novads", Category: "Municipality"},
+	"LV-035": {Code: "LV-035", Country: iso3166.LV, Name: "Ikšķiles novads", Category: "Municipality"},
+	"LV-036": {Code: "LV-036", Country: iso3166.LV, Name: "Ilūkstes novads", Category: "Municipality"},
+	"LV-037": {Code: "LV-037", Country: iso3166.LV, Name: "Inčukalna novads", Category: "Municipality"},
+	"LV-038": {Code: "LV-038", Country: iso3166.LV, Name: "Jaunjelgavas novads", Category: "Municipality"},
+	"LV-039": {Code: "LV-039", Country: iso3166.LV, Name: "Jaunpiebalgas novads", Category: "Municipality"},
+	"LV-040": {Code: "LV-040", Country: iso3166.LV, Name: "Jaunpils novads", Category: "Municipality"},
+	"LV-041": {Code: "LV-041", Country: iso3166.LV, Name: "Jelgavas novads", Category: "Municipality"},
+	"LV-042": {Code: "LV-042", Country: iso3166.LV, Name: "Jēkabpils novads", Category: "Municipality"},
+	"LV-043": {Code: "LV-043", Country: iso3166.LV, Name: "Kandavas novads", Category: "Municipality"},
+	"LV-044": {Code: "LV-044", Country: iso3166.LV, Name: "Kārsavas novads", Category: "Municipality"},
+	"LV-045": {Code: "LV-045", Country: iso3166.LV, Name: "Kocēnu novads", Category: "Municipality"},
+	"LV-046": {Code: "LV-046", Country: iso3166.LV, Name: "Kokneses novads", Category: "Municipality"},
+	"LV-047": {Code: "LV-047", Country: iso3166.LV, Name: "Krāslavas novads", Category: "Municipality"},
+	"LV-048": {Code: "LV-048", Country: iso3166.LV, Name: "Krimuldas novads", Category: "Municipality"},
+	"LV-049": {Code: "LV-049", Country: iso3166.LV, Name: "Krustpils novads", Category: "Municipality"},
+	"LV-050": {Code: "LV-050", Country: iso3166.LV, Name: "Kuldīgas novads", Category: "Municipality"},
+	"LV-051": {Code: "LV-051", Country: iso3166.LV, Name: "Ķeguma novads", Category: "Municipality"},
+	"LV-052": {Code: "LV-052", Country: iso3166.LV, Name: "Ķekavas novads", Category: "Municipality"},
+	"LV-053": {Code: "LV-053", Country: iso3166.LV, Name: "Lielvārdes novads", Category: "Municipality"},
+	"LV-054": {Code: "LV-054", Country: iso3166.LV, Name: "Limbažu novads", Category: "Municipality"},
+	"LV-055": {Code: "LV-055", Country: iso3166.LV, Name: "Līgatnes novads", Category: "Municipality"},
+	"LV-056": {Code: "LV-056", Country: iso3166.LV, Name: "Līvānu novads", Category: "Municipality"},
+	"LV-057": {Code: "LV-057", Country: iso3166.LV, Name: "Lubānas novads", Category: "Municipality"},
+	"LV-058": {Code: "LV-058", Country: iso3166.LV, Name: "Ludzas novads", Category: "Municipality"},
+	"LV-059": {Code: "LV-059", Country: iso3166.LV, Name: "Madonas novads", Category: "Municipality"},
+	"LV-060": {Code: "LV-060", Country: iso3166.LV, Name: "Mazsalacas novads", Category: "Municipality"},
+	"LV-061": {Code: "LV-061", Country: iso3166.LV, Name: "Mālpils novads", Category: "Municipality"},
+	"LV-062": {Code: "LV-062", Country: iso3166.LV, Name: "Mārupes novads", Category: "Municipality"},
+	"LV-063": {Code: "LV-063", Country: iso3166.LV, Name: "Mērsraga novads", Category: "Municipality"},
+	"LV-064": {Code: "LV-064", Country: iso3166.LV, Name: "Naukšēnu novads", Category: "Municipality"},
+	"LV-065": {Code: "LV-065", Country: iso3166.LV, Name: "Neretas novads", Category: "Municipality"},
+	"LV-066": {Code: "LV-066", Country: iso3166.LV, Name: "Nīcas novads", Category: "Municipality"},
+	"LV-067": {Code: "LV-067", Country: iso3166.LV, Name: "Ogres novads", Category: "Municipality"},
+	"LV-068": {Code: "LV-068", Country: iso3166.LV, Name: "Olaines novads", Category: "Municipality"},
+	"LV-069": {Code: "LV-069", Country: iso3166.LV, Name: "Ozolnieku novads", Category: "Municipality"},
+	"LV-070": {Code: "LV-070", Country: iso3166.LV, Name: "Pārgaujas novads", Category: "Municipality"},
+	"LV-071": {Code: "LV-071", Country: iso3166.LV, Name: "Pāvilostas novads", Category: "Municipality"},
+	"LV-072": {Code: "LV-072", Country: iso3166.LV, Name: "Pļaviņu novads", Category: "Municipality"},
+	"LV-073": {Code: "LV-073", Country: iso3166.LV, Name: "Preiļu novads", Category: "Municipality"},
+	"LV-074": {Code: "LV-074", Country: iso3166.LV, Name: "Priekules novads", Category: "Municipality"},
+	"LV-075": {Code: "LV-075", Country: iso3166.LV, Name: "Priekuļu novads", Category: "Municipality"},
+	"LV-076": {Code: "LV-076", Country: iso3166.LV, Name: "Raunas novads", Category: "Municipality"},
+	"LV-077": {Code: "LV-077", Country: iso3166.LV, Name: "Rēzeknes novads", Category: "Municipality"},
+	"LV-078": {Code: "LV-078", Country: iso3166.LV, Name: "Riebiņu novads", Category: "Municipality"},
+	"LV-079": {Code: "LV-079", Country: iso3166.LV, Name: "Rojas novads", Category: "Municipality"},
+	"LV-080": {Code: "LV-080", Country: iso3166.LV, Name: "Ropažu novads", Category: "Municipality"},
+	"LV-081": {Code: "LV-081", Country: iso3166.LV, Name: "Rucavas novads", Category: "Municipality"},
+	"LV-082": {Code: "LV-082", Country: iso3166.LV, Name: "Rugāju novads", Category: "Municipality"},
+	"LV-083": {Code: "LV-083", Country: iso3166.LV, Name: "Rundāles novads", Category: "Municipality"},
+	"LV-084": {Code: "LV-084", Country: iso3166.LV, Name: "Rūjienas novads", Category: "Municipality"},
+	"LV-085": {Code: "LV-085", Country: iso3166.LV, Name: "Salas novads", Category: "Municipality"},
+	"LV-086": {Code: "LV-086", Country: iso3166.LV, Name: "Salacgrīvas novads", Category: "Municipality"},
+	"LV-087": {Code: "LV-087", Country: iso3166.LV, Name: "Salaspils novads", Category: "Municipality"},
+	"LV-088": {Code: "LV-088", Country: iso3166.LV, Name: "Saldus novads", Category: "Municipality"},
+	"LV-089": {Code: "LV-089", Country: iso3166.LV, Name: "Saulkrastu novads", Category: "Municipality"},
+	"LV-090": {Code: "LV-090", Country: iso3166.LV, Name: "Sējas novads", Category: "Municipality"},
+	"LV-091": {Code: "LV-091", Country: iso3166.LV, Name: "Siguldas novads", Category: "Municipality"},
+	"LV-092": {Code: "LV-092", Country: iso3166.LV, Name: "Skrīveru novads", Category: "Municipality"},
+	"LV-093": {Code: "LV-093", Country: iso3166.LV, Name: "Skrundas novads", Category: "Municipality"},
+	"LV-094": {Code: "LV-094", Country: iso3166.LV, Name: "Smiltenes novads", Category: "Municipality"},
+	"LV-095": {Code: "LV-095", Country: iso3166.LV, Name: "Stopiņu novads", Category: "Municipality"},
+	"LV-096": {Code: "LV-096", Country: iso3166.LV, Name: "Strenču novads", Category: "Municipality"},
+	"LV-097": {Code: "LV-097", Country: iso3166.LV, Name: "Talsu novads", Category: "Municipality"},
+	"LV-098": {Code: "LV-098", Country: iso3166.LV, Name: "Tērvetes novads", Category: "Municipality"},
+	"LV-099": {Code: "LV-099", Country: iso3166.LV, Name: "Tukuma novads", Category: "Municipality"},
+	"LV-100": {Code: "LV-100", Country: iso3166.LV, Name: "Vaiņodes novads", Category: "Municipality"},
+	"LV-101": {Code: "LV-101", Country: iso3166.LV, Name: "Valkas novads", Category: "Municipality"},
+	"LV-102": {Code: "LV-102", Country: iso3166.LV, Name: "Varakļānu novads", Category: "Municipality"},
+	"LV-103": {Code: "LV-103", Country: iso3166.LV, Name: "Vārkavas novads", Category: "Municipality"},
+	"LV-104": {Code: "LV-104", Country: iso3166.LV, Name: "Vecpiebalgas novads", Category: "Municipality"},
+	"LV-105": {Code: "LV-105", Country: iso3166.LV, Name: "Vecumnieku novads", Category: "Municipality"},
+	"LV-106": {Code: "LV-106", Country: iso3166.LV, Name: "Ventspils novads", Category: "Municipality"},
+	"LV-107": {Code: "LV-107", Country: iso3166.LV, Name: "Viesītes novads", Category: "Municipality"},
+	"LV-108": {Code: "LV-108", Country: iso3166.LV, Name: "Viļakas novads", Category: "Municipality"},
+	"LV-109": {Code: "LV-109", Country: iso3166.LV, Name: "Viļānu novads", Category: "Municipality"},
+	"LV-110": {Code: "LV-110", Country: iso3166.LV, Name: "Zilupes novads", Category: "Municipality"},
+	"LV-DGV": {Code: "LV-DGV", Country: iso3166.LV, Name: "Daugavpils", Category: "Republican city"},
+	"LV-JEL": {Code: "LV-JEL", Country: iso3166.LV, Name: "Jelgava", Category: "Republican city"},
+	"LV-JKB": {Code: "LV-JKB", Country: iso3166.LV, Name: "Jēkabpils", Category: "Republican city"},
+	"LV-JUR": {Code: "LV-JUR", Country: iso3166.LV, Name: "Jūrmala", Category: "Republican city"},
+	"LV-LPX": {Code: "LV-LPX", Country: iso3166.LV, Name: "Liepāja", Category: "Republican city"},
+	"LV-REZ": {Code: "LV-REZ", Country: iso3166.LV, Name: "Rēzekne", Category: "Republican city"},
+	"LV-RIX": {Code: "LV-RIX", Country: iso3166.LV, Name: "Rīga", Category: "Republican city"},
+	"LV-VEN": {Code: "LV-VEN", Country: iso3166.LV, Name: "Ventspils", Category: "Republican city"},
+	"LV-VMR": {Code: "LV-VMR", Country: iso3166.LV, Name: "Valmiera", Category: "Republican city"},
+	"LY-BA":  {Code: "LY-BA", Country: iso3166.LY, Name: "Banghāzī", Category: "Popularate"},
+	"LY-BU":  {Code: "LY-BU", Country: iso3166.LY, Name: "Al Buţnān", Category: "Popularate"},
+	"LY-DR":  {Code: "LY-DR", Country: iso3166.LY, Name: "Darnah", Category: "Popularate"},
+	"LY-GT":  {Code: "LY-GT", Country: iso3166.LY, Name: "Ghāt", Category: "Popularate"},
+	"LY-JA":  {Code: "LY-JA", Country: iso3166.LY, Name: "Al Jabal al Akhḑar", Category: "Popularate"},
+	"LY-JG":  {Code: "LY-JG", Country: iso3166.LY, Name: "Al Jabal al Gharbī", Category: "Popularate"},
+	"LY-JI":  {Code: "LY-JI", Country: iso3166.LY, Name: "Al Jafārah", Category: "Popularate"},
+	"LY-JU":  {Code: "LY-JU", Country: iso3166.LY, Name: "Al Jufrah", Category: "Popularate"},
+	"LY-KF":  {Code: "LY-KF", Country: iso3166.LY, Name: "Al Kufrah", Category: "Popularate"},
+	"LY-MB":  {Code: "LY-MB", Country: iso3166.LY, Name: "Al Marqab", Category: "Popularate"},
+	"LY-MI":  {Code: "LY-MI", Country: iso3166.LY, Name: "Mişrātah", Category: "Popularate"},
+	"LY-MJ":  {Code: "LY-MJ", Country: iso3166.LY, Name: "Al Marj", Category: "Popularate"},
+	"LY-MQ":  {Code: "LY-MQ", Country: iso3166.LY, Name: "Murzuq", Category: "Popularate"},
+	"LY-NL":  {Code: "LY-NL", Country: iso3166.LY, Name: "Nālūt", Category: "Popularate"},
+	"LY-NQ":  {Code: "LY-NQ", Country: iso3166.LY, Name: "An Nuqāţ al Khams", Category: "Popularate"},
+	"LY-SB":  {Code: "LY-SB", Country: iso3166.LY, Name: "Sabhā", Category: "Popularate"},
+	"LY-SR":  {Code: "LY-SR", Country: iso3166.LY, Name: "Surt", Category: "Popularate"},
+	"LY-TB":  {Code: "LY-TB", Country: iso3166.LY, Name: "Ţarābulus", Category: "Popularate"},
+	"LY-WA":  {Code: "LY-WA", Country: iso3166.LY, Name: "Al Wāḩāt", Category: "Popularate"},
+	"LY-WD":  {Code: "LY-WD", Country: iso3166.LY, Name: "Wādī al Ḩayāt", Category: "Popularate"},
+	"LY-WS":  {Code: "LY-WS", Country: iso3166.LY, Name: "Wādī ash Shāţi’", Category: "Popularate"},
+	"LY-ZA":  {Code: "LY-ZA", Country: iso3166.LY, Name: "Az Zāwiyah", Category: "Popularate"},
+	"MA-01":  {Code: "MA-01", Country: iso3166.MA, Name: "Tanger-Tétouan-Al Hoceïma", Category: "Region"},
+	"MA-02":  {Code: "MA-02", Country: iso3166.MA, Name: "L'Oriental", Category: "Region"},
+	"MA-03":  {Code: "MA-03", Country: iso3166.MA, Name: "Fès-Meknès", Category: "Region"},
+	"MA-04":  {Code: "MA-04", Country: iso3166.MA, Name: "Rabat-Salé-Kénitra", Category: "Region"},
+	"MA-05":  {Code: "MA-05", Country: iso3166.MA, Name: "Béni Mellal-Khénifra", Category: "Region"},
+	"MA-06":  {Code: "MA-06", Country: iso3166.MA, Name: "Casablanca-Settat", Category: "Region"},
+	"MA-07":  {Code: "MA-07", Country: iso3166.MA, Name: "Marrakech-Safi", Category: "Region"},
+	"MA-08":  {Code: "MA-08", Country: iso3166.MA, Name: "Drâa-Tafilalet", Category: "Region"},
+	"MA-09":  {Code: "MA-09", Country: iso3166.MA, Name: "Souss-Massa", Category: "Region"},
+	"MA-10":  {Code: "MA-10", Country: iso3166.MA, Name: "Guelmim-Oued Noun (EH-partial)", Category: "Region"},
+	"MA-11":  {Code: "MA-11", Country: iso3166.MA, Name: "Laâyoune-Sakia El Hamra (EH-partial)", Category: "Region"},
+	"MA-12":  {Code: "MA-12", Country: iso3166.MA, Name: "Dakhla-Oued Ed-Dahab (EH)", Category: "Region"},
+	"MA-AGD": {Code: "MA-AGD", Country: iso3166.MA, Name: "Agadir-Ida-Ou-Tanane", Category: "Prefecture", ParentCode: "MA-09"},
+	"MA-AOU": {Code: "MA-AOU", Country: iso3166.MA, Name: "Aousserd (EH)", Category: "Province", ParentCode: "MA-12"},
+	"MA-ASZ": {Code: "MA-ASZ", Country: iso3166.MA, Name: "Assa-Zag (EH-partial)", Category: "Province", ParentCode: "MA-10"},
+	"MA-AZI": {Code: "MA-AZI", Country: iso3166.MA, Name: "Azilal", Category: "Province", ParentCode: "MA-05"},
+	"MA-BEM": {Code: "MA-BEM", Country: iso3166.MA, Name: "Béni Mellal", Category: "Province", ParentCode: "MA-05"},
+	"MA-BER": {Code: "MA-BER", Country: iso3166.MA, Name: "Berkane", Category: "Province", ParentCode: "MA-02"},
+	"MA-BES": {Code: "MA-BES", Country: iso3166.MA, Name: "Benslimane", Category: "Province", ParentCode: "MA-06"},
+	"MA-BOD": {Code: "MA-BOD", Country: iso3166.MA, Name: "Boujdour (EH)", Category: "Province", ParentCode: "MA-11"},
+	"MA-BOM": {Code: "MA-BOM", Country: iso3166.MA, Name: "Boulemane", Category: "Province", ParentCode: "MA-03"},
+	"MA-BRR": {Code: "MA-BRR", Country: iso3166.MA, Name: "Berrechid", Category: "Province", ParentCode: "MA-06"},
+	"MA-CAS": {Code: "MA-CAS", Country: iso3166.MA, Name: "Casablanca", Category: "Prefecture", ParentCode: "MA-06"},
+	"MA-CHE": {Code: "MA-CHE", Country: iso3166.MA, Name: "Chefchaouen", Category: "Province", ParentCode: "MA-01"},
+	"MA-CHI": {Code: "MA-CHI", Country: iso3166.MA, Name: "Chichaoua", Category: "Province", ParentCode: "MA-07"},
+	"MA-CHT": {Code: "MA-CHT", Country: iso3166.MA, Name: "Chtouka-Ait Baha", Category: "Province", ParentCode: "MA-06"},
+	"MA-DRI": {Code: "MA-DRI", Country: iso3166.MA, Name: "Driouch", Category: "Province", ParentCode: "MA-02"},
+	"MA-ERR": {Code: "MA-ERR", Country: iso3166.MA, Name: "Errachidia", Category: "Province", ParentCode: "MA-08"},
+	"MA-ESI": {Code: "MA-ESI", Country: iso3166.MA, Name: "Essaouira", Category: "Province", ParentCode: "MA-07"},
+	"MA-ESM": {Code: "MA-ESM", Country: iso3166.MA, Name: "Es-Semara (EH-partial)", Category: "Province", ParentCode: "MA-11"},
+	"MA-FAH": {Code: "MA-FAH", Country: iso3166.MA, Name: "Fahs-Anjra", Category: "Province", ParentCode: "MA-01"},
+	"MA-FES": {Code: "MA-FES", Country: iso3166.MA, Name: "Fès", Category: "Prefecture", ParentCode: "MA-03"},
+	"MA-FIG": {Code: "MA-FIG", Country: iso3166.MA, Name: "Figuig", Category: "Province", ParentCode: "MA-02"},
+	"MA-FQH": {Code: "MA-FQH", Country: iso3166.MA, Name: "Fquih Ben Salah", Category: "Province", ParentCode: "MA-05"},
+	"MA-GUE": {Code: "MA-GUE", Country: iso3166.MA, Name: "Guelmim", Category: "Province", ParentCode: "MA-10"},
+	"MA-GUF": {Code: "MA-GUF", Country: iso3166.MA, Name: "Guercif", Category: "Province", ParentCode: "MA-02"},
+	"MA-HAJ": {Code: "MA-HAJ", Country: iso3166.MA, Name: "El Hajeb", Category: "Province", ParentCode: "MA-03"},
+	"MA-HAO": {Code: "MA-HAO", Country: iso3166.MA, Name: "Al Haouz", Category: "Province", ParentCode: "MA-07"},
+	"MA-HOC": {Code: "MA-HOC", Country: iso3166.MA, Name: "Al Hoceïma", Category: "Province", ParentCode: "MA-01"},
+	"MA-IFR": {Code: "MA-IFR", Country: iso3166.MA, Name: "Ifrane", Category: "Province", ParentCode: "MA-03"},
+	"MA-INE": {Code: "MA-INE", Country: iso3166.MA, Name: "Inezgane-Ait Melloul", Category: "Prefecture", ParentCode: "MA-09"},
+	"MA-JDI": {Code: "MA-JDI", Country: iso3166.MA, Name: "El Jadida", Category: "Province", ParentCode: "MA-06"},
+	"MA-JRA": {Code: "MA-JRA", Country: iso3166.MA, Name: "Jerada", Category: "Province", ParentCode: "MA-02"},
+	"MA-KEN": {Code: "MA-KEN", Country: iso3166.MA, Name: "Kénitra", Category: "Province", ParentCode: "MA-04"},
+	"MA-KES": {Code: "MA-KES", Country: iso3166.MA, Name: "El Kelâa des Sraghna", Category: "Province", ParentCode: "MA-07"},
+	"MA-KHE": {Code: "MA-KHE", Country: iso3166.MA, Name: "Khémisset", Category: "Province", ParentCode: "MA-04"},
+	"MA-KHN": {Code: "MA-KHN", Country: iso3166.MA, Name: "Khénifra", Category: "Province", ParentCode: "MA-05"},
+	"MA-KHO": {Code: "MA-KHO", Country: iso3166.MA, Name: "Khouribga", Category: "Province", ParentCode: "MA-05"},
+	"MA-LAA": {Code: "MA-LAA", Country: iso3166.MA, Name: "Laâyoune (EH)", Category: "Province", ParentCode: "MA-11"},
+	"MA-LAR": {Code: "MA-LAR", Country: iso3166.MA, Name: "Larache", Category: "Province", ParentCode: "MA-01"},
+	"MA-MAR": {Code: "MA-MAR", Country: iso3166.MA, Name: "Marrakech", Category: "Prefecture", ParentCode: "MA-07"},
+	"MA-MDF": {Code: "MA-MDF", Country: iso3166.MA, Name: "M’diq-Fnideq", Category: "Prefecture", ParentCode: "MA-01"},
+	"MA-MED": {Code: "MA-MED", Country: iso3166.MA, Name: "Médiouna", Category: "Province", ParentCode: "MA-06"},
+	"MA-MEK": {Code: "MA-MEK", Country: iso3166.MA, Name: "Meknès", Category: "Prefecture", ParentCode: "MA-03"},
+	"MA-MID": {Code: "MA-MID", Country: iso3166.MA, Name: "Midelt", Category: "Province", ParentCode: "MA-08"},
+	"MA-MOH": {Code: "MA-MOH", Country: iso3166.MA, Name: "Mohammadia", Category: "Prefecture", ParentCode: "MA-06"},
+	"MA-MOU": {Code: "MA-MOU", Country: iso3166.MA, Name: "Moulay Yacoub", Category: "Province", ParentCode: "MA-03"},
+	"MA-NAD": {Code: "MA-NAD", Country: iso3166.MA, Name: "Nador", Category: "Province", ParentCode: "MA-02"},
+	"MA-NOU": {Code: "MA-NOU", Country: iso3166.MA, Name: "Nouaceur", Category: "Province", ParentCode: "MA-04"},
+	"MA-OUA": {Code: "MA-OUA", Country: iso3166.MA, Name: "Ouarzazate", Category: "Province", ParentCode: "MA-08"},
+	"MA-OUD": {Code: "MA-OUD", Country: iso3166.MA, Name: "Oued Ed-Dahab (EH)", Category: "Province", ParentCode: "MA-12"},
+	"MA-OUJ": {Code: "MA-OUJ", Country: iso3166.MA, Name: "Oujda-Angad", Category: "Prefecture", ParentCode: "MA-02"},
+	"MA-OUZ": {Code: "MA-OUZ", Country: iso3166.MA, Name: "Ouezzane", Category: "Province", ParentCode: "MA-01"},
+	"MA-RAB": {Code: "MA-RAB", Country: iso3166.MA, Name: "Rabat", Category: "Prefecture", ParentCode: "MA-04"},
+	"MA-REH": {Code: "MA-REH", Country: iso3166.MA, Name: "Rehamna", Category: "Province", ParentCode: "MA-07"},
+	"MA-SAF": {Code: "MA-SAF", Country: iso3166.MA, Name: "Safi", Category: "Province", ParentCode: "MA-07"},
+	"MA-SAL": {Code: "MA-SAL", Country: iso3166.MA, Name: "Salé", Category: "Prefecture", ParentCode: "MA-04"},
+	"MA-SEF": {Code: "MA-SEF", Country: iso3166.MA, Name: "Sefrou", Category: "Province", ParentCode: "MA-03"},
+	"MA-SET": {Code: "MA-SET", Country: iso3166.MA, Name: "Settat", Category: "Province", ParentCode: "MA-06"},
+	"MA-SIB": {Code: "MA-SIB", Country: iso3166.MA, Name: "Sidi Bennour", Category: "Province", ParentCode: "MA-06"},
+	"MA-SIF": {Code: "MA-SIF", Country: iso3166.MA, Name: "Sidi Ifni", Category: "Province", ParentCode: "MA-10"},
+	"MA-SIK": {Code: "MA-SIK", Country: iso3166.MA, Name: "Sidi Kacem", Category: "Province", ParentCode: "MA-04"},
+	"MA-SIL": {Code: "MA-SIL", Country: iso3166.MA, Name: "Sidi Slimane", Category: "Province", ParentCode: "MA-04"},
+	"MA-SKH": {Code: "MA-SKH", Country: iso3166.MA, Name: "Skhirate-Témara", Category: "Prefecture", ParentCode: "MA-04"},
+	"MA-TAF": {Code: "MA-TAF", Country: iso3166.MA, Name: "Tarfaya (EH-partial)", Category: "Province", ParentCode: "MA-11"},
+	"MA-TAI": {Code: "MA-TAI", Country: iso3166.MA, Name: "Taourirt", Category: "Province", ParentCode: "MA-02"},
+	"MA-TAO": {Code: "MA-TAO", Country: iso3166.MA, Name: "Taounate", Category: "Province", ParentCode: "MA-03"},
+	"MA-TAR": {Code: "MA-TAR", Country: iso3166.MA, Name: "Taroudannt", Category: "Province", ParentCode: "MA-09"},
+	"MA-TAT": {Code: "MA-TAT", Country: iso3166.MA, Name: "Tata", Category: "Province", ParentCode: "MA-09"},
+	"MA-TAZ": {Code: "MA-TAZ", Country: iso3166.MA, Name: "Taza", Category: "Province", ParentCode: "MA-03"},
+	"MA-TET": {Code: "MA-TET", Country: iso3166.MA, Name: "Tétouan", Category: "Province", ParentCode: "MA-01"},
+	"MA-TIN": {Code: "MA-TIN", Country: iso3166.MA, Name: "Tinghir", Category: "Province", ParentCode: "MA-08"},
+	"MA-TIZ": {Code: "MA-TIZ", Country: iso3166.MA, Name: "Tiznit", Category: "Province", ParentCode: "MA-09"},
+	"MA-TNG": {Code: "MA-TNG", Country: iso3166.MA, Name: "Tanger-Assilah", Category: "Prefecture", ParentCode: "MA-01"},
+	"MA-TNT": {Code: "MA-TNT", Country: iso3166.MA, Name: "Tan-Tan (EH-partial)", Category: "Province", ParentCode: "MA-10"},
+	"MA-YUS": {Code: "MA-YUS", Country: iso3166.MA, Name: "Youssoufia", Category: "Province", ParentCode: "MA-07"},
+	"MA-ZAG": {Code: "MA-ZAG", Country: iso3166.MA, Name: "Zagora", Category: "Province", ParentCode: "MA-08"},
+	"MC-CL":  {Code: "MC-CL", Country: iso3166.MC, Name: "La Colle", Category: "Quarter"},
+	"MC-CO":  {Code: "MC-CO", Country: iso3166.MC, Name: "La Condamine", Category: "Quarter"},
+	"MC-FO":  {Code: "MC-FO", Country: iso3166.MC, Name: "Fontvieille", Category: "Quarter"},
+	"MC-GA":  {Code: "MC-GA", Country: iso3166.MC, Name: "La Gare", Category: "Quarter"},
+	"MC-JE":  {Code: "MC-JE", Country: iso3166.MC, Name: "Jardin Exotique", Category: "Quarter"},
+	"MC-LA":  {Code: "MC-LA", Country: iso3166.MC, Name: "Larvotto", Category: "Quarter"},
+	"MC-MA":  {Code: "MC-MA", Country: iso3166.MC, Name: "Malbousquet", Category: "Quarter"},
+	"MC-MC":  {Code: "MC-MC", Country: iso3166.MC, Name: "Monte-Carlo", Category: "Quarter"},
+	"MC-MG":  {Code: "MC-MG", Country: iso3166.MC, Name: "Moneghetti", Category: "Quarter"},
+	"MC-MO":  {Code: "MC-MO", Country: iso3166.MC, Name: "Monaco-Ville", Category: "Quarter"},
+	"MC-MU":  {Code: "MC-MU", Country: iso3166.MC, Name: "Moulins", Category: "Quarter"},
+	"MC-PH":  {Code: "MC-PH", Country: iso3166.MC, Name: "Port-Hercule", Category: "Quarter"},
+	"MC-SD":  {Code: "MC-SD", Country: iso3166.MC, Name: "Sainte-Dévote", Category: "Quarter"},
+	"MC-SO":  {Code: "MC-SO", Country: iso3166.MC, Name: "La Source", Category: "Quarter"},
+	"MC-SP":  {Code: "MC-SP", Country: iso3166.MC, Name: "Spélugues", Category: "Quarter"},
+	"MC-SR":  {Code: "MC-SR", Country: iso3166.MC, Name: "Saint-Roman", Category: "Quarter"},
+	"MC-VR":  {Code: "MC-VR", Country: iso3166.MC, Name: "Vallon de la Rousse", Category: "Quarter"},
+	"MD-AN":  {Code: "MD-AN", Country: iso3166.MD, Name: "Anenii Noi", Category: "District"},
+	"MD-BA":  {Code: "MD-BA", Country: iso3166.MD, Name: "Bălți", Category: "City"},
+	"MD-BD":  {Code: "MD-BD", Country: iso3166.MD, Name: "Bender [Tighina]", Category: "City"},
+	"MD-BR":  {Code: "MD-BR", Country: iso3166.MD, Name: "Briceni", Category: "District"},
+	"MD-BS":  {Code: "MD-BS", Country: iso3166.MD, Name: "Basarabeasca", Category: "District"},
+	"MD-CA":  {Code: "MD-CA", Country: iso3166.MD, Name: "Cahul", Category: "District"},
+	"MD-CL":  {Code: "MD-CL", Country: iso3166.MD, Name: "Călărași", Category: "District"},
+	"MD-CM":  {Code: "MD-CM", Country: iso3166.MD, Name: "Cimișlia", Category: "District"},
+	"MD-CR":  {Code: "MD-CR", Country: iso3166.MD, Name: "Criuleni", Category: "District"},
+	"MD-CS":  {Code: "MD-CS", Country: iso3166.MD, Name: "Căușeni", Category: "District"},
+	"MD-CT":  {Code: "MD-CT", Country: iso3166.MD, Name: "Cantemir", Category: "District"},
+	"MD-CU":  {Code: "MD-CU", Country: iso3166.MD, Name: "Chișinău", Category: "City"},
+	"MD-DO":  {Code: "MD-DO", Country: iso3166.MD, Name: "Dondușeni", Category: "District"},
+	"MD-DR":  {Code: "MD-DR", Country: iso3166.MD, Name: "Drochia", Category: "District"},
+	"MD-DU":  {Code: "MD-DU", Country: iso3166.MD, Name: "Dubăsari", Category: "District"},
+	"MD-ED":  {Code: "MD-ED", Country: iso3166.MD, Name: "Edineț", Category: "District"},
+	"MD-FA":  {Code: "MD-FA", Country: iso3166.MD, Name: "Fălești", Category: "District"},
+	"MD-FL":  {Code: "MD-FL", Country: iso3166.MD, Name: "Florești", Category: "District"},
+	"MD-GA":  {Code: "MD-GA", Country: iso3166.MD, Name: "Găgăuzia, Unitatea teritorială autonomă (UTAG)", Category: "Autonomous territorial unit"},
+	"MD-GL":  {Code: "MD-GL", Country: iso3166.MD, Name: "Glodeni", Category: "District"},
+	"MD-HI":  {Code: "MD-HI", Country: iso3166.MD, Name: "Hîncești", Category: "District"},
+	"MD-IA":  {Code: "MD-IA", Country: iso3166.MD, Name: "Ialoveni", Category: "District"},
+	"MD-LE":  {Code: "MD-LE", Country: iso3166.MD, Name: "Leova", Category: "District"},
+	"MD-NI":  {Code: "MD-NI", Country: iso3166.MD, Name: "Nisporeni", Category: "District"},
+	"MD-OC":  {Code: "MD-OC", Country: iso3166.MD, Name: "Ocnița", Category: "District"},
+	"MD-OR":  {Code: "MD-OR", Country: iso3166.MD, Name: "Orhei", Category: "District"},
+	"MD-RE":  {Code: "MD-RE", Country: iso3166.MD, Name: "Rezina", Category: "District"},
+	"MD-RI":  {Code: "MD-RI", Country: iso3166.MD, Name: "Rîșcani", Category: "District"},
+	"MD-SD":  {Code: "MD-SD", Country: iso3166.MD, Name: "Șoldănești", Category: "District"},
+	"MD-SI":  {Code: "MD-SI", Country: iso3166.MD, Name: "Sîngerei", Category: "District"},
+	"MD-SN":  {Code: "MD-SN", Country: iso3166.MD, Name: "Stînga Nistrului, unitatea teritorială din", Category: "Territorial unit"},
+	"MD-SO":  {Code: "MD-SO", Country: iso3166.MD, Name: "Soroca", Category: "District"},
+	"MD-ST":  {Code: "MD-ST", Country: iso3166.MD, Name: "Strășeni", Category: "District"},
+	"MD-SV":  {Code: "MD-SV", Country: iso3166.MD, Name: "Ștefan Vodă", Category: "District"},
+	"MD-TA":  {Code: "MD-TA", Country: iso3166.MD, Name: "Taraclia", Category: "District"},
+	"MD-TE":  {Code: "MD-TE", Country: iso3166.MD, Name: "Telenești", Category: "District"},
+	"MD-UN":  {Code: "MD-UN", Country: iso3166.MD, Name: "Ungheni", Category: "District"},
+	"ME-01":  {Code: "ME-01", Country: iso3166.ME, Name: "Andrijevica", Category: "Municipality"},
+	"ME-02":  {Code: "ME-02", Country: iso3166.ME, Name: "Bar", Category: "Municipality"},
+	"ME-03":  {Code: "ME-03", Country: iso3166.ME, Name: "Berane", Category: "Municipality"},
+	"ME-04":  {Code: "ME-04", Country: iso3166.ME, Name: "Bijelo Polje", Category: "Municipality"},
+	"ME-05":  {Code: "ME-05", Country: iso3166.ME, Name: "Budva", Category: "Municipality"},
+	"ME-06":  {Code: "ME-06", Country: iso3166.ME, Name: "Cetinje", Category: "Municipality"},
+	"ME-07":  {Code: "ME-07", Country: iso3166.ME, Name: "Danilovgrad", Category: "Municipality"},
+	"ME-08":  {Code: "ME-08", Country: iso3166.ME, Name: "Herceg-Novi", Category: "Municipality"},
+	"ME-09":  {Code: "ME-09", Country: iso3166.ME, Name: "Kolašin", Category: "Municipality"},
+	"ME-10":  {Code: "ME-10", Country: iso3166.ME, Name: "Kotor", Category: "Municipality"},
+	"ME-11":  {Code: "ME-11", Country: iso3166.ME, Name: "Mojkovac", Category: "Municipality"},
+	"ME-12":  {Code: "ME-12", Country: iso3166.ME, Name: "Nikšić", Category: "Municipality"},
+	"ME-13":  {Code: "ME-13", Country: iso3166.ME, Name: "Plav", Category: "Municipality"},
+	"ME-14":  {Code: "ME-14", Country: iso3166.ME, Name: "Pljevlja", Category: "Municipality"},
+	"ME-15":  {Code: "ME-15", Country: iso3166.ME, Name: "Plužine", Category: "Municipality"},
+	"ME-16":  {Code: "ME-16", Country: iso3166.ME, Name: "Podgorica", Category: "Municipality"},
+	"ME-17":  {Code: "ME-17", Country: iso3166.ME, Name: "Rožaje", Category: "Municipality"},
+	"ME-18":  {Code: "ME-18", Country: iso3166.ME, Name: "Šavnik", Category: "Municipality"},
+	"ME-19":  {Code: "ME-19", Country: iso3166.ME, Name: "Tivat", Category: "Municipality"},
+	"ME-20":  {Code: "ME-20", Country: iso3166.ME, Name: "Ulcinj", Category: "Municipality"},
+	"ME-21":  {Code: "ME-21", Country: iso3166.ME, Name: "Žabljak", Category: "Municipality"},
+	"ME-22":  {Code: "ME-22", Country: iso3166.ME, Name: "Gusinje", Category: "Municipality"},
+	"ME-23":  {Code: "ME-23", Country: iso3166.ME, Name: "Petnjica", Category: "Municipality"},
+	"ME-24":  {Code: "ME-24", Country: iso3166.ME, Name: "Tuzi", Category: "Municipality"},
+	"MG-A":   {Code: "MG-A", Country: iso3166.MG, Name: "Toamasina", Category: "Province"},
+	"MG-D":   {Code: "MG-D", Country: iso3166.MG, Name: "Antsiranana", Category: "Province"},
+	"MG-F":   {Code: "MG-F", Country: iso3166.MG, Name: "Fianarantsoa", Category: "Province"},
+	"MG-M":   {Code: "MG-M", Country: iso3166.MG, Name: "Mahajanga", Category: "Province"},
+	"MG-T":   {Code: "MG-T", Country: iso3166.MG, Name: "Antananarivo", Category: "Province"},
+	"MG-U":   {Code: "MG-U", Country: iso3166.MG, Name: "Toliara", Category: "Province"},
+	"MH-ALK": {Code: "MH-ALK", Country: iso3166.MH, Name: "Ailuk", Category: "Municipality", ParentCode: "MH-T"},
+	"MH-ALL": {Code: "MH-ALL", Country: iso3166.MH, Name: "Ailinglaplap", Category: "Municipality", ParentCode: "MH-L"},
+	"MH-ARN": {Code: "MH-ARN", Country: iso3166.MH, Name: "Arno", Category: "Municipality", ParentCode: "MH-T"},
+	"MH-AUR": {Code: "MH-AUR", Country: iso3166.MH, Name: "Aur", Category: "Municipality", ParentCode: "MH-T"},
+	"MH-EBO": {Code: "MH-EBO", Country: iso3166.MH, Name: "Ebon", Category: "Municipality", ParentCode: "MH-L"},
+	"MH-ENI": {Code: "MH-ENI", Country: iso3166.MH, Name: "Enewetak & Ujelang", Category: "Municipality", ParentCode: "MH-L"},
+	"MH-JAB": {Code: "MH-JAB", Country: iso3166.MH, Name: "Jabat", Category: "Municipality", ParentCode: "MH-L"},
+	"MH-JAL": {Code: "MH-JAL", Country: iso3166.MH, Name: "Jaluit", Category: "Municipality", ParentCode: "MH-L"},
+	"MH-KIL": {Code: "MH-KIL", Country: iso3166.MH, Name: "Bikini & Kili", Category: "Municipality", ParentCode: "MH-L"},
+	"MH-KWA": {Code: "MH-KWA", Country: iso3166.MH, Name: "Kwajalein", Category: "Municipality", ParentCode: "MH-L"},
+	"MH-L":   {Code: "MH-L", Country: iso3166.MH, Name: "Ralik chain", Category: "Chain (of islands)"},
+	"MH-LAE": {Code: "MH-LAE", Country: iso3166.MH, Name: "Lae", Category: "Municipality", ParentCode: "MH-L"},
+	"MH-LIB": {Code: "MH-LIB", Country: iso3166.MH, Name: "Lib", Category: "Municipality", ParentCode: "MH-L"},
+	"MH-LIK": {Code: "MH-LIK", Country: iso3166.MH, Name: "Likiep", Category: "Municipality", ParentCode: "MH-T"},
+	"MH-MAJ": {Code: "MH-MAJ", Country: iso3166.MH, Name: "Majuro", Category: "Municipality", ParentCode: "MH-T"},
+	"MH-MAL": {Code: "MH-MAL", Country: iso3166.MH, Name: "Maloelap", Category: "Municipality", ParentCode: "MH-T"},
+	"MH-MEJ": {Code: "MH-MEJ", Country: iso3166.MH, Name: "Mejit", Category: "Municipality", ParentCode: "MH-T"},
+	"MH-MIL": {Code: "MH-MIL", Country: iso3166.MH, Name: "Mili", Category: "Municipality", ParentCode: "MH-T"},
+	"MH-NMK": {Code: "MH-NMK", Country: iso3166.MH, Name: "Namdrik", Category: "Municipality", ParentCode: "MH-L"},
+	"MH-NMU": {Code: "MH-NMU", Country: iso3166.MH, Name: "Namu", Category: "Municipality", ParentCode: "MH-L"},
+	"MH-RON": {Code: "MH-RON", Country: iso3166.MH, Name: "Rongelap", Category: "Municipality", ParentCode: "MH-L"},
+	"MH-T":   {Code: "MH-T", Country: iso3166.MH, Name: "Ratak chain", Category: "Chain (of islands)"},
+	"MH-UJA": {Code: "MH-UJA", Country: iso3166.MH, Name: "Ujae", Category: "Municipality", ParentCode: "MH-L"},
+	"MH-UTI": {Code: "MH-UTI", Country: iso3166.MH, Name: "Utrik", Category: "Municipality", ParentCode: "MH-T"},
+	"MH-WTH": {Code: "MH-WTH", Country: iso3166.MH, Name: "Wotho", Category: "Municipality", ParentCode: "MH-L"},
+	"MH-WTJ": {Code: "MH-WTJ", Country: iso3166.MH, Name: "Wotje", Category: "Municipality", ParentCode: "MH-T"},
+	"MK-101": {Code: "MK-101", Country: iso3166.MK, Name: "Veles", Category: "Municipality"},
+	"MK-102": {Code: "MK-102", Country: iso3166.MK, Name: "Gradsko", Category: "Municipality"},
+	"MK-103": {Code: "MK-103", Country: iso3166.MK, Name: "Demir Kapija", Category: "Municipality"},
+	"MK-104": {Code: "MK-104", Country: iso3166.MK, Name: "Kavadarci", Category: "Municipality"},
+	"MK-105": {Code: "MK-105", Country: iso3166.MK, Name: "Lozovo", Category: "Municipality"},
+	"MK-106": {Code: "MK-106", Country: iso3166.MK, Name: "Negotino", Category: "Municipality"},
+	"MK-107": {Code: "MK-107", Country: iso3166.MK, Name: "Rosoman", Category: "Municipality"},
+	"MK-108": {Code: "MK-108", Country: iso3166.MK, Name: "Sveti Nikole", Category: "Municipality"},
+	"MK-109": {Code: "MK-109", Country: iso3166.MK, Name: "Čaška", Category: "Municipality"},
+	"MK-201": {Code: "MK-201", Country: iso3166.MK, Name: "Berovo", Category: "Municipality"},
+	"MK-202": {Code: "MK-202", Country: iso3166.MK, Name: "Vinica", Category: "Municipality"},
+	"MK-203": {Code: "MK-203", Country: iso3166.MK, Name: "Delčevo", Category: "Municipality"},
+	"MK-204": {Code: "MK-204", Country: iso3166.MK, Name: "Zrnovci", Category: "Municipality"},
+	"MK-205": {Code: "MK-205", Country: iso3166.MK, Name: "Karbinci", Category: "Municipality"},
+	"MK-206": {Code: "MK-206", Country: iso3166.MK, Name: "Kočani", Category: "Municipality"},
+	"MK-207": {Code: "MK-207", Country: iso3166.MK, Name: "Makedonska Kamenica", Category: "Municipality"},
+	"MK-208": {Code: "MK-208", Country: iso3166.MK, Name: "Pehčevo", Category: "Municipality"},
+	"MK-209": {Code: "MK-209", Country: iso3166.MK, Name: "Probištip", Category: "Municipality"},
+	"MK-210": {Code: "MK-210", Country: iso3166.MK, Name: "Češinovo-Obleševo", Category: "Municipality"},
+	"MK-211": {Code: "MK-211", Country: iso3166.MK, Name: "Štip", Category: "Municipality"},
+	"MK-301": {Code: "MK-301", Country: iso3166.MK, Name: "Vevčani", Category: "Municipality"},
+	"MK-303": {Code: "MK-303", Country: iso3166.MK, Name: "Debar", Category: "Municipality"},
+	"MK-304": {Code: "MK-304", Country: iso3166.MK, Name: "Debrca", Category: "Municipality"},
+	"MK-307": {Code: "MK-307", Country: iso3166.MK, Name: "Kičevo", Category: "Municipality"},
+	"MK-308": {Code: "MK-308", Country: iso3166.MK, Name: "Makedonski Brod", Category: "Municipality"},
+	"MK-310": {Code: "MK-310", Country: iso3166.MK, Name: "Ohrid", Category: "Municipality"},
+	"MK-311": {Code: "MK-311", Country: iso3166.MK, Name: "Plasnica", Category: "Municipality"},
+	"MK-312": {Code: "MK-312", Country: iso3166.MK, Name: "Struga", Category: "Municipality"},
+	"MK-313": {Code: "MK-313", Country: iso3166.MK, Name: "Centar Župa", Category: "Municipality"},
+	"MK-401": {Code: "MK-401", Country: iso3166.MK, Name: "Bogdanci", Category: "Municipality"},
+	"MK-402": {Code: "MK-402", Country: iso3166.MK, Name: "Bosilovo", Category: "Municipality"},
+	"MK-403": {Code: "MK-403", Country: iso3166.MK, Name: "Valandovo", Category: "Municipality"},
+	"MK-404": {Code: "MK-404", Country: iso3166.MK, Name: "Vasilevo", Category: "Municipality"},
+	"MK-405": {Code: "MK-405", Country: iso3166.MK, Name: "Gevgelija", Category: "Municipality"},
+	"MK-406": {Code: "MK-406", Country: iso3166.MK, Name: "Dojran", Category: "Municipality"},
+	"MK-407": {Code: "MK-407", Country: iso3166.MK, Name: "Konče", Category: "Municipality"},
+	"MK-408": {Code: "MK-408", Country: iso3166.MK, Name: "Novo Selo", Category: "Municipality"},
+	"MK-409": {Code: "MK-409", Country: iso3166.MK, Name: "Radoviš", Category: "Municipality"},
+	"MK-410": {Code: "MK-410", Country: iso3166.MK, Name: "Strumica", Category: "Municipality"},
+	"MK-501": {Code: "MK-501", Country: iso3166.MK, Name: "Bitola", Category: "Municipality"},
+	"MK-502": {Code: "MK-502", Country: iso3166.MK, Name: "Demir Hisar", Category: "Municipality"},
+	"MK-503": {Code: "MK-503", Country: iso3166.MK, Name: "Dolneni", Category: "Municipality"},
+	"MK-504": {Code: "MK-504", Country: iso3166.MK, Name: "Krivogaštani", Category: "Municipality"},
+	"MK-505": {Code: "MK-505", Country: iso3166.MK, Name: "Kruševo", Category: "Municipality"},
+	"MK-506": {Code: "MK-506", Country: iso3166.MK, Name: "Mogila", Category: "Municipality"},
+	"MK-507": {Code: "MK-507", Country: iso3166.MK, Name: "Novaci", Category: "Municipality"},
+	"MK-508": {Code: "MK-508", Country: iso3166.MK, Name: "Prilep", Category: "Municipality"},
+	"MK-509": {Code: "MK-509", Country: iso3166.MK, Name: "Resen", Category: "Municipality"},
+	"MK-601": {Code: "MK-601", Country: iso3166.MK, Name: "Bogovinje", Category: "Municipality"},
+	"MK-602": {Code: "MK-602", Country: iso3166.MK, Name: "Brvenica", Category: "Municipality"},
+	"MK-603": {Code: "MK-603", Country: iso3166.MK, Name: "Vrapčište", Category: "Municipality"},
+	"MK-604": {Code: "MK-604", Country: iso3166.MK, Name: "Gostivar", Category: "Municipality"},
+	"MK-605": {Code: "MK-605", Country: iso3166.MK, Name: "Želino", Category: "Municipality"},
+	"MK-606": {Code: "MK-606", Country: iso3166.MK, Name: "Jegunovce", Category: "Municipality"},
+	"MK-607": {Code: "MK-607", Country: iso3166.MK, Name: "Mavrovo i Rostuše", Category: "Municipality"},
+	"MK-608": {Code: "MK-608", Country: iso3166.MK, Name: "Tearce", Category: "Municipality"},
+	"MK-609": {Code: "MK-609", Country: iso3166.MK, Name: "Tetovo", Category: "Municipality"},
+	"MK-701": {Code: "MK-701", Country: iso3166.MK, Name: "Kratovo", Category: "Municipality"},
+	"MK-702": {Code: "MK-702", Country: iso3166.MK, Name: "Kriva Palanka", Category: "Municipality"},
+	"MK-703": {Code: "MK-703", Country: iso3166.MK, Name: "Kumanovo", Category: "Municipality"},
+	"MK-704": {Code: "MK-704", Country: iso3166.MK, Name: "Lipkovo", Category: "Municipality"},
+	"MK-705": {Code: "MK-705", Country: iso3166.MK, Name: "Rankovce", Category: "Municipality"},
+	"MK-706": {Code: "MK-706", Country: iso3166.MK, Name: "Staro Nagoričane", Category: "Municipality"},
+	"MK-801": {Code: "MK-801", Country: iso3166.MK, Name: "Aerodrom †", Category: "Municipality"},
+	"MK-802": {Code: "MK-802", Country: iso3166.MK, Name: "Aračinovo", Category: "Municipality"},
+	"MK-803": {Code: "MK-803", Country: iso3166.MK, Name: "Butel †", Category: "Municipality"},
+	"MK-804": {Code: "MK-804", Country: iso3166.MK, Name: "Gazi Baba †", Category: "Municipality"},
+	"MK-805": {Code: "MK-805", Country: iso3166.MK, Name: "Gjorče Petrov †", Category: "Municipality"},
+	"MK-806": {Code: "MK-806", Country: iso3166.MK, Name: "Zelenikovo", Category: "Municipality"},
+	"MK-807": {Code: "MK-807", Country: iso3166.MK, Name: "Ilinden", Category: "Municipality"},
+	"MK-808": {Code: "MK-808", Country: iso3166.MK, Name: "Karpoš †", Category: "Municipality"},
+	"MK-809": {Code: "MK-809", Country: iso3166.MK, Name: "Kisela Voda †", Category: "Municipality"},
+	"MK-810": {Code: "MK-810", Country: iso3166.MK, Name: "Petrovec", Category: "Municipality"},
+	"MK-811": {Code: "MK-811", Country: iso3166.MK, Name: "Saraj †", Category: "Municipality"},
+	"MK-812": {Code: "MK-812", Country: iso3166.MK, Name: "Sopište", Category: "Municipality"},
+	"MK-813": {Code: "MK-813", Country: iso3166.MK, Name: "Studeničani", Category: "Municipality"},
+	"MK-814": {Code: "MK-814", Country: iso3166.MK, Name: "Centar †", Category: "Municipality"},
+	"MK-815": {Code: "MK-815", Country: iso3166.MK, Name: "Čair †", Category: "Municipality"},
+	"MK-816": {Code: "MK-816", Country: iso3166.MK, Name: "Čučer-Sandevo", Category: "Municipality"},
+	"MK-817": {Code: "MK-817", Country: iso3166.MK, Name: "Šuto Orizari †", Category: "Municipality"},
+	"ML-1":   {Code: "ML-1", Country: iso3166.ML, Name: "Kayes", Category: "Region"},
+	"ML-10":  {Code: "ML-10", Country: iso3166.ML, Name: "Taoudénit", Category: "Region"},
+	"ML-2":   {Code: "ML-2", Country: iso3166.ML, Name: "Koulikoro", Category: "Region"},
+	"ML-3":   {Code: "ML-3", Country: iso3166.ML, Name: "Sikasso", Category: "Region"},
+	"ML-4":   {Code: "ML-4", Country: iso3166.ML, Name: "Ségou", Category: "Region"},
+	"ML-5":   {Code: "ML-5", Country: iso3166.ML, Name: "Mopti", Category: "Region"},
+	"ML-6":   {Code: "ML-6", Country: iso3166.ML, Name: "Tombouctou", Category: "Region"},
+	"ML-7":   {Code: "ML-7", Country: iso3166.ML, Name: "Gao", Category: "Region"},
+	"ML-8":   {Code: "ML-8", Country: iso3166.ML, Name: "Kidal", Category: "Region"},
+	"ML-9":   {Code: "ML-9", Country: iso3166.ML, Name: "Ménaka", Category: "Region"},
+	"ML-BKO": {Code: "ML-BKO", Country: iso3166.ML, Name: "Bamako", Category: "District"},
+	"MM-01":  {Code: "MM-01", Country: iso3166.MM, Name: "Sagaing", Category: "Region"},
+	"MM-02":  {Code: "MM-02", Country: iso3166.MM, Name: "Bago", Category: "Region"},
+	"MM-03":  {Code: "MM-03", Country: iso3166.MM, Name: "Magway", Category: "Region"},
+	"MM-04":  {Code: "MM-04", Country: iso3166.MM, Name: "Mandalay", Category: "Region"},
+	"MM-05":  {Code: "MM-05", Country: iso3166.MM, Name: "Tanintharyi", Category: "Region"},
+	"MM-06":  {Code: "MM-06", Country: iso3166.MM, Name: "Yangon", Category: "Region"},
+	"MM-07":  {Code: "MM-07", Country: iso3166.MM, Name: "Ayeyarwady", Category: "Region"},
+	"MM-11":  {Code: "MM-11", Country: iso3166.MM, Name: "Kachin", Category: "State"},
+	"MM-12":  {Code: "MM-12", Country: iso3166.MM, Name: "Kayah", Category: "State"},
+	"MM-13":  {Code: "MM-13", Country: iso3166.MM, Name: "Kayin", Category: "State"},
+	"MM-14":  {Code: "MM-14", Country: iso3166.MM, Name: "Chin", Category: "State"},
+	"MM-15":  {Code: "MM-15", Country: iso3166.MM, Name: "Mon", Category: "State"},
+	"MM-16":  {Code: "MM-16", Country: iso3166.MM, Name: "Rakhine", Category: "State"},
+	"MM-17":  {Code: "MM-17", Country: iso3166.MM, Name: "Shan", Category: "State"},
+	"MM-18":  {Code: "MM-18", Country: iso3166.MM, Name: "Nay Pyi Taw", Category: "Union territory"},
+	"MN-035": {Code: "MN-035", Country: iso3166.MN, Name: "Orhon", Category: "Province"},
+	"MN-037": {Code: "MN-037", Country: iso3166.MN, Name: "Darhan uul", Category: "Province"},
+	"MN-039": {Code: "MN-039", Country: iso3166.MN, Name: "Hentiy", Category: "Province"},
+	"MN-041": {Code: "MN-041", Country: iso3166.MN, Name: "Hövsgöl", Category: "Province"},
+	"MN-043": {Code: "MN-043", Country: iso3166.MN, Name: "Hovd", Category: "Province"},
+	"MN-046": {Code: "MN-046", Country: iso3166.MN, Name: "Uvs", Category: "Province"},
+	"MN-047": {Code: "MN-047", Country: iso3166.MN, Name: "Töv", Category: "Province"},
+	"MN-049": {Code: "MN-049", Country: iso3166.MN, Name: "Selenge", Category: "Province"},
+	"MN-051": {Code: "MN-051", Country: iso3166.MN, Name: "Sühbaatar", Category: "Province"},
+	"MN-053": {Code: "MN-053", Country: iso3166.MN, Name: "Ömnögovĭ", Category: "Province"},
+	"MN-055": {Code: "MN-055", Country: iso3166.MN, Name: "Övörhangay", Category: "Province"},
+	"MN-057": {Code: "MN-057", Country: iso3166.MN, Name: "Dzavhan", Category: "Province"},
+	"MN-059": {Code: "MN-059", Country: iso3166.MN, Name: "Dundgovĭ", Category: "Province"},
+	"MN-061": {Code: "MN-061", Country: iso3166.MN, Name: "Dornod", Category: "Province"},
+	"MN-063": {Code: "MN-063", Country: iso3166.MN, Name: "Dornogovĭ", Category: "Province"},
+	"MN-064": {Code: "MN-064", Country: iso3166.MN, Name: "Govĭ-Sümber", Category: "Province"},
+	"MN-065": {Code: "MN-065", Country: iso3166.MN, Name: "Govĭ-Altay", Category: "Province"},
+	"MN-067": {Code: "MN-067", Country: iso3166.MN, Name: "Bulgan", Category: "Province"},
+	"MN-069": {Code: "MN-069", Country: iso3166.MN, Name: "Bayanhongor", Category: "Province"},
+	"MN-071": {Code: "MN-071", Country: iso3166.MN, Name: "Bayan-Ölgiy", Category: "Province"},
+	"MN-073": {Code: "MN-073", Country: iso3166.MN, Name: "Arhangay", Category: "Province"},
+	"MN-1":   {Code: "MN-1", Country: iso3166.MN, Name: "Ulaanbaatar", Category: "Capital city"},
+	"MR-01":  {Code: "MR-01", Country: iso3166.MR, Name: "Hodh ech Chargui", Category: "Region"},
+	"MR-02":  {Code: "MR-02", Country: iso3166.MR, Name: "Hodh el Gharbi", Category: "Region"},
+	"MR-03":  {Code: "MR-03", Country: iso3166.MR, Name: "Assaba", Category: "Region"},
+	"MR-04":  {Code: "MR-04", Country: iso3166.MR, Name: "Gorgol", Category: "Region"},
+	"MR-05":  {Code: "MR-05", Country: iso3166.MR, Name: "Brakna", Category: "Region"},
+	"MR-06":  {Code: "MR-06", Country: iso3166.MR, Name: "Trarza", Category: "Region"},
+	"MR-07":  {Code: "MR-07", Country: iso3166.MR, Name: "Adrar", Category: "Region"},
+	"MR-08":  {Code: "MR-08", Country: iso3166.MR, Name: "Dakhlet Nouâdhibou", Category: "Region"},
+	"MR-09":  {Code: "MR-09", Country: iso3166.MR, Name: "Tagant", Category: "Region"},
+	"MR-10":  {Code: "MR-10", Country: iso3166.MR, Name: "Guidimaka", Category: "Region"},
+	"MR-11":  {Code: "MR-11", Country: iso3166.MR, Name: "Tiris Zemmour", Category: "Region"},
+	"MR-12":  {Code: "MR-12", Country: iso3166.MR, Name: "Inchiri", Category: "Region"},
+	"MR-13":  {Code: "MR-13", Country: iso3166.MR, Name: "Nouakchott Ouest", Category: "Region"},
+	"MR-14":  {Code: "MR-14", Country: iso3166.MR, Name: "Nouakchott Nord", Category: "Region"},
+	"MR-15":  {Code: "MR-15", Country: iso3166.MR, Name: "Nouakchott Sud", Category: "Region"},
+	"MT-01":  {Code: "MT-01", Country: iso3166.MT, Name: "Attard", Category: "Local council"},
+	"MT-02":  {Code: "MT-02", Country: iso3166.MT, Name: "Balzan", Category: "Local council"},
+	"MT-03":  {Code: "MT-03", Country: iso3166.MT, Name: "Birgu", Category: "Local council"},
+	"MT-04":  {Code: "MT-04", Country: iso3166.MT, Name: "Birkirkara", Category: "Local council"},
+	"MT-05":  {Code: "MT-05", Country: iso3166.MT, Name: "Birżebbuġa", Category: "Local council"},
+	"MT-06":  {Code: "MT-06", Country: iso3166.MT, Name: "Bormla", Category: "Local council"},
+	"MT-07":  {Code: "MT-07", Country: iso3166.MT, Name: "Dingli", Category: "Local council"},
+	"MT-08":  {Code: "MT-08", Country: iso3166.MT, Name: "Fgura", Category: "Local council"},
+	"MT-09":  {Code: "MT-09", Country: iso3166.MT, Name: "Floriana", Category: "Local council"},
+	"MT-10":  {Code: "MT-10", Country: iso3166.MT, Name: "Fontana", Category: "Local council"},
+	"MT-11":  {Code: "MT-11", Country: iso3166.MT, Name: "Gudja", Category: "Local council"},
+	"MT-12":  {Code: "MT-12", Country: iso3166.MT, Name: "Gżira", Category: "Local council"},
+	"MT-13":  {Code: "MT-13", Country: iso3166.MT, Name: "Għajnsielem", Category: "Local council"},
+	"MT-14":  {Code: "MT-14", Country: iso3166.MT, Name: "Għarb", Category: "Local council"},
+	"MT-15":  {Code: "MT-15", Country: iso3166.MT, Name: "Għargħur", Category: "Local council"},
+	"MT-16":  {Code: "MT-16", Country: iso3166.MT, Name: "Għasri", Category: "Local council"},
+	"MT-17":  {Code: "MT-17", Country: iso3166.MT, Name: "Għaxaq", Category: "Local council"},
+	"MT-18":  {Code: "MT-18", Country: iso3166.MT, Name: "Ħamrun", Category: "Local council"},
+	"MT-19":  {Code: "MT-19", Country: iso3166.MT, Name: "Iklin", Category: "Local council"},
+	"MT-20":  {Code: "MT-20", Country: iso3166.MT, Name: "Isla", Category: "Local council"},
+	"MT-21":  {Code: "MT-21", Country: iso3166.MT, Name: "Kalkara", Category: "Local council"},
+	"MT-22":  {Code: "MT-22", Country: iso3166.MT, Name: "Kerċem", Category: "Local council"},
+	"MT-23":  {Code: "MT-23", Country: iso3166.MT, Name: "Kirkop", Category: "Local council"},
+	"MT-24":  {Code: "MT-24", Country: iso3166.MT, Name: "Lija", Category: "Local council"},
+	"MT-25":  {Code: "MT-25", Country: iso3166.MT, Name: "Luqa", Category: "Local council"},
+	"MT-26":  {Code: "MT-26", Country: iso3166.MT, Name: "Marsa", Category: "Local council"},
+	"MT-27":  {Code: "MT-27", Country: iso3166.MT, Name: "Marsaskala", Category: "Local council"},
+	"MT-28":  {Code: "MT-28", Country: iso3166.MT, Name: "Marsaxlokk", Category: "Local council"},
+	"MT-29":  {Code: "MT-29", Country: iso3166.MT, Name: "Mdina", Category: "Local council"},
+	"MT-30":  {Code: "MT-30", Country: iso3166.MT, Name: "Mellieħa", Category: "Local council"},
+	"MT-31":  {Code: "MT-31", Country: iso3166.MT, Name: "Mġarr", Category: "Local council"},
+	"MT-32":  {Code: "MT-32", Country: iso3166.MT, Name: "Mosta", Category: "Local council"},
+	"MT-33":  {Code: "MT-33", Country: iso3166.MT, Name: "Mqabba", Category: "Local council"},
+	"MT-34":  {Code: "MT-34", Country: iso3166.MT, Name: "Msida", Category: "Local council"},
+	"MT-35":  {Code: "MT-35", Country: iso3166.MT, Name: "Mtarfa", Category: "Local council"},
+	"MT-36":  {Code: "MT-36", Country: iso3166.MT, Name: "Munxar", Category: "Local council"},
+	"MT-37":  {Code: "MT-37", Country: iso3166.MT, Name: "Nadur", Category: "Local council"},
+	"MT-38":  {Code: "MT-38", Country: iso3166.MT, Name: "Naxxar", Category: "Local council"},
+	"MT-39":  {Code: "MT-39", Country: iso3166.MT, Name: "Paola", Category: "Local council"},
+	"MT-40":  {Code: "MT-40", Country: iso3166.MT, Name: "Pembroke", Category: "Local council"},
+	"MT-41":  {Code: "MT-41", Country: iso3166.MT, Name: "Pietà", Category: "Local council"},
+	"MT-42":  {Code: "MT-42", Country: iso3166.MT, Name: "Qala", Category: "Local council"},
+	"MT-43":  {Code: "MT-43", Country: iso3166.MT, Name: "Qormi", Category: "Local council"},
+	"MT-44":  {Code: "MT-44", Country: iso3166.MT, Name: "Qrendi", Category: "Local council"},
+	"MT-45":  {Code: "MT-45", Country: iso3166.MT, Name: "Rabat Gozo", Category: "Local council"},
+	"MT-46":  {Code: "MT-46", Country: iso3166.MT, Name: "Rabat Malta", Category: "Local council"},
+	"MT-47":  {Code: "MT-47", Country: iso3166.MT, Name: "Safi", Category: "Local council"},
+	"MT-48":  {Code: "MT-48", Country: iso3166.MT, Name: "Saint Julian's", Category: "Local council"},
+	"MT-49":  {Code: "MT-49", Country: iso3166.MT, Name: "Saint John", Category: "Local council"},
+	"MT-50":  {Code: "MT-50", Country: iso3166.MT, Name: "Saint Lawrence", Category: "Local council"},
+	"MT-51":  {Code: "MT-51", Country: iso3166.MT, Name: "Saint Paul's Bay", Category: "Local council"},
+	"MT-52":  {Code: "MT-52", Country: iso3166.MT, Name: "Sannat", Category: "Local council"},
+	"MT-53":  {Code: "MT-53", Country: iso3166.MT, Name: "Saint Lucia's", Category: "Local council"},
+	"MT-54":  {Code: "MT-54", Country: iso3166.MT, Name: "Santa Venera", Category: "Local council"},
+	"MT-55":  {Code: "MT-55", Country: iso3166.MT, Name: "Siġġiewi", Category: "Local council"},
+	"MT-56":  {Code: "MT-56", Country: iso3166.MT, Name: "Sliema", Category: "Local council"},
+	"MT-57":  {Code: "MT-57", Country: iso3166.MT, Name: "Swieqi", Category: "Local council"},
+	"MT-58":  {Code: "MT-58", Country: iso3166.MT, Name: "Ta' Xbiex", Category: "Local council"},
+	"MT-59":  {Code: "MT-59", Country: iso3166.MT, Name: "Tarxien", Category: "Local council"},
+	"MT-60":  {Code: "MT-60", Country: iso3166.MT, Name: "Valletta", Category: "Local council"},
+	"MT-61":  {Code: "MT-61", Country: iso3166.MT, Name: "Xagħra", Category: "Local council"},
+	"MT-62":  {Code: "MT-62", Country: iso3166.MT, Name: "Xewkija", Category: "Local council"},
+	"MT-63":  {Code: "MT-63", Country: iso3166.MT, Name: "Xgħajra", Category: "Local council"},
+	"MT-64":  {Code: "MT-64", Country: iso3166.MT, Name: "Żabbar", Category: "Local council"},
+	"MT-65":  {Code: "MT-65", Country: iso3166.MT, Name: "Żebbuġ Gozo", Category: "Local council"},
+	"MT-66":  {Code: "MT-66", Country: iso3166.MT, Name: "Żebbuġ Malta", Category: "Local council"},
+	"MT-67":  {Code: "MT-67", Country: iso3166.MT, Name: "Żejtun", Category: "Local council"},
+	"MT-68":  {Code: "MT-68", Country: iso3166.MT, Name: "Żurrieq", Category: "Local council"},
+	"MU-AG":  {Code: "MU-AG", Country: iso3166.MU, Name: "Agalega Islands", Category: "Dependency"},
+	"MU-BL":  {Code: "MU-BL", Country: iso3166.MU, Name: "Black River", Category: "District"},
+	"MU-CC":  {Code: "MU-CC", Country: iso3166.MU, Name: "Cargados Carajos Shoals", Category: "Dependency"},
+	"MU-FL":  {Code: "MU-FL", Country: iso3166.MU, Name: "Flacq", Category: "District"},
+	"MU-GP":  {Code: "MU-GP", Country: iso3166.MU, Name: "Grand Port", Category: "District"},
+	"MU-MO":  {Code: "MU-MO", Country: iso3166.MU, Name: "Moka", Category: "District"},
+	"MU-PA":  {Code: "MU-PA", Country: iso3166.MU, Name: "Pamplemousses", Category: "District"},
+	"MU-PL":  {Code: "MU-PL", Country: iso3166.MU, Name: "Port Louis", Category: "District"},
+	"MU-PW":  {Code: "MU-PW", Country: iso3166.MU, Name: "Plaines Wilhems", Category: "District"},
+	"MU-RO":  {Code: "MU-RO", Country: iso3166.MU, Name: "Rodrigues Island", Category: "Dependency"},
+	"MU-RR":  {Code: "MU-RR", Country: iso3166.MU, Name: "Rivière du Rempart", Category: "District"},
+	"MU-SA":  {Code: "MU-SA", Country: iso3166.MU, Name: "Savanne", Category: "District"},
+	"MV-00":  {Code: "MV-00", Country: iso3166.MV, Name: "South Ari Atoll", Category: "Administrative atoll"},
+	"MV-01":  {Code: "MV-01", Country: iso3166.MV, Name: "Addu City", Category: "City"},
+	"MV-02":  {Code: "MV-02", Country: iso3166.MV, Name: "North Ari Atoll", Category: "Administrative atoll"},
+	"MV-03":  {Code: "MV-03", Country: iso3166.MV, Name: "Faadhippolhu", Category: "Administrative atoll"},
+	"MV-04":  {Code: "MV-04", Country: iso3166.MV, Name: "Felidhu Atoll", Category: "Administrative atoll"},
+	"MV-05":  {Code: "MV-05", Country: iso3166.MV, Name: "Hahdhunmathi", Category: "Administrative atoll"},
+	"MV-07":  {Code: "MV-07", Country: iso3166.MV, Name: "North Thiladhunmathi", Category: "Administrative atoll"},
+	"MV-08":  {Code: "MV-08", Country: iso3166.MV, Name: "Kolhumadulu", Category: "Administrative atoll"},
+	"MV-12":  {Code: "MV-12", Country: iso3166.MV, Name: "Mulaku Atoll", Category: "Administrative atoll"},
+	"MV-13":  {Code: "MV-13", Country: iso3166.MV, Name: "North Maalhosmadulu", Category: "Administrative atoll"},
+	"MV-14":  {Code: "MV-14", Country: iso3166.MV, Name: "North Nilandhe Atoll", Category: "Administrative atoll"},
+	"MV-17":  {Code: "MV-17", Country: iso3166.MV, Name: "South Nilandhe Atoll", Category: "Administrative atoll"},
+	"MV-20":  {Code: "MV-20", Country: iso3166.MV, Name: "South Maalhosmadulu", Category: "Administrative atoll"},
+	"MV-23":  {Code: "MV-23", Country: iso3166.MV, Name: "South Thiladhunmathi", Category: "Administrative atoll"},
+	"MV-24":  {Code: "MV-24", Country: iso3166.MV, Name: "North Miladhunmadulu", Category: "Administrative atoll"},
+	"MV-25":  {Code: "MV-25", Country: iso3166.MV, Name: "South Miladhunmadulu", Category: "Administrative atoll"},
+	"MV-26":  {Code: "MV-26", Country: iso3166.MV, Name: "Male Atoll", Category: "Administrative atoll"},
+	"MV-27":  {Code: "MV-27", Country: iso3166.MV, Name: "North Huvadhu Atoll", Category: "Administrative atoll"},
+	"MV-28":  {Code: "MV-28", Country: iso3166.MV, Name: "South Huvadhu Atoll", Category: "Administrative atoll"},
+	"MV-29":  {Code: "MV-29", Country: iso3166.MV, Name: "Fuvammulah", Category: "Administrative atoll"},
+	"MV-MLE": {Code: "MV-MLE", Country: iso3166.MV, Name: "Male", Category: "City"},
+	"MW-BA":  {Code: "MW-BA", Country: iso3166.MW, Name: "Balaka", Category: "District", ParentCode: "MW-S"},
+	"MW-BL":  {Code: "MW-BL", Country: iso3166.MW, Name: "Blantyre", Category: "District", ParentCode: "MW-S"},
+	"MW-C":   {Code: "MW-C", Country: iso3166.MW, Name: "Central Region", Category: "Region"},
+	"MW-CK":  {Code: "MW-CK", Country: iso3166.MW, Name: "Chikwawa", Category: "District", ParentCode: "MW-S"},
+	"MW-CR":  {Code: "MW-CR", Country: iso3166.MW, Name: "Chiradzulu", Category: "District", ParentCode: "MW-S"},
+	"MW-CT":  {Code: "MW-CT", Country: iso3166.MW, Name: "Chitipa", Category: "District", ParentCode: "MW-N"},
+	"MW-DE":  {Code: "MW-DE", Country: iso3166.MW, Name: "Dedza", Category: "District", ParentCode: "MW-C"},
+	"MW-DO":  {Code: "MW-DO", Country: iso3166.MW, Name: "Dowa", Category: "District", ParentCode: "MW-C"},
+	"MW-KR":  {Code: "MW-KR", Country: iso3166.MW, Name: "Karonga", Category: "District", ParentCode: "MW-N"},
+	"MW-KS":  {Code: "MW-KS", Country: iso3166.MW, Name: "Kasungu", Category: "District", ParentCode: "MW-C"},
+	"MW-LI":  {Code: "MW-LI", Country: iso3166.MW, Name: "Lilongwe", Category: "District", ParentCode: "MW-C"},
+	"MW-LK":  {Code: "MW-LK", Country: iso3166.MW, Name: "Likoma", Category: "District", ParentCode: "MW-N"},
+	"MW-MC":  {Code: "MW-MC", Country: iso3166.MW, Name: "Mchinji", Category: "District", ParentCode: "MW-C"},
+	"MW-MG":  {Code: "MW-MG", Country: iso3166.MW, Name: "Mangochi", Category: "District", ParentCode: "MW-S"},
+	"MW-MH":  {Code: "MW-MH", Country: iso3166.MW, Name: "Machinga", Category: "District", ParentCode: "MW-S"},
+	"MW-MU":  {Code: "MW-MU", Country: iso3166.MW, Name: "Mulanje", Category: "District", ParentCode: "MW-S"},
+	"MW-MW":  {Code: "MW-MW", Country: iso3166.MW, Name: "Mwanza", Category: "District", ParentCode: "MW-S"},
+	"MW-MZ":  {Code: "MW-MZ", Country: iso3166.MW, Name: "Mzimba", Category: "District", ParentCode: "MW-N"},
+	"MW-N":   {Code: "MW-N", Country: iso3166.MW, Name: "Northern Region", Category: "Region"},
+	"MW-NB":  {Code: "MW-NB", Country: iso3166.MW, Name: "Nkhata Bay", Category: "District", ParentCode: "MW-N"},
+	"MW-NE":  {Code: "MW-NE", Country: iso3166.MW, Name: "Neno", Category: "District", ParentCode: "MW-S"},
+	"MW-NI":  {Code: "MW-NI", Country: iso3166.MW, Name: "Ntchisi", Category: "District", ParentCode: "MW-C"},
+	"MW-NK":  {Code: "MW-NK", Country: iso3166.MW, Name: "Nkhotakota", Category: "District", ParentCode: "MW-C"},
+	"MW-NS":  {Code: "MW-NS", Country: iso3166.MW, Name: "Nsanje", Category: "District", ParentCode: "MW-S"},
+	"MW-NU":  {Code: "MW-NU", Country: iso3166.MW, Name: "Ntcheu", Category: "District", ParentCode: "MW-C"},
+	"MW-PH":  {Code: "MW-PH", Country: iso3166.MW, Name: "Phalombe", Category: "District", ParentCode: "MW-S"},
+	"MW-RU":  {Code: "MW-RU", Country: iso3166.MW, Name: "Rumphi", Category: "District", ParentCode: "MW-N"},
+	"MW-S":   {Code: "MW-S", Country: iso3166.MW, Name: "Southern Region", Category: "Region"},
+	"MW-SA":  {Code: "MW-SA", Country: iso3166.MW, Name: "Salima", Category: "District", ParentCode: "MW-C"},
+	"MW-TH":  {Code: "MW-TH", Country: iso3166.MW, Name: "Thyolo", Category: "District", ParentCode: "MW-S"},
+	"MW-ZO":  {Code: "MW-ZO", Country: iso3166.MW, Name: "Zomba", Category: "District", ParentCode: "MW-S"},
+	"MX-AGU": {Code: "MX-AGU", Country: iso3166.MX, Name: "Aguascalientes", Category: "State"},
+	"MX-BCN": {Code: "MX-BCN", Country: iso3166.MX, Name: "Baja California", Category: "State"},
+	"MX-BCS": {Code: "MX-BCS", Country: iso3166.MX, Name: "Baja California Sur", Category: "State"},
+	"MX-CAM": {Code: "MX-CAM", Country: iso3166.MX, Name: "Campeche", Category: "State"},
+	"MX-CHH": {Code: "MX-CHH", Country: iso3166.MX, Name: "Chihuahua", Category: "State"},
+	"MX-CHP": {Code: "MX-CHP", Country: iso3166.MX, Name: "Chiapas", Category: "State"},
+	"MX-CMX": {Code: "MX-CMX", Country: iso3166.MX, Name: "Ciudad de México", Category: "Federal district"},
+	"MX-COA": {Code: "MX-COA", Country: iso3166.MX, Name: "Coahuila de Zaragoza", Category: "State"},
+	"MX-COL": {Code: "MX-COL", Country: iso3166.MX, Name: "Colima", Category: "State"},
+	"MX-DUR": {Code: "MX-DUR", Country: iso3166.MX, Name: "Durango", Category: "State"},
+	"MX-GRO": {Code: "MX-GRO", Country: iso3166.MX, Name: "Guerrero", Category: "State"},
+	"MX-GUA": {Code: "MX-GUA", Country: iso3166.MX, Name: "Guanajuato", Category: "State"},
+	"MX-HID": {Code: "MX-HID", Country: iso3166.MX, Name: "Hidalgo", Category: "State"},
+	"MX-JAL": {Code: "MX-JAL", Country: iso3166.MX, Name: "Jalisco", Category: "State"},
+	"MX-MEX": {Code: "MX-MEX", Country: iso3166.MX, Name: "México", Category: "State"},
+	"MX-MIC": {Code: "MX-MIC", Country: iso3166.MX, Name: "Michoacán de Ocampo", Category: "State"},
+	"MX-MOR": {Code: "MX-MOR", Country: iso3166.MX, Name: "Morelos", Category: "State"},
+	"MX-NAY": {Code: "MX-NAY", Country: iso3166.MX, Name: "Nayarit", Category: "State"},
+	"MX-NLE": {Code: "MX-NLE", Country: iso3166.MX, Name: "Nuevo León", Category: "State"},
+	"MX-OAX": {Code: "MX-OAX", Country: iso3166.MX, Name: "Oaxaca", Category: "State"},
+	"MX-PUE": {Code: "MX-PUE", Country: iso3166.MX, Name: "Puebla", Category: "State"},
+	"MX-QUE": {Code: "MX-QUE", Country: iso3166.MX, Name: "Querétaro", Category: "State"},
+	"MX-ROO": {Code: "MX-ROO", Country: iso3166.MX, Name: "Quintana Roo", Category: "State"},
+	"MX-SIN": {Code: "MX-SIN", Country: iso3166.MX, Name: "Sinaloa", Category: "State"},
+	"MX-SLP": {Code: "MX-SLP", Country: iso3166.MX, Name: "San Luis Potosí", Category: "State"},
+	"MX-SON": {Code: "MX-SON", Country: iso3166.MX, Name: "Sonora", Category: "State"},
+	"MX-TAB": {Code: "MX-TAB", Country: iso3166.MX, Name: "Tabasco", Category: "State"},
+	"MX-TAM": {Code: "MX-TAM", Country: iso3166.MX, Name: "Tamaulipas", Category: "State"},
+	"MX-TLA": {Code: "MX-TLA", Country: iso3166.MX, Name: "Tlaxcala", Category: "State"},
+	"MX-VER": {Code: "MX-VER", Country: iso3166.MX, Name: "Veracruz de Ignacio de la Llave", Category: "State"},
+	"MX-YUC": {Code: "MX-YUC", Country: iso3166.MX, Name: "Yucatán", Category: "State"},
+	"MX-ZAC": {Code: "MX-ZAC", Country: iso3166.MX, Name: "Zacatecas", Category: "State"},
+	"MY-01":  {Code: "MY-01", Country: iso3166.MY, Name: "Johor", Category: "State"},
+	"MY-02":  {Code: "MY-02", Country: iso3166.MY, Name: "Kedah", Category: "State"},
+	"MY-03":  {Code: "MY-03", Country: iso3166.MY, Name: "Kelantan", Category: "State"},
+	"MY-04":  {Code: "MY-04", Country: iso3166.MY, Name: "Melaka", Category: "State"},
+	"MY-05":  {Code: "MY-05", Country: iso3166.MY, Name: "Negeri Sembilan", Category: "State"},
+	"MY-06":  {Code: "MY-06", Country: iso3166.MY, Name: "Pahang", Category: "State"},
+	"MY-07":  {Code: "MY-07", Country: iso3166.MY, Name: "Pulau Pinang", Category: "State"},
+	"MY-08":  {Code: "MY-08", Country: iso3166.MY, Name: "Perak", Category: "State"},
+	"MY-09":  {Code: "MY-09", Country: iso3166.MY, Name: "Perlis", Category: "State"},
+	"MY-10":  {Code: "MY-10", Country: iso3166.MY, Name: "Selangor", Category: "State"},
+	"MY-11":  {Code: "MY-11", Country: iso3166.MY, Name: "Terengganu", Category: "State"},
+	"MY-12":  {Code: "MY-12", Country: iso3166.MY, Name: "Sabah", Category: "State"},
+	"MY-13":  {Code: "MY-13", Country: iso3166.MY, Name: "Sarawak", Category: "State"},
+	"MY-14":  {Code: "MY-14", Country: iso3166.MY, Name: "Wilayah Persekutuan Kuala Lumpur", Category: "Federal territory"},
+	"MY-15":  {Code: "MY-15", Country: iso3166.MY, Name: "Wilayah Persekutuan Labuan", Category: "Federal territory"},
+	"MY-16":  {Code: "MY-16", Country: iso3166.MY, Name: "Wilayah Persekutuan Putrajaya", Category: "Federal territory"},
+	"MZ-A":   {Code: "MZ-A", Country: iso3166.MZ, Name: "Niassa", Category: "Province"},
+	"MZ-B":   {Code: "MZ-B", Country: iso3166.MZ, Name: "Manica", Category: "Province"},
+	"MZ-G":   {Code: "MZ-G", Country: iso3166.MZ, Name: "Gaza", Category: "Province"},
+	"MZ-I":   {Code: "MZ-I", Country: iso3166.MZ, Name: "Inhambane", Category: "Province"},
+	"MZ-L":   {Code: "MZ-L", Country: iso3166.MZ, Name: "Maputo", Category: "Province"},
+	"MZ-MPM": {Code: "MZ-MPM", Country: iso3166.MZ, Name: "Maputo", Category: "City"},
+	"MZ-N":   {Code: "MZ-N", Country: iso3166.MZ, Name: "Nampula", Category: "Province"},
+	"MZ-P":   {Code: "MZ-P", Country: iso3166.MZ, Name: "Cabo Delgado", Category: "Province"},
+	"MZ-Q":   {Code: "MZ-Q", Country: iso3166.MZ, Name: "Zambézia", Category: "Province"},
+	"MZ-S":   {Code: "MZ-S", Country: iso3166.MZ, Name: "Sofala", Category: "Province"},
+	"MZ-T":   {Code: "MZ-T", Country: iso3166.MZ, Name: "Tete", Category: "Province"},
+	"NA-CA":  {Code: "NA-CA", Country: iso3166.NA, Name: "Zambezi", Category: "Region"},
+	"NA-ER":  {Code: "NA-ER", Country: iso3166.NA, Name: "Erongo", Category: "Region"},
+	"NA-HA":  {Code: "NA-HA", Country: iso3166.NA, Name: "Hardap", Category: "Region"},
+	"NA-KA":  {Code: "NA-KA", Country: iso3166.NA, Name: "//Karas", Category: "Region"},
+	"NA-KE":  {Code: "NA-KE", Country: iso3166.NA, Name: "Kavango East", Category: "Region"},
+	"NA-KH":  {Code: "NA-KH", Country: iso3166.NA, Name: "Khomas", Category: "Region"},
+	"NA-KU":  {Code: "NA-KU", Country: iso3166.NA, Name: "Kunene", Category: "Region"},
+	"NA-KW":  {Code: "NA-KW", Country: iso3166.NA, Name: "Kavango West", Category: "Region"},
+	"NA-OD":  {Code: "NA-OD", Country: iso3166.NA, Name: "Otjozondjupa", Category: "Region"},
+	"NA-OH":  {Code: "NA-OH", Country: iso3166.NA, Name: "Omaheke", Category: "Region"},
+	"NA-ON":  {Code: "NA-ON", Country: iso3166.NA, Name: "Oshana", Category: "Region"},
+	"NA-OS":  {Code: "NA-OS", Country: iso3166.NA, Name: "Omusati", Category: "Region"},
+	"NA-OT":  {Code: "NA-OT", Country: iso3166.NA, Name: "Oshikoto", Category: "Region"},
+	"NA-OW":  {Code: "NA-OW", Country: iso3166.NA, Name: "Ohangwena", Category: "Region"},
+	"NE-1":   {Code: "NE-1", Country: iso3166.NE, Name: "Agadez", Category: "Region"},
+	"NE-2":   {Code: "NE-2", Country: iso3166.NE, Name: "Diffa", Category: "Region"},
+	"NE-3":   {Code: "NE-3", Country: iso3166.NE, Name: "Dosso", Category: "Region"},
+	"NE-4":   {Code: "NE-4", Country: iso3166.NE, Name: "Maradi", Category: "Region"},
+	"NE-5":   {Code: "NE-5", Country: iso3166.NE, Name: "Tahoua", Category: "Region"},
+	"NE-6":   {Code: "NE-6", Country: iso3166.NE, Name: "Tillabéri", Category: "Region"},
+	"NE-7":   {Code: "NE-7", Country: iso3166.NE, Name: "Zinder", Category: "Region"},
+	"NE-8":   {Code: "NE-8", Country: iso3166.NE, Name: "Niamey", Category: "Urban community"},
+	"NG-AB":  {Code: "NG-AB", Country: iso3166.NG, Name: "Abia", Category: "State"},
+	"NG-AD":  {Code: "NG-AD", Country: iso3166.NG, Name: "Adamawa", Category: "State"},
+	"NG-AK":  {Code: "NG-AK", Country: iso3166.NG, Name: "Akwa Ibom", Category: "State"},
+	"NG-AN":  {Code: "NG-AN", Country: iso3166.NG, Name: "Anambra", Category: "State"},
+	"NG-BA":  {Code: "NG-BA", Country: iso3166.NG, Name: "Bauchi", Category: "State"},
+	"NG-BE":  {Code: "NG-BE", Country: iso3166.NG, Name: "Benue", Category: "State"},
+	"NG-BO":  {Code: "NG-BO", Country: iso3166.NG, Name: "Borno", Category: "State"},
+	"NG-BY":  {Code: "NG-BY", Country: iso3166.NG, Name: "Bayelsa", Category: "State"},
+	"NG-CR":  {Code: "NG-CR", Country: iso3166.NG, Name: "Cross River", Category: "State"},
+	"NG-DE":  {Code: "NG-DE", Country: iso3166.NG, Name: "Delta", Category: "State"},
+	"NG-EB":  {Code: "NG-EB", Country: iso3166.NG, Name: "Ebonyi", Category: "State"},
+	"NG-ED":  {Code: "NG-ED", Country: iso3166.NG, Name: "Edo", Category: "State"},
+	"NG-EK":  {Code: "NG-EK", Country: iso3166.NG, Name: "Ekiti", Category: "State"},
+	"NG-EN":  {Code: "NG-EN", Country: iso3166.NG, Name: "Enugu", Category: "State"},
+	"NG-FC":  {Code: "NG-FC", Country: iso3166.NG, Name: "Abuja Federal Capital Territory", Category: "Capital territory"},
+	"NG-GO":  {Code: "NG-GO", Country: iso3166.NG, Name: "Gombe", Category: "State"},
+	"NG-IM":  {Code: "NG-IM", Country: iso3166.NG, Name: "Imo", Category: "State"},
+	"NG-JI":  {Code: "NG-JI", Country: iso3166.NG, Name: "Jigawa", Category: "State"},
+	"NG-KD":  {Code: "NG-KD", Country: iso3166.NG, Name: "Kaduna", Category: "State"},
+	"NG-KE":  {Code: "NG-KE", Country: iso3166.NG, Name: "Kebbi", Category: "State"},
+	"NG-KN":  {Code: "NG-KN", Country: iso3166.NG, Name: "Kano", Category: "State"},
+	"NG-KO":  {Code: "NG-KO", Country: iso3166.NG, Name: "Kogi", Category: "State"},
+	"NG-KT":  {Code: "NG-KT", Country: iso3166.NG, Name: "Katsina", Category: "State"},
+	"NG-KW":  {Code: "NG-KW", Country: iso3166.NG, Name: "Kwara", Category: "State"},
+	"NG-LA":  {Code: "NG-LA", Country: iso3166.NG, Name: "Lagos", Category: "State"},
+	"NG-NA":  {Code: "NG-NA", Country: iso3166.NG, Name: "Nasarawa", Category: "State"},
+	"NG-NI":  {Code: "NG-NI", Country: iso3166.NG, Name: "Niger", Category: "State"},
+	"NG-OG":  {Code: "NG-OG", Country: iso3166.NG, Name: "Ogun", Category: "State"},
+	"NG-ON":  {Code: "NG-ON", Country: iso3166.NG, Name: "Ondo", Category: "State"},
+	"NG-OS":  {Code: "NG-OS", Country: iso3166.NG, Name: "Osun", Category: "State"},
+	"NG-OY":  {Code: "NG-OY", Country: iso3166.NG, Name: "Oyo", Category: "State"},
+	"NG-PL":  {Code: "NG-PL", Country: iso3166.NG, Name: "Plateau", Category: "State"},
+	"NG-RI":  {Code: "NG-RI", Country: iso3166.NG, Name: "Rivers", Category: "State"},
+	"NG-SO":  {Code: "NG-SO", Country: iso3166.NG, Name: "Sokoto", Category: "State"},
+	"NG-TA":  {Code: "NG-TA", Country: iso3166.NG, Name: "Taraba", Category: "State"},
+	"NG-YO":  {Code: "NG-YO", Country: iso3166.NG, Name: "Yobe", Category: "State"},
+	"NG-ZA":  {Code: "NG-ZA", Country: iso3166.NG, Name: "Zamfara", Category: "State"},
+	"NI-AN":  {Code: "NI-AN", Country: iso3166.NI, Name: "Costa Caribe Norte", Category: "Autonomous region"},
+	"NI-AS":  {Code: "NI-AS", Country: iso3166.NI, Name: "Costa Caribe Sur", Category: "Autonomous region"},
+	"NI-BO":  {Code: "NI-BO", Country: iso3166.NI, Name: "Boaco", Category: "Department"},
+	"NI-CA":  {Code: "NI-CA", Country: iso3166.NI, Name: "Carazo", Category: "Department"},
+	"NI-CI":  {Code: "NI-CI", Country: iso3166.NI, Name: "Chinandega", Category: "Department"},
+	"NI-CO":  {Code: "NI-CO", Country: iso3166.NI, Name: "Chontales", Category: "Department"},
+	"NI-ES":  {Code: "NI-ES", Country: iso3166.NI, Name: "Estelí", Category: "Department"},
+	"NI-GR":  {Code: "NI-GR", Country: iso3166.NI, Name: "Granada", Category: "Department"},
+	"NI-JI":  {Code: "NI-JI", Country: iso3166.NI, Name: "Jinotega", Category: "Department"},
+	"NI-LE":  {Code: "NI-LE", Country: iso3166.NI, Name: "León", Category: "Department"},
+	"NI-MD":  {Code: "NI-MD", Country: iso3166.NI, Name: "Madriz", Category: "Department"},
+	"NI-MN":  {Code: "NI-MN", Country: iso3166.NI, Name: "Managua", Category: "Department"},
+	"NI-MS":  {Code: "NI-MS", Country: iso3166.NI, Name: "Masaya", Category: "Department"},
+	"NI-MT":  {Code: "NI-MT", Country: iso3166.NI, Name: "Matagalpa", Category: "Department"},
+	"NI-NS":  {Code: "NI-NS", Country: iso3166.NI, Name: "Nueva Segovia", Category: "Department"},
+	"NI-RI":  {Code: "NI-RI", Country: iso3166.NI, Name: "Rivas", Category: "Department"},
+	"NI-SJ":  {Code: "NI-SJ", Country: iso3166.NI, Name: "Río San Juan", Category: "Department"},
+	"NL-AW":  {Code: "NL-AW", Country: iso3166.NL, Name: "Aruba", Category: "Country"},
+	"NL-BQ1": {Code: "NL-BQ1", Country: iso3166.NL, Name: "Bonaire", Category: "Special municipality"},
+	"NL-BQ2": {Code: "NL-BQ2", Country: iso3166.NL, Name: "Saba", Category: "Special municipality"},
+	"NL-BQ3": {Code: "NL-BQ3", Country: iso3166.NL, Name: "Sint Eustatius", Category: "Special municipality"},
+	"NL-CW":  {Code: "NL-CW", Country: iso3166.NL, Name: "Curaçao", Category: "Country"},
+	"NL-DR":  {Code: "NL-DR", Country: iso3166.NL, Name: "Drenthe", Category: "Province"},
+	"NL-FL":  {Code: "NL-FL", Country: iso3166.NL, Name: "Flevoland", Category: "Province"},
+	"NL-FR":  {Code: "NL-FR", Country: iso3166.NL, Name: "Fryslân", Category: "Province"},
+	"NL-GE":  {Code: "NL-GE", Country: iso3166.NL, Name: "Gelderland", Category: "Province"},
+	"NL-GR":  {Code: "NL-GR", Country: iso3166.NL, Name: "Groningen", Category: "Province"},
+	"NL-LI":  {Code: "NL-LI", Country: iso3166.NL, Name: "Limburg", Category: "Province"},
+	"NL-NB":  {Code: "NL-NB", Country: iso3166.NL, Name: "Noord-Brabant", Category: "Province"},
+	"NL-NH":  {Code: "NL-NH", Country: iso3166.NL, Name: "Noord-Holland", Category: "Province"},
+	"NL-OV":  {Code: "NL-OV", Country: iso3166.NL, Name: "Overijssel", Category: "Province"},
+	"NL-SX":  {Code: "NL-SX", Country: iso3166.NL, Name: "Sint Maarten", Category: "Country"},
+	"NL-UT":  {Code: "NL-UT", Country: iso3166.NL, Name: "Utrecht", Category: "Province"},
+	"NL-ZE":  {Code: "NL-ZE", Country: iso3166.NL, Name: "Zeeland", Category: "Province"},
+	"NL-ZH":  {Code: "NL-ZH", Country: iso3166.NL, Name: "Zuid-Holland", Category: "Province"},
+	"NO-03":  {Code: "NO-03", Country: iso3166.NO, Name: "Oslo", Category: "County"},
+	"NO-11":  {Code: "NO-11", Country: iso3166.NO, Name: "Rogaland", Category: "County"},
+	"NO-15":  {Code: "NO-15", Country: iso3166.NO, Name: "Møre og Romsdal", Category: "County"},
+	"NO-18":  {Code: "NO-18", Country: iso3166.NO, Name: "Nordland", Category: "County"},
+	"NO-21":  {Code: "NO-21", Country: iso3166.NO, Name: "Svalbard (Arctic Region)", Category: "Arctic region"},
+	"NO-22":  {Code: "NO-22", Country: iso3166.NO, Name: "Jan Mayen (Arctic Region)", Category: "Arctic region"},
+	"NO-30":  {Code: "NO-30", Country: iso3166.NO, Name: "Viken", Category: "County"},
+	"NO-34":  {Code: "NO-34", Country: iso3166.NO, Name: "Innlandet", Category: "County"},
+	"NO-38":  {Code: "NO-38", Country: iso3166.NO, Name: "Vestfold og Telemark", Category: "County"},
+	"NO-42":  {Code: "NO-42", Country: iso3166.NO, Name: "Agder", Category: "County"},
+	"NO-46":  {Code: "NO-46", Country: iso3166.NO, Name: "Vestland", Category: "County"},
+	"NO-50":  {Code: "NO-50", Country: iso3166.NO, Name: "Trööndelage", Category: "County"},
+	"NO-54":  {Code: "NO-54", Country: iso3166.NO, Name: "Romssa ja Finnmárkku", Category: "County"},
+	"NP-1":   {Code: "NP-1", Country: iso3166.NP, Name: "Central", Category: "Development region"},
+	"NP-2":   {Code: "NP-2", Country: iso3166.NP, Name: "Mid Western", Category: "Development region"},
+	"NP-3":   {Code: "NP-3", Country: iso3166.NP, Name: "Western", Category: "Development region"},
+	"NP-4":   {Code: "NP-4", Country: iso3166.NP, Name: "Eastern", Category: "Development region"},
+	"NP-5":   {Code: "NP-5", Country: iso3166.NP, Name: "Far Western", Category: "Development region"},
+	"NP-BA":  {Code: "NP-BA", Country: iso3166.NP, Name: "Bagmati", Category: "Zone", ParentCode: "NP-1"},
+	"NP-BH":  {Code: "NP-BH", Country: iso3166.NP, Name: "Bheri", Category: "Zone", ParentCode: "NP-2"},
+	"NP-DH":  {Code: "NP-DH", Country: iso3166.NP, Name: "Dhawalagiri", Category: "Zone", ParentCode: "NP-3"},
+	"NP-GA":  {Code: "NP-GA", Country: iso3166.NP, Name: "Gandaki", Category: "Zone", ParentCode: "NP-3"},
+	"NP-JA":  {Code: "NP-JA", Country: iso3166.NP, Name: "Janakpur", Category: "Zone", ParentCode: "NP-1"},
+	"NP-KA":  {Code: "NP-KA", Country: iso3166.NP, Name: "Karnali", Category: "Zone", ParentCode: "NP-2"},
+	"NP-KO":  {Code: "NP-KO", Country: iso3166.NP, Name: "Kosi", Category: "Zone", ParentCode: "NP-4"},
+	"NP-LU":  {Code: "NP-LU", Country: iso3166.NP, Name: "Lumbini", Category: "Zone", ParentCode: "NP-3"},
+	"NP-MA":  {Code: "NP-MA", Country: iso3166.NP, Name: "Mahakali", Category: "Zone", ParentCode: "NP-5"},
+	"NP-ME":  {Code: "NP-ME", Country: iso3166.NP, Name: "Mechi", Category: "Zone", ParentCode: "NP-4"},
+	"NP-NA":  {Code: "NP-NA", Country: iso3166.NP, Name: "Narayani", Category: "Zone", ParentCode: "NP-1"},
+	"NP-P1":  {Code: "NP-P1", Country: iso3166.NP, Name: "Province 1", Category: "Province"},
+	"NP-P2":  {Code: "NP-P2", Country: iso3166.NP, Name: "Province 2", Category: "Province"},
+	"NP-P3":  {Code: "NP-P3", Country: iso3166.NP, Name: "Bāgmatī", Category: "Province"},
+	"NP-P4":  {Code: "NP-P4", Country: iso3166.NP, Name: "Gandaki", Category: "Province"},
+	"NP-P5":  {Code: "NP-P5", Country: iso3166.NP, Name: "Province 5", Category: "Province"},
+	"NP-P6":  {Code: "NP-P6", Country: iso3166.NP, Name: "Karnali", Category: "Province"},
+	"NP-P7":  {Code: "NP-P7", Country: iso3166.NP, Name: "Sudūr Pashchim", Category: "Province"},
+	"NP-RA":  {Code: "NP-RA", Country: iso3166.NP, Name: "Rapti", Category: "Zone", ParentCode: "NP-2"},
+	"NP-SA":  {Code: "NP-SA", Country: iso3166.NP, Name: "Sagarmatha", Category: "Zone", ParentCode: "NP-4"},
+	"NP-SE":  {Code: "NP-SE", Country: iso3166.NP, Name: "Seti", Category: "Zone", ParentCode: "NP-5"},
+	"NR-01":  {Code: "NR-01", Country: iso3166.NR, Name: "Aiwo", Category: "District"},
+	"NR-02":  {Code: "NR-02", Country: iso3166.NR, Name: "Anabar", Category: "District"},
+	"NR-03":  {Code: "NR-03", Country: iso3166.NR, Name: "Anetan", Category: "District"},
+	"NR-04":  {Code: "NR-04", Country: iso3166.NR, Name: "Anibare", Category: "District"},
+	"NR-05":  {Code: "NR-05", Country: iso3166.NR, Name: "Baitsi", Category: "District"},
+	"NR-06":  {Code: "NR-06", Country: iso3166.NR, Name: "Boe", Category: "District"},
+	"NR-07":  {Code: "NR-07", Country: iso3166.NR, Name: "Buada", Category: "District"},
+	"NR-08":  {Code: "NR-08", Country: iso3166.NR, Name: "Denigomodu", Category: "District"},
+	"NR-09":  {Code: "NR-09", Country: iso3166.NR, Name: "Ewa", Category: "District"},
+	"NR-10":  {Code: "NR-10", Country: iso3166.NR, Name: "Ijuw", Category: "District"},
+	"NR-11":  {Code: "NR-11", Country: iso3166.NR, Name: "Meneng", Category: "District"},
+	"NR-12":  {Code: "NR-12", Country: iso3166.NR, Name: "Nibok", Category: "District"},
+	"NR-13":  {Code: "NR-13", Country: iso3166.NR, Name: "Uaboe", Category: "District"},
+	"NR-14":  {Code: "NR-14", Country: iso3166.NR, Name: "Yaren", Category: "District"},
+	"NZ-AUK": {Code: "NZ-AUK", Country: iso3166.NZ, Name: "Auckland", Category: "Region"},
+	"NZ-BOP": {Code: "NZ-BOP", Country: iso3166.NZ, Name: "Bay of Plenty", Category: "Region"},
+	"NZ-CAN": {Code: "NZ-CAN", Country: iso3166.NZ, Name: "Canterbury", Category: "Region"},
+	"NZ-CIT": {Code: "NZ-CIT", Country: iso3166.NZ, Name: "Chatham Islands Territory", Category: "Special island authority"},
+	"NZ-GIS": {Code: "NZ-GIS", Country: iso3166.NZ, Name: "Gisborne", Category: "Region"},
+	"NZ-HKB": {Code: "NZ-HKB", Country: iso3166.NZ, Name: "Hawke's Bay", Category: "Region"},
+	"NZ-MBH": {Code: "NZ-MBH", Country: iso3166.NZ, Name: "Marlborough", Category: "Region"},
+	"NZ-MWT": {Code: "NZ-MWT", Country: iso3166.NZ, Name: "Manawatu-Wanganui", Category: "Region"},
+	"NZ-NSN": {Code: "NZ-NSN", Country: iso3166.NZ, Name: "Nelson", Category: "Region"},
+	"NZ-NTL": {Code: "NZ-NTL", Country: iso3166.NZ, Name: "Northland", Category: "Region"},
+	"NZ-OTA": {Code: "NZ-OTA", Country: iso3166.NZ, Name: "Otago", Category: "Region"},
+	"NZ-STL": {Code: "NZ-STL", Country: iso3166.NZ, Name: "Southland", Category: "Region"},
+	"NZ-TAS": {Code: "NZ-TAS", Country: iso3166.NZ, Name: "Tasman", Category: "Region"},
+	"NZ-TKI": {Code: "NZ-TKI", Country: iso3166.NZ, Name: "Taranaki", Category: "Region"},
+	"NZ-WGN": {Code: "NZ-WGN", Country: iso3166.NZ, Name: "Wellington", Category: "Region"},
+	"NZ-WKO": {Code: "NZ-WKO", Country: iso3166.NZ, Name: "Waikato", Category: "Region"},
+	"NZ-WTC": {Code: "NZ-WTC", Country: iso3166.NZ, Name: "West Coast", Category: "Region"},
+	"OM-BJ":  {Code: "OM-BJ", Country: iso3166.OM, Name: "Janūb al Bāţinah", Category: "Governorate"},
+	"OM-BS":  {Code: "OM-BS", Country: iso3166.OM, Name: "Shamāl al Bāţinah", Category: "Governorate"},
+	"OM-BU":  {Code: "OM-BU", Country: iso3166.OM, Name: "Al Buraymī", Category: "Governorate"},
+	"OM-DA":  {Code: "OM-DA", Country: iso3166.OM, Name: "Ad Dākhilīyah", Category: "Governorate"},
+	"OM-MA":  {Code: "OM-MA", Country: iso3166.OM, Name: "Masqaţ", Category: "Governorate"},
+	"OM-MU":  {Code: "OM-MU", Country: iso3166.OM, Name: "Musandam", Category: "Governorate"},
+	"OM-SJ":  {Code: "OM-SJ", Country: iso3166.OM, Name: "Janūb ash Sharqīyah", Category: "Governorate"},
+	"OM-SS":  {Code: "OM-SS", Country: iso3166.OM, Name: "Shamāl ash Sharqīyah", Category: "Governorate"},
+	"OM-WU":  {Code: "OM-WU", Country: iso3166.OM, Name: "Al Wusţá", Category: "Governorate"},
+	"OM-ZA":  {Code: "OM-ZA", Country: iso3166.OM, Name: "Az̧ Z̧āhirah", Category: "Governorate"},
+	"OM-ZU":  {Code: "OM-ZU", Country: iso3166.OM, Name: "Z̧ufār", Category: "Governorate"},
+	"PA-1":   {Code: "PA-1", Country: iso3166.PA, Name: "Bocas del Toro", Category: "Province"},
+	"PA-10":  {Code: "PA-10", Country: iso3166.PA, Name: "Panamá Oeste", Category: "Province"},
+	"PA-2":   {Code: "PA-2", Country: iso3166.PA, Name: "Coclé", Category: "Province"},
+	"PA-3":   {Code: "PA-3", Country: iso3166.PA, Name: "Colón", Category: "Province"},
+	"PA-4":   {Code: "PA-4", Country: iso3166.PA, Name: "Chiriquí", Category: "Province"},
+	"PA-5":   {Code: "PA-5", Country: iso3166.PA, Name: "Darién", Category: "Province"},
+	"PA-6":   {Code: "PA-6", Country: iso3166.PA, Name: "Herrera", Category: "Province"},
+	"PA-7":   {Code: "PA-7", Country: iso3166.PA, Name: "Los Santos", Category: "Province"},
+	"PA-8":   {Code: "PA-8", Country: iso3166.PA, Name: "Panamá", Category: "Province"},
+	"PA-9":   {Code: "PA-9", Country: iso3166.PA, Name: "Veraguas", Category: "Province"},
+	"PA-EM":  {Code: "PA-EM", Country: iso3166.PA, Name: "Emberá", Category: "Indigenous region"},
+	"PA-KY":  {Code: "PA-KY", Country: iso3166.PA, Name: "Guna Yala", Category: "Indigenous region"},
+	"PA-NB":  {Code: "PA-NB", Country: iso3166.PA, Name: "Ngöbe-Buglé", Category: "Indigenous region"},
+	"PE-AMA": {Code: "PE-AMA", Country: iso3166.PE, Name: "Amarumayu", Category: "Region"},
+	"PE-ANC": {Code: "PE-ANC", Country: iso3166.PE, Name: "Ancash", Category: "Region"},
+	"PE-APU": {Code: "PE-APU", Country: iso3166.PE, Name: "Apurimaq", Category: "Region"},
+	"PE-ARE": {Code: "PE-ARE", Country: iso3166.PE, Name: "Arequipa", Category: "Region"},
+	"PE-AYA": {Code: "PE-AYA", Country: iso3166.PE, Name: "Ayacucho", Category: "Region"},
+	"PE-CAJ": {Code: "PE-CAJ", Country: iso3166.PE, Name: "Cajamarca", Category: "Region"},
+	"PE-CAL": {Code: "PE-CAL", Country: iso3166.PE, Name: "El Callao", Category: "Region"},
+	"PE-CUS": {Code: "PE-CUS", Country: iso3166.PE, Name: "Cusco", Category: "Region"},
+	"PE-HUC": {Code: "PE-HUC", Country: iso3166.PE, Name: "Huánuco", Category: "Region"},
+	"PE-HUV": {Code: "PE-HUV", Country: iso3166.PE, Name: "Huancavelica", Category: "Region"},
+	"PE-ICA": {Code: "PE-ICA", Country: iso3166.PE, Name: "Ica", Category: "Region"},
+	"PE-JUN": {Code: "PE-JUN", Country: iso3166.PE, Name: "Hunin", Category: "Region"},
+	"PE-LAL": {Code: "PE-LAL", Country: iso3166.PE, Name: "La Libertad", Category: "Region"},
+	"PE-LAM": {Code: "PE-LAM", Country: iso3166.PE, Name: "Lambayeque", Category: "Region"},
+	"PE-LIM": {Code: "PE-LIM", Country: iso3166.PE, Name: "Lima", Category: "Region"},
+	"PE-LMA": {Code: "PE-LMA", Country: iso3166.PE, Name: "Lima hatun llaqta", Category: "Municipality"},
+	"PE-LOR": {Code: "PE-LOR", Country: iso3166.PE, Name: "Loreto", Category: "Region"},
+	"PE-MDD": {Code: "PE-MDD", Country: iso3166.PE, Name: "Madre de Dios", Category: "Region"},
+	"PE-MOQ": {Code: "PE-MOQ", Country: iso3166.PE, Name: "Moquegua", Category: "Region"},
+	"PE-PAS": {Code: "PE-PAS", Country: iso3166.PE, Name: "Pasco", Category: "Region"},
+	"PE-PIU": {Code: "PE-PIU", Country: iso3166.PE, Name: "Piura", Category: "Region"},
+	"PE-PUN": {Code: "PE-PUN", Country: iso3166.PE, Name: "Puno", Category: "Region"},
+	"PE-SAM": {Code: "PE-SAM", Country: iso3166.PE, Name: "San Martin", Category: "Region"},
+	"PE-TAC": {Code: "PE-TAC", Country: iso3166.PE, Name: "Tacna", Category: "Region"},
+	"PE-TUM": {Code: "PE-TUM", Country: iso3166.PE, Name: "Tumbes", Category: "Region"},
+	"PE-UCA": {Code: "PE-UCA", Country: iso3166.PE, Name: "Ucayali", Category: "Region"},
+	"PG-CPK": {Code: "PG-CPK", Country: iso3166.PG, Name: "Chimbu", Category: "Province"},
+	"PG-CPM": {Code: "PG-CPM", Country: iso3166.PG, Name: "Central", Category: "Province"},
+	"PG-EBR": {Code: "PG-EBR", Country: iso3166.PG, Name: "East New Britain", Category: "Province"},
+	"PG-EHG": {Code: "PG-EHG", Country: iso3166.PG, Name: "Eastern Highlands", Category: "Province"},
+	"PG-EPW": {Code: "PG-EPW", Country: iso3166.PG, Name: "Enga", Category: "Province"},
+	"PG-ESW": {Code: "PG-ESW", Country: iso3166.PG, Name: "East Sepik", Category: "Province"},
+	"PG-GPK": {Code: "PG-GPK", Country: iso3166.PG, Name: "Gulf", Category: "Province"},
+	"PG-HLA": {Code: "PG-HLA", Country: iso3166.PG, Name: "Hela", Category: "Province"},
+	"PG-JWK": {Code: "PG-JWK", Country: iso3166.PG, Name: "Jiwaka", Category: "Province"},
+	"PG-MBA": {Code: "PG-MBA", Country: iso3166.PG, Name: "Milne Bay", Category: "Province"},
+	"PG-MPL": {Code: "PG-MPL", Country: iso3166.PG, Name: "Morobe", Category: "Province"},
+	"PG-MPM": {Code: "PG-MPM", Country: iso3166.PG, Name: "Madang", Category: "Province"},
+	"PG-MRL": {Code: "PG-MRL", Country: iso3166.PG, Name: "Manus", Category: "Province"},
+	"PG-NCD": {Code: "PG-NCD", Country: iso3166.PG, Name: "National Capital District (Port Moresby)", Category: "District"},
+	"PG-NIK": {Code: "PG-NIK", Country: iso3166.PG, Name: "New Ireland", Category: "Province"},
+	"PG-NPP": {Code: "PG-NPP", Country: iso3166.PG, Name: "Northern", Category: "Province"},
+	"PG-NSB": {Code: "PG-NSB", Country: iso3166.PG, Name: "Bougainville", Category: "Autonomous region"},
+	"PG-SAN": {Code: "PG-SAN", Country: iso3166.PG, Name: "West Sepik", Category: "Province"},
+	"PG-SHM": {Code: "PG-SHM", Country: iso3166.PG, Name: "Southern Highlands", Category: "Province"},
+	"PG-WBK": {Code: "PG-WBK", Country: iso3166.PG, Name: "West New Britain", Category: "Province"},
+	"PG-WHM": {Code: "PG-WHM", Country: iso3166.PG, Name: "Western Highlands", Category: "Province"},
+	"PG-WPD": {Code: "PG-WPD", Country: iso3166.PG, Name: "Western", Category: "Province"},
+	"PH-00":  {Code: "PH-00", Country: iso3166.PH, Name: "National Capital Region", Category: "Region"},
+	"PH-01":  {Code: "PH-01", Country: iso3166.PH, Name: "Ilocos (Region I)", Category: "Region"},
+	"PH-02":  {Code: "PH-02", Country: iso3166.PH, Name: "Cagayan Valley (Region II)", Category: "Region"},
+	"PH-03":  {Code: "PH-03", Country: iso3166.PH, Name: "Central Luzon (Region III)", Category: "Region"},
+	"PH-05":  {Code: "PH-05", Country: iso3166.PH, Name: "Bicol (Region V)", Category: "Region"},
+	"PH-06":  {Code: "PH-06", Country: iso3166.PH, Name: "Western Visayas (Region VI)", Category: "Region"},
+	"PH-07":  {Code: "PH-07", Country: iso3166.PH, Name: "Central Visayas (Region VII)", Category: "Region"},
+	"PH-08":  {Code: "PH-08", Country: iso3166.PH, Name: "Eastern Visayas (Region VIII)", Category: "Region"},
+	"PH-09":  {Code: "PH-09", Country: iso3166.PH, Name: "Zamboanga Peninsula (Region IX)", Category: "Region"},
+	"PH-10":  {Code: "PH-10", Country: iso3166.PH, Name: "Northern Mindanao (Region X)", Category: "Region"},
+	"PH-11":  {Code: "PH-11", Country: iso3166.PH, Name: "Davao (Region XI)", Category: "Region"},
+	"PH-12":  {Code: "PH-12", Country: iso3166.PH, Name: "Soccsksargen (Region XII)", Category: "Region"},
+	"PH-13":  {Code: "PH-13", Country: iso3166.PH, Name: "Caraga (Region XIII)", Category: "Region"},
+	"PH-14":  {Code: "PH-14", Country: iso3166.PH, Name: "Autonomous Region in Muslim Mindanao (ARMM)", Category: "Region"},
+	"PH-15":  {Code: "PH-15", Country: iso3166.PH, Name: "Cordillera Administrative Region (CAR)", Category: "Region"},
+	"PH-40":  {Code: "PH-40", Country: iso3166.PH, Name: "Calabarzon (Region IV-A)", Category: "Region"},
+	"PH-41":  {Code: "PH-41", Country: iso3166.PH, Name: "Mimaropa (Region IV-B)", Category: "Region"},
+	"PH-ABR": {Code: "PH-ABR", Country: iso3166.PH, Name: "Abra", Category: "Province", ParentCode: "PH-15"},
+	"PH-AGN": {Code: "PH-AGN", Country: iso3166.PH, Name: "Agusan del Norte", Category: "Province", ParentCode: "PH-13"},
+	"PH-AGS": {Code: "PH-AGS", Country: iso3166.PH, Name: "Agusan del Sur", Category: "Province", ParentCode: "PH-13"},
+	"PH-AKL": {Code: "PH-AKL", Country: iso3166.PH, Name: "Aklan", Category: "Province", ParentCode: "PH-06"},
+	"PH-ALB": {Code: "PH-ALB", Country: iso3166.PH, Name: "Albay", Category: "Province", ParentCode: "PH-05"},
+	"PH-ANT": {Code: "PH-ANT", Country: iso3166.PH, Name: "Antique", Category: "Province", ParentCode: "PH-06"},
+	"PH-APA": {Code: "PH-APA", Country: iso3166.PH, Name: "Apayao", Category: "Province", ParentCode: "PH-15"},
+	"PH-AUR": {Code: "PH-AUR", Country: iso3166.PH, Name: "Aurora", Category: "Province", ParentCode: "PH-03"},
+	"PH-BAN": {Code: "PH-BAN", Country: iso3166.PH, Name: "Bataan", Category: "Province", ParentCode: "PH-03"},
+	"PH-BAS": {Code: "PH-BAS", Country: iso3166.PH, Name: "Basilan", Category: "Province", ParentCode: "PH-09"},
+	"PH-BEN": {Code: "PH-BEN", Country: iso3166.PH, Name: "Benguet", Category: "Province", ParentCode: "PH-15"},
+	"PH-BIL": {Code: "PH-BIL", Country: iso3166.PH, Name: "Biliran", Category: "Province", ParentCode: "PH-08"},
+	"PH-BOH": {Code: "PH-BOH", Country: iso3166.PH, Name: "Bohol", Category: "Province", ParentCode: "PH-07"},
+	"PH-BTG": {Code: "PH-BTG", Country: iso3166.PH, Name: "Batangas", Category: "Province", ParentCode: "PH-40"},
+	"PH-BTN": {Code: "PH-BTN", Country: iso3166.PH, Name: "Batanes", Category: "Province", ParentCode: "PH-02"},
+	"PH-BUK": {Code: "PH-BUK", Country: iso3166.PH, Name: "Bukidnon", Category: "Province", ParentCode: "PH-10"},
+	"PH-BUL": {Code: "PH-BUL", Country: iso3166.PH, Name: "Bulacan", Category: "Province", ParentCode: "PH-03"},
+	"PH-CAG": {Code: "PH-CAG", Country: iso3166.PH, Name: "Cagayan", Category: "Province", ParentCode: "PH-02"},
+	"PH-CAM": {Code: "PH-CAM", Country: iso3166.PH, Name: "Camiguin", Category: "Province", ParentCode: "PH-10"},
+	"PH-CAN": {Code: "PH-CAN", Country: iso3166.PH, Name: "Camarines Norte", Category: "Province", ParentCode: "PH-05"},
+	"PH-CAP": {Code: "PH-CAP", Country: iso3166.PH, Name: "Capiz", Category: "Province", ParentCode: "PH-06"},
+	"PH-CAS": {Code: "PH-CAS", Country: iso3166.PH, Name: "Camarines Sur", Category: "Province", ParentCode: "PH-05"},
+	"PH-CAT": {Code: "PH-CAT", Country: iso3166.PH, Name: "Catanduanes", Category: "Province", ParentCode: "PH-05"},
+	"PH-CAV": {Code: "PH-CAV", Country: iso3166.PH, Name: "Cavite", Category: "Province", ParentCode: "PH-40"},
+	"PH-CEB": {Code: "PH-CEB", Country: iso3166.PH, Name: "Cebu", Category: "Province", ParentCode: "PH-07"},
+	"PH-COM": {Code: "PH-COM", Country: iso3166.PH, Name: "Davao de Oro", Category: "Province", ParentCode: "PH-11"},
+	"PH-DAO": {Code: "PH-DAO", Country: iso3166.PH, Name: "Davao Oriental", Category: "Province", ParentCode: "PH-11"},
+	"PH-DAS": {Code: "PH-DAS", Country: iso3166.PH, Name: "Davao del Sur", Category: "Province", ParentCode: "PH-11"},
+	"PH-DAV": {Code: "PH-DAV", Country: iso3166.PH, Name: "Davao del Norte", Category: "Province", ParentCode: "PH-11"},
+	"PH-DIN": {Code: "PH-DIN", Country: iso3166.PH, Name: "Dinagat Islands", Category: "Province", ParentCode: "PH-13"},
+	"PH-DVO": {Code: "PH-DVO", Country: iso3166.PH, Name: "Davao Occidental", Category: "Province", ParentCode: "PH-11"},
+	"PH-EAS": {Code: "PH-EAS", Country: iso3166.PH, Name: "Eastern Samar", Category: "Province", ParentCode: "PH-08"},
+	"PH-GUI": {Code: "PH-GUI", Country: iso3166.PH, Name: "Guimaras", Category: "Province", ParentCode: "PH-06"},
+	"PH-IFU": {Code: "PH-IFU", Country: iso3166.PH, Name: "Ifugao", Category: "Province", ParentCode: "PH-15"},
+	"PH-ILI": {Code: "PH-ILI", Country: iso3166.PH, Name: "Iloilo", Category: "Province", ParentCode: "PH-06"},
+	"PH-ILN": {Code: "PH-ILN", Country: iso3166.PH, Name: "Ilocos Norte", Category: "Province", ParentCode: "PH-01"},
+	"PH-ILS": {Code: "PH-ILS", Country: iso3166.PH, Name: "Ilocos Sur", Category: "Province", ParentCode: "PH-01"},
+	"PH-ISA": {Code: "PH-ISA", Country: iso3166.PH, Name: "Isabela", Category: "Province", ParentCode: "PH-02"},
+	"PH-KAL": {Code: "PH-KAL", Country: iso3166.PH, Name: "Kalinga", Category: "Province", ParentCode: "PH-15"},
+	"PH-LAG": {Code: "PH-LAG", Country: iso3166.PH, Name: "Laguna", Category: "Province", ParentCode: "PH-40"},
+	"PH-LAN": {Code: "PH-LAN", Country: iso3166.PH, Name: "Lanao del Norte", Category: "Province", ParentCode: "PH-12"},
+	"PH-LAS": {Code: "PH-LAS", Country: iso3166.PH, Name: "Lanao del Sur", Category: "Province", ParentCode: "PH-14"},
+	"PH-LEY": {Code: "PH-LEY", Country: iso3166.PH, Name: "Leyte", Category: "Province", ParentCode: "PH-08"},
+	"PH-LUN": {Code: "PH-LUN", Country: iso3166.PH, Name: "La Union", Category: "Province", ParentCode: "PH-01"},
+	"PH-MAD": {Code: "PH-MAD", Country: iso3166.PH, Name: "Marinduque", Category: "Province", ParentCode: "PH-41"},
+	"PH-MAG": {Code: "PH-MAG", Country: iso3166.PH, Name: "Maguindanao", Category: "Province", ParentCode: "PH-14"},
+	"PH-MAS": {Code: "PH-MAS", Country: iso3166.PH, Name: "Masbate", Category: "Province", ParentCode: "PH-05"},
+	"PH-MDC": {Code: "PH-MDC", Country: iso3166.PH, Name: "Mindoro Occidental", Category: "Province", ParentCode: "PH-41"},
+	"PH-MDR": {Code: "PH-MDR", Country: iso3166.PH, Name: "Mindoro Oriental", Category: "Province", ParentCode: "PH-41"},
+	"PH-MOU": {Code: "PH-MOU", Country: iso3166.PH, Name: "Mountain Province", Category: "Province", ParentCode: "PH-15"},
+	"PH-MSC": {Code: "PH-MSC", Country: iso3166.PH, Name: "Misamis Occidental", Category: "Province", ParentCode: "PH-10"},
+	"PH-MSR": {Code: "PH-MSR", Country: iso3166.PH, Name: "Misamis Oriental", Category: "Province", ParentCode: "PH-10"},
+	"PH-NCO": {Code: "PH-NCO", Country: iso3166.PH, Name: "Cotabato", Category: "Province", ParentCode: "PH-12"},
+	"PH-NEC": {Code: "PH-NEC", Country: iso3166.PH, Name: "Negros Occidental", Category: "Province", ParentCode: "PH-06"},
+	"PH-NER": {Code: "PH-NER", Country: iso3166.PH, Name: "Negros Oriental", Category: "Province", ParentCode: "PH-07"},
+	"PH-NSA": {Code: "PH-NSA", Country: iso3166.PH, Name: "Northern Samar", Category: "Province", ParentCode: "PH-08"},
+	"PH-NUE": {Code: "PH-NUE", Country: iso3166.PH, Name: "Nueva Ecija", Category: "Province", ParentCode: "PH-03"},
+	"PH-NUV": {Code: "PH-NUV", Country: iso3166.PH, Name: "Nueva Vizcaya", Category: "Province", ParentCode: "PH-02"},
+	"PH-PAM": {Code: "PH-PAM", Country: iso3166.PH, Name: "Pampanga", Category: "Province", ParentCode: "PH-03"},
+	"PH-PAN": {Code: "PH-PAN", Country: iso3166.PH, Name: "Pangasinan", Category: "Province", ParentCode: "PH-01"},
+	"PH-PLW": {Code: "PH-PLW", Country: iso3166.PH, Name: "Palawan", Category: "Province", ParentCode: "PH-41"},
+	"PH-QUE": {Code: "PH-QUE", Country: iso3166.PH, Name: "Quezon", Category: "Province", ParentCode: "PH-40"},
+	"PH-QUI": {Code: "PH-QUI", Country: iso3166.PH, Name: "Quirino", Category: "Province", ParentCode: "PH-02"},
+	"PH-RIZ": {Code: "PH-RIZ", Country: iso3166.PH, Name: "Rizal", Category: "Province", ParentCode: "PH-40"},
+	"PH-ROM": {Code: "PH-ROM", Country: iso3166.PH, Name: "Romblon", Category: "Province", ParentCode: "PH-41"},
+	"PH-SAR": {Code: "PH-SAR", Country: iso3166.PH, Name: "Sarangani", Category: "Province", ParentCode: "PH-11"},
+	"PH-SCO": {Code: "PH-SCO", Country: iso3166.PH, Name: "South Cotabato", Category: "Province", ParentCode: "PH-11"},
+	"PH-SIG": {Code: "PH-SIG", Country: iso3166.PH, Name: "Siquijor", Category: "Province", ParentCode: "PH-07"},
+	"PH-SLE": {Code: "PH-SLE", Country: iso3166.PH, Name: "Southern Leyte", Category: "Province", ParentCode: "PH-08"},
+	"PH-SLU": {Code: "PH-SLU", Country: iso3166.PH, Name: "Sulu", Category: "Province", ParentCode: "PH-14"},
+	"PH-SOR": {Code: "PH-SOR", Country: iso3166.PH, Name: "Sorsogon", Category: "Province", ParentCode: "PH-05"},
+	"PH-SUK": {Code: "PH-SUK", Country: iso3166.PH, Name: "Sultan Kudarat", Category: "Province", ParentCode: "PH-12"},
+	"PH-SUN": {Code: "PH-SUN", Country: iso3166.PH, Name: "Surigao del Norte", Category: "Province", ParentCode: "PH-13"},
+	"PH-SUR": {Code: "PH-SUR", Country: iso3166.PH, Name: "Surigao del Sur", Category: "Province", ParentCode: "PH-13"},
+	"PH-TAR": {Code: "PH-TAR", Country: iso3166.PH, Name: "Tarlac", Category: "Province", ParentCode: "PH-03"},
+	"PH-TAW": {Code: "PH-TAW", Country: iso3166.PH, Name: "Tawi-Tawi", Category: "Province", ParentCode: "PH-14"},
+	"PH-WSA": {Code: "PH-WSA", Country: iso3166.PH, Name: "Samar", Category: "Province", ParentCode: "PH-08"},
+	"PH-ZAN": {Code: "PH-ZAN", Country: iso3166.PH, Name: "Zamboanga del Norte", Category: "Province", ParentCode: "PH-09"},
+	"PH-ZAS": {Code: "PH-ZAS", Country: iso3166.PH, Name: "Zamboanga del Sur", Category: "Province", ParentCode: "PH-09"},
+	"PH-ZMB": {Code: "PH-ZMB", Country: iso3166.PH, Name: "Zambales", Category: "Province", ParentCode: "PH-03"},
+	"PH-ZSI": {Code: "PH-ZSI", Country: iso3166.PH, Name: "Zamboanga Sibugay", Category: "Province", ParentCode: "PH-09"},
+	"PK-BA":  {Code: "PK-BA", Country: iso3166.PK, Name: "Balochistan", Category: "Province"},
+	"PK-GB":  {Code: "PK-GB", Country: iso3166.PK, Name: "Gilgit-Baltistan", Category: "Pakistan administered area"},
+	"PK-IS":  {Code: "PK-IS", Country: iso3166.PK, Name: "Islamabad", Category: "Federal capital territory"},
+	"PK-JK":  {Code: "PK-JK", Country: iso3166.PK, Name: "Azad Jammu and Kashmir", Category: "Pakistan administered area"},
+	"PK-KP":  {Code: "PK-KP", Country: iso3166.PK, Name: "Khyber Pakhtunkhwa", Category: "Province"},
+	"PK-PB":  {Code: "PK-PB", Country: iso3166.PK, Name: "Punjab", Category: "Province"},
+	"PK-SD":  {Code: "PK-SD", Country: iso3166.PK, Name: "Sindh", Category: "Province"},
+	"PL-02":  {Code: "PL-02", Country: iso3166.PL, Name: "Dolnośląskie", Category: "Voivodship"},
+	"PL-04":  {Code: "PL-04", Country: iso3166.PL, Name: "Kujawsko-pomorskie", Category: "Voivodship"},
+	"PL-06":  {Code: "PL-06", Country: iso3166.PL, Name: "Lubelskie", Category: "Voivodship"},
+	"PL-08":  {Code: "PL-08", Country: iso3166.PL, Name: "Lubuskie", Category: "Voivodship"},
+	"PL-10":  {Code: "PL-10", Country: iso3166.PL, Name: "Łódzkie", Category: "Voivodship"},
+	"PL-12":  {Code: "PL-12", Country: iso3166.PL, Name: "Małopolskie", Category: "Voivodship"},
+	"PL-14":  {Code: "PL-14", Country: iso3166.PL, Name: "Mazowieckie", Category: "Voivodship"},
+	"PL-16":  {Code: "PL-16", Country: iso3166.PL, Name: "Opolskie", Category: "Voivodship"},
+	"PL-18":  {Code: "PL-18", Country: iso3166.PL, Name: "Podkarpackie", Category: "Voivodship"},
+	"PL-20":  {Code: "PL-20", Country: iso3166.PL, Name: "Podlaskie", Category: "Voivodship"},
+	"PL-22":  {Code: "PL-22", Country: iso3166.PL, Name: "Pomorskie", Category: "Voivodship"},
+	"PL-24":  {Code: "PL-24", Country: iso3166.PL, Name: "Śląskie", Category: "Voivodship"},
+	"PL-26":  {Code: "PL-26", Country: iso3166.PL, Name: "Świętokrzyskie", Category: "Voivodship"},
+	"PL-28":  {Code: "PL-28", Country: iso3166.PL, Name: "Warmińsko-mazurskie", Category: "Voivodship"},
+	"PL-30":  {Code: "PL-30", Country: iso3166.PL, Name: "Wielkopolskie", Category: "Voivodship"},
+	"PL-32":  {Code: "PL-32", Country: iso3166.PL, Name: "Zachodniopomorskie", Category: "Voivodship"},
+	"PS-BTH": {Code: "PS-BTH", Country: iso3166.PS, Name: "Bethlehem", Category: "Governorate"},
+	"PS-DEB": {Code: "PS-DEB", Country: iso3166.PS, Name: "Deir El Balah", Category: "Governorate"},
+	"PS-GZA": {Code: "PS-GZA", Country: iso3166.PS, Name: "Gaza", Category: "Governorate"},
+	"PS-HBN": {Code: "PS-HBN", Country: iso3166.PS, Name: "Hebron", Category: "Governorate"},
+	"PS-JEM": {Code: "PS-JEM", Country: iso3166.PS, Name: "Jerusalem", Category: "Governorate"},
+	"PS-JEN": {Code: "PS-JEN", Country: iso3166.PS, Name: "Jenin", Category: "Governorate"},
+	"PS-JRH": {Code: "PS-JRH", Country: iso3166.PS, Name: "Jericho and Al Aghwar", Category: "Governorate"},
+	"PS-KYS": {Code: "PS-KYS", Country: iso3166.PS, Name: "Khan Yunis", Category: "Governorate"},
+	"PS-NBS": {Code: "PS-NBS", Country: iso3166.PS, Name: "Nablus", Category: "Governorate"},
+	"PS-NGZ": {Code: "PS-NGZ", Country: iso3166.PS, Name: "North Gaza", Category: "Governorate"},
+	"PS-QQA": {Code: "PS-QQA", Country: iso3166.PS, Name: "Qalqilya", Category: "Governorate"},
+	"PS-RBH": {Code: "PS-RBH", Country: iso3166.PS, Name: "Ramallah", Category: "Governorate"},
+	"PS-RFH": {Code: "PS-RFH", Country: iso3166.PS, Name: "Rafah", Category: "Governorate"},
+	"PS-SLT": {Code: "PS-SLT", Country: iso3166.PS, Name: "Salfit", Category: "Governorate"},
+	"PS-TBS": {Code: "PS-TBS", Country: iso3166.PS, Name: "Tubas", Category: "Governorate"},
+	"PS-TKM": {Code: "PS-TKM", Country: iso3166.PS, Name: "Tulkarm", Category: "Governorate"},
+	"PT-01":  {Code: "PT-01", Country: iso3166.PT, Name: "Aveiro", Category: "District"},
+	"PT-02":  {Code: "PT-02", Country: iso3166.PT, Name: "Beja", Category: "District"},
+	"PT-03":  {Code: "PT-03", Country: iso3166.PT, Name: "Braga", Category: "District"},
+	"PT-04":  {Code: "PT-04", Country: iso3166.PT, Name: "Bragança", Category: "District"},
+	"PT-05":  {Code: "PT-05", Country: iso3166.PT, Name: "Castelo Branco", Category: "District"},
+	"PT-06":  {Code: "PT-06", Country: iso3166.PT, Name: "Coimbra", Category: "District"},
+	"PT-07":  {Code: "PT-07", Country: iso3166.PT, Name: "Évora", Category: "District"},
+	"PT-08":  {Code: "PT-08", Country: iso3166.PT, Name: "Faro", Category: "District"},
+	"PT-09":  {Code: "PT-09", Country: iso3166.PT, Name: "Guarda", Category: "District"},
+	"PT-10":  {Code: "PT-10", Country: iso3166.PT, Name: "Leiria", Category: "District"},
+	"PT-11":  {Code: "PT-11", Country: iso3166.PT, Name: "Lisboa", Category: "District"},
+	"PT-12":  {Code: "PT-12", Country: iso3166.PT, Name: "Portalegre", Category: "District"},
+	"PT-13":  {Code: "PT-13", Country: iso3166.PT, Name: "Porto", Category: "District"},
+	"PT-14":  {Code: "PT-14", Country: iso3166.PT, Name: "Santarém", Category: "District"},
+	"PT-15":  {Code: "PT-15", Country: iso3166.PT, Name: "Setúbal", Category: "District"},
+	"PT-16":  {Code: "PT-16", Country: iso3166.PT, Name: "Viana do Castelo", Category: "District"},
+	"PT-17":  {Code: "PT-17", Country: iso3166.PT, Name: "Vila Real", Category: "District"},
+	"PT-18":  {Code: "PT-18", Country: iso3166.PT, Name: "Viseu", Category: "District"},
+	"PT-20":  {Code: "PT-20", Country: iso3166.PT, Name: "Região Autónoma dos Açores", Category: "Autonomous region"},
+	"PT-30":  {Code: "PT-30", Country: iso3166.PT, Name: "Região Autónoma da Madeira", Category: "Autonomous region"},
+	"PW-002": {Code: "PW-002", Country: iso3166.PW, Name: "Aimeliik", Category: "State"},
+	"PW-004": {Code: "PW-004", Country: iso3166.PW, Name: "Airai", Category: "State"},
+	"PW-010": {Code: "PW-010", Country: iso3166.PW, Name: "Angaur", Category: "State"},
+	"PW-050": {Code: "PW-050", Country: iso3166.PW, Name: "Hatohobei", Category: "State"},
+	"PW-100": {Code: "PW-100", Country: iso3166.PW, Name: "Kayangel", Category: "State"},
+	"PW-150": {Code: "PW-150", Country: iso3166.PW, Name: "Koror", Category: "State"},
+	"PW-212": {Code: "PW-212", Country: iso3166.PW, Name: "Melekeok", Category: "State"},
+	"PW-214": {Code: "PW-214", Country: iso3166.PW, Name: "Ngaraard", Category: "State"},
+	"PW-218": {Code: "PW-218", Country: iso3166.PW, Name: "Ngarchelong", Category: "State"},
+	"PW-222": {Code: "PW-222", Country: iso3166.PW, Name: "Ngardmau", Category: "State"},
+	"PW-224": {Code: "PW-224", Country: iso3166.PW, Name: "Ngatpang", Category: "State"},
+	"PW-226": {Code: "PW-226", Country: iso3166.PW, Name: "Ngchesar", Category: "State"},
+	"PW-227": {Code: "PW-227", Country: iso3166.PW, Name: "Ngeremlengui", Category: "State"},
+	"PW-228": {Code: "PW-228", Country: iso3166.PW, Name: "Ngiwal", Category: "State"},
+	"PW-350": {Code: "PW-350", Country: iso3166.PW, Name: "Peleliu", Category: "State"},
+	"PW-370": {Code: "PW-370", Country: iso3166.PW, Name: "Sonsorol", Category: "State"},
+	"PY-1":   {Code: "PY-1", Country: iso3166.PY, Name: "Concepción", Category: "Department"},
+	"PY-10":  {Code: "PY-10", Country: iso3166.PY, Name: "Alto Paraná", Category: "Department"},
+	"PY-11":  {Code: "PY-11", Country: iso3166.PY, Name: "Central", Category: "Department"},
+	"PY-12":  {Code: "PY-12", Country: iso3166.PY, Name: "Ñeembucú", Category: "Department"},
+	"PY-13":  {Code: "PY-13", Country: iso3166.PY, Name: "Amambay", Category: "Department"},
+	"PY-14":  {Code: "PY-14", Country: iso3166.PY, Name: "Canindeyú", Category: "Department"},
+	"PY-15":  {Code: "PY-15", Country: iso3166.PY, Name: "Presidente Hayes", Category: "Department"},
+	"PY-16":  {Code: "PY-16", Country: iso3166.PY, Name: "Alto Paraguay", Category: "Department"},
+	"PY-19":  {Code: "PY-19", Country: iso3166.PY, Name: "Boquerón", Category: "Department"},
+	"PY-2":   {Code: "PY-2", Country: iso3166.PY, Name: "San Pedro", Category: "Department"},
+	"PY-3":   {Code: "PY-3", Country: iso3166.PY, Name: "Cordillera", Category: "Department"},
+	"PY-4":   {Code: "PY-4", Country: iso3166.PY, Name: "Guairá", Category: "Department"},
+	"PY-5":   {Code: "PY-5", Country: iso3166.PY, Name: "Caaguazú", Category: "Department"},
+	"PY-6":   {Code: "PY-6", Country: iso3166.PY, Name: "Caazapá", Category: "Department"},
+	"PY-7":   {Code: "PY-7", Country: iso3166.PY, Name: "Itapúa", Category: "Department"},
+	"PY-8":   {Code: "PY-8", Country: iso3166.PY, Name: "Misiones", Category: "Department"},
+	"PY-9":   {Code: "PY-9", Country: iso3166.PY, Name: "Paraguarí", Category: "Department"},
+	"PY-ASU": {Code: "PY-ASU", Country: iso3166.PY, Name: "Asunción", Category: "Capital"},
+	"QA-DA":  {Code: "QA-DA", Country: iso3166.QA, Name: "Ad Dawḩah", Category: "Municipality"},
+	"QA-KH":  {Code: "QA-KH", Country: iso3166.QA, Name: "Al Khawr wa adh Dhakhīrah", Category: "Municipality"},
+	"QA-MS":  {Code: "QA-MS", Country: iso3166.QA, Name: "Ash Shamāl", Category: "Municipality"},
+	"QA-RA":  {Code: "QA-RA", Country: iso3166.QA, Name: "Ar Rayyān", Category: "Municipality"},
+	"QA-SH":  {Code: "QA-SH", Country: iso3166.QA, Name: "Ash Shīḩānīyah", Category: "Municipality"},
+	"QA-US":  {Code: "QA-US", Country: iso3166.QA, Name: "Umm Şalāl", Category: "Municipality"},
+	"QA-WA":  {Code: "QA-WA", Country: iso3166.QA, Name: "Al Wakrah", Category: "Municipality"},
+	"QA-ZA":  {Code: "QA-ZA", Country: iso3166.QA, Name: "Az̧ Z̧a‘āyin", Category: "Municipality"},
+	"RO-AB":  {Code: "RO-AB", Country: iso3166.RO, Name: "Alba", Category: "Department"},
+	"RO-AG":  {Code: "RO-AG", Country: iso3166.RO, Name: "Argeș", Category: "Department"},
+	"RO-AR":  {Code: "RO-AR", Country: iso3166.RO, Name: "Arad", Category: "Department"},
+	"RO-B":   {Code: "RO-B", Country: iso3166.RO, Name: "București", Category: "Municipality"},
+	"RO-BC":  {Code: "RO-BC", Country: iso3166.RO, Name: "Bacău", Category: "Department"},
+	"RO-BH":  {Code: "RO-BH", Country: iso3166.RO, Name: "Bihor", Category: "Department"},
+	"RO-BN":  {Code: "RO-BN", Country: iso3166.RO, Name: "Bistrița-Năsăud", Category: "Department"},
+	"RO-BR":  {Code: "RO-BR", Country: iso3166.RO, Name: "Brăila", Category: "Department"},
+	"RO-BT":  {Code: "RO-BT", Country: iso3166.RO, Name: "Botoșani", Category: "Department"},
+	"RO-BV":  {Code: "RO-BV", Country: iso3166.RO, Name: "Brașov", Category: "Department"},
+	"RO-BZ":  {Code: "RO-BZ", Country: iso3166.RO, Name: "Buzău", Category: "Department"},
+	"RO-CJ":  {Code: "RO-CJ", Country: iso3166.RO, Name: "Cluj", Category: "Department"},
+	"RO-CL":  {Code: "RO-CL", Country: iso3166.RO, Name: "Călărași", Category: "Department"},
+	"RO-CS":  {Code: "RO-CS", Country: iso3166.RO, Name: "Caraș-Severin", Category: "Department"},
+	"RO-CT":  {Code: "RO-CT", Country: iso3166.RO, Name: "Constanța", Category: "Department"},
+	"RO-CV":  {Code: "RO-CV", Country: iso3166.RO, Name: "Covasna", Category: "Department"},
+	"RO-DB":  {Code: "RO-DB", Country: iso3166.RO, Name: "Dâmbovița", Category: "Department"},
+	"RO-DJ":  {Code: "RO-DJ", Country: iso3166.RO, Name: "Dolj", Category: "Department"},
+	"RO-GJ":  {Code: "RO-GJ", Country: iso3166.RO, Name: "Gorj", Category: "Department"},
+	"RO-GL":  {Code: "RO-GL", Country: iso3166.RO, Name: "Galați", Category: "Department"},
+	"RO-GR":  {Code: "RO-GR", Country: iso3166.RO, Name: "Giurgiu", Category: "Department"},
+	"RO-HD":  {Code: "RO-HD", Country: iso3166.RO, Name: "Hunedoara", Category: "Department"},
+	"RO-HR":  {Code: "RO-HR", Country: iso3166.RO, Name: "Harghita", Category: "Department"},
+	"RO-IF":  {Code: "RO-IF", Country: iso3166.RO, Name: "Ilfov", Category: "Department"},
+	"RO-IL":  {Code: "RO-IL", Country: iso3166.RO, Name: "Ialomița", Category: "Department"},
+	"RO-IS":  {Code: "RO-IS", Country: iso3166.RO, Name: "Iași", Category: "Department"},
+	"RO-MH":  {Code: "RO-MH", Country: iso3166.RO, Name: "Mehedinți", Category: "Department"},
+	"RO-MM":  {Code: "RO-MM", Country: iso3166.RO, Name: "Maramureș", Category: "Department"},
+	"RO-MS":  {Code: "RO-MS", Country: iso3166.RO, Name: "Mureș", Category: "Department"},
+	"RO-NT":  {Code: "RO-NT", Country: iso3166.RO, Name: "Neamț", Category: "Department"},
+	"RO-OT":  {Code: "RO-OT", Country: iso3166.RO, Name: "Olt", Category: "Department"},
+	"RO-PH":  {Code: "RO-PH", Country: iso3166.RO, Name: "Prahova", Category: "Department"},
+	"RO-SB":  {Code: "RO-SB", Country: iso3166.RO, Name: "Sibiu", Category: "Department"},
+	"RO-SJ":  {Code: "RO-SJ", Country: iso3166.RO, Name: "Sălaj", Category: "Department"},
+	"RO-SM":  {Code: "RO-SM", Country: iso3166.RO, Name: "Satu Mare", Category: "Department"},
+	"RO-SV":  {Code: "RO-SV", Country: iso3166.RO, Name: "Suceava", Category: "Department"},
+	"RO-TL":  {Code: "RO-TL", Country: iso3166.RO, Name: "Tulcea", Category: "Department"},
+	"RO-TM":  {Code: "RO-TM", Country: iso3166.RO, Name: "Timiș", Category: "Department"},
+	"RO-TR":  {Code: "RO-TR", Country: iso3166.RO, Name: "Teleorman", Category: "Department"},
+	"RO-VL":  {Code: "RO-VL", Country: iso3166.RO, Name: "Vâlcea", Category: "Department"},
+	"RO-VN":  {Code: "RO-VN", Country: iso3166.RO, Name: "Vrancea", Category: "Department"},
+	"RO-VS":  {Code: "RO-VS", Country: iso3166.RO, Name: "Vaslui", Category: "Department"},
+	"RS-00":  {Code: "RS-00", Country: iso3166.RS, Name: "Beograd", Category: "City"},
+	"RS-01":  {Code: "RS-01", Country: iso3166.RS, Name: "Severnobački okrug", Category: "District", ParentCode: "RS-VO"},
+	"RS-02":  {Code: "RS-02", Country: iso3166.RS, Name: "Srednjebanatski okrug", Category: "District", ParentCode: "RS-VO"},
+	"RS-03":  {Code: "RS-03", Country: iso3166.RS, Name: "Severnobanatski okrug", Category: "District", ParentCode: "RS-VO"},
+	"RS-04":  {Code: "RS-04", Country: iso3166.RS, Name: "Južnobanatski okrug", Category: "District", ParentCode: "RS-VO"},
+	"RS-05":  {Code: "RS-05", Country: iso3166.RS, Name: "Zapadnobački okrug", Category: "District", ParentCode: "RS-VO"},
+	"RS-06":  {Code: "RS-06", Country: iso3166.RS, Name: "Južnobački okrug", Category: "District", ParentCode: "RS-VO"},
+	"RS-07":  {Code: "RS-07", Country: iso3166.RS, Name: "Sremski okrug", Category: "District", ParentCode: "RS-VO"},
+	"RS-08":  {Code: "RS-08", Country: iso3166.RS, Name: "Mačvanski okrug", Category: "District"},
+	"RS-09":  {Code: "RS-09", Country: iso3166.RS, Name: "Kolubarski okrug", Category: "District"},
+	"RS-10":  {Code: "RS-10", Country: iso3166.RS, Name: "Podunavski okrug", Category: "District"},
+	"RS-11":  {Code: "RS-11", Country: iso3166.RS, Name: "Braničevski okrug", Category: "District"},
+	"RS-12":  {Code: "RS-12", Country: iso3166.RS, Name: "Šumadijski okrug", Category: "District"},
+	"RS-13":  {Code: "RS-13", Country: iso3166.RS, Name: "Pomoravski okrug", Category: "District"},
+	"RS-14":  {Code: "RS-14", Country: iso3166.RS, Name: "Borski okrug", Category: "District"},
+	"RS-15":  {Code: "RS-15", Country: iso3166.RS, Name: "Zaječarski okrug", Category: "District"},
+	"RS-16":  {Code: "RS-16", Country: iso3166.RS, Name: "Zlatiborski okrug", Category: "District"},
+	"RS-17":  {Code: "RS-17", Country: iso3166.RS, Name: "Moravički okrug", Category: "District"},
+	"RS-18":  {Code: "RS-18", Country: iso3166.RS, Name: "Raški okrug", Category: "District"},
+	"RS-19":  {Code: "RS-19", Country: iso3166.RS, Name: "Rasinski okrug", Category: "District"},
+	"RS-20":  {Code: "RS-20", Country: iso3166.RS, Name: "Nišavski okrug", Category: "District"},
+	"RS-21":  {Code: "RS-21", Country: iso3166.RS, Name: "Toplički okrug", Category: "District"},
+	"RS-22":  {Code: "RS-22", Country: iso3166.RS, Name: "Pirotski okrug", Category: "District"},
+	"RS-23":  {Code: "RS-23", Country: iso3166.RS, Name: "Jablanički okrug", Category: "District"},
+	"RS-24":  {Code: "RS-24", Country: iso3166.RS, Name: "Pčinjski okrug", Category: "District"},
+	"RS-25":  {Code: "RS-25", Country: iso3166.RS, Name: "Kosovski okrug", Category: "District", ParentCode: "RS-KM"},
+	"RS-26":  {Code: "RS-26", Country: iso3166.RS, Name: "Pećki okrug", Category: "District", ParentCode: "RS-KM"},
+	"RS-27":  {Code: "RS-27", Country: iso3166.RS, Name: "Prizrenski okrug", Category: "District", ParentCode: "RS-KM"},
+	"RS-28":  {Code: "RS-28", Country: iso3166.RS, Name: "Kosovsko-Mitrovački okrug", Category: "District", ParentCode: "RS-KM"},
+	"RS-29":  {Code: "RS-29", Country: iso3166.RS, Name: "Kosovsko-Pomoravski okrug", Category: "District", ParentCode: "RS-KM"},
+	"RS-KM":  {Code: "RS-KM", Country: iso3166.RS, Name: "Kosovo-Metohija", Category: "Autonomous province"},
+	"RS-VO":  {Code: "RS-VO", Country: iso3166.RS, Name: "Vojvodina", Category: "Autonomous province"},
+	"RU-AD":  {Code: "RU-AD", Country: iso3166.RU, Name: "Adygeja, Respublika", Category: "Republic"},
+	"RU-AL":  {Code: "RU-AL", Country: iso3166.RU, Name: "Altaj, Respublika", Category: "Republic"},
+	"RU-ALT": {Code: "RU-ALT", Country: iso3166.RU, Name: "Altajskij kraj", Category: "Administrative territory"},
+	"RU-AMU": {Code: "RU-AMU", Country: iso3166.RU, Name: "Amurskaja oblast'", Category: "Administrative region"},
+	"RU-ARK": {Code: "RU-ARK", Country: iso3166.RU, Name: "Arhangel'skaja oblast'", Category: "Administrative region"},
+	"RU-AST": {Code: "RU-AST", Country: iso3166.RU, Name: "Astrahanskaja oblast'", Category: "Administrative region"},
+	"RU-BA":  {Code: "RU-BA", Country: iso3166.RU, Name: "Bashkortostan, Respublika", Category: "Republic"},
+	"RU-BEL": {Code: "RU-BEL", Country: iso3166.RU, Name: "Belgorodskaja oblast'", Category: "Administrative region"},
+	"RU-BRY": {Code: "RU-BRY", Country: iso3166.RU, Name: "Brjanskaja oblast'", Category: "Administrative region"},
+	"RU-BU":  {Code: "RU-BU", Country: iso3166.RU, Name: "Burjatija, Respublika", Category: "Republic"},
+	"RU-CE":  {Code: "RU-CE", Country: iso3166.RU, Name: "Chechenskaya Respublika", Category: "Republic"},
+	"RU-CHE": {Code: "RU-CHE", Country: iso3166.RU, Name: "Chelyabinskaya oblast'", Category: "Administrative region"},
+	"RU-CHU": {Code: "RU-CHU", Country: iso3166.RU, Name: "Chukotskiy avtonomnyy okrug", Category: "Autonomous district"},
+	"RU-CU":  {Code: "RU-CU", Country: iso3166.RU, Name: "Chuvashskaya Respublika", Category: "Republic"},
+	"RU-DA":  {Code: "RU-DA", Country: iso3166.RU, Name: "Dagestan, Respublika", Category: "Republic"},
+	"RU-IN":  {Code: "RU-IN", Country: iso3166.RU, Name: "Ingushetiya, Respublika", Category: "Republic"},
+	"RU-IRK": {Code: "RU-IRK", Country: iso3166.RU, Name: "Irkutskaja oblast'", Category: "Administrative region"},
+	"RU-IVA": {Code: "RU-IVA", Country: iso3166.RU, Name: "Ivanovskaja oblast'", Category: "Administrative region"},
+	"RU-KAM": {Code: "RU-KAM", Country: iso3166.RU, Name: "Kamchatskiy kray", Category: "Administrative territory"},
+	"RU-KB":  {Code: "RU-KB", Country: iso3166.RU, Name: "Kabardino-Balkarskaja Respublika", Category: "Republic"},
+	"RU-KC":  {Code: "RU-KC", Country: iso3166.RU, Name: "Karachayevo-Cherkesskaya Respublika", Category: "Republic"},
+	"RU-KDA": {Code: "RU-KDA", Country: iso3166.RU, Name: "Krasnodarskij kraj", Category: "Administrative territory"},
+	"RU-KEM": {Code: "RU-KEM", Country: iso3166.RU, Name: "Kemerovskaja oblast'", Category: "Administrative region"},
+	"RU-KGD": {Code: "RU-KGD", Country: iso3166.RU, Name: "Kaliningradskaja oblast'", Category: "Administrative region"},
+	"RU-KGN": {Code: "RU-KGN", Country: iso3166.RU, Name: "Kurganskaja oblast'", Category: "Administrative region"},
+	"RU-KHA": {Code: "RU-KHA", Country: iso3166.RU, Name: "Habarovskij kraj", Category: "Administrative territory"},
+	"RU-KHM": {Code: "RU-KHM", Country: iso3166.RU, Name: "Hanty-Mansijskij avtonomnyj okrug", Category: "Autonomous district"},
+	"RU-KIR": {Code: "RU-KIR", Country: iso3166.RU, Name: "Kirovskaja oblast'", Category: "Administrative region"},
+	"RU-KK":  {Code: "RU-KK", Country: iso3166.RU, Name: "Hakasija, Respublika", Category: "Republic"},
+	"RU-KL":  {Code: "RU-KL", Country: iso3166.RU, Name: "Kalmykija, Respublika", Category: "Republic"},
+	"RU-KLU": {Code: "RU-KLU", Country: iso3166.RU, Name: "Kaluzhskaya oblast'", Category: "Administrative region"},
+	"RU-KO":  {Code: "RU-KO", Country: iso3166.RU, Name: "Komi, Respublika", Category: "Republic"},
+	"RU-KOS": {Code: "RU-KOS", Country: iso3166.RU, Name: "Kostromskaja oblast'", Category: "Administrative region"},
+	"RU-KR":  {Code: "RU-KR", Country: iso3166.RU, Name: "Karelija, Respublika", Category: "Republic"},
+	"RU-KRS": {Code: "RU-KRS", Country: iso3166.RU, Name: "Kurskaja oblast'", Category: "Administrative region"},
+	"RU-KYA": {Code: "RU-KYA", Country: iso3166.RU, Name: "Krasnojarskij kraj", Category: "Administrative territory"},
+	"RU-LEN": {Code: "RU-LEN", Country: iso3166.RU, Name: "Leningradskaja oblast'", Category: "Administrative region"},
+	"RU-LIP": {Code: "RU-LIP", Country: iso3166.RU, Name: "Lipeckaja oblast'", Category: "Administrative region"},
+	"RU-MAG": {Code: "RU-MAG", Country: iso3166.RU, Name: "Magadanskaja oblast'", Category: "Administrative region"},
+	"RU-ME":  {Code: "RU-ME", Country: iso3166.RU, Name: "Marij Èl, Respublika", Category: "Republic"},
+	"RU-MO":  {Code: "RU-MO", Country: iso3166.RU, Name: "Mordovija, Respublika", Category: "Republic"},
+	"RU-MOS": {Code: "RU-MOS", Country: iso3166.RU, Name: "Moskovskaja oblast'", Category: "Administrative region"},
+	"RU-MOW": {Code: "RU-MOW", Country: iso3166.RU, Name: "Moskva", Category: "Autonomous city"},
+	"RU-MUR": {Code: "RU-MUR", Country: iso3166.RU, Name: "Murmanskaja oblast'", Category: "Administrative region"},
+	"RU-NEN": {Code: "RU-NEN", Country: iso3166.RU, Name: "Neneckij avtonomnyj okrug", Category: "Autonomous district"},
+	"RU-NGR": {Code: "RU-NGR", Country: iso3166.RU, Name: "Novgorodskaja oblast'", Category: "Administrative region"},
+	"RU-NIZ": {Code: "RU-NIZ", Country: iso3166.RU, Name: "Nizhegorodskaya oblast'", Category: "Administrative region"},
+	"RU-NVS": {Code: "RU-NVS", Country: iso3166.RU, Name: "Novosibirskaja oblast'", Category: "Administrative region"},
+	"RU-OMS": {Code: "RU-OMS", Country: iso3166.RU, Name: "Omskaja oblast'", Category: "Administrative region"},
+	"RU-ORE": {Code: "RU-ORE", Country: iso3166.RU, Name: "Orenburgskaja oblast'", Category: "Administrative region"},
+	"RU-ORL": {Code: "RU-ORL", Country: iso3166.RU, Name: "Orlovskaja oblast'", Category: "Administrative region"},
+	"RU-PER": {Code: "RU-PER", Country: iso3166.RU, Name: "Permskij kraj", Category: "Administrative territory"},
+	"RU-PNZ": {Code: "RU-PNZ", Country: iso3166.RU, Name: "Penzenskaja oblast'", Category: "Administrative region"},
+	"RU-PRI": {Code: "RU-PRI", Country: iso3166.RU, Name: "Primorskij kraj", Category: "Administrative territory"},
+	"RU-PSK": {Code: "RU-PSK", Country: iso3166.RU, Name: "Pskovskaja oblast'", Category: "Administrative region"},
+	"RU-ROS": {Code: "RU-ROS", Country: iso3166.RU, Name: "Rostovskaja oblast'", Category: "Administrative region"},
+	"RU-RYA": {Code: "RU-RYA", Country: iso3166.RU, Name: "Rjazanskaja oblast'", Category: "Administrative region"},
+	"RU-SA":  {Code: "RU-SA", Country: iso3166.RU, Name: "Saha, Respublika", Category: "Republic"},
+	"RU-SAK": {Code: "RU-SAK", Country: iso3166.RU, Name: "Sahalinskaja oblast'", Category: "Administrative region"},
+	"RU-SAM": {Code: "RU-SAM", Country: iso3166.RU, Name: "Samarskaja oblast'", Category: "Administrative region"},
+	"RU-SAR": {Code: "RU-SAR", Country: iso3166.RU, Name: "Saratovskaja oblast'", Category: "Administrative region"},
+	"RU-SE":  {Code: "RU-SE", Country: iso3166.RU, Name: "Severnaja Osetija, Respublika", Category: "Republic"},
+	"RU-SMO": {Code: "RU-SMO", Country: iso3166.RU, Name: "Smolenskaja oblast'", Category: "Administrative region"},
+	"RU-SPE": {Code: "RU-SPE", Country: iso3166.RU, Name: "Sankt-Peterburg", Category: "Autonomous city"},
+	"RU-STA": {Code: "RU-STA", Country: iso3166.RU, Name: "Stavropol'skij kraj", Category: "Administrative territory"},
+	"RU-SVE": {Code: "RU-SVE", Country: iso3166.RU, Name: "Sverdlovskaja oblast'", Category: "Administrative region"},
+	"RU-TA":  {Code: "RU-TA", Country: iso3166.RU, Name: "Tatarstan, Respublika", Category: "Republic"},
+	"RU-TAM": {Code: "RU-TAM", Country: iso3166.RU, Name: "Tambovskaja oblast'", Category: "Administrative region"},
+	"RU-TOM": {Code: "RU-TOM", Country: iso3166.RU, Name: "Tomskaja oblast'", Category: "Administrative region"},
+	"RU-TUL": {Code: "RU-TUL", Country: iso3166.RU, Name: "Tul'skaja oblast'", Category: "Administrative region"},
+	"RU-TVE": {Code: "RU-TVE", Country: iso3166.RU, Name: "Tverskaja oblast'", Category: "Administrative region"},
+	"RU-TY":  {Code: "RU-TY", Country: iso3166.RU, Name: "Tyva, Respublika", Category: "Republic"},
+	"RU-TYU": {Code: "RU-TYU", Country: iso3166.RU, Name: "Tjumenskaja oblast'", Category: "Administrative region"},
+	"RU-UD":  {Code: "RU-UD", Country: iso3166.RU, Name: "Udmurtskaja Respublika", Category: "Republic"},
+	"RU-ULY": {Code: "RU-ULY", Country: iso3166.RU, Name: "Ul'janovskaja oblast'", Category: "Administrative region"},
+	"RU-VGG": {Code: "RU-VGG", Country: iso3166.RU, Name: "Volgogradskaja oblast'", Category: "Administrative region"},
+	"RU-VLA": {Code: "RU-VLA", Country: iso3166.RU, Name: "Vladimirskaja oblast'", Category: "Administrative region"},
+	"RU-VLG": {Code: "RU-VLG", Country: iso3166.RU, Name: "Vologodskaja oblast'", Category: "Administrative region"},
+	"RU-VOR": {Code: "RU-VOR", Country: iso3166.RU, Name: "Voronezhskaya oblast'", Category: "Administrative region"},
+	"RU-YAN": {Code: "RU-YAN", Country: iso3166.RU, Name: "Jamalo-Neneckij avtonomnyj okrug", Category: "Autonomous district"},
+	"RU-YAR": {Code: "RU-YAR", Country: iso3166.RU, Name: "Jaroslavskaja oblast'", Category: "Administrative region"},
+	"RU-YEV": {Code: "RU-YEV", Country: iso3166.RU, Name: "Evrejskaja avtonomnaja oblast'", Category: "Autonomous region"},
+	"RU-ZAB": {Code: "RU-ZAB", Country: iso3166.RU, Name: "Zabajkal'skij kraj", Category: "Administrative territory"},
+	"RW-01":  {Code: "RW-01", Country: iso3166.RW, Name: "City of Kigali", Category: "City"},
+	"RW-02":  {Code: "RW-02", Country: iso3166.RW, Name: "Eastern", Category: "Province"},
+	"RW-03":  {Code: "RW-03", Country: iso3166.RW, Name: "Northern", Category: "Province"},
+	"RW-04":  {Code: "RW-04", Country: iso3166.RW, Name: "Western", Category: "Province"},
+	"RW-05":  {Code: "RW-05", Country: iso3166.RW, Name: "Southern", Category: "Province"},
+	"SA-01":  {Code: "SA-01", Country: iso3166.SA, Name: "Ar Riyāḑ", Category: "Region"},
+	"SA-02":  {Code: "SA-02", Country: iso3166.SA, Name: "Makkah al Mukarramah", Category: "Region"},
+	"SA-03":  {Code: "SA-03", Country: iso3166.SA, Name: "Al Madīnah al Munawwarah", Category: "Region"},
+	"SA-04":  {Code: "SA-04", Country: iso3166.SA, Name: "Ash Sharqīyah", Category: "Region"},
+	"SA-05":  {Code: "SA-05", Country: iso3166.SA, Name: "Al Qaşīm", Category: "Region"},
+	"SA-06":  {Code: "SA-06", Country: iso3166.SA, Name: "Ḩā'il", Category: "Region"},
+	"SA-07":  {Code: "SA-07", Country: iso3166.SA, Name: "Tabūk", Category: "Region"},
+	"SA-08":  {Code: "SA-08", Country: iso3166.SA, Name: "Al Ḩudūd ash Shamālīyah", Category: "Region"},
+	"SA-09":  {Code: "SA-09", Country: iso3166.SA, Name: "Jāzān", Category: "Region"},
+	"SA-10":  {Code: "SA-10", Country: iso3166.SA, Name: "Najrān", Category: "Region"},
+	"SA-11":  {Code: "SA-11", Country: iso3166.SA, Name: "Al Bāḩah", Category: "Region"},
+	"SA-12":  {Code: "SA-12", Country: iso3166.SA, Name: "Al Jawf", Category: "Region"},
+	"SA-14":  {Code: "SA-14", Country: iso3166.SA, Name: "'Asīr", Category: "Region"},
+	"SB-CE":  {Code: "SB-CE", Country: iso3166.SB, Name: "Central", Category: "Province"},
+	"SB-CH":  {Code: "SB-CH", Country: iso3166.SB, Name: "Choiseul", Category: "Province"},
+	"SB-CT":  {Code: "SB-CT", Country: iso3166.SB, Name: "Capital Territory (Honiara)", Category: "Capital territory"},
+	"SB-GU":  {Code: "SB-GU", Country: iso3166.SB, Name: "Guadalcanal", Category: "Province"},
+	"SB-IS":  {Code: "SB-IS", Country: iso3166.SB, Name: "Isabel", Category: "Province"},
+	"SB-MK":  {Code: "SB-MK", Country: iso3166.SB, Name: "Makira-Ulawa", Category: "Province"},
+	"SB-ML":  {Code: "SB-ML", Country: iso3166.SB, Name: "Malaita", Category: "Province"},
+	"SB-RB":  {Code: "SB-RB", Country: iso3166.SB, Name: "Rennell and Bellona", Category: "Province"},
+	"SB-TE":  {Code: "SB-TE", Country: iso3166.SB, Name: "Temotu", Category: "Province"},
+	"SB-WE":  {Code: "SB-WE", Country: iso3166.SB, Name: "Western", Category: "Province"},
+	"SC-01":  {Code: "SC-01", Country: iso3166.SC, Name: "Anse aux Pins", Category: "District"},
+	"SC-02":  {Code: "SC-02", Country: iso3166.SC, Name: "Anse Boileau", Category: "District"},
+	"SC-03":  {Code: "SC-03", Country: iso3166.SC, Name: "Anse Etoile", Category: "District"},
+	"SC-04":  {Code: "SC-04", Country: iso3166.SC, Name: "Au Cap", Category: "District"},
+	"SC-05":  {Code: "SC-05", Country: iso3166.SC, Name: "Anse Royale", Category: "District"},
+	"SC-06":  {Code: "SC-06", Country: iso3166.SC, Name: "Baie Lazare", Category: "District"},
+	"SC-07":  {Code: "SC-07", Country: iso3166.SC, Name: "Baie Sainte Anne", Category: "District"},
+	"SC-08":  {Code: "SC-08", Country: iso3166.SC, Name: "Beau Vallon", Category: "District"},
+	"SC-09":  {Code: "SC-09", Country: iso3166.SC, Name: "Bel Air", Category: "District"},
+	"SC-10":  {Code: "SC-10", Country: iso3166.SC, Name: "Bel Ombre", Category: "District"},
+	"SC-11":  {Code: "SC-11", Country: iso3166.SC, Name: "Cascade", Category: "District"},
+	"SC-12":  {Code: "SC-12", Country: iso3166.SC, Name: "Glacis", Category: "District"},
+	"SC-13":  {Code: "SC-13", Country: iso3166.SC, Name: "Grand Anse Mahe", Category: "District"},
+	"SC-14":  {Code: "SC-14", Country: iso3166.SC, Name: "Grand Anse Praslin", Category: "District"},
+	"SC-15":  {Code: "SC-15", Country: iso3166.SC, Name: "La Digue", Category: "District"},
+	"SC-16":  {Code: "SC-16", Country: iso3166.SC, Name: "English River", Category: "District"},
+	"SC-17":  {Code: "SC-17", Country: iso3166.SC, Name: "Mont Buxton", Category: "District"},
+	"SC-18":  {Code: "SC-18", Country: iso3166.SC, Name: "Mont Fleuri", Category: "District"},
+	"SC-19":  {Code: "SC-19", Country: iso3166.SC, Name: "Plaisance", Category: "District"},
+	"SC-20":  {Code: "SC-20", Country: iso3166.SC, Name: "Pointe Larue", Category: "District"},
+	"SC-21":  {Code: "SC-21", Country: iso3166.SC, Name: "Port Glaud", Category: "District"},
+	"SC-22":  {Code: "SC-22", Country: iso3166.SC, Name: "Saint Louis", Category: "District"},
+	"SC-23":  {Code: "SC-23", Country: iso3166.SC, Name: "Takamaka", Category: "District"},
+	"SC-24":  {Code: "SC-24", Country: iso3166.SC, Name: "Les Mamelles", Category: "District"},
+	"SC-25":  {Code: "SC-25", Country: iso3166.SC, Name: "Roche Caiman", Category: "District"},
+	"SC-26":  {Code: "SC-26", Country: iso3166.SC, Name: "Ile Perseverance I", Category: "District"},
+	"SC-27":  {Code: "SC-27", Country: iso3166.SC, Name: "Ile Perseverance II", Category: "District"},
+	"SD-DC":  {Code: "SD-DC", Country: iso3166.SD, Name: "Central Darfur", Category: "State"},
+	"SD-DE":  {Code: "SD-DE", Country: iso3166.SD, Name: "East Darfur", Category: "State"},
+	"SD-DN":  {Code: "SD-DN", Country: iso3166.SD, Name: "North Darfur", Category: "State"},
+	"SD-DS":  {Code: "SD-DS", Country: iso3166.SD, Name: "South Darfur", Category: "State"},
+	"SD-DW":  {Code: "SD-DW", Country: iso3166.SD, Name: "West Darfur", Category: "State"},
+	"SD-GD":  {Code: "SD-GD", Country: iso3166.SD, Name: "Gedaref", Category: "State"},
+	"SD-GK":  {Code: "SD-GK", Country: iso3166.SD, Name: "West Kordofan", Category: "State"},
+	"SD-GZ":  {Code: "SD-GZ", Country: iso3166.SD, Name: "Gezira", Category: "State"},
+	"SD-KA":  {Code: "SD-KA", Country: iso3166.SD, Name: "Kassala", Category: "State"},
+	"SD-KH":  {Code: "SD-KH", Country: iso3166.SD, Name: "Khartoum", Category: "State"},
+	"SD-KN":  {Code: "SD-KN", Country: iso3166.SD, Name: "North Kordofan", Category: "State"},
+	"SD-KS":  {Code: "SD-KS", Country: iso3166.SD, Name: "South Kordofan", Category: "State"},
+	"SD-NB":  {Code: "SD-NB", Country: iso3166.SD, Name: "Blue Nile", Category: "State"},
+	"SD-NO":  {Code: "SD-NO", Country: iso3166.SD, Name: "Northern", Category: "State"},
+	"SD-NR":  {Code: "SD-NR", Country: iso3166.SD, Name: "River Nile", Category: "State"},
+	"SD-NW":  {Code: "SD-NW", Country: iso3166.SD, Name: "White Nile", Category: "State"},
+	"SD-RS":  {Code: "SD-RS", Country: iso3166.SD, Name: "Red Sea", Category: "State"},
+	"SD-SI":  {Code: "SD-SI", Country: iso3166.SD, Name: "Sennar", Category: "State"},
+	"SE-AB":  {Code: "SE-AB", Country: iso3166.SE, Name: "Stockholms län [SE-01]", Category: "County"},
+	"SE-AC":  {Code: "SE-AC", Country: iso3166.SE, Name: "Västerbottens län [SE-24]", Category: "County"},
+	"SE-BD":  {Code: "SE-BD", Country: iso3166.SE, Name: "Norrbottens län [SE-25]", Category: "County"},
+	"SE-C":   {Code: "SE-C", Country: iso3166.SE, Name: "Uppsala län [SE-03]", Category: "County"},
+	"SE-D":   {Code: "SE-D", Country: iso3166.SE, Name: "Södermanlands län [SE-04]", Category: "County"},
+	"SE-E":   {Code: "SE-E", Country: iso3166.SE, Name: "Östergötlands län [SE-05]", Category: "County"},
+	"SE-F":   {Code: "SE-F", Country: iso3166.SE, Name: "Jönköpings län [SE-06]", Category: "County"},
+	"SE-G":   {Code: "SE-G", Country: iso3166.SE, Name: "Kronobergs län [SE-07]", Category: "County"},
+	"SE-H":   {Code: "SE-H", Country: iso3166.SE, Name: "Kalmar län [SE-08]", Category: "County"},
+	"SE-I":   {Code: "SE-I", Country: iso3166.SE, Name: "Gotlands län [SE-09]", Category: "County"},
+	"SE-K":   {Code: "SE-K", Country: iso3166.SE, Name: "Blekinge län [SE-10]", Category: "County"},
+	"SE-M":   {Code: "SE-M", Country: iso3166.SE, Name: "Skåne län [SE-12]", Category: "County"},
+	"SE-N":   {Code: "SE-N", Country: iso3166.SE, Name: "Hallands län [SE-13]", Category: "County"},
+	"SE-O":   {Code: "SE-O", Country: iso3166.SE, Name: "Västra Götalands län [SE-14]", Category: "County"},
+	"SE-S":   {Code: "SE-S", Country: iso3166.SE, Name: "Värmlands län [SE-17]", Category: "County"},
+	"SE-T":   {Code: "SE-T", Country: iso3166.SE, Name: "Örebro län [SE-18]", Category: "County"},
+	"SE-U":   {Code: "SE-U", Country: iso3166.SE, Name: "Västmanlands län [SE-19]", Category: "County"},
+	"SE-W":   {Code: "SE-W", Country: iso3166.SE, Name: "Dalarnas län [SE-20]", Category: "County"},
+	"SE-X":   {Code: "SE-X", Country: iso3166.SE, Name: "Gävleborgs län [SE-21]", Category: "County"},
+	"SE-Y":   {Code: "SE-Y", Country: iso3166.SE, Name: "Västernorrlands län [SE-22]", Category: "County"},
+	"SE-Z":   {Code: "SE-Z", Country: iso3166.SE, Name: "Jämtlands län [SE-23]", Category: "County"},
+	"SG-01":  {Code: "SG-01", Country: iso3166.SG, Name: "Central Singapore", Category: "District"},
+	"SG-02":  {Code: "SG-02", Country: iso3166.SG, Name: "North East", Category: "District"},
+	"SG-03":  {Code: "SG-03", Country: iso3166.SG, Name: "North West", Category: "District"},
+	"SG-04":  {Code: "SG-04", Country: iso3166.SG, Name: "South East", Category: "District"},
+	"SG-05":  {Code: "SG-05", Country: iso3166.SG, Name: "South West", Category: "District"},
+	"SH-AC":  {Code: "SH-AC", Country: iso3166.SH, Name: "Ascension", Category: "Geographical entity"},
+	"SH-HL":  {Code: "SH-HL", Country: iso3166.SH, Name: "Saint Helena", Category: "Geographical entity"},
+	"SH-TA":  {Code: "SH-TA", Country: iso3166.SH, Name: "Tristan da Cunha", Category: "Geographical entity"},
+	"SI-001": {Code: "SI-001", Country: iso3166.SI, Name: "Ajdovščina", Category: "Municipality"},
+	"SI-002": {Code: "SI-002", Country: iso3166.SI, Name: "Beltinci", Category: "Municipality"},
+	"SI-003": {Code: "SI-003", Country: iso3166.SI, Name: "Bled", Category: "Municipality"},
+	"SI-004": {Code: "SI-004", Country: iso3166.SI, Name: "Bohinj", Category: "Municipality"},
+	"SI-005": {Code: "SI-005", Country: iso3166.SI, Name: "Borovnica", Category: "Municipality"},
+	"SI-006": {Code: "SI-006", Country: iso3166.SI, Name: "Bovec", Category: "Municipality"},
+	"SI-007": {Code: "SI-007", Country: iso3166.SI, Name: "Brda", Category: "Municipality"},
+	"SI-008": {Code: "SI-008", Country: iso3166.SI, Name: "Brezovica", Category: "Municipality"},
+	"SI-009": {Code: "SI-009", Country: iso3166.SI, Name: "Brežice", Category: "Municipality"},
+	"SI-010": {Code: "SI-010", Country: iso3166.SI, Name: "Tišina", Category: "Municipality"},
+	"SI-011": {Code: "SI-011", Country: iso3166.SI, Name: "Celje", Category: "Municipality"},
+	"SI-012": {Code: "SI-012", Country: iso3166.SI, Name: "Cerklje na Gorenjskem", Category: "Municipality"},
+	"SI-013": {Code: "SI-013", Country: iso3166.SI, Name: "Cerknica", Category: "Municipality"},
+	"SI-014": {Code: "SI-014", Country: iso3166.SI, Name: "Cerkno", Category: "Municipality"},
+	"SI-015": {Code: "SI-015", Country: iso3166.SI, Name: "Črenšovci", Category: "Municipality"},
+	"SI-016": {Code: "SI-016", Country: iso3166.SI, Name: "Črna na Koroškem", Category: "Municipality"},
+	"SI-017": {Code: "SI-017", Country: iso3166.SI, Name: "Črnomelj", Category: "Municipality"},
+	"SI-018": {Code: "SI-018", Country: iso3166.SI, Name: "Destrnik", Category: "Municipality"},
+	"SI-019": {Code: "SI-019", Country: iso3166.SI, Name: "Divača", Category: "Municipality"},
+	"SI-020": {Code: "SI-020", Country: iso3166.SI, Name: "Dobrepolje", Category: "Municipality"},
+	"SI-021": {Code: "SI-021", Country: iso3166.SI, Name: "Dobrova-Polhov Gradec", Category: "Municipality"},
+	"SI-022": {Code: "SI-022", Country: iso3166.SI, Name: "Dol pri Ljubljani", Category: "Municipality"},
+	"SI-023": {Code: "SI-023", Country: iso3166.SI, Name: "Domžale", Category: "Municipality"},
+	"SI-024": {Code: "SI-024", Country: iso3166.SI, Name: "Dornava", Category: "Municipality"},
+	"SI-025": {Code: "SI-025", Country: iso3166.SI, Name: "Dravograd", Category: "Municipality"},
+	"SI-026": {Code: "SI-026", Country: iso3166.SI, Name: "Duplek", Category: "Municipality"},
+	"SI-027": {Code: "SI-027", Country: iso3166.SI, Name: "Gorenja vas-Poljane", Category: "Municipality"},
+	"SI-028": {Code: "SI-028", Country: iso3166.SI, Name: "Gorišnica", Category: "Municipality"},
+	"SI-029": {Code: "SI-029", Country: iso3166.SI, Name: "Gornja Radgona", Category: "Municipality"},
+	"SI-030": {Code: "SI-030", Country: iso3166.SI, Name: "Gornji Grad", Category: "Municipality"},
+	"SI-031": {Code: "SI-031", Country: iso3166.SI, Name: "Gornji Petrovci", Category: "Municipality"},
+	"SI-032": {Code: "SI-032", Country: iso3166.SI, Name: "Grosuplje", Category: "Municipality"},
+	"SI-033": {Code: "SI-033", Country: iso3166.SI, Name: "Šalovci", Category: "Municipality"},
+	"SI-034": {Code: "SI-034", Country: iso3166.SI, Name: "Hrastnik", Category: "Municipality"},
+	"SI-035": {Code: "SI-035", Country: iso3166.SI, Name: "Hrpelje-Kozina", Category: "Municipality"},
+	"SI-036": {Code: "SI-036", Country: iso3166.SI, Name: "Idrija", Category: "Municipality"},
+	"SI-037": {Code: "SI-037", Country: iso3166.SI, Name: "Ig", Category: "Municipality"},
+	"SI-038": {Code: "SI-038", Country: iso3166.SI, Name: "Ilirska Bistrica", Category: "Municipality"},
+	"SI-039": {Code: "SI-039", Country: iso3166.SI, Name: "Ivančna Gorica", Category: "Municipality"},
+	"SI-040": {Code: "SI-040", Country: iso3166.SI, Name: "Izola", Category: "Municipality"},
+	"SI-041": {Code: "SI-041", Country: iso3166.SI, Name: "Jesenice", Category: "Municipality"},
+	"SI-042": {Code: "SI-042", Country: iso3166.SI, Name: "Juršinci", Category: "Municipality"},
+	"SI-043": {Code: "SI-043", Country: iso3166.SI, Name: "Kamnik", Category: "Municipality"},
+	"SI-044": {Code: "SI-044", Country: iso3166.SI, Name: "Kanal", Category: "Municipality"},
+	"SI-045": {Code: "SI-045", Country: iso3166.SI, Name: "Kidričevo", Category: "Municipality"},
+	"SI-046": {Code: "SI-046", Country: iso3166.SI, Name: "Kobarid", Category: "Municipality"},
+	"SI-047": {Code: "SI-047", Country: iso3166.SI, Name: "Kobilje", Category: "Municipality"},
+	"SI-048": {Code: "SI-048", Country: iso3166.SI, Name: "Kočevje", Category: "Municipality"},
+	"SI-049": {Code: "SI-049", Country: iso3166.SI, Name: "Komen", Category: "Municipality"},
+	"SI-050": {Code: "SI-050", Country: iso3166.SI, Name: "Koper", Category: "Municipality"},
+	"SI-051": {Code: "SI-051", Country: iso3166.SI, Name: "Kozje", Category: "Municipality"},
+	"SI-052": {Code: "SI-052", Country: iso3166.SI, Name: "Kranj", Category: "Municipality"},
+	"SI-053": {Code: "SI-053", Country: iso3166.SI, Name: "Kranjska Gora", Category: "Municipality"},
+	"SI-054": {Code: "SI-054", Country: iso3166.SI, Name: "Krško", Category: "Municipality"},
+	"SI-055": {Code: "SI-055", Country: iso3166.SI, Name: "Kungota", Category: "Municipality"},
+	"SI-056": {Code: "SI-056", Country: iso3166.SI, Name: "Kuzma", Category: "Municipality"},
+	"SI-057": {Code: "SI-057", Country: iso3166.SI, Name: "Laško", Category: "Municipality"},
+	"SI-058": {Code: "SI-058", Country: iso3166.SI, Name: "Lenart", Category: "Municipality"},
+	"SI-059": {Code: "SI-059", Country: iso3166.SI, Name: "Lendava", Category: "Municipality"},
+	"SI-060": {Code: "SI-060", Country: iso3166.SI, Name: "Litija", Category: "Municipality"},
+	"SI-061": {Code: "SI-061", Country: iso3166.SI, Name: "Ljubljana", Category: "Municipality"},
+	"SI-062": {Code: "SI-062", Country: iso3166.SI, Name: "Ljubno", Category: "Municipality"},
+	"SI-063": {Code: "SI-063", Country: iso3166.SI, Name: "Ljutomer", Category: "Municipality"},
+	"SI-064": {Code: "SI-064", Country: iso3166.SI, Name: "Logatec", Category: "Municipality"},
+	"SI-065": {Code: "SI-065", Country: iso3166.SI, Name: "Loška dolina", Category: "Municipality"},
+	"SI-066": {Code: "SI-066", Country: iso3166.SI, Name: "Loški Potok", Category: "Municipality"},
+	"SI-067": {Code: "SI-067", Country: iso3166.SI, Name: "Luče", Category: "Municipality"},
+	"SI-068": {Code: "SI-068", Country: iso3166.SI, Name: "Lukovica", Category: "Municipality"},
+	"SI-069": {Code: "SI-069", Country: iso3166.SI, Name: "Majšperk", Category: "Municipality"},
+	"SI-070": {Code: "SI-070", Country: iso3166.SI, Name: "Maribor", Category: "Municipality"},
+	"SI-071": {Code: "SI-071", Country: iso3166.SI, Name: "Medvode", Category: "Municipality"},
+	"SI-072": {Code: "SI-072", Country: iso3166.SI, Name: "Mengeš", Category: "Municipality"},
+	"SI-073": {Code: "SI-073", Country: iso3166.SI, Name: "Metlika", Category: "Municipality"},
+	"SI-074": {Code: "SI-074", Country: iso3166.SI, Name: "Mežica", Category: "Municipality"},
+	"SI-075": {Code: "SI-075", Country: iso3166.SI, Name: "Miren-Kostanjevica", Category: "Municipality"},
+	"SI-076": {Code: "SI-076", Country: iso3166.SI, Name: "Mislinja", Category: "Municipality"},
+	"SI-077": {Code: "SI-077", Country: iso3166.SI, Name: "Moravče", Category: "Municipality"},
+	"SI-078": {Code: "SI-078", Country: iso3166.SI, Name: "Moravske Toplice", Category: "Municipality"},
+	"SI-079": {Code: "SI-079", Country: iso3166.SI, Name: "Mozirje", Category: "Municipality"},
+	"SI-080": {Code: "SI-080", Country: iso3166.SI, Name: "Murska Sobota", Category: "Municipality"},
+	"SI-081": {Code: "SI-081", Country: iso3166.SI, Name: "Muta", Category: "Municipality"},
+	"SI-082": {Code: "SI-082", Country: iso3166.SI, Name: "Naklo", Category: "Municipality"},
+	"SI-083": {Code: "SI-083", Country: iso3166.SI, Name: "Nazarje", Category: "Municipality"},
+	"SI-084": {Code: "SI-084", Country: iso3166.SI, Name: "Nova Gorica", Category: "Municipality"},
+	"SI-085": {Code: "SI-085", Country: iso3166.SI, Name: "Novo Mesto", Category: "Municipality"},
+	"SI-086": {Code: "SI-086", Country: iso3166.SI, Name: "Odranci", Category: "Municipality"},
+	"SI-087": {Code: "SI-087", Country: iso3166.SI, Name: "Ormož", Category: "Municipality"},
+	"SI-088": {Code: "SI-088", Country: iso3166.SI, Name: "Osilnica", Category: "Municipality"},
+	"SI-089": {Code: "SI-089", Country: iso3166.SI, Name: "Pesnica", Category: "Municipality"},
+	"SI-090": {Code: "SI-090", Country: iso3166.SI, Name: "Piran", Category: "Municipality"},
+	"SI-091": {Code: "SI-091", Country: iso3166.SI, Name: "Pivka", Category: "Municipality"},
+	"SI-092": {Code: "SI-092", Country: iso3166.SI, Name: "Podčetrtek", Category: "Municipality"},
+	"SI-093": {Code: "SI-093", Country: iso3166.SI, Name: "Podvelka", Category: "Municipality"},
+	"SI-094": {Code: "SI-094", Country: iso3166.SI, Name: "Postojna", Category: "Municipality"},
+	"SI-095": {Code: "SI-095", Country: iso3166.SI, Name: "Preddvor", Category: "Municipality"},
+	"SI-096": {Code: "SI-096", Country: iso3166.SI, Name: "Ptuj", Category: "Municipality"},
+	"SI-097": {Code: "SI-097", Country: iso3166.SI, Name: "Puconci", Category: "Municipality"},
+	"SI-098": {Code: "SI-098", Country: iso3166.SI, Name: "Rače-Fram", Category: "Municipality"},
+	"SI-099": {Code: "SI-099", Country: iso3166.SI, Name: "Radeče", Category: "Municipality"},
+	"SI-100": {Code: "SI-100", Country: iso3166.SI, Name: "Radenci", Category: "Municipality"},
+	"SI-101": {Code: "SI-101", Country: iso3166.SI, Name: "Radlje ob Dravi", Category: "Municipality"},
+	"SI-102": {Code: "SI-102", Country: iso3166.SI, Name: "Radovljica", Category: "Municipality"},
+	"SI-103": {Code: "SI-103", Country: iso3166.SI, Name: "Ravne na Koroškem", Category: "Municipality"},
+	"SI-104": {Code: "SI-104", Country: iso3166.SI, Name: "Ribnica", Category: "Municipality"},
+	"SI-105": {Code: "SI-105", Country: iso3166.SI, Name: "Rogašovci", Category: "Municipality"},
+	"SI-106": {Code: "SI-106", Country: iso3166.SI, Name: "Rogaška Slatina", Category: "Municipality"},
+	"SI-107": {Code: "SI-107", Country: iso3166.SI, Name: "Rogatec", Category: "Municipality"},
+	"SI-108": {Code: "SI-108", Country: iso3166.SI, Name: "Ruše", Category: "Municipality"},
+	"SI-109": {Code: "SI-109", Country: iso3166.SI, Name: "Semič", Category: "Municipality"},
+	"SI-110": {Code: "SI-110", Country: iso3166.SI, Name: "Sevnica", Category: "Municipality"},
+	"SI-111": {Code: "SI-111", Country: iso3166.SI, Name: "Sežana", Category: "Municipality"},
+	"SI-112": {Code: "SI-112", Country: iso3166.SI, Name: "Slovenj Gradec", Category: "Municipality"},
+	"SI-113": {Code: "SI-113", Country: iso3166.SI, Name: "Slovenska Bistrica", Category: "Municipality"},
+	"SI-114": {Code: "SI-114", Country: iso3166.SI, Name: "Slovenske Konjice", Category: "Municipality"},
+	"SI-115": {Code: "SI-115", Country: iso3166.SI, Name: "Starše", Category: "Municipality"},
+	"SI-116": {Code: "SI-116", Country: iso3166.SI, Name: "Sveti Jurij ob Ščavnici", Category: "Municipality"},
+	"SI-117": {Code: "SI-117", Country: iso3166.SI, Name: "Šenčur", Category: "Municipality"},
+	"SI-118": {Code: "SI-118", Country: iso3166.SI, Name: "Šentilj", Category: "Municipality"},
+	"SI-119": {Code: "SI-119", Country: iso3166.SI, Name: "Šentjernej", Category: "Municipality"},
+	"SI-120": {Code: "SI-120", Country: iso3166.SI, Name: "Šentjur", Category: "Municipality"},
+	"SI-121": {Code: "SI-121", Country: iso3166.SI, Name: "Škocjan", Category: "Municipality"},
+	"SI-122": {Code: "SI-122", Country: iso3166.SI, Name: "Škofja Loka", Category: "Municipality"},
+	"SI-123": {Code: "SI-123", Country: iso3166.SI, Name: "Škofljica", Category: "Municipality"},
+	"SI-124": {Code: "SI-124", Country: iso3166.SI, Name: "Šmarje pri Jelšah", Category: "Municipality"},
+	"SI-125": {Code: "SI-125", Country: iso3166.SI, Name: "Šmartno ob Paki", Category: "Municipality"},
+	"SI-126": {Code: "SI-126", Country: iso3166.SI, Name: "Šoštanj", Category: "Municipality"},
+	"SI-127": {Code: "SI-127", Country: iso3166.SI, Name: "Štore", Category: "Municipality"},
+	"SI-128": {Code: "SI-128", Country: iso3166.SI, Name: "Tolmin", Category: "Municipality"},
+	"SI-129": {Code: "SI-129", Country: iso3166.SI, Name: "Trbovlje", Category: "Municipality"},
+	"SI-130": {Code: "SI-130", Country: iso3166.SI, Name: "Trebnje", Category: "Municipality"},
+	"SI-131": {Code: "SI-131", Country: iso3166.SI, Name: "Tržič", Category: "Municipality"},
+	"SI-132": {Code: "SI-132", Country: iso3166.SI, Name: "Turnišče", Category: "Municipality"},
+	"SI-133": {Code: "SI-133", Country: iso3166.SI, Name: "Velenje", Category: "Municipality"},
+	"SI-134": {Code: "SI-134", Country: iso3166.SI, Name: "Velike Lašče", Category: "Municipality"},
+	"SI-135": {Code: "SI-135", Country: iso3166.SI, Name: "Videm", Category: "Municipality"},
+	"SI-136": {Code: "SI-136", Country: iso3166.SI, Name: "Vipava", Category: "Municipality"},
+	"SI-137": {Code: "SI-137", Country: iso3166.SI, Name: "Vitanje", Category: "Municipality"},
+	"SI-138": {Code: "SI-138", Country: iso3166.SI, Name: "Vodice", Category: "Municipality"},
+	"SI-139": {Code: "SI-139", Country: iso3166.SI, Name: "Vojnik", Category: "Municipality"},
+	"SI-140": {Code: "SI-140", Country: iso3166.SI, Name: "Vrhnika", Category: "Municipality"},
+	"SI-141": {Code: "SI-141", Country: iso3166.SI, Name: "Vuzenica", Category: "Municipality"},
+	"SI-142": {Code: "SI-142", Country: iso3166.SI, Name: "Zagorje ob Savi", Category: "Municipality"},
+	"SI-143": {Code: "SI-143", Country: iso3166.SI, Name: "Zavrč", Category: "Municipality"},
+	"SI-144": {Code: "SI-144", Country: iso3166.SI, Name: "Zreče", Category: "Municipality"},
+	"SI-146": {Code: "SI-146", Country: iso3166.SI, Name: "Železniki", Category: "Municipality"},
+	"SI-147": {Code: "SI-147", Country: iso3166.SI, Name: "Žiri", Category: "Municipality"},
+	"SI-148": {Code: "SI-148", Country: iso3166.SI, Name: "Benedikt", Category: "Municipality"},
+	"SI-149": {Code: "SI-149", Country: iso3166.SI, Name: "Bistrica ob Sotli", Category: "Municipality"},
+	"SI-150": {Code: "SI-150", Country: iso3166.SI, Name: "Bloke", Category: "Municipality"},
+	"SI-151": {Code: "SI-151", Country: iso3166.SI, Name: "Braslovče", Category: "Municipality"},
+	"SI-152": {Code: "SI-152", Country: iso3166.SI, Name: "Cankova", Category: "Municipality"},
+	"SI-153": {Code: "SI-153", Country: iso3166.SI, Name: "Cerkvenjak", Category: "Municipality"},
+	"SI-154": {Code: "SI-154", Country: iso3166.SI, Name: "Dobje", Category: "Municipality"},
+	"SI-155": {Code: "SI-155", Country: iso3166.SI, Name: "Dobrna", Category: "Municipality"},
+	"SI-156": {Code: "SI-156", Country: iso3166.SI, Name: "Dobrovnik", Category: "Municipality"},
+	"SI-157": {Code: "SI-157", Country: iso3166.SI, Name: "Dolenjske Toplice", Category: "Municipality"},
+	"SI-158": {Code: "SI-158", Country: iso3166.SI, Name: "Grad", Category: "Municipality"},
+	"SI-159": {Code: "SI-159", Country: iso3166.SI, Name: "Hajdina", Category: "Municipality"},
+	"SI-160": {Code: "SI-160", Country: iso3166.SI, Name: "Hoče-Slivnica", Category: "Municipality"},
+	"SI-161": {Code: "SI-161", Country: iso3166.SI, Name: "Hodoš", Category: "Municipality"},
+	"SI-162": {Code: "SI-162", Country: iso3166.SI, Name: "Horjul", Category: "Municipality"},
+	"SI-163": {Code: "SI-163", Country: iso3166.SI, Name: "Jezersko", Category: "Municipality"},
+	"SI-164": {Code: "SI-164", Country: iso3166.SI, Name: "Komenda", Category: "Municipality"},
+	"SI-165": {Code: "SI-165", Country: iso3166.SI, Name: "Kostel", Category: "Municipality"},
+	"SI-166": {Code: "SI-166", Country: iso3166.SI, Name: "Križevci", Category: "Municipality"},
+	"SI-167": {Code: "SI-167", Country: iso3166.SI, Name: "Lovrenc na Pohorju", Category: "Municipality"},
+	"SI-168": {Code: "SI-168", Country: iso3166.SI, Name: "Markovci", Category: "Municipality"},
+	"SI-169": {Code: "SI-169", Country: iso3166.SI, Name: "Miklavž na Dravskem polju", Category: "Municipality"},
+	"SI-170": {Code: "SI-170", Country: iso3166.SI, Name: "Mirna Peč", Category: "Municipality"},
+	"SI-171": {Code: "SI-171", Country: iso3166.SI, Name: "Oplotnica", Category: "Municipality"},
+	"SI-172": {Code: "SI-172", Country: iso3166.SI, Name: "Podlehnik", Category: "Municipality"},
+	"SI-173": {Code: "SI-173", Country: iso3166.SI, Name: "Polzela", Category: "Municipality"},
+	"SI-174": {Code: "SI-174", Country: iso3166.SI, Name: "Prebold", Category: "Municipality"},
+	"SI-175": {Code: "SI-175", Country: iso3166.SI, Name: "Prevalje", Category: "Municipality"},
+	"SI-176": {Code: "SI-176", Country: iso3166.SI, Name: "Razkrižje", Category: "Municipality"},
+	"SI-177": {Code: "SI-177", Country: iso3166.SI, Name: "Ribnica na Pohorju", Category: "Municipality"},
+	"SI-178": {Code: "SI-178", Country: iso3166.SI, Name: "Selnica ob Dravi", Category: "Municipality"},
+	"SI-179": {Code: "SI-179", Country: iso3166.SI, Name: "Sodražica", Category: "Municipality"},
+	"SI-180": {Code: "SI-180", Country: iso3166.SI, Name: "Solčava", Category: "Municipality"},
+	"SI-181": {Code: "SI-181", Country: iso3166.SI, Name: "Sveta Ana", Category: "Municipality"},
+	"SI-182": {Code: "SI-182", Country: iso3166.SI, Name: "Sveti Andraž v Slovenskih goricah", Category: "Municipality"},
+	"SI-183": {Code: "SI-183", Country: iso3166.SI, Name: "Šempeter-Vrtojba", Category: "Municipality"},
+	"SI-184": {Code: "SI-184", Country: iso3166.SI, Name: "Tabor", Category: "Municipality"},
+	"SI-185": {Code: "SI-185", Country: iso3166.SI, Name: "Trnovska Vas", Category: "Municipality"},
+	"SI-186": {Code: "SI-186", Country: iso3166.SI, Name: "Trzin", Category: "Municipality"},
+	"SI-187": {Code: "SI-187", Country: iso3166.SI, Name: "Velika Polana", Category: "Municipality"},
+	"SI-188": {Code: "SI-188", Country: iso3166.SI, Name: "Veržej", Category: "Municipality"},
+	"SI-189": {Code: "SI-189", Country: iso3166.SI, Name: "Vransko", Category: "Municipality"},
+	"SI-190": {Code: "SI-190", Country: iso3166.SI, Name: "Žalec", Category: "Municipality"},
+	"SI-191": {Code: "SI-191", Country: iso3166.SI, Name: "Žetale", Category: "Municipality"},
+	"SI-192": {Code: "SI-192", Country: iso3166.SI, Name: "Žirovnica", Category: "Municipality"},
+	"SI-193": {Code: "SI-193", Country: iso3166.SI, Name: "Žužemberk", Category: "Municipality"},
+	"SI-194": {Code: "SI-194", Country: iso3166.SI, Name: "Šmartno pri Litiji", Category: "Municipality"},
+	"SI-195": {Code: "SI-195", Country: iso3166.SI, Name: "Apače", Category: "Municipality"},
+	"SI-196": {Code: "SI-196", Country: iso3166.SI, Name: "Cirkulane", Category: "Municipality"},
+	"SI-197": {Code: "SI-197", Country: iso3166.SI, Name: "Kosanjevica na Krki", Category: "Municipality"},
+	"SI-198": {Code: "SI-198", Country: iso3166.SI, Name: "Makole", Category: "Municipality"},
+	"SI-199": {Code: "SI-199", Country: iso3166.SI, Name: "Mokronog-Trebelno", Category: "Municipality"},
+	"SI-200": {Code: "SI-200", Country: iso3166.SI, Name: "Poljčane", Category: "Municipality"},
+	"SI-201": {Code: "SI-201", Country: iso3166.SI, Name: "Renče-Vogrsko", Category: "Municipality"},
+	"SI-202": {Code: "SI-202", Country: iso3166.SI, Name: "Središče ob Dravi", Category: "Municipality"},
+	"SI-203": {Code: "SI-203", Country: iso3166.SI, Name: "Straža", Category: "Municipality"},
+	"SI-204": {Code: "SI-204", Country: iso3166.SI, Name: "Sveta Trojica v Slovenskih goricah", Category: "Municipality"},
+	"SI-205": {Code: "SI-205", Country: iso3166.SI, Name: "Sveti Tomaž", Category: "Municipality"},
+	"SI-206": {Code: "SI-206", Country: iso3166.SI, Name: "Šmarješke Toplice", Category: "Municipality"},
+	"SI-207": {Code: "SI-207", Country: iso3166.SI, Name: "Gorje", Category: "Municipality"},
+	"SI-208": {Code: "SI-208", Country: iso3166.SI, Name: "Log-Dragomer", Category: "Municipality"},
+	"SI-209": {Code: "SI-209", Country: iso3166.SI, Name: "Rečica ob Savinji", Category: "Municipality"},
+	"SI-210": {Code: "SI-210", Country: iso3166.SI, Name: "Sveti Jurij v Slovenskih goricah", Category: "Municipality"},
+	"SI-211": {Code: "SI-211", Country: iso3166.SI, Name: "Šentrupert", Category: "Municipality"},
+	"SI-212": {Code: "SI-212", Country: iso3166.SI, Name: "Mirna", Category: "Municipality"},
+	"SI-213": {Code: "SI-213", Country: iso3166.SI, Name: "Ankaran", Category: "Municipality"},
+	"SK-BC":  {Code: "SK-BC", Country: iso3166.SK, Name: "Banskobystrický kraj", Category: "Region"},
+	"SK-BL":  {Code: "SK-BL", Country: iso3166.SK, Name: "Bratislavský kraj", Category: "Region"},
+	"SK-KI":  {Code: "SK-KI", Country: iso3166.SK, Name: "Košický kraj", Category: "Region"},
+	"SK-NI":  {Code: "SK-NI", Country: iso3166.SK, Name: "Nitriansky kraj", Category: "Region"},
+	"SK-PV":  {Code: "SK-PV", Country: iso3166.SK, Name: "Prešovský kraj", Category: "Region"},
+	"SK-TA":  {Code: "SK-TA", Country: iso3166.SK, Name: "Trnavský kraj", Category: "Region"},
+	"SK-TC":  {Code: "SK-TC", Country: iso3166.SK, Name: "Trenčiansky kraj", Category: "Region"},
+	"SK-ZI":  {Code: "SK-ZI", Country: iso3166.SK, Name: "Žilinský kraj", Category: "Region"},
+	"SL-E":   {Code: "SL-E", Country: iso3166.SL, Name: "Eastern", Category: "Province"},
+	"SL-N":   {Code: "SL-N", Country: iso3166.SL, Name: "Northern", Category: "Province"},
+	"SL-NW":  {Code: "SL-NW", Country: iso3166.SL, Name: "North Western", Category: "Province"},
+	"SL-S":   {Code: "SL-S", Country: iso3166.SL, Name: "Southern", Category: "Province"},
+	"SL-W":   {Code: "SL-W", Country: iso3166.SL, Name: "Western Area (Freetown)", Category: "Area"},
+	"SM-01":  {Code: "SM-01", Country: iso3166.SM, Name: "Acquaviva", Category: "Municipality"},
+	"SM-02":  {Code: "SM-02", Country: iso3166.SM, Name: "Chiesanuova", Category: "Municipality"},
+	"SM-03":  {Code: "SM-03", Country: iso3166.SM, Name: "Domagnano", Category: "Municipality"},
+	"SM-04":  {Code: "SM-04", Country: iso3166.SM, Name: "Faetano", Category: "Municipality"},
+	"SM-05":  {Code: "SM-05", Country: iso3166.SM, Name: "Fiorentino", Category: "Municipality"},
+	"SM-06":  {Code: "SM-06", Country: iso3166.SM, Name: "Borgo Maggiore", Category: "Municipality"},
+	"SM-07":  {Code: "SM-07", Country: iso3166.SM, Name: "Città di San Marino", Category: "Municipality"},
+	"SM-08":  {Code: "SM-08", Country: iso3166.SM, Name: "Montegiardino", Category: "Municipality"},
+	"SM-09":  {Code: "SM-09", Country: iso3166.SM, Name: "Serravalle", Category: "Municipality"},
+	"SN-DB":  {Code: "SN-DB", Country: iso3166.SN, Name: "Diourbel", Category: "Region"},
+	"SN-DK":  {Code: "SN-DK", Country: iso3166.SN, Name: "Dakar", Category: "Region"},
+	"SN-FK":  {Code: "SN-FK", Country: iso3166.SN, Name: "Fatick", Category: "Region"},
+	"SN-KA":  {Code: "SN-KA", Country: iso3166.SN, Name: "Kaffrine", Category: "Region"},
+	"SN-KD":  {Code: "SN-KD", Country: iso3166.SN, Name: "Kolda", Category: "Region"},
+	"SN-KE":  {Code: "SN-KE", Country: iso3166.SN, Name: "Kédougou", Category: "Region"},
+	"SN-KL":  {Code: "SN-KL", Country: iso3166.SN, Name: "Kaolack", Category: "Region"},
+	"SN-LG":  {Code: "SN-LG", Country: iso3166.SN, Name: "Louga", Category: "Region"},
+	"SN-MT":  {Code: "SN-MT", Country: iso3166.SN, Name: "Matam", Category: "Region"},
+	"SN-SE":  {Code: "SN-SE", Country: iso3166.SN, Name: "Sédhiou", Category: "Region"},
+	"SN-SL":  {Code: "SN-SL", Country: iso3166.SN, Name: "Saint-Louis", Category: "Region"},
+	"SN-TC":  {Code: "SN-TC", Country: iso3166.SN, Name: "Tambacounda", Category: "Region"},
+	"SN-TH":  {Code: "SN-TH", Country: iso3166.SN, Name: "Thiès", Category: "Region"},
+	"SN-ZG":  {Code: "SN-ZG", Country: iso3166.SN, Name: "Ziguinchor", Category: "Region"},
+	"SO-AW":  {Code: "SO-AW", Country: iso3166.SO, Name: "Awdal", Category: "Region"},
+	"SO-BK":  {Code: "SO-BK", Country: iso3166.SO, Name: "Bakool", Category: "Region"},
+	"SO-BN":  {Code: "SO-BN", Country: iso3166.SO, Name: "Banaadir", Category: "Region"},
+	"SO-BR":  {Code: "SO-BR", Country: iso3166.SO, Name: "Bari", Category: "Region"},
+	"SO-BY":  {Code: "SO-BY", Country: iso3166.SO, Name: "Bay", Category: "Region"},
+	"SO-GA":  {Code: "SO-GA", Country: iso3166.SO, Name: "Galguduud", Category: "Region"},
+	"SO-GE":  {Code: "SO-GE", Country: iso3166.SO, Name: "Gedo", Category: "Region"},
+	"SO-HI":  {Code: "SO-HI", Country: iso3166.SO, Name: "Hiiraan", Category: "Region"},
+	"SO-JD":  {Code: "SO-JD", Country: iso3166.SO, Name: "Jubbada Dhexe", Category: "Region"},
+	"SO-JH":  {Code: "SO-JH", Country: iso3166.SO, Name: "Jubbada Hoose", Category: "Region"},
+	"SO-MU":  {Code: "SO-MU", Country: iso3166.SO, Name: "Mudug", Category: "Region"},
+	"SO-NU":  {Code: "SO-NU", Country: iso3166.SO, Name: "Nugaal", Category: "Region"},
+	"SO-SA":  {Code: "SO-SA", Country: iso3166.SO, Name: "Sanaag", Category: "Region"},
+	"SO-SD":  {Code: "SO-SD", Country: iso3166.SO, Name: "Shabeellaha Dhexe", Category: "Region"},
+	"SO-SH":  {Code: "SO-SH", Country: iso3166.SO, Name: "Shabeellaha Hoose", Category: "Region"},
+	"SO-SO":  {Code: "SO-SO", Country: iso3166.SO, Name: "Sool", Category: "Region"},
+	"SO-TO":  {Code: "SO-TO", Country: iso3166.SO, Name: "Togdheer", Category: "Region"},
+	"SO-WO":  {Code: "SO-WO", Country: iso3166.SO, Name: "Woqooyi Galbeed", Category: "Region"},
+	"SR-BR":  {Code: "SR-BR", Country: iso3166.SR, Name: "Brokopondo", Category: "District"},
+	"SR-CM":  {Code: "SR-CM", Country: iso3166.SR, Name: "Commewijne", Category: "District"},
+	"SR-CR":  {Code: "SR-CR", Country: iso3166.SR, Name: "Coronie", Category: "District"},
+	"SR-MA":  {Code: "SR-MA", Country: iso3166.SR, Name: "Marowijne", Category: "District"},
+	"SR-NI":  {Code: "SR-NI", Country: iso3166.SR, Name: "Nickerie", Category: "District"},
+	"SR-PM":  {Code: "SR-PM", Country: iso3166.SR, Name: "Paramaribo", Category: "District"},
+	"SR-PR":  {Code: "SR-PR", Country: iso3166.SR, Name: "Para", Category: "District"},
+	"SR-SA":  {Code: "SR-SA", Country: iso3166.SR, Name: "Saramacca", Category: "District"},
+	"SR-SI":  {Code: "SR-SI", Country: iso3166.SR, Name: "Sipaliwini", Category: "District"},
+	"SR-WA":  {Code: "SR-WA", Country: iso3166.SR, Name: "Wanica", Category: "District"},
+	"SS-BN":  {Code: "SS-BN", Country: iso3166.SS, Name: "Northern Bahr el Ghazal", Category: "State"},
+	"SS-BW":  {Code: "SS-BW", Country: iso3166.SS, Name: "Western Bahr el Ghazal", Category: "State"},
+	"SS-EC":  {Code: "SS-EC", Country: iso3166.SS, Name: "Central Equatoria", Category: "State"},
+	"SS-EE":  {Code: "SS-EE", Country: iso3166.SS, Name: "Eastern Equatoria", Category: "State"},
+	"SS-EW":  {Code: "SS-EW", Country: iso3166.SS, Name: "Western Equatoria", Category: "State"},
+	"SS-JG":  {Code: "SS-JG", Country: iso3166.SS, Name: "Jonglei", Category: "State"},
+	"SS-LK":  {Code: "SS-LK", Country: iso3166.SS, Name: "Lakes", Category: "State"},
+	"SS-NU":  {Code: "SS-NU", Country: iso3166.SS, Name: "Upper Nile", Category: "State"},
+	"SS-UY":  {Code: "SS-UY", Country: iso3166.SS, Name: "Unity", Category: "State"},
+	"SS-WR":  {Code: "SS-WR", Country: iso3166.SS, Name: "Warrap", Category: "State"},
+	"ST-01":  {Code: "ST-01", Country: iso3166.ST, Name: "Água Grande", Category: "District"},
+	"ST-02":  {Code: "ST-02", Country: iso3166.ST, Name: "Cantagalo", Category: "District"},
+	"ST-03":  {Code: "ST-03", Country: iso3166.ST, Name: "Caué", Category: "District"},
+	"ST-04":  {Code: "ST-04", Country: iso3166.ST, Name: "Lembá", Category: "District"},
+	"ST-05":  {Code: "ST-05", Country: iso3166.ST, Name: "Lobata", Category: "District"},
+	"ST-06":  {Code: "ST-06", Country: iso3166.ST, Name: "Mé-Zóchi", Category: "District"},
+	"ST-P":   {Code: "ST-P", Country: iso3166.ST, Name: "Príncipe", Category: "Autonomous region"},
+	"SV-AH":  {Code: "SV-AH", Country: iso3166.SV, Name: "Ahuachapán", Category: "Department"},
+	"SV-CA":  {Code: "SV-CA", Country: iso3166.SV, Name: "Cabañas", Category: "Department"},
+	"SV-CH":  {Code: "SV-CH", Country: iso3166.SV, Name: "Chalatenango", Category: "Department"},
+	"SV-CU":  {Code: "SV-CU", Country: iso3166.SV, Name: "Cuscatlán", Category: "Department"},
+	"SV-LI":  {Code: "SV-LI", Country: iso3166.SV, Name: "La Libertad", Category: "Department"},
+	"SV-MO":  {Code: "SV-MO", Country: iso3166.SV, Name: "Morazán", Category: "Department"},
+	"SV-PA":  {Code: "SV-PA", Country: iso3166.SV, Name: "La Paz", Category: "Department"},
+	"SV-SA":  {Code: "SV-SA", Country: iso3166.SV, Name: "Santa Ana", Category: "Department"},
+	"SV-SM":  {Code: "SV-SM", Country: iso3166.SV, Name: "San Miguel", Category: "Department"},
+	"SV-SO":  {Code: "SV-SO", Country: iso3166.SV, Name: "Sonsonate", Category: "Department"},
+	"SV-SS":  {Code: "SV-SS", Country: iso3166.SV, Name: "San Salvador", Category: "Department"},
+	"SV-SV":  {Code: "SV-SV", Country: iso3166.SV, Name: "San Vicente", Category: "Department"},
+	"SV-UN":  {Code: "SV-UN", Country: iso3166.SV, Name: "La Unión", Category: "Department"},
+	"SV-US":  {Code: "SV-US", Country: iso3166.SV, Name: "Usulután", Category: "Department"},
+	"SY-DI":  {Code: "SY-DI", Country: iso3166.SY, Name: "Dimashq", Category: "Province"},
+	"SY-DR":  {Code: "SY-DR", Country: iso3166.SY, Name: "Dar'ā", Category: "Province"},
+	"SY-DY":  {Code: "SY-DY", Country: iso3166.SY, Name: "Dayr az Zawr", Category: "Province"},
+	"SY-HA":  {Code: "SY-HA", Country: iso3166.SY, Name: "Al Ḩasakah", Category: "Province"},
+	"SY-HI":  {Code: "SY-HI", Country: iso3166.SY, Name: "Ḩimş", Category: "Province"},
+	"SY-HL":  {Code: "SY-HL", Country: iso3166.SY, Name: "Ḩalab", Category: "Province"},
+	"SY-HM":  {Code: "SY-HM", Country: iso3166.SY, Name: "Ḩamāh", Category: "Province"},
+	"SY-ID":  {Code: "SY-ID", Country: iso3166.SY, Name: "Idlib", Category: "Province"},
+	"SY-LA":  {Code: "SY-LA", Country: iso3166.SY, Name: "Al Lādhiqīyah", Category: "Province"},
+	"SY-QU":  {Code: "SY-QU", Country: iso3166.SY, Name: "Al Qunayţirah", Category: "Province"},
+	"SY-RA":  {Code: "SY-RA", Country: iso3166.SY, Name: "Ar Raqqah", Category: "Province"},
+	"SY-RD":  {Code: "SY-RD", Country: iso3166.SY, Name: "Rīf Dimashq", Category: "Province"},
+	"SY-SU":  {Code: "SY-SU", Country: iso3166.SY, Name: "As Suwaydā'", Category: "Province"},
+	"SY-TA":  {Code: "SY-TA", Country: iso3166.SY, Name: "Ţarţūs", Category: "Province"},
+	"SZ-HH":  {Code: "SZ-HH", Country: iso3166.SZ, Name: "Hhohho", Category: "Region"},
+	"SZ-LU":  {Code: "SZ-LU", Country: iso3166.SZ, Name: "Lubombo", Category: "Region"},
+	"SZ-MA":  {Code: "SZ-MA", Country: iso3166.SZ, Name: "Manzini", Category: "Region"},
+	"SZ-SH":  {Code: "SZ-SH", Country: iso3166.SZ, Name: "Shiselweni", Category: "Region"},
+	"TD-BA":  {Code: "TD-BA", Country: iso3166.TD, Name: "Al Baţḩā’", Category: "Province"},
+	"TD-BG":  {Code: "TD-BG", Country: iso3166.TD, Name: "Bahr el Ghazal", Category: "Province"},
+	"TD-BO":  {Code: "TD-BO", Country: iso3166.TD, Name: "Borkou", Category: "Province"},
+	"TD-CB":  {Code: "TD-CB", Country: iso3166.TD, Name: "Chari-Baguirmi", Category: "Province"},
+	"TD-EE":  {Code: "TD-EE", Country: iso3166.TD, Name: "Ennedi-Est", Category: "Province"},
+	"TD-EO":  {Code: "TD-EO", Country: iso3166.TD, Name: "Ennedi-Ouest", Category: "Province"},
+	"TD-GR":  {Code: "TD-GR", Country: iso3166.TD, Name: "Guéra", Category: "Province"},
+	"TD-HL":  {Code: "TD-HL", Country: iso3166.TD, Name: "Hadjer Lamis", Category: "Province"},
+	"TD-KA":  {Code: "TD-KA", Country: iso3166.TD, Name: "Kanem", Category: "Province"},
+	"TD-LC":  {Code: "TD-LC", Country: iso3166.TD, Name: "Al Buḩayrah", Category: "Province"},
+	"TD-LO":  {Code: "TD-LO", Country: iso3166.TD, Name: "Logone-Occidental", Category: "Province"},
+	"TD-LR":  {Code: "TD-LR", Country: iso3166.TD, Name: "Logone-Oriental", Category: "Province"},
+	"TD-MA":  {Code: "TD-MA", Country: iso3166.TD, Name: "Mandoul", Category: "Province"},
+	"TD-MC":  {Code: "TD-MC", Country: iso3166.TD, Name: "Moyen-Chari", Category: "Province"},
+	"TD-ME":  {Code: "TD-ME", Country: iso3166.TD, Name: "Mayo-Kebbi-Est", Category: "Province"},
+	"TD-MO":  {Code: "TD-MO", Country: iso3166.TD, Name: "Mayo-Kebbi-Ouest", Category: "Province"},
+	"TD-ND":  {Code: "TD-ND", Country: iso3166.TD, Name: "Madīnat Injamīnā", Category: "Province"},
+	"TD-OD":  {Code: "TD-OD", Country: iso3166.TD, Name: "Ouaddaï", Category: "Province"},
+	"TD-SA":  {Code: "TD-SA", Country: iso3166.TD, Name: "Salamat", Category: "Province"},
+	"TD-SI":  {Code: "TD-SI", Country: iso3166.TD, Name: "Sila", Category: "Province"},
+	"TD-TA":  {Code: "TD-TA", Country: iso3166.TD, Name: "Tandjilé", Category: "Province"},
+	"TD-TI":  {Code: "TD-TI", Country: iso3166.TD, Name: "Tibastī", Category: "Province"},
+	"TD-WF":  {Code: "TD-WF", Country: iso3166.TD, Name: "Wadi Fira", Category: "Province"},
+	"TG-C":   {Code: "TG-C", Country: iso3166.TG, Name: "Centrale", Category: "Region"},
+	"TG-K":   {Code: "TG-K", Country: iso3166.TG, Name: "Kara", Category: "Region"},
+	"TG-M":   {Code: "TG-M", Country: iso3166.TG, Name: "Maritime (Région)", Category: "Region"},
+	"TG-P":   {Code: "TG-P", Country: iso3166.TG, Name: "Plateaux", Category: "Region"},
+	"TG-S":   {Code: "TG-S", Country: iso3166.TG, Name: "Savanes", Category: "Region"},
+	"TH-10":  {Code: "TH-10", Country: iso3166.TH, Name: "Krung Thep Maha Nakhon", Category: "Metropolitan administration"},
+	"TH-11":  {Code: "TH-11", Country: iso3166.TH, Name: "Samut Prakan", Category: "Province"},
+	"TH-12":  {Code: "TH-12", Country: iso3166.TH, Name: "Nonthaburi", Category: "Province"},
+	"TH-13":  {Code: "TH-13", Country: iso3166.TH, Name: "Pathum Thani", Category: "Province"},
+	"TH-14":  {Code: "TH-14", Country: iso3166.TH, Name: "Phra Nakhon Si Ayutthaya", Category: "Province"},
+	"TH-15":  {Code: "TH-15", Country: iso3166.TH, Name: "Ang Thong", Category: "Province"},
+	"TH-16":  {Code: "TH-16", Country: iso3166.TH, Name: "Lop Buri", Category: "Province"},
+	"TH-17":  {Code: "TH-17", Country: iso3166.TH, Name: "Sing Buri", Category: "Province"},
+	"TH-18":  {Code: "TH-18", Country: iso3166.TH, Name: "Chai Nat", Category: "Province"},
+	"TH-19":  {Code: "TH-19", Country: iso3166.TH, Name: "Saraburi", Category: "Province"},
+	"TH-20":  {Code: "TH-20", Country: iso3166.TH, Name: "Chon Buri", Category: "Province"},
+	"TH-21":  {Code: "TH-21", Country: iso3166.TH, Name: "Rayong", Category: "Province"},
+	"TH-22":  {Code: "TH-22", Country: iso3166.TH, Name: "Chanthaburi", Category: "Province"},
+	"TH-23":  {Code: "TH-23", Country: iso3166.TH, Name: "Trat", Category: "Province"},
+	"TH-24":  {Code: "TH-24", Country: iso3166.TH, Name: "Chachoengsao", Category: "Province"},
+	"TH-25":  {Code: "TH-25", Country: iso3166.TH, Name: "Prachin Buri", Category: "Province"},
+	"TH-26":  {Code: "TH-26", Country: iso3166.TH, Name: "Nakhon Nayok", Category: "Province"},
+	"TH-27":  {Code: "TH-27", Country: iso3166.TH, Name: "Sa Kaeo", Category: "Province"},
+	"TH-30":  {Code: "TH-30", Country: iso3166.TH, Name: "Nakhon Ratchasima", Category: "Province"},
+	"TH-31":  {Code: "TH-31", Country: iso3166.TH, Name: "Buri Ram", Category: "Province"},
+	"TH-32":  {Code: "TH-32", Country: iso3166.TH, Name: "Surin", Category: "Province"},
+	"TH-33":  {Code: "TH-33", Country: iso3166.TH, Name: "Si Sa Ket", Category: "Province"},
+	"TH-34":  {Code: "TH-34", Country: iso3166.TH, Name: "Ubon Ratchathani", Category: "Province"},
+	"TH-35":  {Code: "TH-35", Country: iso3166.TH, Name: "Yasothon", Category: "Province"},
+	"TH-36":  {Code: "TH-36", Country: iso3166.TH, Name: "Chaiyaphum", Category: "Province"},
+	"TH-37":  {Code: "TH-37", Country: iso3166.TH, Name: "Amnat Charoen", Category: "Province"},
+	"TH-38":  {Code: "TH-38", Country: iso3166.TH, Name: "Bueng Kan", Category: "Province"},
+	"TH-39":  {Code: "TH-39", Country: iso3166.TH, Name: "Nong Bua Lam Phu", Category: "Province"},
+	"TH-40":  {Code: "TH-40", Country: iso3166.TH, Name: "Khon Kaen", Category: "Province"},
+	"TH-41":  {Code: "TH-41", Country: iso3166.TH, Name: "Udon Thani", Category: "Province"},
+	"TH-42":  {Code: "TH-42", Country: iso3166.TH, Name: "Loei", Category: "Province"},
+	"TH-43":  {Code: "TH-43", Country: iso3166.TH, Name: "Nong Khai", Category: "Province"},
+	"TH-44":  {Code: "TH-44", Country: iso3166.TH, Name: "Maha Sarakham", Category: "Province"},
+	"TH-45":  {Code: "TH-45", Country: iso3166.TH, Name: "Roi Et", Category: "Province"},
+	"TH-46":  {Code: "TH-46", Country: iso3166.TH, Name: "Kalasin", Category: "Province"},
+	"TH-47":  {Code: "TH-47", Country: iso3166.TH, Name: "Sakon Nakhon", Category: "Province"},
+	"TH-48":  {Code: "TH-48", Country: iso3166.TH, Name: "Nakhon Phanom", Category: "Province"},
+	"TH-49":  {Code: "TH-49", Country: iso3166.TH, Name: "Mukdahan", Category: "Province"},
+	"TH-50":  {Code: "TH-50", Country: iso3166.TH, Name: "Chiang Mai", Category: "Province"},
+	"TH-51":  {Code: "TH-51", Country: iso3166.TH, Name: "Lamphun", Category: "Province"},
+	"TH-52":  {Code: "TH-52", Country: iso3166.TH, Name: "Lampang", Category: "Province"},
+	"TH-53":  {Code: "TH-53", Country: iso3166.TH, Name: "Uttaradit", Category: "Province"},
+	"TH-54":  {Code: "TH-54", Country: iso3166.TH, Name: "Phrae", Category: "Province"},
+	"TH-55":  {Code: "TH-55", Country: iso3166.TH, Name: "Nan", Category: "Province"},
+	"TH-56":  {Code: "TH-56", Country: iso3166.TH, Name: "Phayao", Category: "Province"},
+	"TH-57":  {Code: "TH-57", Country: iso3166.TH, Name: "Chiang Rai", Category: "Province"},
+	"TH-58":  {Code: "TH-58", Country: iso3166.TH, Name: "Mae Hong Son", Category: "Province"},
+	"TH-60":  {Code: "TH-60", Country: iso3166.TH, Name: "Nakhon Sawan", Category: "Province"},
+	"TH-61":  {Code: "TH-61", Country: iso3166.TH, Name: "Uthai Thani", Category: "Province"},
+	"TH-62":  {Code: "TH-62", Country: iso3166.TH, Name: "Kamphaeng Phet", Category: "Province"},
+	"TH-63":  {Code: "TH-63", Country: iso3166.TH, Name: "Tak", Category: "Province"},
+	"TH-64":  {Code: "TH-64", Country: iso3166.TH, Name: "Sukhothai", Category: "Province"},
+	"TH-65":  {Code: "TH-65", Country: iso3166.TH, Name: "Phitsanulok", Category: "Province"},
+	"TH-66":  {Code: "TH-66", Country: iso3166.TH, Name: "Phichit", Category: "Province"},
+	"TH-67":  {Code: "TH-67", Country: iso3166.TH, Name: "Phetchabun", Category: "Province"},
+	"TH-70":  {Code: "TH-70", Country: iso3166.TH, Name: "Ratchaburi", Category: "Province"},
+	"TH-71":  {Code: "TH-71", Country: iso3166.TH, Name: "Kanchanaburi", Category: "Province"},
+	"TH-72":  {Code: "TH-72", Country: iso3166.TH, Name: "Suphan Buri", Category: "Province"},
+	"TH-73":  {Code: "TH-73", Country: iso3166.TH, Name: "Nakhon Pathom", Category: "Province"},
+	"TH-74":  {Code: "TH-74", Country: iso3166.TH, Name: "Samut Sakhon", Category: "Province"},
+	"TH-75":  {Code: "TH-75", Country: iso3166.TH, Name: "Samut Songkhram", Category: "Province"},
+	"TH-76":  {Code: "TH-76", Country: iso3166.TH, Name: "Phetchaburi", Category: "Province"},
+	"TH-77":  {Code: "TH-77", Country: iso3166.TH, Name: "Prachuap Khiri Khan", Category: "Province"},
+	"TH-80":  {Code: "TH-80", Country: iso3166.TH, Name: "Nakhon Si Thammarat", Category: "Province"},
+	"TH-81":  {Code: "TH-81", Country: iso3166.TH, Name: "Krabi", Category: "Province"},
+	"TH-82":  {Code: "TH-82", Country: iso3166.TH, Name: "Phangnga", Category: "Province"},
+	"TH-83":  {Code: "TH-83", Country: iso3166.TH, Name: "Phuket", Category: "Province"},
+	"TH-84":  {Code: "TH-84", Country: iso3166.TH, Name: "Surat Thani", Category: "Province"},
+	"TH-85":  {Code: "TH-85", Country: iso3166.TH, Name: "Ranong", Category: "Province"},
+	"TH-86":  {Code: "TH-86", Country: iso3166.TH, Name: "Chumphon", Category: "Province"},
+	"TH-90":  {Code: "TH-90", Country: iso3166.TH, Name: "Songkhla", Category: "Province"},
+	"TH-91":  {Code: "TH-91", Country: iso3166.TH, Name: "Satun", Category: "Province"},
+	"TH-92":  {Code: "TH-92", Country: iso3166.TH, Name: "Trang", Category: "Province"},
+	"TH-93":  {Code: "TH-93", Country: iso3166.TH, Name: "Phatthalung", Category: "Province"},
+	"TH-94":  {Code: "TH-94", Country: iso3166.TH, Name: "Pattani", Category: "Province"},
+	"TH-95":  {Code: "TH-95", Country: iso3166.TH, Name: "Yala", Category: "Province"},
+	"TH-96":  {Code: "TH-96", Country: iso3166.TH, Name: "Narathiwat", Category: "Province"},
+	"TH-S":   {Code: "TH-S", Country: iso3166.TH, Name: "Phatthaya", Category: "Special administrative city"},
+	"TJ-DU":  {Code: "TJ-DU", Country: iso3166.TJ, Name: "Dushanbe", Category: "Capital territory"},
+	"TJ-GB":  {Code: "TJ-GB", Country: iso3166.TJ, Name: "Kŭhistoni Badakhshon", Category: "Autonomous region"},
+	"TJ-KT":  {Code: "TJ-KT", Country: iso3166.TJ, Name: "Khatlon", Category: "Region"},
+	"TJ-RA":  {Code: "TJ-RA", Country: iso3166.TJ, Name: "nohiyahoi tobei jumhurí", Category: "Districts under republic administration"},
+	"TJ-SU":  {Code: "TJ-SU", Country: iso3166.TJ, Name: "Sughd", Category: "Region"},
+	"TL-AL":  {Code: "TL-AL", Country: iso3166.TL, Name: "Aileu", Category: "Municipality"},
+	"TL-AN":  {Code: "TL-AN", Country: iso3166.TL, Name: "Ainaro", Category: "Municipality"},
+	"TL-BA":  {Code: "TL-BA", Country: iso3166.TL, Name: "Baucau", Category: "Municipality"},
+	"TL-BO":  {Code: "TL-BO", Country: iso3166.TL, Name: "Bobonaro", Category: "Municipality"},
+	"TL-CO":  {Code: "TL-CO", Country: iso3166.TL, Name: "Cova Lima", Category: "Municipality"},
+	"TL-DI":  {Code: "TL-DI", Country: iso3166.TL, Name: "Díli", Category: "Municipality"},
+	"TL-ER":  {Code: "TL-ER", Country: iso3166.TL, Name: "Ermera", Category: "Municipality"},
+	"TL-LA":  {Code: "TL-LA", Country: iso3166.TL, Name: "Lautein", Category: "Municipality"},
+	"TL-LI":  {Code: "TL-LI", Country: iso3166.TL, Name: "Likisá", Category: "Municipality"},
+	"TL-MF":  {Code: "TL-MF", Country: iso3166.TL, Name: "Manufahi", Category: "Municipality"},
+	"TL-MT":  {Code: "TL-MT", Country: iso3166.TL, Name: "Manatuto", Category: "Municipality"},
+	"TL-OE":  {Code: "TL-OE", Country: iso3166.TL, Name: "Oekusi-Ambenu", Category: "Special administrative region"},
+	"TL-VI":  {Code: "TL-VI", Country: iso3166.TL, Name: "Vikeke", Category: "Municipality"},
+	"TM-A":   {Code: "TM-A", Country: iso3166.TM, Name: "Ahal", Category: "Region"},
+	"TM-B":   {Code: "TM-B", Country: iso3166.TM, Name: "Balkan", Category: "Region"},
+	"TM-D":   {Code: "TM-D", Country: iso3166.TM, Name: "Daşoguz", Category: "Region"},
+	"TM-L":   {Code: "TM-L", Country: iso3166.TM, Name: "Lebap", Category: "Region"},
+	"TM-M":   {Code: "TM-M", Country: iso3166.TM, Name: "Mary", Category: "Region"},
+	"TM-S":   {Code: "TM-S", Country: iso3166.TM, Name: "Aşgabat", Category: "City"},
+	"TN-11":  {Code: "TN-11", Country: iso3166.TN, Name: "Tunis", Category: "Governorate"},
+	"TN-12":  {Code: "TN-12", Country: iso3166.TN, Name: "L'Ariana", Category: "Governorate"},
+	"TN-13":  {Code: "TN-13", Country: iso3166.TN, Name: "Ben Arous", Category: "Governorate"},
+	"TN-14":  {Code: "TN-14", Country: iso3166.TN, Name: "La Manouba", Category: "Governorate"},
+	"TN-21":  {Code: "TN-21", Country: iso3166.TN, Name: "Nabeul", Category: "Governorate"},
+	"TN-22":  {Code: "TN-22", Country: iso3166.TN, Name: "Zaghouan", Category: "Governorate"},
+	"TN-23":  {Code: "TN-23", Country: iso3166.TN, Name: "Bizerte", Category: "Governorate"},
+	"TN-31":  {Code: "TN-31", Country: iso3166.TN, Name: "Béja", Category: "Governorate"},
+	"TN-32":  {Code: "TN-32", Country: iso3166.TN, Name: "Jendouba", Category: "Governorate"},
+	"TN-33":  {Code: "TN-33", Country: iso3166.TN, Name: "Le Kef", Category: "Governorate"},
+	"TN-34":  {Code: "TN-34", Country: iso3166.TN, Name: "Siliana", Category: "Governorate"},
+	"TN-41":  {Code: "TN-41", Country: iso3166.TN, Name: "Kairouan", Category: "Governorate"},
+	"TN-42":  {Code: "TN-42", Country: iso3166.TN, Name: "Kasserine", Category: "Governorate"},
+	"TN-43":  {Code: "TN-43", Country: iso3166.TN, Name: "Sidi Bouzid", Category: "Governorate"},
+	"TN-51":  {Code: "TN-51", Country: iso3166.TN, Name: "Sousse", Category: "Governorate"},
+	"TN-52":  {Code: "TN-52", Country: iso3166.TN, Name: "Monastir", Category: "Governorate"},
+	"TN-53":  {Code: "TN-53", Country: iso3166.TN, Name: "Mahdia", Category: "Governorate"},
+	"TN-61":  {Code: "TN-61", Country: iso3166.TN, Name: "Sfax", Category: "Governorate"},
+	"TN-71":  {Code: "TN-71", Country: iso3166.TN, Name: "Gafsa", Category: "Governorate"},
+	"TN-72":  {Code: "TN-72", Country: iso3166.TN, Name: "Tozeur", Category: "Governorate"},
+	"TN-73":  {Code: "TN-73", Country: iso3166.TN, Name: "Kébili", Category: "Governorate"},
+	"TN-81":  {Code: "TN-81", Country: iso3166.TN, Name: "Gabès", Category: "Governorate"},
+	"TN-82":  {Code: "TN-82", Country: iso3166.TN, Name: "Médenine", Category: "Governorate"},
+	"TN-83":  {Code: "TN-83", Country: iso3166.TN, Name: "Tataouine", Category: "Governorate"},
+	"TO-01":  {Code: "TO-01", Country: iso3166.TO, Name: "'Eua", Category: "Division"},
+	"TO-02":  {Code: "TO-02", Country: iso3166.TO, Name: "Ha'apai", Category: "Division"},
+	"TO-03":  {Code: "TO-03", Country: iso3166.TO, Name: "Niuas", Category: "Division"},
+	"TO-04":  {Code: "TO-04", Country: iso3166.TO, Name: "Tongatapu", Category: "Division"},
+	"TO-05":  {Code: "TO-05", Country: iso3166.TO, Name: "Vava'u", Category: "Division"},
+	"TR-01":  {Code: "TR-01", Country: iso3166.TR, Name: "Adana", Category: "Province"},
+	"TR-02":  {Code: "TR-02", Country: iso3166.TR, Name: "Adıyaman", Category: "Province"},
+	"TR-03":  {Code: "TR-03", Country: iso3166.TR, Name: "Afyonkarahisar", Category: "Province"},
+	"TR-04":  {Code: "TR-04", Country: iso3166.TR, Name: "Ağrı", Category: "Province"},
+	"TR-05":  {Code: "TR-05", Country: iso3166.TR, Name: "Amasya", Category: "Province"},
+	"TR-06":  {Code: "TR-06", Country: iso3166.TR, Name: "Ankara", Category: "Province"},
+	"TR-07":  {Code: "TR-07", Country: iso3166.TR, Name: "Antalya", Category: "Province"},
+	"TR-08":  {Code: "TR-08", Country: iso3166.TR, Name: "Artvin", Category: "Province"},
+	"TR-09":  {Code: "TR-09", Country: iso3166.TR, Name: "Aydın", Category: "Province"},
+	"TR-10":  {Code: "TR-10", Country: iso3166.TR, Name: "Balıkesir", Category: "Province"},
+	"TR-11":  {Code: "TR-11", Country: iso3166.TR, Name: "Bilecik", Category: "Province"},
+	"TR-12":  {Code: "TR-12", Country: iso3166.TR, Name: "Bingöl", Category: "Province"},
+	"TR-13":  {Code: "TR-13", Country: iso3166.TR, Name: "Bitlis", Category: "Province"},
+	"TR-14":  {Code: "TR-14", Country: iso3166.TR, Name: "Bolu", Category: "Province"},
+	"TR-15":  {Code: "TR-15", Country: iso3166.TR, Name: "Burdur", Category: "Province"},
+	"TR-16":  {Code: "TR-16", Country: iso3166.TR, Name: "Bursa", Category: "Province"},
+	"TR-17":  {Code: "TR-17", Country: iso3166.TR, Name: "Çanakkale", Category: "Province"},
+	"TR-18":  {Code: "TR-18", Country: iso3166.TR, Name: "Çankırı", Category: "Province"},
+	"TR-19":  {Code: "TR-19", Country: iso3166.TR, Name: "Çorum", Category: "Province"},
+	"TR-20":  {Code: "TR-20", Country: iso3166.TR, Name: "Denizli", Category: "Province"},
+	"TR-21":  {Code: "TR-21", Country: iso3166.TR, Name: "Diyarbakır", Category: "Province"},
+	"TR-22":  {Code: "TR-22", Country: iso3166.TR, Name: "Edirne", Category: "Province"},
+	"TR-23":  {Code: "TR-23", Country: iso3166.TR, Name: "Elazığ", Category: "Province"},
+	"TR-24":  {Code: "TR-24", Country: iso3166.TR, Name: "Erzincan", Category: "Province"},
+	"TR-25":  {Code: "TR-25", Country: iso3166.TR, Name: "Erzurum", Category: "Province"},
+	"TR-26":  {Code: "TR-26", Country: iso3166.TR, Name: "Eskişehir", Category: "Province"},
+	"TR-27":  {Code: "TR-27", Country: iso3166.TR, Name: "Gaziantep", Category: "Province"},
+	"TR-28":  {Code: "TR-28", Country: iso3166.TR, Name: "Giresun", Category: "Province"},
+	"TR-29":  {Code: "TR-29", Country: iso3166.TR, Name: "Gümüşhane", Category: "Province"},
+	"TR-30":  {Code: "TR-30", Country: iso3166.TR, Name: "Hakkâri", Category: "Province"},
+	"TR-31":  {Code: "TR-31", Country: iso3166.TR, Name: "Hatay", Category: "Province"},
+	"TR-32":  {Code: "TR-32", Country: iso3166.TR, Name: "Isparta", Category: "Province"},
+	"TR-33":  {Code: "TR-33", Country: iso3166.TR, Name: "Mersin", Category: "Province"},
+	"TR-34":  {Code: "TR-34", Country: iso3166.TR, Name: "İstanbul", Category: "Province"},
+	"TR-35":  {Code: "TR-35", Country: iso3166.TR, Name: "İzmir", Category: "Province"},
+	"TR-36":  {Code: "TR-36", Country: iso3166.TR, Name: "Kars", Category: "Province"},
+	"TR-37":  {Code: "TR-37", Country: iso3166.TR, Name: "Kastamonu", Category: "Province"},
+	"TR-38":  {Code: "TR-38", Country: iso3166.TR, Name: "Kayseri", Category: "Province"},
+	"TR-39":  {Code: "TR-39", Country: iso3166.TR, Name: "Kırklareli", Category: "Province"},
+	"TR-40":  {Code: "TR-40", Country: iso3166.TR, Name: "Kırşehir", Category: "Province"},
+	"TR-41":  {Code: "TR-41", Country: iso3166.TR, Name: "Kocaeli", Category: "Province"},
+	"TR-42":  {Code: "TR-42", Country: iso3166.TR, Name: "Konya", Category: "Province"},
+	"TR-43":  {Code: "TR-43", Country: iso3166.TR, Name: "Kütahya", Category: "Province"},
+	"TR-44":  {Code: "TR-44", Country: iso3166.TR, Name: "Malatya", Category: "Province"},
+	"TR-45":  {Code: "TR-45", Country: iso3166.TR, Name: "Manisa", Category: "Province"},
+	"TR-46":  {Code: "TR-46", Country: iso3166.TR, Name: "Kahramanmaraş", Category: "Province"},
+	"TR-47":  {Code: "TR-47", Country: iso3166.TR, Name: "Mardin", Category: "Province"},
+	"TR-48":  {Code: "TR-48", Country: iso3166.TR, Name: "Muğla", Category: "Province"},
+	"TR-49":  {Code: "TR-49", Country: iso3166.TR, Name: "Muş", Category: "Province"},
+	"TR-50":  {Code: "TR-50", Country: iso3166.TR, Name: "Nevşehir", Category: "Province"},
+	"TR-51":  {Code: "TR-51", Country: iso3166.TR, Name: "Niğde", Category: "Province"},
+	"TR-52":  {Code: "TR-52", Country: iso3166.TR, Name: "Ordu", Category: "Province"},
+	"TR-53":  {Code: "TR-53", Country: iso3166.TR, Name: "Rize", Category: "Province"},
+	"TR-54":  {Code: "TR-54", Country: iso3166.TR, Name: "Sakarya", Category: "Province"},
+	"TR-55":  {Code: "TR-55", Country: iso3166.TR, Name: "Samsun", Category: "Province"},
+	"TR-56":  {Code: "TR-56", Country: iso3166.TR, Name: "Siirt", Category: "Province"},
+	"TR-57":  {Code: "TR-57", Country: iso3166.TR, Name: "Sinop", Category: "Province"},
+	"TR-58":  {Code: "TR-58", Country: iso3166.TR, Name: "Sivas", Category: "Province"},
+	"TR-59":  {Code: "TR-59", Country: iso3166.TR, Name: "Tekirdağ", Category: "Province"},
+	"TR-60":  {Code: "TR-60", Country: iso3166.TR, Name: "Tokat", Category: "Province"},
+	"TR-61":  {Code: "TR-61", Country: iso3166.TR, Name: "Trabzon", Category: "Province"},
+	"TR-62":  {Code: "TR-62", Country: iso3166.TR, Name: "Tunceli", Category: "Province"},
+	"TR-63":  {Code: "TR-63", Country: iso3166.TR, Name: "Şanlıurfa", Category: "Province"},
+	"TR-64":  {Code: "TR-64", Country: iso3166.TR, Name: "Uşak", Category: "Province"},
+	"TR-65":  {Code: "TR-65", Country: iso3166.TR, Name: "Van", Category: "Province"},
+	"TR-66":  {Code: "TR-66", Country: iso3166.TR, Name: "Yozgat", Category: "Province"},
+	"TR-67":  {Code: "TR-67", Country: iso3166.TR, Name: "Zonguldak", Category: "Province"},
+	"TR-68":  {Code: "TR-68", Country: iso3166.TR, Name: "Aksaray", Category: "Province"},
+	"TR-69":  {Code: "TR-69", Country: iso3166.TR, Name: "Bayburt", Category: "Province"},
+	"TR-70":  {Code: "TR-70", Country: iso3166.TR, Name: "Karaman", Category: "Province"},
+	"TR-71":  {Code: "TR-71", Country: iso3166.TR, Name: "Kırıkkale", Category: "Province"},
+	"TR-72":  {Code: "TR-72", Country: iso3166.TR, Name: "Batman", Category: "Province"},
+	"TR-73":  {Code: "TR-73", Country: iso3166.TR, Name: "Şırnak", Category: "Province"},
+	"TR-74":  {Code: "TR-74", Country: iso3166.TR, Name: "Bartın", Category: "Province"},
+	"TR-75":  {Code: "TR-75", Country: iso3166.TR, Name: "Ardahan", Category: "Province"},
+	"TR-76":  {Code: "TR-76", Country: iso3166.TR, Name: "Iğdır", Category: "Province"},
+	"TR-77":  {Code: "TR-77", Country: iso3166.TR, Name: "Yalova", Category: "Province"},
+	"TR-78":  {Code: "TR-78", Country: iso3166.TR, Name: "Karabük", Category: "Province"},
+	"TR-79":  {Code: "TR-79", Country: iso3166.TR, Name: "Kilis", Category: "Province"},
+	"TR-80":  {Code: "TR-80", Country: iso3166.TR, Name: "Osmaniye", Category: "Province"},
+	"TR-81":  {Code: "TR-81", Country: iso3166.TR, Name: "Düzce", Category: "Province"},
+	"TT-ARI": {Code: "TT-ARI", Country: iso3166.TT, Name: "Arima", Category: "Borough"},
+	"TT-CHA": {Code: "TT-CHA", Country: iso3166.TT, Name: "Chaguanas", Category: "Borough"},
+	"TT-CTT": {Code: "TT-CTT", Country: iso3166.TT, Name: "Couva-Tabaquite-Talparo", Category: "Region"},
+	"TT-DMN": {Code: "TT-DMN", Country: iso3166.TT, Name: "Diego Martin", Category: "Region"},
+	"TT-MRC": {Code: "TT-MRC", Country: iso3166.TT, Name: "Mayaro-Rio Claro", Category: "Region"},
+	"TT-PED": {Code: "TT-PED", Country: iso3166.TT, Name: "Penal-Debe", Category: "Region"},
+	"TT-POS": {Code: "TT-POS", Country: iso3166.TT, Name: "Port of Spain", Category: "City"},
+	"TT-PRT": {Code: "TT-PRT", Country: iso3166.TT, Name: "Princes Town", Category: "Region"},
+	"TT-PTF": {Code: "TT-PTF", Country: iso3166.TT, Name: "Point Fortin", Category: "Borough"},
+	"TT-SFO": {Code: "TT-SFO", Country: iso3166.TT, Name: "San Fernando", Category: "City"},
+	"TT-SGE": {Code: "TT-SGE", Country: iso3166.TT, Name: "Sangre Grande", Category: "Region"},
+	"TT-SIP": {Code: "TT-SIP", Country: iso3166.TT, Name: "Siparia", Category: "Region"},
+	"TT-SJL": {Code: "TT-SJL", Country: iso3166.TT, Name: "San Juan-Laventille", Category: "Region"},
+	"TT-TOB": {Code: "TT-TOB", Country: iso3166.TT, Name: "Tobago", Category: "Ward"},
+	"TT-TUP": {Code: "TT-TUP", Country: iso3166.TT, Name: "Tunapuna-Piarco", Category: "Region"},
+	"TV-FUN": {Code: "TV-FUN", Country: iso3166.TV, Name: "Funafuti", Category: "Town council"},
+	"TV-NIT": {Code: "TV-NIT", Country: iso3166.TV, Name: "Niutao", Category: "Island council"},
+	"TV-NKF": {Code: "TV-NKF", Country: iso3166.TV, Name: "Nukufetau", Category: "Island council"},
+	"TV-NKL": {Code: "TV-NKL", Country: iso3166.TV, Name: "Nukulaelae", Category: "Island council"},
+	"TV-NMA": {Code: "TV-NMA", Country: iso3166.TV, Name: "Nanumea", Category: "Island council"},
+	"TV-NMG": {Code: "TV-NMG", Country: iso3166.TV, Name: "Nanumaga", Category: "Island council"},
+	"TV-NUI": {Code: "TV-NUI", Country: iso3166.TV, Name: "Nui", Category: "Island council"},
+	"TV-VAI": {Code: "TV-VAI", Country: iso3166.TV, Name: "Vaitupu", Category: "Island council"},
+	"TW-CHA": {Code: "TW-CHA", Country: iso3166.TW, Name: "Changhua", Category: "County"},
+	"TW-CYI": {Code: "TW-CYI", Country: iso3166.TW, Name: "Chiayi", Category: "City"},
+	"TW-CYQ": {Code: "TW-CYQ", Country: iso3166.TW, Name: "Chiayi", Category: "County"},
+	"TW-HSQ": {Code: "TW-HSQ", Country: iso3166.TW, Name: "Hsinchu", Category: "County"},
+	"TW-HSZ": {Code: "TW-HSZ", Country: iso3166.TW, Name: "Hsinchu", Category: "City"},
+	"TW-HUA": {Code: "TW-HUA", Country: iso3166.TW, Name: "Hualien", Category: "County"},
+	"TW-ILA": {Code: "TW-ILA", Country: iso3166.TW, Name: "Yilan", Category: "County"},
+	"TW-KEE": {Code: "TW-KEE", Country: iso3166.TW, Name: "Keelung", Category: "City"},
+	"TW-KHH": {Code: "TW-KHH", Country: iso3166.TW, Name: "Kaohsiung", Category: "Special municipality"},
+	"TW-KIN": {Code: "TW-KIN", Country: iso3166.TW, Name: "Kinmen", Category: "County"},
+	"TW-LIE": {Code: "TW-LIE", Country: iso3166.TW, Name: "Lienchiang", Category: "County"},
+	"TW-MIA": {Code: "TW-MIA", Country: iso3166.TW, Name: "Miaoli", Category: "County"},
+	"TW-NAN": {Code: "TW-NAN", Country: iso3166.TW, Name: "Nantou", Category: "County"},
+	"TW-NWT": {Code: "TW-NWT", Country: iso3166.TW, Name: "New Taipei", Category: "Special municipality"},
+	"TW-PEN": {Code: "TW-PEN", Country: iso3166.TW, Name: "Penghu", Category: "County"},
+	"TW-PIF": {Code: "TW-PIF", Country: iso3166.TW, Name: "Pingtung", Category: "County"},
+	"TW-TAO": {Code: "TW-TAO", Country: iso3166.TW, Name: "Taoyuan", Category: "Special municipality"},
+	"TW-TNN": {Code: "TW-TNN", Country: iso3166.TW, Name: "Tainan", Category: "Special municipality"},
+	"TW-TPE": {Code: "TW-TPE", Country: iso3166.TW, Name: "Taipei", Category: "Special municipality"},
+	"TW-TTT": {Code: "TW-TTT", Country: iso3166.TW, Name: "Taitung", Category: "County"},
+	"TW-TXG": {Code: "TW-TXG", Country: iso3166.TW, Name: "Taichung", Category: "Special municipality"},
+	"TW-YUN": {Code: "TW-YUN", Country: iso3166.TW, Name: "Yunlin", Category: "County"},
+	"TZ-01":  {Code: "TZ-01", Country: iso3166.TZ, Name: "Arusha", Category: "Region"},
+	"TZ-02":  {Code: "TZ-02", Country: iso3166.TZ, Name: "Dar es Salaam", Category: "Region"},
+	"TZ-03":  {Code: "TZ-03", Country: iso3166.TZ, Name: "Dodoma", Category: "Region"},
+	"TZ-04":  {Code: "TZ-04", Country: iso3166.TZ, Name: "Iringa", Category: "Region"},
+	"TZ-05":  {Code: "TZ-05", Country: iso3166.TZ, Name: "Kagera", Category: "Region"},
+	"TZ-06":  {Code: "TZ-06", Country: iso3166.TZ, Name: "Pemba North", Category: "Region"},
+	"TZ-07":  {Code: "TZ-07", Country: iso3166.TZ, Name: "Zanzibar North", Category: "Region"},
+	"TZ-08":  {Code: "TZ-08", Country: iso3166.TZ, Name: "Kigoma", Category: "Region"},
+	"TZ-09":  {Code: "TZ-09", Country: iso3166.TZ, Name: "Kilimanjaro", Category: "Region"},
+	"TZ-10":  {Code: "TZ-10", Country: iso3166.TZ, Name: "Pemba South", Category: "Region"},
+	"TZ-11":  {Code: "TZ-11", Country: iso3166.TZ, Name: "Zanzibar South", Category: "Region"},
+	"TZ-12":  {Code: "TZ-12", Country: iso3166.TZ, Name: "Lindi", Category: "Region"},
+	"TZ-13":  {Code: "TZ-13", Country: iso3166.TZ, Name: "Mara", Category: "Region"},
+	"TZ-14":  {Code: "TZ-14", Country: iso3166.TZ, Name: "Mbeya", Category: "Region"},
+	"TZ-15":  {Code: "TZ-15", Country: iso3166.TZ, Name: "Zanzibar West", Category: "Region"},
+	"TZ-16":  {Code: "TZ-16", Country: iso3166.TZ, Name: "Morogoro", Category: "Region"},
+	"TZ-17":  {Code: "TZ-17", Country: iso3166.TZ, Name: "Mtwara", Category: "Region"},
+	"TZ-18":  {Code: "TZ-18", Country: iso3166.TZ, Name: "Mwanza", Category: "Region"},
+	"TZ-19":  {Code: "TZ-19", Country: iso3166.TZ, Name: "Coast", Category: "Region"},
+	"TZ-20":  {Code: "TZ-20", Country: iso3166.TZ, Name: "Rukwa", Category: "Region"},
+	"TZ-21":  {Code: "TZ-21", Country: iso3166.TZ, Name: "Ruvuma", Category: "Region"},
+	"TZ-22":  {Code: "TZ-22", Country: iso3166.TZ, Name: "Shinyanga", Category: "Region"},
+	"TZ-23":  {Code: "TZ-23", Country: iso3166.TZ, Name: "Singida", Category: "Region"},
+	"TZ-24":  {Code: "TZ-24", Country: iso3166.TZ, Name: "Tabora", Category: "Region"},
+	"TZ-25":  {Code: "TZ-25", Country: iso3166.TZ, Name: "Tanga", Category: "Region"},
+	"TZ-26":  {Code: "TZ-26", Country: iso3166.TZ, Name: "Manyara", Category: "Region"},
+	"TZ-27":  {Code: "TZ-27", Country: iso3166.TZ, Name: "Geita", Category: "Region"},
+	"TZ-28":  {Code: "TZ-28", Country: iso3166.TZ, Name: "Katavi", Category: "Region"},
+	"TZ-29":  {Code: "TZ-29", Country: iso3166.TZ, Name: "Njombe", Category: "Region"},
+	"TZ-30":  {Code: "TZ-30", Country: iso3166.TZ, Name: "Simiyu", Category: "Region"},
+	"TZ-31":  {Code: "TZ-31", Country: iso3166.TZ, Name: "Songwe", Category: "Region"},
+	"UA-05":  {Code: "UA-05", Country: iso3166.UA, Name: "Vinnytska oblast", Category: "Region"},
+	"UA-07":  {Code: "UA-07", Country: iso3166.UA, Name: "Volynska oblast", Category: "Region"},
+	"UA-09":  {Code: "UA-09", Country: iso3166.UA, Name: "Luhanska oblast", Category: "Region"},
+	"UA-12":  {Code: "UA-12", Country: iso3166.UA, Name: "Dnipropetrovska oblast", Category: "Region"},
+	"UA-14":  {Code: "UA-14", Country: iso3166.UA, Name: "Donetska oblast", Category: "Region"},
+	"UA-18":  {Code: "UA-18", Country: iso3166.UA, Name: "Zhytomyrska oblast", Category: "Region"},
+	"UA-21":  {Code: "UA-21", Country: iso3166.UA, Name: "Zakarpatska oblast", Category: "Region"},
+	"UA-23":  {Code: "UA-23", Country: iso3166.UA, Name: "Zaporizka oblast", Category: "Region"},
+	"UA-26":  {Code: "UA-26", Country: iso3166.UA, Name: "Ivano-Frankivska oblast", Category: "Region"},
+	"UA-30":  {Code: "UA-30", Country: iso3166.UA, Name: "Kyiv", Category: "City"},
+	"UA-32":  {Code: "UA-32", Country: iso3166.UA, Name: "Kyivska oblast", Category: "Region"},
+	"UA-35":  {Code: "UA-35", Country: iso3166.UA, Name: "Kirovohradska oblast", Category: "Region"},
+	"UA-40":  {Code: "UA-40", Country: iso3166.UA, Name: "Sevastopol", Category: "City"},
+	"UA-43":  {Code: "UA-43", Country: iso3166.UA, Name: "Avtonomna Respublika Krym", Category: "Republic"},
+	"UA-46":  {Code: "UA-46", Country: iso3166.UA, Name: "Lvivska oblast", Category: "Region"},
+	"UA-48":  {Code: "UA-48", Country: iso3166.UA, Name: "Mykolaivska oblast", Category: "Region"},
+	"UA-51":  {Code: "UA-51", Country: iso3166.UA, Name: "Odeska oblast", Category: "Region"},
+	"UA-53":  {Code: "UA-53", Country: iso3166.UA, Name: "Poltavska oblast", Category: "Region"},
+	"UA-56":  {Code: "UA-56", Country: iso3166.UA, Name: "Rivnenska oblast", Category: "Region"},
+	"UA-59":  {Code: "UA-59", Country: iso3166.UA, Name: "Sumska oblast", Category: "Region"},
+	"UA-61":  {Code: "UA-61", Country: iso3166.UA, Name: "Ternopilska oblast", Category: "Region"},
+	"UA-63":  {Code: "UA-63", Country: iso3166.UA, Name: "Kharkivska oblast", Category: "Region"},
+	"UA-65":  {Code: "UA-65", Country: iso3166.UA, Name: "Khersonska oblast", Category: "Region"},
+	"UA-68":  {Code: "UA-68", Country: iso3166.UA, Name: "Khmelnytska oblast", Category: "Region"},
+	"UA-71":  {Code: "UA-71", Country: iso3166.UA, Name: "Cherkaska oblast", Category: "Region"},
+	"UA-74":  {Code: "UA-74", Country: iso3166.UA, Name: "Chernihivska oblast", Category: "Region"},
+	"UA-77":  {Code: "UA-77", Country: iso3166.UA, Name: "Chernivetska oblast", Category: "Region"},
+	"UG-101": {Code: "UG-101", Country: iso3166.UG, Name: "Kalangala", Category: "District", ParentCode: "UG-C"},
+	"UG-102": {Code: "UG-102", Country: iso3166.UG, Name: "Kampala", Category: "City", ParentCode: "UG-C"},
+	"UG-103": {Code: "UG-103", Country: iso3166.UG, Name: "Kiboga", Category: "District", ParentCode: "UG-C"},
+	"UG-104": {Code: "UG-104", Country: iso3166.UG, Name: "Luwero", Category: "District", ParentCode: "UG-C"},
+	"UG-105": {Code: "UG-105", Country: iso3166.UG, Name: "Masaka", Category: "District", ParentCode: "UG-C"},
+	"UG-106": {Code: "UG-106", Country: iso3166.UG, Name: "Mpigi", Category: "District", ParentCode: "UG-C"},
+	"UG-107": {Code: "UG-107", Country: iso3166.UG, Name: "Mubende", Category: "District", ParentCode: "UG-C"},
+	"UG-108": {Code: "UG-108", Country: iso3166.UG, Name: "Mukono", Category: "District", ParentCode: "UG-C"},
+	"UG-109": {Code: "UG-109", Country: iso3166.UG, Name: "Nakasongola", Category: "District", ParentCode: "UG-C"},
+	"UG-110": {Code: "UG-110", Country: iso3166.UG, Name: "Rakai", Category: "District", ParentCode: "UG-C"},
+	"UG-111": {Code: "UG-111", Country: iso3166.UG, Name: "Sembabule", Category: "District", ParentCode: "UG-C"},
+	"UG-112": {Code: "UG-112", Country: iso3166.UG, Name: "Kayunga", Category: "District", ParentCode: "UG-C"},
+	"UG-113": {Code: "UG-113", Country: iso3166.UG, Name: "Wakiso", Category: "District", ParentCode: "UG-C"},
+	"UG-114": {Code: "UG-114", Country: iso3166.UG, Name: "Lyantonde", Category: "District", ParentCode: "UG-C"},
+	"UG-115": {Code: "UG-115", Country: iso3166.UG, Name: "Mityana", Category: "District", ParentCode: "UG-C"},
+	"UG-116": {Code: "UG-116", Country: iso3166.UG, Name: "Nakaseke", Category: "District", ParentCode: "UG-C"},
+	"UG-117": {Code: "UG-117", Country: iso3166.UG, Name: "Buikwe", Category: "District", ParentCode: "UG-C"},
+	"UG-118": {Code: "UG-118", Country: iso3166.UG, Name: "Bukomansibi", Category: "District", ParentCode: "UG-C"},
+	"UG-119": {Code: "UG-119", Country: iso3166.UG, Name: "Butambala", Category: "District", ParentCode: "UG-C"},
+	"UG-120": {Code: "UG-120", Country: iso3166.UG, Name: "Buvuma", Category: "District", ParentCode: "UG-C"},
+	"UG-121": {Code: "UG-121", Country: iso3166.UG, Name: "Gomba", Category: "District", ParentCode: "UG-C"},
+	"UG-122": {Code: "UG-122", Country: iso3166.UG, Name: "Kalungu", Category: "District", ParentCode: "UG-C"},
+	"UG-123": {Code: "UG-123", Country: iso3166.UG, Name: "Kyankwanzi", Category: "District", ParentCode: "UG-C"},
+	"UG-124": {Code: "UG-124", Country: iso3166.UG, Name: "Lwengo", Category: "District", ParentCode: "UG-C"},
+	"UG-125": {Code: "UG-125", Country: iso3166.UG, Name: "Kyotera", Category: "District", ParentCode: "UG-C"},
+	"UG-126": {Code: "UG-126", Country: iso3166.UG, Name: "Kasanda", Category: "District", ParentCode: "UG-C"},
+	"UG-201": {Code: "UG-201", Country: iso3166.UG, Name: "Bugiri", Category: "District", ParentCode: "UG-E"},
+	"UG-202": {Code: "UG-202", Country: iso3166.UG, Name: "Busia", Category: "District", ParentCode: "UG-E"},
+	"UG-203": {Code: "UG-203", Country: iso3166.UG, Name: "Iganga", Category: "District", ParentCode: "UG-E"},
+	"UG-204": {Code: "UG-204", Country: iso3166.UG, Name: "Jinja", Category: "District", ParentCode: "UG-E"},
+	"UG-205": {Code: "UG-205", Country: iso3166.UG, Name: "Kamuli", Category: "District", ParentCode: "UG-E"},
+	"UG-206": {Code: "UG-206", Country: iso3166.UG, Name: "Kapchorwa", Category: "District", ParentCode: "UG-E"},
+	"UG-207": {Code: "UG-207", Country: iso3166.UG, Name: "Katakwi", Category: "District", ParentCode: "UG-E"},
+	"UG-208": {Code: "UG-208", Country: iso3166.UG, Name: "Kumi", Category: "District", ParentCode: "UG-E"},
+	"UG-209": {Code: "UG-209", Country: iso3166.UG, Name: "Mbale", Category: "District", ParentCode: "UG-E"},
+	"UG-210": {Code: "UG-210", Country: iso3166.UG, Name: "Pallisa", Category: "District", ParentCode: "UG-E"},
+	"UG-211": {Code: "UG-211", Country: iso3166.UG, Name: "Soroti", Category: "District", ParentCode: "UG-E"},
+	"UG-212": {Code: "UG-212", Country: iso3166.UG, Name: "Tororo", Category: "District", ParentCode: "UG-E"},
+	"UG-213": {Code: "UG-213", Country: iso3166.UG, Name: "Kaberamaido", Category: "District", ParentCode: "UG-E"},
+	"UG-214": {Code: "UG-214", Country: iso3166.UG, Name: "Mayuge", Category: "District", ParentCode: "UG-E"},
+	"UG-215": {Code: "UG-215", Country: iso3166.UG, Name: "Sironko", Category: "District", ParentCode: "UG-E"},
+	"UG-216": {Code: "UG-216", Country: iso3166.UG, Name: "Amuria", Category: "District", ParentCode: "UG-E"},
+	"UG-217": {Code: "UG-217", Country: iso3166.UG, Name: "Budaka", Category: "District", ParentCode: "UG-E"},
+	"UG-218": {Code: "UG-218", Country: iso3166.UG, Name: "Bududa", Category: "District", ParentCode: "UG-E"},
+	"UG-219": {Code: "UG-219", Country: iso3166.UG, Name: "Bukedea", Category: "District", ParentCode: "UG-E"},
+	"UG-220": {Code: "UG-220", Country: iso3166.UG, Name: "Bukwo", Category: "District", ParentCode: "UG-E"},
+	"UG-221": {Code: "UG-221", Country: iso3166.UG, Name: "Butaleja", Category: "District", ParentCode: "UG-E"},
+	"UG-222": {Code: "UG-222", Country: iso3166.UG, Name: "Kaliro", Category: "District", ParentCode: "UG-E"},
+	"UG-223": {Code: "UG-223", Country: iso3166.UG, Name: "Manafwa", Category: "District", ParentCode: "UG-E"},
+	"UG-224": {Code: "UG-224", Country: iso3166.UG, Name: "Namutumba", Category: "District", ParentCode: "UG-E"},
+	"UG-225": {Code: "UG-225", Country: iso3166.UG, Name: "Bulambuli", Category: "District", ParentCode: "UG-E"},
+	"UG-226": {Code: "UG-226", Country: iso3166.UG, Name: "Buyende", Category: "District", ParentCode: "UG-E"},
+	"UG-227": {Code: "UG-227", Country: iso3166.UG, Name: "Kibuku", Category: "District", ParentCode: "UG-E"},
+	"UG-228": {Code: "UG-228", Country: iso3166.UG, Name: "Kween", Category: "District", ParentCode: "UG-E"},
+	"UG-229": {Code: "UG-229", Country: iso3166.UG, Name: "Luuka", Category: "District", ParentCode: "UG-E"},
+	"UG-230": {Code: "UG-230", Country: iso3166.UG, Name: "Namayingo", Category: "District", ParentCode: "UG-E"},
+	"UG-231": {Code: "UG-231", Country: iso3166.UG, Name: "Ngora", Category: "District", ParentCode: "UG-E"},
+	"UG-232": {Code: "UG-232", Country: iso3166.UG, Name: "Serere", Category: "District", ParentCode: "UG-E"},
+	"UG-233": {Code: "UG-233", Country: iso3166.UG, Name: "Butebo", Category: "District", ParentCode: "UG-E"},
+	"UG-234": {Code: "UG-234", Country: iso3166.UG, Name: "Namisindwa", Category: "District", ParentCode: "UG-E"},
+	"UG-235": {Code: "UG-235", Country: iso3166.UG, Name: "Bugweri", Category: "District", ParentCode: "UG-E"},
+	"UG-236": {Code: "UG-236", Country: iso3166.UG, Name: "Kapelebyong", Category: "District", ParentCode: "UG-E"},
+	"UG-237": {Code: "UG-237", Country: iso3166.UG, Name: "Kalaki", Category: "District", ParentCode: "UG-E"},
+	"UG-301": {Code: "UG-301", Country: iso3166.UG, Name: "Adjumani", Category: "District", ParentCode: "UG-N"},
+	"UG-302": {Code: "UG-302", Country: iso3166.UG, Name: "Apac", Category: "District", ParentCode: "UG-N"},
+	"UG-303": {Code: "UG-303", Country: iso3166.UG, Name: "Arua", Category: "District", ParentCode: "UG-N"},
+	"UG-304": {Code: "UG-304", Country: iso3166.UG, Name: "Gulu", Category: "District", ParentCode: "UG-N"},
+	"UG-305": {Code: "UG-305", Country: iso3166.UG, Name: "Kitgum", Category: "District", ParentCode: "UG-N"},
+	"UG-306": {Code: "UG-306", Country: iso3166.UG, Name: "Kotido", Category: "District", ParentCode: "UG-N"},
+	"UG-307": {Code: "UG-307", Country: iso3166.UG, Name: "Lira", Category: "District", ParentCode: "UG-N"},
+	"UG-308": {Code: "UG-308", Country: iso3166.UG, Name: "Moroto", Category: "District", ParentCode: "UG-N"},
+	"UG-309": {Code: "UG-309", Country: iso3166.UG, Name: "Moyo", Category: "District", ParentCode: "UG-N"},
+	"UG-310": {Code: "UG-310", Country: iso3166.UG, Name: "Nebbi", Category: "District", ParentCode: "UG-N"},
+	"UG-311": {Code: "UG-311", Country: iso3166.UG, Name: "Nakapiripirit", Category: "District", ParentCode: "UG-N"},
+	"UG-312": {Code: "UG-312", Country: iso3166.UG, Name: "Pader", Category: "District", ParentCode: "UG-N"},
+	"UG-313": {Code: "UG-313", Country: iso3166.UG, Name: "Yumbe", Category: "District", ParentCode: "UG-N"},
+	"UG-314": {Code: "UG-314", Country: iso3166.UG, Name: "Abim", Category: "District", ParentCode: "UG-N"},
+	"UG-315": {Code: "UG-315", Country: iso3166.UG, Name: "Amolatar", Category: "District", ParentCode: "UG-N"},
+	"UG-316": {Code: "UG-316", Country: iso3166.UG, Name: "Amuru", Category: "District", ParentCode: "UG-N"},
+	"UG-317": {Code: "UG-317", Country: iso3166.UG, Name: "Dokolo", Category: "District", ParentCode: "UG-N"},
+	"UG-318": {Code: "UG-318", Country: iso3166.UG, Name: "Kaabong", Category: "District", ParentCode: "UG-N"},
+	"UG-319": {Code: "UG-319", Country: iso3166.UG, Name: "Koboko", Category: "District", ParentCode: "UG-N"},
+	"UG-320": {Code: "UG-320", Country: iso3166.UG, Name: "Maracha", Category: "District", ParentCode: "UG-N"},
+	"UG-321": {Code: "UG-321", Country: iso3166.UG, Name: "Oyam", Category: "District", ParentCode: "UG-N"},
+	"UG-322": {Code: "UG-322", Country: iso3166.UG, Name: "Agago", Category: "District", ParentCode: "UG-N"},
+	"UG-323": {Code: "UG-323", Country: iso3166.UG, Name: "Alebtong", Category: "District", ParentCode: "UG-N"},
+	"UG-324": {Code: "UG-324", Country: iso3166.UG, Name: "Amudat", Category: "District", ParentCode: "UG-N"},
+	"UG-325": {Code: "UG-325", Country: iso3166.UG, Name: "Kole", Category: "District", ParentCode: "UG-N"},
+	"UG-326": {Code: "UG-326", Country: iso3166.UG, Name: "Lamwo", Category: "District", ParentCode: "UG-N"},
+	"UG-327": {Code: "UG-327", Country: iso3166.UG, Name: "Napak", Category: "District", ParentCode: "UG-N"},
+	"UG-328": {Code: "UG-328", Country: iso3166.UG, Name: "Nwoya", Category: "District", ParentCode: "UG-N"},
+	"UG-329": {Code: "UG-329", Country: iso3166.UG, Name: "Otuke", Category: "District", ParentCode: "UG-N"},
+	"UG-330": {Code: "UG-330", Country: iso3166.UG, Name: "Zombo", Category: "District", ParentCode: "UG-N"},
+	"UG-331": {Code: "UG-331", Country: iso3166.UG, Name: "Omoro", Category: "District", ParentCode: "UG-N"},
+	"UG-332": {Code: "UG-332", Country: iso3166.UG, Name: "Pakwach", Category: "District", ParentCode: "UG-N"},
+	"UG-333": {Code: "UG-333", Country: iso3166.UG, Name: "Kwania", Category: "District", ParentCode: "UG-N"},
+	"UG-334": {Code: "UG-334", Country: iso3166.UG, Name: "Nabilatuk", Category: "District", ParentCode: "UG-N"},
+	"UG-335": {Code: "UG-335", Country: iso3166.UG, Name: "Karenga", Category: "District", ParentCode: "UG-N"},
+	"UG-336": {Code: "UG-336", Country: iso3166.UG, Name: "Madi-Okollo", Category: "District", ParentCode: "UG-N"},
+	"UG-337": {Code: "UG-337", Country: iso3166.UG, Name: "Obongi", Category: "District", ParentCode: "UG-N"},
+	"UG-401": {Code: "UG-401", Country: iso3166.UG, Name: "Bundibugyo", Category: "District", ParentCode: "UG-W"},
+	"UG-402": {Code: "UG-402", Country: iso3166.UG, Name: "Bushenyi", Category: "District", ParentCode: "UG-W"},
+	"UG-403": {Code: "UG-403", Country: iso3166.UG, Name: "Hoima", Category: "District", ParentCode: "UG-W"},
+	"UG-404": {Code: "UG-404", Country: iso3166.UG, Name: "Kabale", Category: "District", ParentCode: "UG-W"},
+	"UG-405": {Code: "UG-405", Country: iso3166.UG, Name: "Kabarole", Category: "District", ParentCode: "UG-W"},
+	"UG-406": {Code: "UG-406", Country: iso3166.UG, Name: "Kasese", Category: "District", ParentCode: "UG-W"},
+	"UG-407": {Code: "UG-407", Country: iso3166.UG, Name: "Kibaale", Category: "District", ParentCode: "UG-W"},
+	"UG-408": {Code: "UG-408", Country: iso3166.UG, Name: "Kisoro", Category: "District", ParentCode: "UG-W"},
+	"UG-409": {Code: "UG-409", Country: iso3166.UG, Name: "Masindi", Category: "District", ParentCode: "UG-W"},
+	"UG-410": {Code: "UG-410", Country: iso3166.UG, Name: "Mbarara", Category: "District", ParentCode: "UG-W"},
+	"UG-411": {Code: "UG-411", Country: iso3166.UG, Name: "Ntungamo", Category: "District", ParentCode: "UG-W"},
+	"UG-412": {Code: "UG-412", Country: iso3166.UG, Name: "Rukungiri", Category: "District", ParentCode: "UG-W"},
+	"UG-413": {Code: "UG-413", Country: iso3166.UG, Name: "Kamwenge", Category: "District", ParentCode: "UG-W"},
+	"UG-414": {Code: "UG-414", Country: iso3166.UG, Name: "Kanungu", Category: "District", ParentCode: "UG-W"},
+	"UG-415": {Code: "UG-415", Country: iso3166.UG, Name: "Kyenjojo", Category: "District", ParentCode: "UG-W"},
+	"UG-416": {Code: "UG-416", Country: iso3166.UG, Name: "Buliisa", Category: "District", ParentCode: "UG-W"},
+	"UG-417": {Code: "UG-417", Country: iso3166.UG, Name: "Ibanda", Category: "District", ParentCode: "UG-W"},
+	"UG-418": {Code: "UG-418", Country: iso3166.UG, Name: "Isingiro", Category: "District", ParentCode: "UG-W"},
+	"UG-419": {Code: "UG-419", Country: iso3166.UG, Name: "Kiruhura", Category: "District", ParentCode: "UG-W"},
+	"UG-420": {Code: "UG-420", Country: iso3166.UG, Name: "Buhweju", Category: "District", ParentCode: "UG-W"},
+	"UG-421": {Code: "UG-421", Country: iso3166.UG, Name: "Kiryandongo", Category: "District", ParentCode: "UG-W"},
+	"UG-422": {Code: "UG-422", Country: iso3166.UG, Name: "Kyegegwa", Category: "District", ParentCode: "UG-W"},
+	"UG-423": {Code: "UG-423", Country: iso3166.UG, Name: "Mitooma", Category: "District", ParentCode: "UG-W"},
+	"UG-424": {Code: "UG-424", Country: iso3166.UG, Name: "Ntoroko", Category: "District", ParentCode: "UG-W"},
+	"UG-425": {Code: "UG-425", Country: iso3166.UG, Name: "Rubirizi", Category: "District", ParentCode: "UG-W"},
+	"UG-426": {Code: "UG-426", Country: iso3166.UG, Name: "Sheema", Category: "District", ParentCode: "UG-W"},
+	"UG-427": {Code: "UG-427", Country: iso3166.UG, Name: "Kagadi", Category: "District", ParentCode: "UG-W"},
+	"UG-428": {Code: "UG-428", Country: iso3166.UG, Name: "Kakumiro", Category: "District", ParentCode: "UG-W"},
+	"UG-429": {Code: "UG-429", Country: iso3166.UG, Name: "Rubanda", Category: "District", ParentCode: "UG-W"},
+	"UG-430": {Code: "UG-430", Country: iso3166.UG, Name: "Bunyangabu", Category: "District", ParentCode: "UG-W"},
+	"UG-431": {Code: "UG-431", Country: iso3166.UG, Name: "Rukiga", Category: "District", ParentCode: "UG-W"},
+	"UG-432": {Code: "UG-432", Country: iso3166.UG, Name: "Kikuube", Category: "District", ParentCode: "UG-W"},
+	"UG-433": {Code: "UG-433", Country: iso3166.UG, Name: "Kazo", Category: "District", ParentCode: "UG-W"},
+	"UG-434": {Code: "UG-434", Country: iso3166.UG, Name: "Kitagwenda", Category: "District", ParentCode: "UG-W"},
+	"UG-435": {Code: "UG-435", Country: iso3166.UG, Name: "Rwampara", Category: "District", ParentCode: "UG-W"},
+	"UG-C":   {Code: "UG-C", Country: iso3166.UG, Name: "Central", Category: "Geographical region"},
+	"UG-E":   {Code: "UG-E", Country: iso3166.UG, Name: "Eastern", Category: "Geographical region"},
+	"UG-N":   {Code: "UG-N", Country: iso3166.UG, Name: "Northern", Category: "Geographical region"},
+	"UG-W":   {Code: "UG-W", Country: iso3166.UG, Name: "Western", Category: "Geographical region"},
+	"UM-67":  {Code: "UM-67", Country: iso3166.UM, Name: "Johnston Atoll", Category: "Islands, groups of islands"},
+	"UM-71":  {Code: "UM-71", Country: iso3166.UM, Name: "Midway Islands", Category: "Islands, groups of islands"},
+	"UM-76":  {Code: "UM-76", Country: iso3166.UM, Name: "Navassa Island", Category: "Islands, groups of islands"},
+	"UM-79":  {Code: "UM-79", Country: iso3166.UM, Name: "Wake Island", Category: "Islands, groups of islands"},
+	"UM-81":  {Code: "UM-81", Country: iso3166.UM, Name: "Baker Island", Category: "Islands, groups of islands"},
+	"UM-84":  {Code: "UM-84", Country: iso3166.UM, Name: "Howland Island", Category: "Islands, groups of islands"},
+	"UM-86":  {Code: "UM-86", Country: iso3166.UM, Name: "Jarvis Island", Category: "Islands, groups of islands"},
+	"UM-89":  {Code: "UM-89", Country: iso3166.UM, Name: "Kingman Reef", Category: "Islands, groups of islands"},
+	"UM-95":  {Code: "UM-95", Country: iso3166.UM, Name: "Palmyra Atoll", Category: "Islands, groups of islands"},
+	"US-AK":  {Code: "US-AK", Country: iso3166.US, Name: "Alaska", Category: "State"},
+	"US-AL":  {Code: "US-AL", Country: iso3166.US, Name: "Alabama", Category: "State"},
+	"US-AR":  {Code: "US-AR", Country: iso3166.US, Name: "Arkansas", Category: "State"},
+	"US-AS":  {Code: "US-AS", Country: iso3166.US, Name: "American Samoa", Category: "Outlying area"},
+	"US-AZ":  {Code: "US-AZ", Country: iso3166.US, Name: "Arizona", Category: "State"},
+	"US-CA":  {Code: "US-CA", Country: iso3166.US, Name: "California", Category: "State"},
+	"US-CO":  {Code: "US-CO", Country: iso3166.US, Name: "Colorado", Category: "State"},
+	"US-CT":  {Code: "US-CT", Country: iso3166.US, Name: "Connecticut", Category: "State"},
+	"US-DC":  {Code: "US-DC", Country: iso3166.US, Name: "District of Columbia", Category: "District"},
+	"US-DE":  {Code: "US-DE", Country: iso3166.US, Name: "Delaware", Category: "State"},
+	"US-FL":  {Code: "US-FL", Country: iso3166.US, Name: "Florida", Category: "State"},
+	"US-GA":  {Code: "US-GA", Country: iso3166.US, Name: "Georgia", Category: "State"},
+	"US-GU":  {Code: "US-GU", Country: iso3166.US, Name: "Guam", Category: "Outlying area"},
+	"US-HI":  {Code: "US-HI", Country: iso3166.US, Name: "Hawaii", Category: "State"},
+	"US-IA":  {Code: "US-IA", Country: iso3166.US, Name: "Iowa", Category: "State"},
+	"US-ID":  {Code: "US-ID", Country: iso3166.US, Name: "Idaho", Category: "State"},
+	"US-IL":  {Code: "US-IL", Country: iso3166.US, Name: "Illinois", Category: "State"},
+	"US-IN":  {Code: "US-IN", Country: iso3166.US, Name: "Indiana", Category: "State"},
+	"US-KS":  {Code: "US-KS", Country: iso3166.US, Name: "Kansas", Category: "State"},
+	"US-KY":  {Code: "US-KY", Country: iso3166.US, Name: "Kentucky", Category: "State"},
+	"US-LA":  {Code: "US-LA", Country: iso3166.US, Name: "Louisiana", Category: "State"},
+	"US-MA":  {Code: "US-MA", Country: iso3166.US, Name: "Massachusetts", Category: "State"},
+	"US-MD":  {Code: "US-MD", Country: iso3166.US, Name: "Maryland", Category: "State"},
+	"US-ME":  {Code: "US-ME", Country: iso3166.US, Name: "Maine", Category: "State"},
+	"US-MI":  {Code: "US-MI", Country: iso3166.US, Name: "Michigan", Category: "State"},
+	"US-MN":  {Code: "US-MN", Country: iso3166.US, Name: "Minnesota", Category: "State"},
+	"US-MO":  {Code: "US-MO", Country: iso3166.US, Name: "Missouri", Category: "State"},
+	"US-MP":  {Code: "US-MP", Country: iso3166.US, Name: "Northern Mariana Islands", Category: "Outlying area"},
+	"US-MS":  {Code: "US-MS", Country: iso3166.US, Name: "Mississippi", Category: "State"},
+	"US-MT":  {Code: "US-MT", Country: iso3166.US, Name: "Montana", Category: "State"},
+	"US-NC":  {Code: "US-NC", Country: iso3166.US, Name: "North Carolina", Category: "State"},
+	"US-ND":  {Code: "US-ND", Country: iso3166.US, Name: "North Dakota", Category: "State"},
+	"US-NE":  {Code: "US-NE", Country: iso3166.US, Name: "Nebraska", Category: "State"},
+	"US-NH":  {Code: "US-NH", Country: iso3166.US, Name: "New Hampshire", Category: "State"},
+	"US-NJ":  {Code: "US-NJ", Country: iso3166.US, Name: "New Jersey", Category: "State"},
+	"US-NM":  {Code: "US-NM", Country: iso3166.US, Name: "New Mexico", Category: "State"},
+	"US-NV":  {Code: "US-NV", Country: iso3166.US, Name: "Nevada", Category: "State"},
+	"US-NY":  {Code: "US-NY", Country: iso3166.US, Name: "New York", Category: "State"},
+	"US-OH":  {Code: "US-OH", Country: iso3166.US, Name: "Ohio", Category: "State"},
+	"US-OK":  {Code: "US-OK", Country: iso3166.US, Name: "Oklahoma", Category: "State"},
+	"US-OR":  {Code: "US-OR", Country: iso3166.US, Name: "Oregon", Category: "State"},
+	"US-PA":  {Code: "US-PA", Country: iso3166.US, Name: "Pennsylvania", Category: "State"},
+	"US-PR":  {Code: "US-PR", Country: iso3166.US, Name: "Puerto Rico", Category: "Outlying area"},
+	"US-RI":  {Code: "US-RI", Country: iso3166.US, Name: "Rhode Island", Category: "State"},
+	"US-SC":  {Code: "US-SC", Country: iso3166.US, Name: "South Carolina", Category: "State"},
+	"US-SD":  {Code: "US-SD", Country: iso3166.US, Name: "South Dakota", Category: "State"},
+	"US-TN":  {Code: "US-TN", Country: iso3166.US, Name: "Tennessee", Category: "State"},
+	"US-TX":  {Code: "US-TX", Country: iso3166.US, Name: "Texas", Category: "State"},
+	"US-UM":  {Code: "US-UM", Country: iso3166.US, Name: "United States Minor Outlying Islands", Category: "Outlying area"},
+	"US-UT":  {Code: "US-UT", Country: iso3166.US, Name: "Utah", Category: "State"},
+	"US-VA":  {Code: "US-VA", Country: iso3166.US, Name: "Virginia", Category: "State"},
+	"US-VI":  {Code: "US-VI", Country: iso3166.US, Name: "Virgin Islands, U.S.", Category: "Outlying area"},
+	"US-VT":  {Code: "US-VT", Country: iso3166.US, Name: "Vermont", Category: "State"},
+	"US-WA":  {Code: "US-WA", Country: iso3166.US, Name: "Washington", Category: "State"},
+	"US-WI":  {Code: "US-WI", Country: iso3166.US, Name: "Wisconsin", Category: "State"},
+	"US-WV":  {Code: "US-WV", Country: iso3166.US, Name: "West Virginia", Category: "State"},
+	"US-WY":  {Code: "US-WY", Country: iso3166.US, Name: "Wyoming", Category: "State"},
+	"UY-AR":  {Code: "UY-AR", Country: iso3166.UY, Name: "Artigas", Category: "Department"},
+	"UY-CA":  {Code: "UY-CA", Country: iso3166.UY, Name: "Canelones", Category: "Department"},
+	"UY-CL":  {Code: "UY-CL", Country: iso3166.UY, Name: "Cerro Largo", Category: "Department"},
+	"UY-CO":  {Code: "UY-CO", Country: iso3166.UY, Name: "Colonia", Category: "Department"},
+	"UY-DU":  {Code: "UY-DU", Country: iso3166.UY, Name: "Durazno", Category: "Department"},
+	"UY-FD":  {Code: "UY-FD", Country: iso3166.UY, Name: "Florida", Category: "Department"},
+	"UY-FS":  {Code: "UY-FS", Country: iso3166.UY, Name: "Flores", Category: "Department"},
+	"UY-LA":  {Code: "UY-LA", Country: iso3166.UY, Name: "Lavalleja", Category: "Department"},
+	"UY-MA":  {Code: "UY-MA", Country: iso3166.UY, Name: "Maldonado", Category: "Department"},
+	"UY-MO":  {Code: "UY-MO", Country: iso3166.UY, Name: "Montevideo", Category: "Department"},
+	"UY-PA":  {Code: "UY-PA", Country: iso3166.UY, Name: "Paysandú", Category: "Department"},
+	"UY-RN":  {Code: "UY-RN", Country: iso3166.UY, Name: "Río Negro", Category: "Department"},
+	"UY-RO":  {Code: "UY-RO", Country: iso3166.UY, Name: "Rocha", Category: "Department"},
+	"UY-RV":  {Code: "UY-RV", Country: iso3166.UY, Name: "Rivera", Category: "Department"},
+	"UY-SA":  {Code: "UY-SA", Country: iso3166.UY, Name: "Salto", Category: "Department"},
+	"UY-SJ":  {Code: "UY-SJ", Country: iso3166.UY, Name: "San José", Category: "Department"},
+	"UY-SO":  {Code: "UY-SO", Country: iso3166.UY, Name: "Soriano", Category: "Department"},
+	"UY-TA":  {Code: "UY-TA", Country: iso3166.UY, Name: "Tacuarembó", Category: "Department"},
+	"UY-TT":  {Code: "UY-TT", Country: iso3166.UY, Name: "Treinta y Tres", Category: "Department"},
+	"UZ-AN":  {Code: "UZ-AN", Country: iso3166.UZ, Name: "Andijon", Category: "Region"},
+	"UZ-BU":  {Code: "UZ-BU", Country: iso3166.UZ, Name: "Buxoro", Category: "Region"},
+	"UZ-FA":  {Code: "UZ-FA", Country: iso3166.UZ, Name: "Farg‘ona", Category: "Region"},
+	"UZ-JI":  {Code: "UZ-JI", Country: iso3166.UZ, Name: "Jizzax", Category: "Region"},
+	"UZ-NG":  {Code: "UZ-NG", Country: iso3166.UZ, Name: "Namangan", Category: "Region"},
+	"UZ-NW":  {Code: "UZ-NW", Country: iso3166.UZ, Name: "Navoiy", Category: "Region"},
+	"UZ-QA":  {Code: "UZ-QA", Country: iso3166.UZ, Name: "Qashqadaryo", Category: "Region"},
+	"UZ-QR":  {Code: "UZ-QR", Country: iso3166.UZ, Name: "Qoraqalpog‘iston Respublikasi", Category: "Republic"},
+	"UZ-SA":  {Code: "UZ-SA", Country: iso3166.UZ, Name: "Samarqand", Category: "Region"},
+	"UZ-SI":  {Code: "UZ-SI", Country: iso3166.UZ, Name: "Sirdaryo", Category: "Region"},
+	"UZ-SU":  {Code: "UZ-SU", Country: iso3166.UZ, Name: "Surxondaryo", Category: "Region"},
+	"UZ-TK":  {Code: "UZ-TK", Country: iso3166.UZ, Name: "Toshkent", Category: "City"},
+	"UZ-TO":  {Code: "UZ-TO", Country: iso3166.UZ, Name: "Toshkent", Category: "Region"},
+	"UZ-XO":  {Code: "UZ-XO", Country: iso3166.UZ, Name: "Xorazm", Category: "Region"},
+	"VC-01":  {Code: "VC-01", Country: iso3166.VC, Name: "Charlotte", Category: "Parish"},
+	"VC-02":  {Code: "VC-02", Country: iso3166.VC, Name: "Saint Andrew", Category: "Parish"},
+	"VC-03":  {Code: "VC-03", Country: iso3166.VC, Name: "Saint David", Category: "Parish"},
+	"VC-04":  {Code: "VC-04", Country: iso3166.VC, Name: "Saint George", Category: "Parish"},
+	"VC-05":  {Code: "VC-05", Country: iso3166.VC, Name: "Saint Patrick", Category: "Parish"},
+	"VC-06":  {Code: "VC-06", Country: iso3166.VC, Name: "Grenadines", Category: "Parish"},
+	"VE-A":   {Code: "VE-A", Country: iso3166.VE, Name: "Distrito Capital", Category: "Capital district"},
+	"VE-B":   {Code: "VE-B", Country: iso3166.VE, Name: "Anzoátegui", Category: "State"},
+	"VE-C":   {Code: "VE-C", Country: iso3166.VE, Name: "Apure", Category: "State"},
+	"VE-D":   {Code: "VE-D", Country: iso3166.VE, Name: "Aragua", Category: "State"},
+	"VE-E":   {Code: "VE-E", Country: iso3166.VE, Name: "Barinas", Category: "State"},
+	"VE-F":   {Code: "VE-F", Country: iso3166.VE, Name: "Bolívar", Category: "State"},
+	"VE-G":   {Code: "VE-G", Country: iso3166.VE, Name: "Carabobo", Category: "State"},
+	"VE-H":   {Code: "VE-H", Country: iso3166.VE, Name: "Cojedes", Category: "State"},
+	"VE-I":   {Code: "VE-I", Country: iso3166.VE, Name: "Falcón", Category: "State"},
+	"VE-J":   {Code: "VE-J", Country: iso3166.VE, Name: "Guárico", Category: "State"},
+	"VE-K":   {Code: "VE-K", Country: iso3166.VE, Name: "Lara", Category: "State"},
+	"VE-L":   {Code: "VE-L", Country: iso3166.VE, Name: "Mérida", Category: "State"},
+	"VE-M":   {Code: "VE-M", Country: iso3166.VE, Name: "Miranda", Category: "State"},
+	"VE-N":   {Code: "VE-N", Country: iso3166.VE, Name: "Monagas", Category: "State"},
+	"VE-O":   {Code: "VE-O", Country: iso3166.VE, Name: "Nueva Esparta", Category: "State"},
+	"VE-P":   {Code: "VE-P", Country: iso3166.VE, Name: "Portuguesa", Category: "State"},
+	"VE-R":   {Code: "VE-R", Country: iso3166.VE, Name: "Sucre", Category: "State"},
+	"VE-S":   {Code: "VE-S", Country: iso3166.VE, Name: "Táchira", Category: "State"},
+	"VE-T":   {Code: "VE-T", Country: iso3166.VE, Name: "Trujillo", Category: "State"},
+	"VE-U":   {Code: "VE-U", Country: iso3166.VE, Name: "Yaracuy", Category: "State"},
+	"VE-V":   {Code: "VE-V", Country: iso3166.VE, Name: "Zulia", Category: "State"},
+	"VE-W":   {Code: "VE-W", Country: iso3166.VE, Name: "Dependencias Federales", Category: "Federal dependency"},
+	"VE-X":   {Code: "VE-X", Country: iso3166.VE, Name: "La Guaira", Category: "State"},
+	"VE-Y":   {Code: "VE-Y", Country: iso3166.VE, Name: "Delta Amacuro", Category: "State"},
+	"VE-Z":   {Code: "VE-Z", Country: iso3166.VE, Name: "Amazonas", Category: "State"},
+	"VN-01":  {Code: "VN-01", Country: iso3166.VN, Name: "Lai Châu", Category: "Province"},
+	"VN-02":  {Code: "VN-02", Country: iso3166.VN, Name: "Lào Cai", Category: "Province"},
+	"VN-03":  {Code: "VN-03", Country: iso3166.VN, Name: "Hà Giang", Category: "Province"},
+	"VN-04":  {Code: "VN-04", Country: iso3166.VN, Name: "Cao Bằng", Category: "Province"},
+	"VN-05":  {Code: "VN-05", Country: iso3166.VN, Name: "Sơn La", Category: "Province"},
+	"VN-06":  {Code: "VN-06", Country: iso3166.VN, Name: "Yên Bái", Category: "Province"},
+	"VN-07":  {Code: "VN-07", Country: iso3166.VN, Name: "Tuyên Quang", Category: "Province"},
+	"VN-09":  {Code: "VN-09", Country: iso3166.VN, Name: "Lạng Sơn", Category: "Province"},
+	"VN-13":  {Code: "VN-13", Country: iso3166.VN, Name: "Quảng Ninh", Category: "Province"},
+	"VN-14":  {Code: "VN-14", Country: iso3166.VN, Name: "Hòa Bình", Category: "Province"},
+	"VN-18":  {Code: "VN-18", Country: iso3166.VN, Name: "Ninh Bình", Category: "Province"},
+	"VN-20":  {Code: "VN-20", Country: iso3166.VN, Name: "Thái Bình", Category: "Province"},
+	"VN-21":  {Code: "VN-21", Country: iso3166.VN, Name: "Thanh Hóa", Category: "Province"},
+	"VN-22":  {Code: "VN-22", Country: iso3166.VN, Name: "Nghệ An", Category: "Province"},
+	"VN-23":  {Code: "VN-23", Country: iso3166.VN, Name: "Hà Tĩnh", Category: "Province"},
+	"VN-24":  {Code: "VN-24", Country: iso3166.VN, Name: "Quảng Bình", Category: "Province"},
+	"VN-25":  {Code: "VN-25", Country: iso3166.VN, Name: "Quảng Trị", Category: "Province"},
+	"VN-26":  {Code: "VN-26", Country: iso3166.VN, Name: "Thừa Thiên-Huế", Category: "Province"},
+	"VN-27":  {Code: "VN-27", Country: iso3166.VN, Name: "Quảng Nam", Category: "Province"},
+	"VN-28":  {Code: "VN-28", Country: iso3166.VN, Name: "Kon Tum", Category: "Province"},
+	"VN-29":  {Code: "VN-29", Country: iso3166.VN, Name: "Quảng Ngãi", Category: "Province"},
+	"VN-30":  {Code: "VN-30", Country: iso3166.VN, Name: "Gia Lai", Category: "Province"},
+	"VN-31":  {Code: "VN-31", Country: iso3166.VN, Name: "Bình Định", Category: "Province"},
+	"VN-32":  {Code: "VN-32", Country: iso3166.VN, Name: "Phú Yên", Category: "Province"},
+	"VN-33":  {Code: "VN-33", Country: iso3166.VN, Name: "Đắk Lắk", Category: "Province"},
+	"VN-34":  {Code: "VN-34", Country: iso3166.VN, Name: "Khánh Hòa", Category: "Province"},
+	"VN-35":  {Code: "VN-35", Country: iso3166.VN, Name: "Lâm Đồng", Category: "Province"},
+	"VN-36":  {Code: "VN-36", Country: iso3166.VN, Name: "Ninh Thuận", Category: "Province"},
+	"VN-37":  {Code: "VN-37", Country: iso3166.VN, Name: "Tây Ninh", Category: "Province"},
+	"VN-39":  {Code: "VN-39", Country: iso3166.VN, Name: "Đồng Nai", Category: "Province"},
+	"VN-40":  {Code: "VN-40", Country: iso3166.VN, Name: "Bình Thuận", Category: "Province"},
+	"VN-41":  {Code: "VN-41", Country: iso3166.VN, Name: "Long An", Category: "Province"},
+	"VN-43":  {Code: "VN-43", Country: iso3166.VN, Name: "Bà Rịa - Vũng Tàu", Category: "Province"},
+	"VN-44":  {Code: "VN-44", Country: iso3166.VN, Name: "An Giang", Category: "Province"},
+	"VN-45":  {Code: "VN-45", Country: iso3166.VN, Name: "Đồng Tháp", Category: "Province"},
+	"VN-46":  {Code: "VN-46", Country: iso3166.VN, Name: "Tiền Giang", Category: "Province"},
+	"VN-47":  {Code: "VN-47", Country: iso3166.VN, Name: "Kiến Giang", Category: "Province"},
+	"VN-49":  {Code: "VN-49", Country: iso3166.VN, Name: "Vĩnh Long", Category: "Province"},
+	"VN-50":  {Code: "VN-50", Country: iso3166.VN, Name: "Bến Tre", Category: "Province"},
+	"VN-51":  {Code: "VN-51", Country: iso3166.VN, Name: "Trà Vinh", Category: "Province"},
+	"VN-52":  {Code: "VN-52", Country: iso3166.VN, Name: "Sóc Trăng", Category: "Province"},
+	"VN-53":  {Code: "VN-53", Country: iso3166.VN, Name: "Bắc Kạn", Category: "Province"},
+	"VN-54":  {Code: "VN-54", Country: iso3166.VN, Name: "Bắc Giang", Category: "Province"},
+	"VN-55":  {Code: "VN-55", Country: iso3166.VN, Name: "Bạc Liêu", Category: "Province"},
+	"VN-56":  {Code: "VN-56", Country: iso3166.VN, Name: "Bắc Ninh", Category: "Province"},
+	"VN-57":  {Code: "VN-57", Country: iso3166.VN, Name: "Bình Dương", Category: "Province"},
+	"VN-58":  {Code: "VN-58", Country: iso3166.VN, Name: "Bình Phước", Category: "Province"},
+	"VN-59":  {Code: "VN-59", Country: iso3166.VN, Name: "Cà Mau", Category: "Province"},
+	"VN-61":  {Code: "VN-61", Country: iso3166.VN, Name: "Hải Dương", Category: "Province"},
+	"VN-63":  {Code: "VN-63", Country: iso3166.VN, Name: "Hà Nam", Category: "Province"},
+	"VN-66":  {Code: "VN-66", Country: iso3166.VN, Name: "Hưng Yên", Category: "Province"},
+	"VN-67":  {Code: "VN-67", Country: iso3166.VN, Name: "Nam Định", Category: "Province"},
+	"VN-68":  {Code: "VN-68", Country: iso3166.VN, Name: "Phú Thọ", Category: "Province"},
+	"VN-69":  {Code: "VN-69", Country: iso3166.VN, Name: "Thái Nguyên", Category: "Province"},
+	"VN-70":  {Code: "VN-70", Country: iso3166.VN, Name: "Vĩnh Phúc", Category: "Province"},
+	"VN-71":  {Code: "VN-71", Country: iso3166.VN, Name: "Điện Biên", Category: "Province"},
+	"VN-72":  {Code: "VN-72", Country: iso3166.VN, Name: "Đắk Nông", Category: "Province"},
+	"VN-73":  {Code: "VN-73", Country: iso3166.VN, Name: "Hậu Giang", Category: "Province"},
+	"VN-CT":  {Code: "VN-CT", Country: iso3166.VN, Name: "Cần Thơ", Category: "Municipality"},
+	"VN-DN":  {Code: "VN-DN", Country: iso3166.VN, Name: "Đà Nẵng", Category: "Municipality"},
+	"VN-HN":  {Code: "VN-HN", Country: iso3166.VN, Name: "Hà Nội", Category: "Municipality"},
+	"VN-HP":  {Code: "VN-HP", Country: iso3166.VN, Name: "Hải Phòng", Category: "Municipality"},
+	"VN-SG":  {Code: "VN-SG", Country: iso3166.VN, Name: "Hồ Chí Minh", Category: "Municipality"},
+	"VU-MAP": {Code: "VU-MAP", Country: iso3166.VU, Name: "Malampa", Category: "Province"},
+	"VU-PAM": {Code: "VU-PAM", Country: iso3166.VU, Name: "Pénama", Category: "Province"},
+	"VU-SAM": {Code: "VU-SAM", Country: iso3166.VU, Name: "Sanma", Category: "Province"},
+	"VU-SEE": {Code: "VU-SEE", Country: iso3166.VU, Name: "Shéfa", Category: "Province"},
+	"VU-TAE": {Code: "VU-TAE", Country: iso3166.VU, Name: "Taféa", Category: "Province"},
+	"VU-TOB": {Code: "VU-TOB", Country: iso3166.VU, Name: "Torba", Category: "Province"},
+	"WF-AL":  {Code: "WF-AL", Country: iso3166.WF, Name: "Alo", Category: "Administrative precinct"},
+	"WF-SG":  {Code: "WF-SG", Country: iso3166.WF, Name: "Sigave", Category: "Administrative precinct"},
+	"WF-UV":  {Code: "WF-UV", Country: iso3166.WF, Name: "Uvea", Category: "Administrative precinct"},
+	"WS-AA":  {Code: "WS-AA", Country: iso3166.WS, Name: "A'ana", Category: "District"},
+	"WS-AL":  {Code: "WS-AL", Country: iso3166.WS, Name: "Aiga-i-le-Tai", Category: "District"},
+	"WS-AT":  {Code: "WS-AT", Country: iso3166.WS, Name: "Atua", Category: "District"},
+	"WS-FA":  {Code: "WS-FA", Country: iso3166.WS, Name: "Fa'asaleleaga", Category: "District"},
+	"WS-GE":  {Code: "WS-GE", Country: iso3166.WS, Name: "Gaga'emauga", Category: "District"},
+	"WS-GI":  {Code: "WS-GI", Country: iso3166.WS, Name: "Gagaifomauga", Category: "District"},
+	"WS-PA":  {Code: "WS-PA", Country: iso3166.WS, Name: "Palauli", Category: "District"},
+	"WS-SA":  {Code: "WS-SA", Country: iso3166.WS, Name: "Satupa'itea", Category: "District"},
+	"WS-TU":  {Code: "WS-TU", Country: iso3166.WS, Name: "Tuamasaga", Category: "District"},
+	"WS-VF":  {Code: "WS-VF", Country: iso3166.WS, Name: "Va'a-o-Fonoti", Category: "District"},
+	"WS-VS":  {Code: "WS-VS", Country: iso3166.WS, Name: "Vaisigano", Category: "District"},
+	"YE-AB":  {Code: "YE-AB", Country: iso3166.YE, Name: "Abyan", Category: "Governorate"},
+	"YE-AD":  {Code: "YE-AD", Country: iso3166.YE, Name: "‘Adan", Category: "Governorate"},
+	"YE-AM":  {Code: "YE-AM", Country: iso3166.YE, Name: "‘Amrān", Category: "Governorate"},
+	"YE-BA":  {Code: "YE-BA", Country: iso3166.YE, Name: "Al Bayḑā’", Category: "Governorate"},
+	"YE-DA":  {Code: "YE-DA", Country: iso3166.YE, Name: "Aḑ Ḑāli‘", Category: "Governorate"},
+	"YE-DH":  {Code: "YE-DH", Country: iso3166.YE, Name: "Dhamār", Category: "Governorate"},
+	"YE-HD":  {Code: "YE-HD", Country: iso3166.YE, Name: "Ḩaḑramawt", Category: "Governorate"},
+	"YE-HJ":  {Code: "YE-HJ", Country: iso3166.YE, Name: "Ḩajjah", Category: "Governorate"},
+	"YE-HU":  {Code: "YE-HU", Country: iso3166.YE, Name: "Al Ḩudaydah", Category: "Governorate"},
+	"YE-IB":  {Code: "YE-IB", Country: iso3166.YE, Name: "Ibb", Category: "Governorate"},
+	"YE-JA":  {Code: "YE-JA", Country: iso3166.YE, Name: "Al Jawf", Category: "Governorate"},
+	"YE-LA":  {Code: "YE-LA", Country: iso3166.YE, Name: "Laḩij", Category: "Governorate"},
+	"YE-MA":  {Code: "YE-MA", Country: iso3166.YE, Name: "Ma’rib", Category: "Governorate"},
+	"YE-MR":  {Code: "YE-MR", Country: iso3166.YE, Name: "Al Mahrah", Category: "Governorate"},
+	"YE-MW":  {Code: "YE-MW", Country: iso3166.YE, Name: "Al Maḩwīt", Category: "Governorate"},
+	"YE-RA":  {Code: "YE-RA", Country: iso3166.YE, Name: "Raymah", Category: "Governorate"},
+	"YE-SA":  {Code: "YE-SA", Country: iso3166.YE, Name: "Amānat al ‘Āşimah [city]", Category: "Municipality"},
+	"YE-SD":  {Code: "YE-SD", Country: iso3166.YE, Name: "Şāʻdah", Category: "Governorate"},
+	"YE-SH":  {Code: "YE-SH", Country: iso3166.YE, Name: "Shabwah", Category: "Governorate"},
+	"YE-SN":  {Code: "YE-SN", Country: iso3166.YE, Name: "Şanʻā’", Category: "Governorate"},
+	"YE-SU":  {Code: "YE-SU", Country: iso3166.YE, Name: "Arkhabīl Suquţrá", Category: "Governorate"},
+	"YE-TA":  {Code: "YE-TA", Country: iso3166.YE, Name: "Tāʻizz", Category: "Governorate"},
+	"ZA-EC":  {Code: "ZA-EC", Country: iso3166.ZA, Name: "Eastern Cape", Category: "Province"},
+	"ZA-FS":  {Code: "ZA-FS", Country: iso3166.ZA, Name: "Free State", Category: "Province"},
+	"ZA-GP":  {Code: "ZA-GP", Country: iso3166.ZA, Name: "Gauteng", Category: "Province"},
+	"ZA-KZN": {Code: "ZA-KZN", Country: iso3166.ZA, Name: "Kwazulu-Natal", Category: "Province"},
+	"ZA-LP":  {Code: "ZA-LP", Country: iso3166.ZA, Name: "Limpopo", Category: "Province"},
+	"ZA-MP":  {Code: "ZA-MP", Country: iso3166.ZA, Name: "Mpumalanga", Category: "Province"},
+	"ZA-NC":  {Code: "ZA-NC", Country: iso3166.ZA, Name: "Northern Cape", Category: "Province"},
+	"ZA-NW":  {Code: "ZA-NW", Country: iso3166.ZA, Name: "North-West", Category: "Province"},
+	"ZA-WC":  {Code: "ZA-WC", Country: iso3166.ZA, Name: "Western Cape", Category: "Province"},
+	"ZM-01":  {Code: "ZM-01", Country: iso3166.ZM, Name: "Western", Category: "Province"},
+	"ZM-02":  {Code: "ZM-02", Country: iso3166.ZM, Name: "Central", Category: "Province"},
+	"ZM-03":  {Code: "ZM-03", Country: iso3166.ZM, Name: "Eastern", Category: "Province"},
+	"ZM-04":  {Code: "ZM-04", Country: iso3166.ZM, Name: "Luapula", Category: "Province"},
+	"ZM-05":  {Code: "ZM-05", Country: iso3166.ZM, Name: "Northern", Category: "Province"},
+	"ZM-06":  {Code: "ZM-06", Country: iso3166.ZM, Name: "North-Western", Category: "Province"},
+	"ZM-07":  {Code: "ZM-07", Country: iso3166.ZM, Name: "Southern", Category: "Province"},
+	"ZM-08":  {Code: "ZM-08", Country: iso3166.ZM, Name: "Copperbelt", Category: "Province"},
+	"ZM-09":  {Code: "ZM-09", Country: iso3166.ZM, Name: "Lusaka", Category: "Province"},
+	"ZM-10":  {Code: "ZM-10", Country: iso3166.ZM, Name: "Muchinga", Category: "Province"},
+	"ZW-BU":  {Code: "ZW-BU", Country: iso3166.ZW, Name: "Bulawayo", Category: "Province"},
+	"ZW-HA":  {Code: "ZW-HA", Country: iso3166.ZW, Name: "Harare", Category: "Province"},
+	"ZW-MA":  {Code: "ZW-MA", Country: iso3166.ZW, Name: "Manicaland", Category: "Province"},
+	"ZW-MC":  {Code: "ZW-MC", Country: iso3166.ZW, Name: "Mashonaland Central", Category: "Province"},
+	"ZW-ME":  {Code: "ZW-ME", Country: iso3166.ZW, Name: "Mashonaland East", Category: "Province"},
+	"ZW-MI":  {Code: "ZW-MI", Country: iso3166.ZW, Name: "Midlands", Category: "Province"},
+	"ZW-MN":  {Code: "ZW-MN", Country: iso3166.ZW, Name: "Matabeleland North", Category: "Province"},
+	"ZW-MS":  {Code: "ZW-MS", Country: iso3166.ZW, Name: "Matabeleland South", Category: "Province"},
+	"ZW-MV":  {Code: "ZW-MV", Country: iso3166.ZW, Name: "Masvingo", Category: "Province"},
+	"ZW-MW":  {Code: "ZW-MW", Country: iso3166.ZW, Name: "Mashonaland West", Category: "Province"},
+}
@@ -0,0 +1,181 @@
+// Command bankcode generates the directory of German bank codes
+// (Bankleitzahlen) used by package de/bankcode.
+//
+// It reads the Bankleitzahlendatei published by the Deutsche Bundesbank in its
+// unpacked, fixed-width text format, named blz.txt.
+//
+// Download the file at
+// https://www.bundesbank.de/de/aufgaben/unbarer-zahlungsverkehr/serviceangebot/bankleitzahlen/download-bankleitzahlen-602592
+// to use this program.
+//
+// If blz.txt is missing, the excerpt of it in blz_excerpt.txt is used instead.
+// The generated directory is then marked as incomplete, so that package
+// de/bankcode doesn't report bank codes missing from the excerpt as unknown.
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"sort"
+	"strings"
+)
+
+// field is a field of a record of the Bankleitzahlendatei, given as 0-based,
+// half-open range.
+type field struct{ start, end int }
+
+// record layout of the Bankleitzahlendatei
+var (
+	blzField         = field{0, 8}
+	featureField     = field{8, 9} // 1 for the bank itself, 2 for branches
+	nameField        = field{9, 67}
+	postalCodeField  = field{67, 72}
+	cityField        = field{72, 107}
+	shortNameField   = field{107, 134}
+	panField         = field{134, 139}
+	bicField         = field{139, 150}
+	methodField      = field{150, 152}
+	deletionField    = field{159, 160}
+	successorField   = field{160, 168}
+	recordLen        = 174 // the last six bytes are the unused IBAN rule
+	noSuccessorValue = "00000000"
+)
+
+type bank struct {
+	Code        string
+	Name        string
+	PostalCode  string
+	City        string
+	ShortName   string
+	PAN         string
+	BIC         string
+	CheckMethod string
+	Deleted     bool
+	Successor   string
+}
+
+func main() {
+	if err := run(); err != nil {
+		panic(err)
+	}
+}
+
+func run() error {
+	complete := true
+
+	in, err := os.Open("blz.txt")
+	if errors.Is(err, fs.ErrNotExist) {
+		fmt.Fprintln(os.Stderr, "blz.txt not found, using blz_excerpt.txt")
+
+		complete = false
+		in, err = os.Open("blz_excerpt.txt")
+	}
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	banks := make(map[string]bank)
+
+	s := bufio.NewScanner(in)
+	for line := 1; s.Scan(); line++ {
+		record := s.Bytes()
+		if len(record) == 0 {
+			continue
+		} else if len(record) != recordLen {
+			return fmt.Errorf("line %d: expected record to be %d bytes long, but is %d", line, recordLen, len(record))
+		}
+
+		b := bank{
+			Code:        get(record, blzField),
+			Name:        get(record, nameField),
+			PostalCode:  get(record, postalCodeField),
+			City:        get(record, cityField),
+			ShortName:   get(record, shortNameField),
+			PAN:         get(record, panField),
+			BIC:         get(record, bicField),
+			CheckMethod: get(record, methodField),
+			Deleted:     get(record, deletionField) == "1",
+			Successor:   get(record, successorField),
+		}
+		if b.Successor == noSuccessorValue {
+			b.Successor = ""
+		}
+
+		// every branch of a bank has its own record, however, they all share
+		// the same method
+		if existing, ok := banks[b.Code]; ok {
+			if existing.CheckMethod != b.CheckMethod {
+				return fmt.Errorf("line %d: bank code %s uses both method %s and %s",
+					line, b.Code, existing.CheckMethod, b.CheckMethod)
+			}
+
+			// only the record of the bank itself is of interest
+			if get(record, featureField) != "1" {
+				continue
+			}
+		}
+
+		banks[b.Code] = b
+	}
+	if err := s.Err(); err != nil {
+		return err
+	}
+
+	codes := make([]string, 0, len(banks))
+	for code := range banks {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	out, err := os.Create("banks.go")
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	fmt.Fprintln(out, "package", os.Getenv("GOPACKAGE"))
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "// Code generated by tools/codegen/bankcode. DO NOT EDIT.")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "// complete indicates whether banks contains the full Bankleitzahlendatei, and")
+	fmt.Fprintln(out, "// not just an excerpt of it.")
+	fmt.Fprintf(out, "var complete = %t\n", complete)
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "var banks = map[BankCode]Bank{")
+	for _, code := range codes {
+		b := banks[code]
+
+		fmt.Fprintf(out, "\t%q: {\n", b.Code)
+		fmt.Fprintf(out, "\t\tCode:        %q,\n", b.Code)
+		fmt.Fprintf(out, "\t\tName:        %q,\n", b.Name)
+		fmt.Fprintf(out, "\t\tPostalCode:  %q,\n", b.PostalCode)
+		fmt.Fprintf(out, "\t\tCity:        %q,\n", b.City)
+		fmt.Fprintf(out, "\t\tShortName:   %q,\n", b.ShortName)
+		fmt.Fprintf(out, "\t\tPAN:         %q,\n", b.PAN)
+		fmt.Fprintf(out, "\t\tBIC:         %q,\n", b.BIC)
+		fmt.Fprintf(out, "\t\tCheckMethod: %q,\n", b.CheckMethod)
+		fmt.Fprintf(out, "\t\tDeleted:     %t,\n", b.Deleted)
+		fmt.Fprintf(out, "\t\tSuccessor:   %q,\n", b.Successor)
+		fmt.Fprintf(out, "\t},\n")
+	}
+	fmt.Fprintln(out, "}")
+
+	return nil
+}
+
+// get returns the trimmed value of the passed field.
+//
+// The Bankleitzahlendatei is encoded in ISO 8859-1, whose code points map
+// directly to the first 256 Unicode code points.
+func get(record []byte, f field) string {
+	var sb strings.Builder
+	for _, b := range record[f.start:f.end] {
+		sb.WriteRune(rune(b))
+	}
+
+	return strings.TrimSpace(sb.String())
+}
@@ -0,0 +1,164 @@
+// Command bic_bank_codes generates the national bank code to BIC directories
+// used by package bic to derive the BIC of an IBAN.
+//
+// It reads the bank lists published by the central banks and clearing houses
+// of AT, BE, CH, and NL, see package banklist for the supported formats and
+// file names.
+// Download the lists from the URLs listed in banklist.Sources, and save them
+// as CSV files to use this program.
+//
+// For countries whose list is missing, the ranges in bank_codes.csv are used
+// instead.
+// As bank_codes.csv is only an excerpt, package bic doesn't report bank codes
+// of these countries missing from it as unknown.
+// It contains one range of bank codes per line in the format
+//
+//	country;first bank code;last bank code;bic
+//
+// Lines starting with '#' are ignored.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/mavolin/standards/iso3166"
+	"github.com/mavolin/standards/tools/codegen/internal/banklist"
+)
+
+type bankCodeRange struct {
+	first, last string
+	bic         string
+}
+
+func main() {
+	if err := run(); err != nil {
+		panic(err)
+	}
+}
+
+func run() error {
+	in, err := os.Open("bank_codes.csv")
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	ranges := make(map[iso3166.Alpha2Code][]bankCodeRange)
+
+	s := bufio.NewScanner(in)
+	for line := 1; s.Scan(); line++ {
+		text := strings.TrimSpace(s.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields := strings.Split(text, ";")
+		if len(fields) != 4 {
+			return fmt.Errorf("line %d: expected 4 fields, but got %d", line, len(fields))
+		}
+
+		country, err := iso3166.ParseAlpha2(fields[0])
+		if err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+
+		r := bankCodeRange{first: fields[1], last: fields[2], bic: fields[3]}
+		if len(r.first) != len(r.last) || r.first > r.last {
+			return fmt.Errorf("line %d: invalid range %s-%s", line, r.first, r.last)
+		} else if len(r.bic) != 11 {
+			return fmt.Errorf("line %d: BIC %s must be given in its 11-character form", line, r.bic)
+		}
+
+		ranges[country] = append(ranges[country], r)
+	}
+	if err := s.Err(); err != nil {
+		return err
+	}
+
+	var complete []iso3166.Alpha2Code
+
+	for _, src := range banklist.Sources {
+		if src.First == nil {
+			continue
+		}
+
+		records, err := banklist.Read(src)
+		if errors.Is(err, fs.ErrNotExist) {
+			fmt.Fprintf(os.Stderr, "%s: %s not found, using the ranges in bank_codes.csv\n", src.Country, src.File)
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		country, err := iso3166.ParseAlpha2(src.Country)
+		if err != nil {
+			return err
+		}
+
+		rs := make([]bankCodeRange, len(records))
+		for i, r := range records {
+			rs[i] = bankCodeRange{first: r.First, last: r.Last, bic: r.BIC}
+		}
+		ranges[country] = rs
+		complete = append(complete, country)
+	}
+
+	countries := make([]iso3166.Alpha2Code, 0, len(ranges))
+	for country, rs := range ranges {
+		countries = append(countries, country)
+		sort.Slice(rs, func(i, j int) bool { return rs[i].first < rs[j].first })
+
+		for i := 1; i < len(rs); i++ {
+			if rs[i-1].last >= rs[i].first {
+				return fmt.Errorf("%s: ranges %s-%s and %s-%s overlap",
+					country.Code, rs[i-1].first, rs[i-1].last, rs[i].first, rs[i].last)
+			}
+		}
+	}
+	sort.Slice(countries, func(i, j int) bool { return countries[i].Code < countries[j].Code })
+
+	// if no list was found, completeBankCodeCountries is empty, so let gofmt
+	// decide how to format it
+	out := new(bytes.Buffer)
+
+	fmt.Fprintln(out, "package", os.Getenv("GOPACKAGE"))
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "import \"github.com/mavolin/standards/iso3166\"")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "// Code generated by tools/codegen/bic_bank_codes. DO NOT EDIT.")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "// completeBankCodeCountries are the countries all of whose bank codes are")
+	fmt.Fprintln(out, "// listed in bankCodeRanges.")
+	fmt.Fprintln(out, "// For all other countries, bankCodeRanges contains at most an excerpt.")
+	fmt.Fprintln(out, "var completeBankCodeCountries = map[iso3166.Alpha2Code]struct{}{")
+	for _, country := range complete {
+		fmt.Fprintf(out, "\tiso3166.%s: {},\n", country.Code)
+	}
+	fmt.Fprintln(out, "}")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "var bankCodeRanges = map[iso3166.Alpha2Code][]bankCodeRange{")
+	for _, country := range countries {
+		fmt.Fprintf(out, "\tiso3166.%s: {\n", country.Code)
+		for _, r := range ranges[country] {
+			fmt.Fprintf(out, "\t\t{First: %q, Last: %q, BIC: BIC{%q, %q, %q, %q}},\n",
+				r.first, r.last, r.bic[:4], r.bic[4:6], r.bic[6:8], r.bic[8:])
+		}
+		fmt.Fprintln(out, "\t},")
+	}
+	fmt.Fprintln(out, "}")
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile("bank_codes.go", src, 0o644)
+}
@@ -0,0 +1,177 @@
+// Command bic_directory generates the BIC directory embedded in package bic.
+//
+// It reads the bank lists published by the central banks and clearing houses
+// of AT, BE, CH, DE, and NL, see package banklist for the supported formats
+// and file names.
+// Download the lists from the URLs listed in banklist.Sources, and save them
+// as CSV files to use this program.
+// All BICs in the lists are considered active.
+//
+// Additionally, it reads the file directory.csv, which contains one BIC per
+// line in the format
+//
+//	bic;institution;city;active
+//
+// where bic is the 11-character form of the BIC, and active is either 1 or 0.
+// Lines starting with '#' are ignored.
+// For countries whose list is missing, all BICs in directory.csv are used.
+// As directory.csv is only an excerpt, package bic doesn't report BICs of these
+// countries missing from it as unknown.
+// For all other countries, only the inactive BICs in directory.csv are used,
+// as deleted or expired BICs are not part of the published lists.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/mavolin/standards/iso3166"
+	"github.com/mavolin/standards/tools/codegen/internal/banklist"
+)
+
+type entry struct {
+	bic    string
+	name   string
+	city   string
+	active bool
+}
+
+func main() {
+	if err := run(); err != nil {
+		panic(err)
+	}
+}
+
+func run() error {
+	in, err := os.Open("directory.csv")
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	entries := make(map[string]entry)
+
+	s := bufio.NewScanner(in)
+	for line := 1; s.Scan(); line++ {
+		text := strings.TrimSpace(s.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields := strings.Split(text, ";")
+		if len(fields) != 4 {
+			return fmt.Errorf("line %d: expected 4 fields, but got %d", line, len(fields))
+		}
+
+		e := entry{bic: fields[0], name: fields[1], city: fields[2], active: fields[3] == "1"}
+		if len(e.bic) != 11 {
+			return fmt.Errorf("line %d: BIC %s must be given in its 11-character form", line, e.bic)
+		} else if fields[3] != "0" && fields[3] != "1" {
+			return fmt.Errorf("line %d: active must be 0 or 1, but is %s", line, fields[3])
+		}
+
+		if _, err := iso3166.ParseAlpha2(e.bic[4:6]); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+
+		if _, ok := entries[e.bic]; ok {
+			return fmt.Errorf("line %d: duplicate BIC %s", line, e.bic)
+		}
+
+		entries[e.bic] = e
+	}
+	if err := s.Err(); err != nil {
+		return err
+	}
+
+	var complete []string
+
+	for _, src := range banklist.Sources {
+		listed, err := banklist.ReadBICs(src)
+		if errors.Is(err, fs.ErrNotExist) {
+			fmt.Fprintf(os.Stderr, "%s: %s not found, using the BICs in directory.csv\n", src.Country, src.File)
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		listedBICs := make(map[string]struct{}, len(listed))
+		for _, b := range listed {
+			// some lists also contain institutions of neighboring countries,
+			// e.g. the Swiss list those of Liechtenstein
+			if b.BIC[4:6] != src.Country {
+				continue
+			}
+
+			listedBICs[b.BIC] = struct{}{}
+
+			// not all lists contain cities, so keep the one from
+			// directory.csv, if there is one
+			e := entry{bic: b.BIC, name: b.Name, city: b.City, active: true}
+			if e.city == "" {
+				e.city = entries[b.BIC].city
+			}
+
+			entries[b.BIC] = e
+		}
+
+		for bic, e := range entries {
+			if _, ok := listedBICs[bic]; !ok && e.active && bic[4:6] == src.Country {
+				delete(entries, bic)
+			}
+		}
+
+		complete = append(complete, src.Country)
+	}
+	sort.Strings(complete)
+
+	bics := make([]string, 0, len(entries))
+	for bic := range entries {
+		bics = append(bics, bic)
+	}
+	sort.Strings(bics)
+
+	// if no list was found, completeCountries is empty, so let gofmt decide
+	// how to format it
+	out := new(bytes.Buffer)
+
+	fmt.Fprintln(out, "package", os.Getenv("GOPACKAGE"))
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "// Code generated by tools/codegen/bic_directory. DO NOT EDIT.")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "// completeCountries are the countries all of whose BICs are listed in")
+	fmt.Fprintln(out, "// directory.")
+	fmt.Fprintln(out, "// For all other countries, directory contains at most an excerpt.")
+	fmt.Fprintln(out, "var completeCountries = map[string]struct{}{")
+	for _, country := range complete {
+		fmt.Fprintf(out, "\t%q: {},\n", country)
+	}
+	fmt.Fprintln(out, "}")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "var directory = map[BIC]Entry{")
+	for _, bic := range bics {
+		e := entries[bic]
+
+		fmt.Fprintf(out, "\t{%q, %q, %q, %q}: {\n", bic[:4], bic[4:6], bic[6:8], bic[8:])
+		fmt.Fprintf(out, "\t\tBIC:    BIC{%q, %q, %q, %q},\n", bic[:4], bic[4:6], bic[6:8], bic[8:])
+		fmt.Fprintf(out, "\t\tName:   %q,\n", e.name)
+		fmt.Fprintf(out, "\t\tCity:   %q,\n", e.city)
+		fmt.Fprintf(out, "\t\tActive: %t,\n", e.active)
+		fmt.Fprintf(out, "\t},\n")
+	}
+	fmt.Fprintln(out, "}")
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile("directory.go", src, 0o644)
+}
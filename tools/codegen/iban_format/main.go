@@ -0,0 +1,405 @@
+// Command iban_format generates the [iban.Format] of each country that uses
+// IBANs.
+//
+// The BBAN formats are read from the "IBAN formats by country" table of the
+// English Wikipedia article on IBANs, which must be saved as in.html.
+// The table needs to be marked with id="IBAN_formats_by_country_table".
+//
+// The example IBANs are read from examples.txt, which contains one IBAN per
+// line, and are taken from the IBAN Registry published by SWIFT.
+// Besides being part of the formats, they are written to examples_test.go,
+// where they serve as regression tests.
+//
+// Whether a country is part of SEPA is taken from the EPC List of SEPA Scheme
+// Countries, see sepaCountries.
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/mavolin/standards/iso3166"
+)
+
+// https://www.europeanpaymentscouncil.eu/document-library/other/epc-list-sepa-scheme-countries
+// EPC409-09, version 5.0
+//
+// Only lists the countries with their own IBAN country code, territories
+// using the IBANs of another country (e.g. the French overseas departments)
+// are covered by that country.
+var sepaCountries = map[string]bool{
+	// EU
+	"AT": true, "BE": true, "BG": true, "HR": true, "CY": true, "CZ": true,
+	"DK": true, "EE": true, "FI": true, "FR": true, "DE": true, "GR": true,
+	"HU": true, "IE": true, "IT": true, "LV": true, "LT": true, "LU": true,
+	"MT": true, "NL": true, "PL": true, "PT": true, "RO": true, "SK": true,
+	"SI": true, "ES": true, "SE": true,
+	// EEA
+	"IS": true, "LI": true, "NO": true,
+	// others
+	"AD": true, "AL": true, "CH": true, "GB": true, "GI": true, "MC": true,
+	"MD": true, "ME": true, "MK": true, "SM": true, "VA": true,
+}
+
+type field struct {
+	verb   byte
+	class  byte
+	length int
+}
+
+type format struct {
+	country iso3166.Alpha2Code
+	length  int
+	fields  []field
+	example string
+}
+
+func main() {
+	if err := run(); err != nil {
+		panic(err)
+	}
+}
+
+func run() error {
+	in, err := os.Open("in.html")
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	n, err := html.Parse(in)
+	if err != nil {
+		return err
+	}
+
+	var rawRules map[iso3166.Alpha2Code][2]string
+
+	walk(n, func(n *html.Node) (dive, stop bool) {
+		if n.DataAtom != atom.Table {
+			return true, false
+		}
+
+		for _, attr := range n.Attr {
+			if attr.Key != "id" {
+				continue
+			} else if attr.Val != "IBAN_formats_by_country_table" {
+				continue
+			}
+
+			// we found our table
+
+			tbody := n.FirstChild
+			for tbody != nil {
+				if tbody.DataAtom == atom.Tbody {
+					break
+				}
+				tbody = tbody.NextSibling
+			}
+
+			rawRules, err = extractRawRulesFromTable(tbody)
+			return false, true
+		}
+
+		return true, false
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(rawRules) == 0 {
+		return errors.New("could not find table")
+	}
+
+	formats, err := genFormats(rawRules)
+	if err != nil {
+		return err
+	}
+
+	if err := addExamples(formats); err != nil {
+		return err
+	}
+
+	countries := make([]iso3166.Alpha2Code, 0, len(formats))
+	for country := range formats {
+		countries = append(countries, country)
+	}
+	sort.Slice(countries, func(i, j int) bool { return countries[i].Code < countries[j].Code })
+
+	if err := writeFormats(countries, formats); err != nil {
+		return err
+	}
+
+	return writeExamplesTest(countries, formats)
+}
+
+func writeFormats(countries []iso3166.Alpha2Code, formats map[iso3166.Alpha2Code]*format) error {
+	f, err := os.Create("formats.go")
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	fmt.Fprintln(f, "package", os.Getenv("GOPACKAGE"))
+	fmt.Fprintln(f)
+	fmt.Fprintln(f, "import \"github.com/mavolin/standards/iso3166\"")
+	fmt.Fprintln(f)
+	fmt.Fprintln(f, "// Code generated by tools/codegen/iban_format. DO NOT EDIT.")
+	fmt.Fprintln(f)
+	fmt.Fprintln(f, "var formats = map[iso3166.Alpha2Code]Format{")
+
+	for _, country := range countries {
+		format := formats[country]
+
+		fmt.Fprintf(f, "\tiso3166.%s: {\n", country.Code)
+		fmt.Fprintf(f, "\t\tCountry: iso3166.%s,\n", country.Code)
+		fmt.Fprintf(f, "\t\tLength:  %d,\n", format.length)
+		fmt.Fprintf(f, "\t\tFields: []FieldSpec{\n")
+		for _, field := range format.fields {
+			name := verbToName[field.verb]
+			if name == "" {
+				name = `""`
+			}
+
+			fmt.Fprintf(f, "\t\t\t{Name: %s, Class: %s, Length: %d},\n", name, classToName[field.class], field.length)
+		}
+		fmt.Fprintf(f, "\t\t},\n")
+		fmt.Fprintf(f, "\t\tSEPA:    %t,\n", sepaCountries[country.Code])
+		fmt.Fprintf(f, "\t\tExample: %q,\n", format.example)
+		fmt.Fprintf(f, "\t},\n")
+	}
+
+	fmt.Fprintln(f, "}")
+
+	return nil
+}
+
+// writeExamplesTest writes the example IBANs to examples_test.go, where they
+// are used as regression tests for Parse.
+func writeExamplesTest(countries []iso3166.Alpha2Code, formats map[iso3166.Alpha2Code]*format) error {
+	f, err := os.Create("examples_test.go")
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	fmt.Fprintln(f, "package", os.Getenv("GOPACKAGE"))
+	fmt.Fprintln(f)
+	fmt.Fprintln(f, "import \"github.com/mavolin/standards/iso3166\"")
+	fmt.Fprintln(f)
+	fmt.Fprintln(f, "// Code generated by tools/codegen/iban_format. DO NOT EDIT.")
+	fmt.Fprintln(f)
+	fmt.Fprintln(f, "var examples = []struct {")
+	fmt.Fprintln(f, "\tCountry iso3166.Alpha2Code")
+	fmt.Fprintln(f, "\tIBAN    string")
+	fmt.Fprintln(f, "}{")
+
+	for _, country := range countries {
+		if example := formats[country].example; example != "" {
+			fmt.Fprintf(f, "\t{Country: iso3166.%s, IBAN: %q},\n", country.Code, example)
+		}
+	}
+
+	fmt.Fprintln(f, "}")
+
+	return nil
+}
+
+func extractRawRulesFromTable(tbody *html.Node) (map[iso3166.Alpha2Code][2]string, error) {
+	rawRules := make(map[iso3166.Alpha2Code][2]string)
+
+	tr := nextElement(tbody.FirstChild)
+
+	for tr != nil {
+		var rawCountryCode string
+
+		countryEl := nextElement(tr.FirstChild)
+		charsEl := nextElement(countryEl.NextSibling)
+		charClassesEl := nextElement(charsEl.NextSibling)
+		formatEl := nextElement(charClassesEl.NextSibling)
+
+		charClasses := charClassesEl.FirstChild.Data
+		charClasses = strings.ReplaceAll(charClasses, " ", "")
+		charClasses = strings.ReplaceAll(charClasses, "\n", "")
+
+		var formatBuilder strings.Builder
+
+		codeEl := formatEl.FirstChild
+
+		countryCodeEl := codeEl.FirstChild
+		rawCountryCode = countryCodeEl.FirstChild.FirstChild.Data
+
+		checkDigitsEl := nextElement(countryCodeEl.NextSibling)
+
+		bbanEl := checkDigitsEl.NextSibling
+		for bbanEl != nil {
+			switch bbanEl.Type {
+			case html.TextNode:
+				formatBuilder.WriteString(bbanEl.Data)
+			case html.ElementNode:
+				formatBuilder.WriteString(bbanEl.FirstChild.Data)
+			}
+
+			bbanEl = bbanEl.NextSibling
+		}
+
+		countryCode, err := iso3166.ParseAlpha2(rawCountryCode)
+		if err != nil {
+			return nil, err
+		}
+
+		format := strings.ReplaceAll(formatBuilder.String(), " ", "")
+		format = strings.ReplaceAll(format, "\n", "")
+		rawRules[countryCode] = [2]string{charClasses, format}
+
+		tr = nextElement(tr.NextSibling)
+	}
+
+	return rawRules, nil
+}
+
+func walk(n *html.Node, f func(*html.Node) (dive, stop bool)) (stop bool) {
+	for n != nil {
+		dive, stop := f(n)
+		if stop {
+			return true
+		}
+		if dive {
+			stop = walk(n.FirstChild, f)
+			if stop {
+				return stop
+			}
+		}
+
+		n = n.NextSibling
+	}
+
+	return false
+}
+
+func nextElement(n *html.Node) *html.Node {
+	for n != nil {
+		if n.Type == html.ElementNode {
+			return n
+		}
+
+		n = n.NextSibling
+	}
+
+	return nil
+}
+
+func genFormats(rawRules map[iso3166.Alpha2Code][2]string) (map[iso3166.Alpha2Code]*format, error) {
+	formats := make(map[iso3166.Alpha2Code]*format, len(rawRules))
+
+	for country, rule := range rawRules {
+		rawLengths := strings.Split(rule[0], ",")
+		verbs := rule[1]
+
+		// the char class of each character in the bban
+		classes := make([]byte, 0, len(verbs))
+
+		for _, rawLength := range rawLengths {
+			class := rawLength[len(rawLength)-1]
+			if classToName[class] == "" {
+				return nil, fmt.Errorf("%s: unknown char class %c", country.Code, class)
+			}
+
+			length, err := strconv.Atoi(rawLength[:len(rawLength)-1])
+			if err != nil {
+				return nil, err
+			}
+
+			for i := 0; i < length; i++ {
+				classes = append(classes, class)
+			}
+		}
+
+		if len(classes) != len(verbs) {
+			return nil, fmt.Errorf("%s: length of char classes and format do not match", country.Code)
+		}
+
+		f := &format{country: country, length: 4 + len(verbs)}
+
+		for i := 0; i < len(verbs); i++ {
+			verb := verbs[i]
+			if _, ok := verbToName[verb]; !ok {
+				return nil, fmt.Errorf("%s: unknown field %c", country.Code, verb)
+			}
+
+			if len(f.fields) > 0 {
+				last := &f.fields[len(f.fields)-1]
+				if last.verb == verb && last.class == classes[i] {
+					last.length++
+					continue
+				}
+			}
+
+			f.fields = append(f.fields, field{verb: verb, class: classes[i], length: 1})
+		}
+
+		formats[country] = f
+	}
+
+	return formats, nil
+}
+
+func addExamples(formats map[iso3166.Alpha2Code]*format) error {
+	in, err := os.Open("examples.txt")
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	s := bufio.NewScanner(in)
+	for line := 1; s.Scan(); line++ {
+		example := strings.TrimSpace(s.Text())
+		if example == "" || strings.HasPrefix(example, "#") {
+			continue
+		}
+
+		country, err := iso3166.ParseAlpha2(example[:2])
+		if err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+
+		f, ok := formats[country]
+		if !ok {
+			return fmt.Errorf("line %d: no format for %s", line, country.Code)
+		} else if len(example) != f.length {
+			return fmt.Errorf("line %d: expected example to be %d characters long", line, f.length)
+		}
+
+		f.example = example
+	}
+
+	return s.Err()
+}
+
+var verbToName = map[byte]string{
+	'0': "",
+	'a': "BalanceAccountNumber",
+	'b': "BankCode",
+	'c': "AccountNumber",
+	'i': "OwnerIdentificationNumber",
+	'm': "CurrencyCode",
+	'n': "OwnerAccountNumber",
+	'p': "AccountNumberPrefix",
+	'q': "BankCode", // BIC bank code, used by IE
+	's': "BranchCode",
+	't': "AccountType",
+	'x': "NationalChecksum",
+}
+
+var classToName = map[byte]string{
+	'a': "Alpha",
+	'n': "Numeric",
+	'c': "Alphanumeric",
+}
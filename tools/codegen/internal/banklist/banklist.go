@@ -0,0 +1,338 @@
+// Package banklist reads the bank lists published by the central banks and
+// clearing houses of AT, BE, CH, DE, and NL.
+//
+// The lists are published in different formats, some of them only as Excel
+// workbooks.
+// Read and ReadBICs expect them as CSV files, as downloaded or as exported
+// from the workbooks, separated either by ';' or ','.
+// Columns are found by their header, and rows before the header, which some
+// lists use for a title or the date of the list, are skipped.
+package banklist
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Source is a bank list published by a country's central bank or clearing
+// house.
+type Source struct {
+	// Country is the ISO 3166-1 alpha-2 code of the country.
+	Country string
+	// Publisher and URL describe where the list is published.
+	Publisher, URL string
+	// File is the name of the CSV file the list is read from.
+	File string
+
+	// CodeLen is the length of the country's bank codes.
+	// Numeric bank codes that are shorter are padded with leading zeros.
+	CodeLen int
+
+	// First, Last, BIC, Name, and City are the possible headers of the
+	// columns holding the first and last bank code of a range, the BIC, and
+	// the name and city of the institution.
+	//
+	// Lists that assign a BIC to every single bank code have no Last column,
+	// and lists that only contain BICs have neither a First nor a Last
+	// column.
+	// Lists without a City column leave BIC.City empty.
+	First, Last, BIC, Name, City []string
+}
+
+// Sources are the bank lists of the countries supported by Read and
+// ReadBICs.
+var Sources = []Source{
+	{
+		Country:   "AT",
+		Publisher: "Oesterreichische Nationalbank, SEPA-Zahlungsverkehrs-Verzeichnis",
+		URL:       "https://www.oenb.at/Statistik/Klassifikationen/SEPA-Zahlungsverkehrs-Verzeichnis.html",
+		File:      "bank_list_at.csv",
+		CodeLen:   5,
+		First:     []string{"Bankleitzahl"},
+		BIC:       []string{"SWIFT-Code", "BIC"},
+		Name:      []string{"Bankenname"},
+		City:      []string{"Ort"},
+	},
+	{
+		Country:   "BE",
+		Publisher: "National Bank of Belgium, Identification codes of Belgian banks",
+		URL:       "https://www.nbb.be/en/payment-systems/payment-standards/bank-identification-codes",
+		File:      "bank_list_be.csv",
+		CodeLen:   3,
+		First:     []string{"From", "Van"},
+		Last:      []string{"To", "Tot"},
+		BIC:       []string{"Biccode", "BIC"},
+		Name:      []string{"T_Institutions_English", "T_Institutions_Dutch"},
+	},
+	{
+		Country:   "CH",
+		Publisher: "SIX Interbank Clearing, Bank Master",
+		URL:       "https://www.six-group.com/en/products-services/banking-services/master-data/download-bank-master.html",
+		File:      "bank_list_ch.csv",
+		CodeLen:   5,
+		First:     []string{"IID", "BC-Nr"},
+		BIC:       []string{"BIC", "SWIFT"},
+		Name:      []string{"Bank/Institution Name", "Bankname"},
+		City:      []string{"Place", "Ort"},
+	},
+	{
+		Country:   "DE",
+		Publisher: "Deutsche Bundesbank, SCL-Directory",
+		URL:       "https://www.bundesbank.de/de/aufgaben/unbarer-zahlungsverkehr/serviceangebot/sepa-clearer-des-emz/scl-directory-621898",
+		File:      "bank_list_de.csv",
+		BIC:       []string{"BIC"},
+		Name:      []string{"Name"},
+	},
+	{
+		Country:   "NL",
+		Publisher: "Betaalvereniging Nederland, BIC-lijst",
+		URL:       "https://www.betaalvereniging.nl/betalingsverkeer/giraal-betalingsverkeer/bic-sepa-transacties/",
+		File:      "bank_list_nl.csv",
+		CodeLen:   4,
+		First:     []string{"Identifier", "Bank Identifier"},
+		BIC:       []string{"BIC"},
+		Name:      []string{"Naam betaaldienstverlener", "Name"},
+	},
+}
+
+// Record is a range of bank codes listed with the same BIC.
+type Record struct {
+	// First and Last are the first and last bank code of the range, both
+	// inclusive.
+	First, Last string
+	// BIC is the 11-character form of the BIC.
+	BIC string
+}
+
+// BIC is a BIC listed in a bank list.
+type BIC struct {
+	// BIC is the 11-character form of the BIC.
+	BIC string
+	// Name and City are the name and city of the institution the BIC is
+	// assigned to.
+	Name, City string
+}
+
+// Read reads the bank code ranges of the passed source from src.File.
+//
+// Rows without a valid BIC, e.g. of banks that are not reachable via SWIFT,
+// are skipped.
+// If a bank code is listed more than once, e.g. once for every branch, only
+// the first row is used.
+//
+// If src.File does not exist, the returned error wraps fs.ErrNotExist.
+func Read(src Source) ([]Record, error) {
+	if src.First == nil {
+		return nil, fmt.Errorf("%s: list has no bank codes", src.File)
+	}
+
+	rows, err := readTable(src.File, [][]string{src.First, src.Last, src.BIC}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []Record
+	seen := make(map[string]struct{})
+
+	for _, row := range rows {
+		first, last, bic := row.fields[0], row.fields[1], normalizeBIC(row.fields[2])
+		if first == "" || bic == "" {
+			continue
+		}
+
+		if last == "" {
+			last = first
+		}
+
+		if first, err = src.pad(first); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", src.File, row.line, err)
+		} else if last, err = src.pad(last); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", src.File, row.line, err)
+		}
+
+		if _, ok := seen[first]; ok {
+			continue
+		}
+		seen[first] = struct{}{}
+
+		records = append(records, Record{First: first, Last: last, BIC: bic})
+	}
+
+	return records, nil
+}
+
+// ReadBICs reads the BICs of the passed source from src.File.
+//
+// Rows without a valid BIC are skipped.
+// If a BIC is listed more than once, e.g. once for every bank code it is
+// used for, only the first row is used.
+//
+// If src.File does not exist, the returned error wraps fs.ErrNotExist.
+func ReadBICs(src Source) ([]BIC, error) {
+	rows, err := readTable(src.File, [][]string{src.BIC}, [][]string{src.Name, src.City})
+	if err != nil {
+		return nil, err
+	}
+
+	var bics []BIC
+	seen := make(map[string]struct{})
+
+	for _, row := range rows {
+		bic := normalizeBIC(row.fields[0])
+		if bic == "" {
+			continue
+		}
+
+		if _, ok := seen[bic]; ok {
+			continue
+		}
+		seen[bic] = struct{}{}
+
+		bics = append(bics, BIC{BIC: bic, Name: row.fields[1], City: row.fields[2]})
+	}
+
+	return bics, nil
+}
+
+type row struct {
+	line   int
+	fields []string
+}
+
+// readTable reads the passed CSV file, and returns the fields of the passed
+// columns, followed by those of the optional columns, of every row after the
+// header.
+//
+// columns and optional are the possible headers of each column.
+// The header must contain all columns, except those whose headers are nil.
+// Fields of columns missing from the header are always empty.
+func readTable(file string, columns, optional [][]string) ([]row, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	data = bytes.TrimPrefix(data, []byte("\ufeff"))
+
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+	if bytes.Count(data, []byte(",")) > bytes.Count(data, []byte(";")) {
+		r.Comma = ','
+	} else {
+		r.Comma = ';'
+	}
+
+	var (
+		indexes []int
+		rows    []row
+	)
+
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+
+		if indexes == nil {
+			indexes = header(record, columns, optional)
+			continue
+		}
+
+		fields := make([]string, len(indexes))
+		for i, index := range indexes {
+			if index >= 0 && index < len(record) {
+				fields[i] = strings.TrimSpace(record[index])
+			}
+		}
+
+		line, _ := r.FieldPos(0)
+		rows = append(rows, row{line: line, fields: fields})
+	}
+
+	if indexes == nil {
+		return nil, fmt.Errorf("%s: found no header with the columns %q", file, columns)
+	}
+
+	return rows, nil
+}
+
+// header returns the indexes of the passed columns and optional columns in
+// the passed record, or nil, if record is not the header.
+//
+// The index of a column missing from the header is -1.
+func header(record []string, columns, optional [][]string) []int {
+	indexes := make([]int, 0, len(columns)+len(optional))
+
+	for _, names := range columns {
+		i := column(record, names)
+		if names != nil && i < 0 {
+			return nil
+		}
+
+		indexes = append(indexes, i)
+	}
+
+	for _, names := range optional {
+		indexes = append(indexes, column(record, names))
+	}
+
+	return indexes
+}
+
+// column returns the index of the first column of the passed header row
+// whose name is one of names, or -1, if there is none.
+func column(header []string, names []string) int {
+	for i, h := range header {
+		for _, name := range names {
+			if strings.EqualFold(strings.TrimSpace(h), name) {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
+
+// pad pads the passed numeric bank code with leading zeros to src.CodeLen.
+func (src Source) pad(code string) (string, error) {
+	if len(code) < src.CodeLen && strings.Trim(code, "0123456789") == "" {
+		code = strings.Repeat("0", src.CodeLen-len(code)) + code
+	}
+
+	if len(code) != src.CodeLen {
+		return "", fmt.Errorf("bank code %s is not %d characters long", code, src.CodeLen)
+	}
+
+	return strings.ToUpper(code), nil
+}
+
+// normalizeBIC returns the 11-character form of the passed BIC.
+//
+// If bic is not a BIC, e.g. because the list uses a placeholder such as
+// "NAV" or "-" for banks without a BIC, normalizeBIC returns "".
+func normalizeBIC(bic string) string {
+	bic = strings.ToUpper(strings.ReplaceAll(bic, " ", ""))
+	if len(bic) == 8 {
+		bic += "XXX"
+	}
+
+	if len(bic) != 11 {
+		return ""
+	}
+
+	for _, r := range bic {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return ""
+		}
+	}
+
+	return bic
+}
@@ -1,5 +1,5 @@
 // Command iso3166-1 generates [iso3166.Alpha2Code] structs for all iso3166-1
-// country codes.
+// country codes, and the alpha-3 and numeric codes corresponding to them.
 //
 // The current implementation decodes HTML downloaded (the iso site client-side
 // renders their page) by the user from the official iso.org site containing a
@@ -10,15 +10,27 @@
 //
 // Download the HTML at https://www.iso.org/obp/ui/#iso:pub:PUB500001:en to
 // use this program.
+//
+// The decoding table only contains alpha-2 codes.
+// The alpha-3 and numeric codes are taken from the CLDR data of
+// golang.org/x/text/language, which also contains codes not assigned by ISO.
+// Therefore, only codes of officially assigned, exceptionally reserved, and
+// transitionally reserved alpha-2 codes are used, and codes from the
+// user-assigned ranges are omitted.
+// Numeric codes of reserved alpha-2 codes are omitted, if they are also used
+// by an officially assigned code, e.g. 104 of BU (Burma), which is used by MM
+// (Myanmar).
 package main
 
 import (
 	"errors"
 	"fmt"
 	"os"
+	"sort"
 
 	"golang.org/x/net/html"
 	"golang.org/x/net/html/atom"
+	"golang.org/x/text/language"
 
 	"github.com/mavolin/standards/iso3166"
 )
@@ -74,10 +86,16 @@ func run() error {
 		return errors.New("could not find table")
 	}
 
+	alpha3s, numerics, err := otherCodes(codes)
+	if err != nil {
+		return err
+	}
+
 	out, err := os.Create("codes.go")
 	if err != nil {
 		return err
 	}
+	defer out.Close()
 
 	fmt.Fprintln(out, "package", os.Getenv("GOPACKAGE"))
 	fmt.Fprintln(out)
@@ -102,9 +120,161 @@ func run() error {
 	}
 	fmt.Fprintln(out, "}")
 
+	fmt.Fprintln(out)
+
+	fmt.Fprintln(out, "var alpha3Codes = map[string]Alpha3Code{")
+	for _, code := range codes {
+		if alpha3, ok := alpha3s[code.Code]; ok {
+			fmt.Fprintf(out, "\t%q: {Code: %q, Status: %d, Country: %q},\n", alpha3, alpha3, code.Status, code.Country)
+		}
+	}
+	fmt.Fprintln(out, "}")
+
+	fmt.Fprintln(out)
+
+	// sort by numeric code, for readability
+	byNumeric := make([]iso3166.Alpha2Code, 0, len(numerics))
+	for _, code := range codes {
+		if _, ok := numerics[code.Code]; ok {
+			byNumeric = append(byNumeric, code)
+		}
+	}
+	sort.SliceStable(byNumeric, func(i, j int) bool { return numerics[byNumeric[i].Code] < numerics[byNumeric[j].Code] })
+
+	fmt.Fprintln(out, "var numericCodes = map[string]NumericCode{")
+	for i, code := range byNumeric {
+		numeric := numerics[code.Code]
+
+		// if multiple reserved codes share the same numeric code, use the first
+		if i > 0 && numerics[byNumeric[i-1].Code] == numeric {
+			continue
+		}
+
+		fmt.Fprintf(out, "\t%q: {Code: %q, Status: %d, Country: %q},\n", numeric, numeric, code.Status, code.Country)
+	}
+	fmt.Fprintln(out, "}")
+
+	fmt.Fprintln(out)
+
+	fmt.Fprintln(out, "var alpha2ToAlpha3 = map[string]string{")
+	for _, code := range codes {
+		if alpha3, ok := alpha3s[code.Code]; ok {
+			fmt.Fprintf(out, "\t%q: %q,\n", code.Code, alpha3)
+		}
+	}
+	fmt.Fprintln(out, "}")
+
+	fmt.Fprintln(out)
+
+	fmt.Fprintln(out, "var alpha2ToNumeric = map[string]string{")
+	for _, code := range codes {
+		if numeric, ok := numerics[code.Code]; ok {
+			fmt.Fprintf(out, "\t%q: %q,\n", code.Code, numeric)
+		}
+	}
+	fmt.Fprintln(out, "}")
+
+	fmt.Fprintln(out)
+
+	fmt.Fprintln(out, "var alpha3ToAlpha2 = map[string]string{")
+	for _, code := range codes {
+		if alpha3, ok := alpha3s[code.Code]; ok {
+			fmt.Fprintf(out, "\t%q: %q,\n", alpha3, code.Code)
+		}
+	}
+	fmt.Fprintln(out, "}")
+
+	fmt.Fprintln(out)
+
+	fmt.Fprintln(out, "var numericToAlpha2 = map[string]string{")
+	for i, code := range byNumeric {
+		numeric := numerics[code.Code]
+		if i > 0 && numerics[byNumeric[i-1].Code] == numeric {
+			continue
+		}
+
+		fmt.Fprintf(out, "\t%q: %q,\n", numeric, code.Code)
+	}
+	fmt.Fprintln(out, "}")
+
 	return nil
 }
 
+// otherCodes returns the alpha-3 and numeric codes of the passed alpha-2
+// codes, indexed by their alpha-2 code.
+func otherCodes(codes []iso3166.Alpha2Code) (alpha3s, numerics map[string]string, err error) {
+	alpha3s = make(map[string]string, len(codes))
+	numerics = make(map[string]string, len(codes))
+
+	// the alpha-2 codes using each alpha-3 code, to detect duplicates
+	alpha3Users := make(map[string]string, len(codes))
+
+	// first pass: officially assigned codes, so that we know which numeric
+	// codes are in use
+	officialNumerics := make(map[string]string, len(codes))
+
+	for _, pass := range []func(iso3166.Status) bool{
+		func(s iso3166.Status) bool { return s == iso3166.OfficiallyAssigned },
+		func(s iso3166.Status) bool {
+			return s == iso3166.ExceptionallyReserved || s == iso3166.TransitionallyReserved
+		},
+	} {
+		for _, code := range codes {
+			if !pass(code.Status) {
+				continue
+			}
+
+			region, err := language.ParseRegion(code.Code)
+			if err != nil {
+				if code.Status == iso3166.OfficiallyAssigned {
+					return nil, nil, fmt.Errorf("%s: %w", code.Code, err)
+				}
+
+				continue
+			}
+
+			if alpha3 := region.ISO3(); !isUserAssignedAlpha3(alpha3) {
+				if user, ok := alpha3Users[alpha3]; ok {
+					return nil, nil, fmt.Errorf("%s: alpha-3 code %s is already used by %s", code.Code, alpha3, user)
+				}
+
+				alpha3s[code.Code] = alpha3
+				alpha3Users[alpha3] = code.Code
+			} else if code.Status == iso3166.OfficiallyAssigned {
+				return nil, nil, fmt.Errorf("%s: no alpha-3 code", code.Code)
+			}
+
+			// numeric codes 900 through 999 are user-assigned
+			if m49 := region.M49(); m49 > 0 && m49 < 900 {
+				numeric := fmt.Sprintf("%03d", m49)
+
+				if code.Status == iso3166.OfficiallyAssigned {
+					if user, ok := officialNumerics[numeric]; ok {
+						return nil, nil, fmt.Errorf("%s: numeric code %s is already used by %s", code.Code, numeric, user)
+					}
+
+					officialNumerics[numeric] = code.Code
+				} else if _, ok := officialNumerics[numeric]; ok {
+					continue
+				}
+
+				numerics[code.Code] = numeric
+			} else if code.Status == iso3166.OfficiallyAssigned {
+				return nil, nil, fmt.Errorf("%s: no numeric code", code.Code)
+			}
+		}
+	}
+
+	return alpha3s, numerics, nil
+}
+
+// isUserAssignedAlpha3 reports whether the passed alpha-3 code lies in one of
+// the ranges reserved for user-assigned codes, i.e. AAA-AAZ, QMA-QZZ,
+// XAA-XZZ, and ZZA-ZZZ.
+func isUserAssignedAlpha3(code string) bool {
+	return code[:2] == "AA" || (code[0] == 'Q' && code[1] >= 'M') || code[0] == 'X' || code[:2] == "ZZ"
+}
+
 func extractCountryCodesFromTable(tbody *html.Node) ([]iso3166.Alpha2Code, error) {
 	codes := make([]iso3166.Alpha2Code, 0, 26*26)
 
@@ -188,7 +358,7 @@ func statusFromClass(class string) (iso3166.Status, error) {
 	case "grs-status5":
 		return iso3166.IndeterminatelyReserved, nil
 	case "grs-status6":
-		return iso3166.IndeterminatelyReserved, nil
+		return iso3166.FormerlyAssigned, nil
 	default:
 		return 0, fmt.Errorf("unrecognized class %s", class)
 	}
@@ -0,0 +1,153 @@
+// Command iso3166-2 generates the ISO 3166-2 subdivision codes used by
+// package iso3166/subdivision.
+//
+// It reads the file iso_3166-2.json of the iso-codes project, which compiles
+// the subdivision codes from the ISO 3166-2 newsletters and online browsing
+// platform.
+//
+// Download the file at
+// https://salsa.debian.org/iso-codes-team/iso-codes/-/blob/main/data/iso_3166-2.json
+// to use this program.
+//
+// Changes published by ISO, but not yet part of the iso-codes release the file
+// was taken from, are applied from the lists of supplements and withdrawn
+// codes below.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/mavolin/standards/iso3166"
+)
+
+type subdivision struct {
+	Code   string `json:"code"`
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Parent string `json:"parent"`
+}
+
+// supplements are subdivisions that ISO has assigned, but that are missing
+// from iso_3166-2.json.
+// They are only added, if iso_3166-2.json doesn't contain them, so that
+// regenerating from a newer release replaces them with the iso-codes data.
+var supplements = []subdivision{
+	// ISO 3166-2 Online Browsing Platform, change of 2021-11-25
+	{Code: "FR-69M", Name: "Métropole de Lyon", Type: "Metropolitan collectivity with special status", Parent: "ARA"},
+	{Code: "FR-6AE", Name: "Alsace", Type: "European collectivity", Parent: "GES"},
+	{Code: "FR-75C", Name: "Paris", Type: "Metropolitan collectivity with special status", Parent: "IDF"},
+}
+
+// withdrawn are the codes that ISO has deleted, but that are still part of
+// iso_3166-2.json.
+// They are removed, if iso_3166-2.json contains them.
+var withdrawn = []string{
+	// ISO 3166-2 Online Browsing Platform, change of 2021-11-25, superseded
+	// by FR-75C
+	"FR-75",
+}
+
+func main() {
+	if err := run(); err != nil {
+		panic(err)
+	}
+}
+
+func run() error {
+	in, err := os.Open("iso_3166-2.json")
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	var data struct {
+		Subdivisions []subdivision `json:"3166-2"`
+	}
+	if err := json.NewDecoder(in).Decode(&data); err != nil {
+		return err
+	}
+
+	subdivisions := make(map[string]subdivision, len(data.Subdivisions)+len(supplements))
+	for _, s := range data.Subdivisions {
+		country, _, ok := strings.Cut(s.Code, "-")
+		if !ok {
+			return fmt.Errorf("%s: code has no country prefix", s.Code)
+		} else if _, err := iso3166.ParseAlpha2(country); err != nil {
+			return fmt.Errorf("%s: %w", s.Code, err)
+		}
+
+		// parents are either given as full code, or only by the part after
+		// the country code
+		if s.Parent != "" && !strings.Contains(s.Parent, "-") {
+			s.Parent = country + "-" + s.Parent
+		}
+
+		if _, ok := subdivisions[s.Code]; ok {
+			return fmt.Errorf("%s: duplicate code", s.Code)
+		}
+
+		subdivisions[s.Code] = s
+	}
+
+	for _, s := range supplements {
+		if _, ok := subdivisions[s.Code]; ok {
+			continue
+		}
+
+		if s.Parent != "" {
+			s.Parent = s.Code[:2] + "-" + s.Parent
+		}
+
+		subdivisions[s.Code] = s
+	}
+
+	for _, code := range withdrawn {
+		delete(subdivisions, code)
+	}
+
+	codes := make([]string, 0, len(subdivisions))
+	for code, s := range subdivisions {
+		if s.Parent != "" {
+			if _, ok := subdivisions[s.Parent]; !ok {
+				return fmt.Errorf("%s: unknown parent %s", code, s.Parent)
+			}
+		}
+
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	// the codes are of different lengths, so let gofmt align the entries
+	out := new(bytes.Buffer)
+
+	fmt.Fprintln(out, "package", os.Getenv("GOPACKAGE"))
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "import \"github.com/mavolin/standards/iso3166\"")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "// Code generated by tools/codegen/iso3166-2. DO NOT EDIT.")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "var subdivisions = map[string]Subdivision{")
+	for _, code := range codes {
+		s := subdivisions[code]
+
+		fmt.Fprintf(out, "\t%q: {Code: %q, Country: iso3166.%s, Name: %q, Category: %q", s.Code, s.Code, s.Code[:2], s.Name, s.Type)
+		if s.Parent != "" {
+			fmt.Fprintf(out, ", ParentCode: %q", s.Parent)
+		}
+		fmt.Fprintln(out, "},")
+	}
+	fmt.Fprintln(out, "}")
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile("subdivisions.go", src, 0o644)
+}
@@ -0,0 +1,166 @@
+// Command iso3166-3 generates the ISO 3166-3 codes for formerly used country
+// names used by package iso3166.
+//
+// It reads the file iso_3166-3.json of the iso-codes project, which compiles
+// the codes from the ISO 3166 newsletters and online browsing platform.
+//
+// Download the file at
+// https://salsa.debian.org/iso-codes-team/iso-codes/-/blob/main/data/iso_3166-3.json
+// to use this program.
+//
+// The last two letters of an ISO 3166-3 code are the alpha-2 code of the
+// successor, if there is a single one.
+// For all other codes, the successors are defined in successorOverrides.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mavolin/standards/iso3166"
+)
+
+type formerCountry struct {
+	Alpha2         string `json:"alpha_2"`
+	Alpha3         string `json:"alpha_3"`
+	Alpha4         string `json:"alpha_4"`
+	Name           string `json:"name"`
+	Numeric        string `json:"numeric"`
+	WithdrawalDate string `json:"withdrawal_date"`
+}
+
+// successorOverrides are the successors of the codes whose last two letters
+// are not the alpha-2 code of their single successor, e.g. "HH" for
+// countries that were divided.
+var successorOverrides = map[string][]string{
+	"ANHH": {"BQ", "CW", "SX"},
+	"BYAA": {"BY"},
+	"CSHH": {"CZ", "SK"},
+	"CSXX": {"ME", "RS"},
+	"FQHH": {"AQ", "TF"},
+	"GEHH": {"KI", "TV"},
+	"NTHH": {"IQ", "SA"},
+	"PCHH": {"FM", "MH", "MP", "PW"},
+	"SUHH": {"AM", "AZ", "EE", "GE", "KG", "KZ", "LT", "LV", "MD", "RU", "TJ", "TM", "UZ"},
+}
+
+// validFromOverrides are the dates from which the codes that reused the
+// alpha-2 code of another formerly used country name were valid.
+// All other codes are assumed to have been valid since the first edition of
+// ISO 3166.
+var validFromOverrides = map[string]string{
+	// CS was reassigned from Czechoslovakia to Serbia and Montenegro, when
+	// Yugoslavia (YUCS) was renamed
+	"CSXX": "2003-07-23",
+}
+
+func main() {
+	if err := run(); err != nil {
+		panic(err)
+	}
+}
+
+func run() error {
+	in, err := os.Open("iso_3166-3.json")
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	var data struct {
+		Countries []formerCountry `json:"3166-3"`
+	}
+	if err := json.NewDecoder(in).Decode(&data); err != nil {
+		return err
+	}
+
+	sort.Slice(data.Countries, func(i, j int) bool { return data.Countries[i].Alpha4 < data.Countries[j].Alpha4 })
+
+	out := new(bytes.Buffer)
+
+	fmt.Fprintln(out, "package", os.Getenv("GOPACKAGE"))
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "import \"time\"")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "// Code generated by tools/codegen/iso3166-3. DO NOT EDIT.")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "var alpha4Codes = map[string]Alpha4Code{")
+	for _, c := range data.Countries {
+		if len(c.Alpha4) != 4 || c.Alpha4[:2] != c.Alpha2 {
+			return fmt.Errorf("%s: invalid code for alpha-2 code %s", c.Alpha4, c.Alpha2)
+		}
+
+		successors, ok := successorOverrides[c.Alpha4]
+		if !ok {
+			successors = []string{c.Alpha4[2:]}
+		}
+
+		for _, s := range successors {
+			code, err := iso3166.ParseAlpha2(s)
+			if err != nil {
+				return fmt.Errorf("%s: successor %s: %w", c.Alpha4, s, err)
+			} else if code.Status == iso3166.Unassigned {
+				return fmt.Errorf("%s: successor %s is not assigned", c.Alpha4, s)
+			}
+		}
+
+		until, err := validUntil(c.WithdrawalDate)
+		if err != nil {
+			return fmt.Errorf("%s: %w", c.Alpha4, err)
+		}
+
+		fmt.Fprintf(out, "\t%q: {\n", c.Alpha4)
+		fmt.Fprintf(out, "\t\tCode: %q,\n", c.Alpha4)
+		fmt.Fprintf(out, "\t\tAlpha2: %s,\n", c.Alpha2)
+		fmt.Fprintf(out, "\t\tAlpha3: %q,\n", c.Alpha3)
+		if c.Numeric != "" {
+			fmt.Fprintf(out, "\t\tNumeric: %q,\n", c.Numeric)
+		}
+		fmt.Fprintf(out, "\t\tCountry: %q,\n", c.Name)
+		if from, ok := validFromOverrides[c.Alpha4]; ok {
+			fromDate, err := time.Parse("2006-01-02", from)
+			if err != nil {
+				return fmt.Errorf("%s: %w", c.Alpha4, err)
+			}
+
+			fmt.Fprintf(out, "\t\tValidFrom: time.Date(%d, %d, %d, 0, 0, 0, 0, time.UTC),\n",
+				fromDate.Year(), fromDate.Month(), fromDate.Day())
+		}
+		fmt.Fprintf(out, "\t\tValidUntil: time.Date(%d, %d, %d, 0, 0, 0, 0, time.UTC),\n",
+			until.Year(), until.Month(), until.Day())
+		fmt.Fprintf(out, "\t\tSuccessors: []Alpha2Code{%s},\n", strings.Join(successors, ", "))
+		fmt.Fprintln(out, "\t},")
+	}
+	fmt.Fprintln(out, "}")
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile("alpha4_codes.go", src, 0o644)
+}
+
+// validUntil returns the exclusive end of the validity of a code withdrawn
+// at the passed date.
+//
+// If only the year of the withdrawal is known, validUntil returns the first
+// day of the following year.
+func validUntil(withdrawn string) (time.Time, error) {
+	if len(withdrawn) == 4 {
+		t, err := time.Parse("2006", withdrawn)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		return t.AddDate(1, 0, 0), nil
+	}
+
+	return time.Parse("2006-01-02", withdrawn)
+}
@@ -0,0 +1,255 @@
+// Command iso3166_info generates the country information used by
+// [iso3166.Alpha2Code.Info].
+//
+// It reads the file calling_codes.csv, which contains the E.164 calling code
+// of each country in the format
+//
+//	country;calling code
+//
+// Lines starting with '#' are ignored.
+//
+// Currencies and continents are taken from the CLDR data of
+// golang.org/x/text.
+// As that data may lag behind, currencyOverrides and continentOverrides
+// correct it where necessary.
+// The SEPA membership is taken from package iban, and the remaining
+// information is defined in this file.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"sort"
+	"strings"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+
+	"github.com/mavolin/standards/iban"
+	"github.com/mavolin/standards/iso3166"
+)
+
+// currencyOverrides are the currencies that changed since the release of the
+// CLDR data of golang.org/x/text.
+// An empty string means the country has no currency of its own.
+var currencyOverrides = map[string]string{
+	"AQ": "",
+	"BG": "EUR", // adopted on 2026-01-01
+	"CW": "XCG", // Caribbean guilder, replaced ANG on 2025-03-31
+	"HR": "EUR", // adopted on 2023-01-01
+	"MR": "MRU", // redenominated on 2018-01-01
+	"SL": "SLE", // redenominated on 2022-07-01
+	"SX": "XCG", // Caribbean guilder, replaced ANG on 2025-03-31
+	"VE": "VES", // redenominated on 2018-08-20
+	"ZW": "ZWG", // Zimbabwe Gold, introduced on 2024-04-05
+}
+
+// continents maps the UN M49 regions to the continents they belong to.
+var continents = map[string]string{
+	"002": "Africa",
+	"005": "SouthAmerica",
+	"009": "Oceania",
+	"013": "NorthAmerica", // Central America
+	"021": "NorthAmerica", // Northern America
+	"029": "NorthAmerica", // Caribbean
+	"142": "Asia",
+	"150": "Europe",
+}
+
+// continentOverrides are the continents of the countries that CLDR doesn't
+// assign to a continent, or assigns to "Outlying Oceania".
+var continentOverrides = map[string]string{
+	"AQ": "Antarctica",
+	"BV": "Antarctica",
+	"GS": "Antarctica",
+	"HM": "Antarctica",
+	"IO": "Asia",
+	"TF": "Antarctica",
+}
+
+// tldOverrides are the ccTLDs that don't match the alpha-2 code.
+// An empty string means that no ccTLD is delegated for the country.
+var tldOverrides = map[string]string{
+	"BL": "",
+	"BQ": "",
+	"EH": "",
+	"GB": ".uk",
+	"MF": "",
+	"UM": "",
+	"XK": "",
+}
+
+// Member states of the European Union, and the European Economic Area.
+// Territories with an alpha-2 code of their own, e.g. GP, are not listed.
+var (
+	eu = []string{
+		"AT", "BE", "BG", "CY", "CZ", "DE", "DK", "EE", "ES", "FI", "FR", "GR", "HR", "HU", "IE", "IT", "LT", "LU",
+		"LV", "MT", "NL", "PL", "PT", "RO", "SE", "SI", "SK",
+	}
+	eea      = append([]string{"IS", "LI", "NO"}, eu...)
+	eurozone = []string{
+		"AT", "BE", "BG", "CY", "DE", "EE", "ES", "FI", "FR", "GR", "HR", "IE", "IT", "LT", "LU", "LV", "MT", "NL",
+		"PT", "SI", "SK",
+	}
+)
+
+type info struct {
+	callingCode string
+	currency    string
+	tld         string
+	continent   string
+	eu          bool
+	eea         bool
+	eurozone    bool
+	sepa        bool
+}
+
+func main() {
+	if err := run(); err != nil {
+		panic(err)
+	}
+}
+
+func run() error {
+	callingCodes, err := readCallingCodes()
+	if err != nil {
+		return err
+	}
+
+	sepaCountries := iban.SEPACountries()
+	sepa := make([]string, 0, len(sepaCountries))
+	for _, country := range sepaCountries {
+		sepa = append(sepa, country.Code)
+	}
+
+	codes := make([]string, 0, len(callingCodes))
+	infos := make(map[string]info, len(callingCodes))
+	for code, callingCode := range callingCodes {
+		region, err := language.ParseRegion(code)
+		if err != nil {
+			return fmt.Errorf("%s: %w", code, err)
+		}
+
+		i := info{
+			callingCode: callingCode,
+			tld:         "." + strings.ToLower(code),
+			eu:          contains(eu, code),
+			eea:         contains(eea, code),
+			eurozone:    contains(eurozone, code),
+			sepa:        contains(sepa, code),
+		}
+
+		if cur, ok := currencyOverrides[code]; ok {
+			i.currency = cur
+		} else if unit, ok := currency.FromRegion(region); ok {
+			i.currency = unit.String()
+		} else {
+			return fmt.Errorf("%s: no currency", code)
+		}
+
+		if continent, ok := continentOverrides[code]; ok {
+			i.continent = continent
+		} else {
+			for m49, continent := range continents {
+				if language.MustParseRegion(m49).Contains(region) {
+					i.continent = continent
+					break
+				}
+			}
+
+			if i.continent == "" {
+				return fmt.Errorf("%s: no continent", code)
+			}
+		}
+
+		if tld, ok := tldOverrides[code]; ok {
+			i.tld = tld
+		}
+
+		codes = append(codes, code)
+		infos[code] = i
+	}
+	sort.Strings(codes)
+
+	out := new(bytes.Buffer)
+
+	fmt.Fprintln(out, "package", os.Getenv("GOPACKAGE"))
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "// Code generated by tools/codegen/iso3166_info. DO NOT EDIT.")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "var infos = map[string]Info{")
+	for _, code := range codes {
+		i := infos[code]
+
+		fmt.Fprintf(out, "\t%q: {CallingCode: %q, Currency: %q, TLD: %q, Continent: %s",
+			code, i.callingCode, i.currency, i.tld, i.continent)
+		if i.eu {
+			fmt.Fprint(out, ", EU: true")
+		}
+		if i.eea {
+			fmt.Fprint(out, ", EEA: true")
+		}
+		if i.eurozone {
+			fmt.Fprint(out, ", Eurozone: true")
+		}
+		if i.sepa {
+			fmt.Fprint(out, ", SEPA: true")
+		}
+		fmt.Fprintln(out, "},")
+	}
+	fmt.Fprintln(out, "}")
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile("info.go", src, 0o644)
+}
+
+func readCallingCodes() (map[string]string, error) {
+	in, err := os.Open("calling_codes.csv")
+	if err != nil {
+		return nil, err
+	}
+	defer in.Close()
+
+	callingCodes := make(map[string]string)
+
+	s := bufio.NewScanner(in)
+	for line := 1; s.Scan(); line++ {
+		text := strings.TrimSpace(s.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields := strings.Split(text, ";")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("line %d: expected 2 fields, but got %d", line, len(fields))
+		}
+
+		code, err := iso3166.ParseAlpha2(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		} else if _, ok := callingCodes[code.Code]; ok {
+			return nil, fmt.Errorf("line %d: duplicate country %s", line, code.Code)
+		}
+
+		callingCodes[code.Code] = fields[1]
+	}
+
+	return callingCodes, s.Err()
+}
+
+func contains(codes []string, code string) bool {
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+
+	return false
+}
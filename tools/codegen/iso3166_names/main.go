@@ -0,0 +1,96 @@
+// Command iso3166_names generates the localized country names used by
+// package iso3166.
+//
+// The names are taken from the CLDR data of golang.org/x/text, and are
+// generated for all officially assigned alpha-2 codes, and for XK (Kosovo).
+// Unlike ISO's short names, the CLDR names are the names commonly used in
+// the respective language, e.g. "South Korea" instead of
+// "Korea (the Republic of)".
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"sort"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
+
+	"github.com/mavolin/standards/iso3166"
+)
+
+// languages are the languages names are generated for, in the order they are
+// stored in.
+//
+// The first language is used as fallback, if none of the others match.
+// Keep in sync with nameLanguages in package iso3166.
+var languages = []language.Tag{
+	language.English,
+	language.German,
+	language.French,
+	language.Spanish,
+	language.Italian,
+}
+
+func main() {
+	if err := run(); err != nil {
+		panic(err)
+	}
+}
+
+func run() error {
+	var codes []iso3166.Alpha2Code
+	for a := 'A'; a <= 'Z'; a++ {
+		for b := 'A'; b <= 'Z'; b++ {
+			code, err := iso3166.ParseAlpha2(string(a) + string(b))
+			if err != nil {
+				return err
+			}
+
+			if code.Status == iso3166.OfficiallyAssigned || code == iso3166.XK {
+				codes = append(codes, code)
+			}
+		}
+	}
+	sort.Slice(codes, func(i, j int) bool { return codes[i].Code < codes[j].Code })
+
+	out := new(bytes.Buffer)
+
+	fmt.Fprintln(out, "package", os.Getenv("GOPACKAGE"))
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "// Code generated by tools/codegen/iso3166_names. DO NOT EDIT.")
+	fmt.Fprintln(out)
+	fmt.Fprintf(out, "var names = map[string][%d]string{\n", len(languages))
+	for _, code := range codes {
+		region, err := language.ParseRegion(code.Code)
+		if err != nil {
+			return fmt.Errorf("%s: %w", code.Code, err)
+		} else if region.Canonicalize() != region {
+			return fmt.Errorf("%s: CLDR considers the code deprecated", code.Code)
+		}
+
+		fmt.Fprintf(out, "\t%q: {", code.Code)
+		for i, lang := range languages {
+			name := display.Regions(lang).Name(region)
+			if name == "" {
+				return fmt.Errorf("%s: no name in %s", code.Code, lang)
+			}
+
+			if i > 0 {
+				fmt.Fprint(out, ", ")
+			}
+			fmt.Fprintf(out, "%q", name)
+		}
+		fmt.Fprintln(out, "},")
+	}
+	fmt.Fprintln(out, "}")
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile("names.go", src, 0o644)
+}